@@ -20,6 +20,8 @@ var (
 	fd_Module_base_minimum_deposit                  protoreflect.FieldDescriptor
 	fd_Module_max_add_liquidity_slippage_percentage protoreflect.FieldDescriptor
 	fd_Module_stableswap                            protoreflect.FieldDescriptor
+	fd_Module_fee_conversion                        protoreflect.FieldDescriptor
+	fd_Module_max_affiliate_fee_bps                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +32,8 @@ func init() {
 	fd_Module_base_minimum_deposit = md_Module.Fields().ByName("base_minimum_deposit")
 	fd_Module_max_add_liquidity_slippage_percentage = md_Module.Fields().ByName("max_add_liquidity_slippage_percentage")
 	fd_Module_stableswap = md_Module.Fields().ByName("stableswap")
+	fd_Module_fee_conversion = md_Module.Fields().ByName("fee_conversion")
+	fd_Module_max_affiliate_fee_bps = md_Module.Fields().ByName("max_affiliate_fee_bps")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -127,6 +131,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.FeeConversion != nil {
+		value := protoreflect.ValueOfMessage(x.FeeConversion.ProtoReflect())
+		if !f(fd_Module_fee_conversion, value) {
+			return
+		}
+	}
+	if x.MaxAffiliateFeeBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxAffiliateFeeBps)
+		if !f(fd_Module_max_affiliate_fee_bps, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -152,6 +168,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxAddLiquiditySlippagePercentage != int64(0)
 	case "noble.swap.module.v1.Module.stableswap":
 		return x.Stableswap != nil
+	case "noble.swap.module.v1.Module.fee_conversion":
+		return x.FeeConversion != nil
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		return x.MaxAffiliateFeeBps != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -178,6 +198,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxAddLiquiditySlippagePercentage = int64(0)
 	case "noble.swap.module.v1.Module.stableswap":
 		x.Stableswap = nil
+	case "noble.swap.module.v1.Module.fee_conversion":
+		x.FeeConversion = nil
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		x.MaxAffiliateFeeBps = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -209,6 +233,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "noble.swap.module.v1.Module.stableswap":
 		value := x.Stableswap
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.module.v1.Module.fee_conversion":
+		value := x.FeeConversion
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		value := x.MaxAffiliateFeeBps
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -239,6 +269,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxAddLiquiditySlippagePercentage = value.Int()
 	case "noble.swap.module.v1.Module.stableswap":
 		x.Stableswap = value.Message().Interface().(*StableSwap)
+	case "noble.swap.module.v1.Module.fee_conversion":
+		x.FeeConversion = value.Message().Interface().(*FeeConversion)
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		x.MaxAffiliateFeeBps = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -264,6 +298,11 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.Stableswap = new(StableSwap)
 		}
 		return protoreflect.ValueOfMessage(x.Stableswap.ProtoReflect())
+	case "noble.swap.module.v1.Module.fee_conversion":
+		if x.FeeConversion == nil {
+			x.FeeConversion = new(FeeConversion)
+		}
+		return protoreflect.ValueOfMessage(x.FeeConversion.ProtoReflect())
 	case "noble.swap.module.v1.Module.authority":
 		panic(fmt.Errorf("field authority of message noble.swap.module.v1.Module is not mutable"))
 	case "noble.swap.module.v1.Module.base_denom":
@@ -272,6 +311,8 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field base_minimum_deposit of message noble.swap.module.v1.Module is not mutable"))
 	case "noble.swap.module.v1.Module.max_add_liquidity_slippage_percentage":
 		panic(fmt.Errorf("field max_add_liquidity_slippage_percentage of message noble.swap.module.v1.Module is not mutable"))
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		panic(fmt.Errorf("field max_affiliate_fee_bps of message noble.swap.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -296,6 +337,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 	case "noble.swap.module.v1.Module.stableswap":
 		m := new(StableSwap)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.module.v1.Module.fee_conversion":
+		m := new(FeeConversion)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.module.v1.Module.max_affiliate_fee_bps":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.Module"))
@@ -383,6 +429,13 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Stableswap)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.FeeConversion != nil {
+			l = options.Size(x.FeeConversion)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAffiliateFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAffiliateFeeBps))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -412,6 +465,25 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxAffiliateFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAffiliateFeeBps))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FeeConversion != nil {
+			encoded, err := options.Marshal(x.FeeConversion)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if x.Stableswap != nil {
 			encoded, err := options.Marshal(x.Stableswap)
 			if err != nil {
@@ -637,6 +709,61 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeConversion", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeConversion == nil {
+					x.FeeConversion = &FeeConversion{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeConversion); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAffiliateFeeBps", wireType)
+				}
+				x.MaxAffiliateFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAffiliateFeeBps |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -673,14 +800,16 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_StableSwap                       protoreflect.MessageDescriptor
-	fd_StableSwap_unbonding_block_delta protoreflect.FieldDescriptor
+	md_StableSwap                         protoreflect.MessageDescriptor
+	fd_StableSwap_unbonding_block_delta   protoreflect.FieldDescriptor
+	fd_StableSwap_compounding_block_delta protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_swap_module_v1_module_proto_init()
 	md_StableSwap = File_noble_swap_module_v1_module_proto.Messages().ByName("StableSwap")
 	fd_StableSwap_unbonding_block_delta = md_StableSwap.Fields().ByName("unbonding_block_delta")
+	fd_StableSwap_compounding_block_delta = md_StableSwap.Fields().ByName("compounding_block_delta")
 }
 
 var _ protoreflect.Message = (*fastReflection_StableSwap)(nil)
@@ -754,6 +883,12 @@ func (x *fastReflection_StableSwap) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.CompoundingBlockDelta != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CompoundingBlockDelta)
+		if !f(fd_StableSwap_compounding_block_delta, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -771,6 +906,8 @@ func (x *fastReflection_StableSwap) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		return x.UnbondingBlockDelta != int64(0)
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		return x.CompoundingBlockDelta != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -789,6 +926,8 @@ func (x *fastReflection_StableSwap) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		x.UnbondingBlockDelta = int64(0)
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		x.CompoundingBlockDelta = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -808,6 +947,9 @@ func (x *fastReflection_StableSwap) Get(descriptor protoreflect.FieldDescriptor)
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		value := x.UnbondingBlockDelta
 		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		value := x.CompoundingBlockDelta
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -830,6 +972,8 @@ func (x *fastReflection_StableSwap) Set(fd protoreflect.FieldDescriptor, value p
 	switch fd.FullName() {
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		x.UnbondingBlockDelta = value.Int()
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		x.CompoundingBlockDelta = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -852,6 +996,8 @@ func (x *fastReflection_StableSwap) Mutable(fd protoreflect.FieldDescriptor) pro
 	switch fd.FullName() {
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		panic(fmt.Errorf("field unbonding_block_delta of message noble.swap.module.v1.StableSwap is not mutable"))
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		panic(fmt.Errorf("field compounding_block_delta of message noble.swap.module.v1.StableSwap is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -867,6 +1013,8 @@ func (x *fastReflection_StableSwap) NewField(fd protoreflect.FieldDescriptor) pr
 	switch fd.FullName() {
 	case "noble.swap.module.v1.StableSwap.unbonding_block_delta":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.module.v1.StableSwap.compounding_block_delta":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.StableSwap"))
@@ -939,6 +1087,9 @@ func (x *fastReflection_StableSwap) ProtoMethods() *protoiface.Methods {
 		if x.UnbondingBlockDelta != 0 {
 			n += 1 + runtime.Sov(uint64(x.UnbondingBlockDelta))
 		}
+		if x.CompoundingBlockDelta != 0 {
+			n += 1 + runtime.Sov(uint64(x.CompoundingBlockDelta))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -968,6 +1119,11 @@ func (x *fastReflection_StableSwap) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.CompoundingBlockDelta != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CompoundingBlockDelta))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.UnbondingBlockDelta != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingBlockDelta))
 			i--
@@ -1041,6 +1197,25 @@ func (x *fastReflection_StableSwap) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompoundingBlockDelta", wireType)
+				}
+				x.CompoundingBlockDelta = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CompoundingBlockDelta |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1076,126 +1251,727 @@ func (x *fastReflection_StableSwap) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: noble/swap/module/v1/module.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_FeeConversion                             protoreflect.MessageDescriptor
+	fd_FeeConversion_block_delta                 protoreflect.FieldDescriptor
+	fd_FeeConversion_max_price_impact_percentage protoreflect.FieldDescriptor
+	fd_FeeConversion_treasury                    protoreflect.FieldDescriptor
 )
 
-// Module is the config object of the Noble Swap module.
-type Module struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_noble_swap_module_v1_module_proto_init()
+	md_FeeConversion = File_noble_swap_module_v1_module_proto.Messages().ByName("FeeConversion")
+	fd_FeeConversion_block_delta = md_FeeConversion.Fields().ByName("block_delta")
+	fd_FeeConversion_max_price_impact_percentage = md_FeeConversion.Fields().ByName("max_price_impact_percentage")
+	fd_FeeConversion_treasury = md_FeeConversion.Fields().ByName("treasury")
+}
 
-	// authority defines the custom module authority.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// base_denom defines the base denom that is used as the standard pairing denomination for all liquidity pools.
-	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	// base_minimum_deposit specifies the minimum amount of the base token required for depositing into liquidity pools.
-	BaseMinimumDeposit int64 `protobuf:"varint,3,opt,name=base_minimum_deposit,json=baseMinimumDeposit,proto3" json:"base_minimum_deposit,omitempty"`
-	// max_add_liquidity_slippage_percentage specifies the maximum slippage a user may encounter when adding liquidity.
-	MaxAddLiquiditySlippagePercentage int64 `protobuf:"varint,4,opt,name=max_add_liquidity_slippage_percentage,json=maxAddLiquiditySlippagePercentage,proto3" json:"max_add_liquidity_slippage_percentage,omitempty"`
-	// stableswap contains the custom attributes and configurations required for the StableSwap module.
-	Stableswap *StableSwap `protobuf:"bytes,5,opt,name=stableswap,proto3" json:"stableswap,omitempty"`
+var _ protoreflect.Message = (*fastReflection_FeeConversion)(nil)
+
+type fastReflection_FeeConversion FeeConversion
+
+func (x *FeeConversion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeConversion)(x)
 }
 
-func (x *Module) Reset() {
-	*x = Module{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_module_v1_module_proto_msgTypes[0]
+func (x *FeeConversion) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_module_v1_module_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Module) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_FeeConversion_messageType fastReflection_FeeConversion_messageType
+var _ protoreflect.MessageType = fastReflection_FeeConversion_messageType{}
 
-func (*Module) ProtoMessage() {}
+type fastReflection_FeeConversion_messageType struct{}
 
-// Deprecated: Use Module.ProtoReflect.Descriptor instead.
-func (*Module) Descriptor() ([]byte, []int) {
-	return file_noble_swap_module_v1_module_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_FeeConversion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeConversion)(nil)
 }
-
-func (x *Module) GetAuthority() string {
-	if x != nil {
-		return x.Authority
-	}
-	return ""
+func (x fastReflection_FeeConversion_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeConversion)
 }
-
-func (x *Module) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
-	}
-	return ""
+func (x fastReflection_FeeConversion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeConversion
 }
 
-func (x *Module) GetBaseMinimumDeposit() int64 {
-	if x != nil {
-		return x.BaseMinimumDeposit
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeConversion) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeConversion
 }
 
-func (x *Module) GetMaxAddLiquiditySlippagePercentage() int64 {
-	if x != nil {
-		return x.MaxAddLiquiditySlippagePercentage
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeConversion) Type() protoreflect.MessageType {
+	return _fastReflection_FeeConversion_messageType
 }
 
-func (x *Module) GetStableswap() *StableSwap {
-	if x != nil {
-		return x.Stableswap
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeConversion) New() protoreflect.Message {
+	return new(fastReflection_FeeConversion)
 }
 
-type StableSwap struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// unbonding_block_delta defines the number of blocks between unbonding BeginBlocker executions.
-	UnbondingBlockDelta int64 `protobuf:"varint,1,opt,name=unbonding_block_delta,json=unbondingBlockDelta,proto3" json:"unbonding_block_delta,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeConversion) Interface() protoreflect.ProtoMessage {
+	return (*FeeConversion)(x)
 }
 
-func (x *StableSwap) Reset() {
-	*x = StableSwap{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_module_v1_module_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeConversion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockDelta != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockDelta)
+		if !f(fd_FeeConversion_block_delta, value) {
+			return
+		}
+	}
+	if x.MaxPriceImpactPercentage != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxPriceImpactPercentage)
+		if !f(fd_FeeConversion_max_price_impact_percentage, value) {
+			return
+		}
+	}
+	if x.Treasury != "" {
+		value := protoreflect.ValueOfString(x.Treasury)
+		if !f(fd_FeeConversion_treasury, value) {
+			return
+		}
 	}
 }
 
-func (x *StableSwap) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeConversion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		return x.BlockDelta != int64(0)
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		return x.MaxPriceImpactPercentage != int64(0)
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		return x.Treasury != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", fd.FullName()))
+	}
 }
 
-func (*StableSwap) ProtoMessage() {}
-
-// Deprecated: Use StableSwap.ProtoReflect.Descriptor instead.
-func (*StableSwap) Descriptor() ([]byte, []int) {
-	return file_noble_swap_module_v1_module_proto_rawDescGZIP(), []int{1}
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeConversion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		x.BlockDelta = int64(0)
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		x.MaxPriceImpactPercentage = int64(0)
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		x.Treasury = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *StableSwap) GetUnbondingBlockDelta() int64 {
-	if x != nil {
-		return x.UnbondingBlockDelta
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeConversion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		value := x.BlockDelta
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		value := x.MaxPriceImpactPercentage
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		value := x.Treasury
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", descriptor.FullName()))
 	}
-	return 0
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeConversion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		x.BlockDelta = value.Int()
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		x.MaxPriceImpactPercentage = value.Int()
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		x.Treasury = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeConversion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		panic(fmt.Errorf("field block_delta of message noble.swap.module.v1.FeeConversion is not mutable"))
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		panic(fmt.Errorf("field max_price_impact_percentage of message noble.swap.module.v1.FeeConversion is not mutable"))
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		panic(fmt.Errorf("field treasury of message noble.swap.module.v1.FeeConversion is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeConversion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.module.v1.FeeConversion.block_delta":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.module.v1.FeeConversion.max_price_impact_percentage":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.module.v1.FeeConversion.treasury":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.module.v1.FeeConversion"))
+		}
+		panic(fmt.Errorf("message noble.swap.module.v1.FeeConversion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeConversion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.module.v1.FeeConversion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeConversion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeConversion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeConversion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeConversion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeConversion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockDelta != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockDelta))
+		}
+		if x.MaxPriceImpactPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPriceImpactPercentage))
+		}
+		l = len(x.Treasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeConversion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Treasury) > 0 {
+			i -= len(x.Treasury)
+			copy(dAtA[i:], x.Treasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Treasury)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxPriceImpactPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPriceImpactPercentage))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BlockDelta != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockDelta))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeConversion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeConversion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockDelta", wireType)
+				}
+				x.BlockDelta = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockDelta |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpactPercentage", wireType)
+				}
+				x.MaxPriceImpactPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPriceImpactPercentage |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Treasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/module/v1/module.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Module is the config object of the Noble Swap module.
+type Module struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority defines the custom module authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// base_denom defines the base denom that is used as the standard pairing denomination for all liquidity pools.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// base_minimum_deposit specifies the initial minimum amount of the base token required for depositing into liquidity pools.
+	// It is used until the module params are set through genesis or MsgUpdateParams.
+	BaseMinimumDeposit int64 `protobuf:"varint,3,opt,name=base_minimum_deposit,json=baseMinimumDeposit,proto3" json:"base_minimum_deposit,omitempty"`
+	// max_add_liquidity_slippage_percentage specifies the initial maximum slippage a user may encounter when adding liquidity.
+	// It is used until the module params are set through genesis or MsgUpdateParams.
+	MaxAddLiquiditySlippagePercentage int64 `protobuf:"varint,4,opt,name=max_add_liquidity_slippage_percentage,json=maxAddLiquiditySlippagePercentage,proto3" json:"max_add_liquidity_slippage_percentage,omitempty"`
+	// stableswap contains the custom attributes and configurations required for the StableSwap module.
+	Stableswap *StableSwap `protobuf:"bytes,5,opt,name=stableswap,proto3" json:"stableswap,omitempty"`
+	// fee_conversion configures the conversion of the protocol fees into the base denom, which is disabled when unset.
+	FeeConversion *FeeConversion `protobuf:"bytes,6,opt,name=fee_conversion,json=feeConversion,proto3" json:"fee_conversion,omitempty"`
+	// max_affiliate_fee_bps defines the maximum affiliate fee, in basis points, that can be taken from the swaps output.
+	MaxAffiliateFeeBps int64 `protobuf:"varint,7,opt,name=max_affiliate_fee_bps,json=maxAffiliateFeeBps,proto3" json:"max_affiliate_fee_bps,omitempty"`
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_module_v1_module_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_noble_swap_module_v1_module_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Module) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *Module) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *Module) GetBaseMinimumDeposit() int64 {
+	if x != nil {
+		return x.BaseMinimumDeposit
+	}
+	return 0
+}
+
+func (x *Module) GetMaxAddLiquiditySlippagePercentage() int64 {
+	if x != nil {
+		return x.MaxAddLiquiditySlippagePercentage
+	}
+	return 0
+}
+
+func (x *Module) GetStableswap() *StableSwap {
+	if x != nil {
+		return x.Stableswap
+	}
+	return nil
+}
+
+func (x *Module) GetFeeConversion() *FeeConversion {
+	if x != nil {
+		return x.FeeConversion
+	}
+	return nil
+}
+
+func (x *Module) GetMaxAffiliateFeeBps() int64 {
+	if x != nil {
+		return x.MaxAffiliateFeeBps
+	}
+	return 0
+}
+
+type StableSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unbonding_block_delta defines the initial number of blocks between unbonding BeginBlocker executions.
+	// It is used until the module params are set through genesis or MsgUpdateParams.
+	UnbondingBlockDelta int64 `protobuf:"varint,1,opt,name=unbonding_block_delta,json=unbondingBlockDelta,proto3" json:"unbonding_block_delta,omitempty"`
+	// compounding_block_delta defines the number of blocks between auto-compounding BeginBlocker executions.
+	// It defaults to 100 when unset.
+	CompoundingBlockDelta int64 `protobuf:"varint,2,opt,name=compounding_block_delta,json=compoundingBlockDelta,proto3" json:"compounding_block_delta,omitempty"`
+}
+
+func (x *StableSwap) Reset() {
+	*x = StableSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_module_v1_module_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StableSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StableSwap) ProtoMessage() {}
+
+// Deprecated: Use StableSwap.ProtoReflect.Descriptor instead.
+func (*StableSwap) Descriptor() ([]byte, []int) {
+	return file_noble_swap_module_v1_module_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StableSwap) GetUnbondingBlockDelta() int64 {
+	if x != nil {
+		return x.UnbondingBlockDelta
+	}
+	return 0
+}
+
+func (x *StableSwap) GetCompoundingBlockDelta() int64 {
+	if x != nil {
+		return x.CompoundingBlockDelta
+	}
+	return 0
+}
+
+type FeeConversion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block_delta defines the number of blocks between protocol fees conversion BeginBlocker executions.
+	BlockDelta int64 `protobuf:"varint,1,opt,name=block_delta,json=blockDelta,proto3" json:"block_delta,omitempty"`
+	// max_price_impact_percentage defines the maximum price impact tolerated when converting the protocol fees.
+	MaxPriceImpactPercentage int64 `protobuf:"varint,2,opt,name=max_price_impact_percentage,json=maxPriceImpactPercentage,proto3" json:"max_price_impact_percentage,omitempty"`
+	// treasury defines the address receiving the converted protocol fees, which are burned when empty.
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+}
+
+func (x *FeeConversion) Reset() {
+	*x = FeeConversion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_module_v1_module_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeConversion) ProtoMessage() {}
+
+// Deprecated: Use FeeConversion.ProtoReflect.Descriptor instead.
+func (*FeeConversion) Descriptor() ([]byte, []int) {
+	return file_noble_swap_module_v1_module_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeeConversion) GetBlockDelta() int64 {
+	if x != nil {
+		return x.BlockDelta
+	}
+	return 0
+}
+
+func (x *FeeConversion) GetMaxPriceImpactPercentage() int64 {
+	if x != nil {
+		return x.MaxPriceImpactPercentage
+	}
+	return 0
+}
+
+func (x *FeeConversion) GetTreasury() string {
+	if x != nil {
+		return x.Treasury
+	}
+	return ""
 }
 
 var File_noble_swap_module_v1_module_proto protoreflect.FileDescriptor
@@ -1206,7 +1982,7 @@ var file_noble_swap_module_v1_module_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x02, 0x0a, 0x06,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x03, 0x0a, 0x06,
 	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
 	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e,
@@ -1223,26 +1999,46 @@ var file_noble_swap_module_v1_module_proto_rawDesc = []byte{
 	0x65, 0x73, 0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f,
 	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e,
 	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x0a, 0x73,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x16, 0xba, 0xc0, 0x96, 0xda, 0x01,
-	0x10, 0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79,
-	0x7a, 0x22, 0x40, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12,
-	0x32, 0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
-	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
-	0x6c, 0x74, 0x61, 0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x30, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x4d, 0xaa, 0x02, 0x14, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x53, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x14, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75,
-	0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
-	0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x12, 0x4a, 0x0a, 0x0e, 0x66, 0x65, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x66, 0x66,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a, 0x16, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x10,
+	0x0a, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a,
+	0x22, 0x78, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x32,
+	0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x46,
+	0x65, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x3d, 0x0a,
+	0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0xcc, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x4d, 0xaa, 0x02, 0x14, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70,
+	0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17,
+	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1257,18 +2053,20 @@ func file_noble_swap_module_v1_module_proto_rawDescGZIP() []byte {
 	return file_noble_swap_module_v1_module_proto_rawDescData
 }
 
-var file_noble_swap_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_noble_swap_module_v1_module_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_noble_swap_module_v1_module_proto_goTypes = []interface{}{
-	(*Module)(nil),     // 0: noble.swap.module.v1.Module
-	(*StableSwap)(nil), // 1: noble.swap.module.v1.StableSwap
+	(*Module)(nil),        // 0: noble.swap.module.v1.Module
+	(*StableSwap)(nil),    // 1: noble.swap.module.v1.StableSwap
+	(*FeeConversion)(nil), // 2: noble.swap.module.v1.FeeConversion
 }
 var file_noble_swap_module_v1_module_proto_depIdxs = []int32{
 	1, // 0: noble.swap.module.v1.Module.stableswap:type_name -> noble.swap.module.v1.StableSwap
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: noble.swap.module.v1.Module.fee_conversion:type_name -> noble.swap.module.v1.FeeConversion
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_module_v1_module_proto_init() }
@@ -1301,6 +2099,18 @@ func file_noble_swap_module_v1_module_proto_init() {
 				return nil
 			}
 		}
+		file_noble_swap_module_v1_module_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeConversion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1308,7 +2118,7 @@ func file_noble_swap_module_v1_module_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_swap_module_v1_module_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -68,6 +68,261 @@ func (x *_PoolCreated_10_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_PoolCreated_11_list)(nil)
+
+type _PoolCreated_11_list struct {
+	list *[]*UnbondingTier
+}
+
+func (x *_PoolCreated_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolCreated_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolCreated_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolCreated_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolCreated_11_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolCreated_11_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolCreated_12_list)(nil)
+
+type _PoolCreated_12_list struct {
+	list *[]*ExitFeeTier
+}
+
+func (x *_PoolCreated_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolCreated_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolCreated_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolCreated_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolCreated_12_list) AppendMutable() protoreflect.Value {
+	v := new(ExitFeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolCreated_12_list) NewElement() protoreflect.Value {
+	v := new(ExitFeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolCreated_13_list)(nil)
+
+type _PoolCreated_13_list struct {
+	list *[]*LockTier
+}
+
+func (x *_PoolCreated_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolCreated_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolCreated_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolCreated_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolCreated_13_list) AppendMutable() protoreflect.Value {
+	v := new(LockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolCreated_13_list) NewElement() protoreflect.Value {
+	v := new(LockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolCreated_14_list)(nil)
+
+type _PoolCreated_14_list struct {
+	list *[]*FeeSplit
+}
+
+func (x *_PoolCreated_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolCreated_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolCreated_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolCreated_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolCreated_14_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSplit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolCreated_14_list) NewElement() protoreflect.Value {
+	v := new(FeeSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolCreated_18_list)(nil)
+
+type _PoolCreated_18_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PoolCreated_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolCreated_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolCreated_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolCreated_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolCreated_18_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolCreated_18_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolCreated_18_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_PoolCreated                         protoreflect.MessageDescriptor
 	fd_PoolCreated_pool_id                 protoreflect.FieldDescriptor
@@ -80,6 +335,14 @@ var (
 	fd_PoolCreated_future_a                protoreflect.FieldDescriptor
 	fd_PoolCreated_future_a_time           protoreflect.FieldDescriptor
 	fd_PoolCreated_rate_multipliers        protoreflect.FieldDescriptor
+	fd_PoolCreated_unbonding_tiers         protoreflect.FieldDescriptor
+	fd_PoolCreated_exit_fee_tiers          protoreflect.FieldDescriptor
+	fd_PoolCreated_lock_tiers              protoreflect.FieldDescriptor
+	fd_PoolCreated_fee_splits              protoreflect.FieldDescriptor
+	fd_PoolCreated_dynamic_fee             protoreflect.FieldDescriptor
+	fd_PoolCreated_directional_fee         protoreflect.FieldDescriptor
+	fd_PoolCreated_creator                 protoreflect.FieldDescriptor
+	fd_PoolCreated_creation_fee            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -95,6 +358,14 @@ func init() {
 	fd_PoolCreated_future_a = md_PoolCreated.Fields().ByName("future_a")
 	fd_PoolCreated_future_a_time = md_PoolCreated.Fields().ByName("future_a_time")
 	fd_PoolCreated_rate_multipliers = md_PoolCreated.Fields().ByName("rate_multipliers")
+	fd_PoolCreated_unbonding_tiers = md_PoolCreated.Fields().ByName("unbonding_tiers")
+	fd_PoolCreated_exit_fee_tiers = md_PoolCreated.Fields().ByName("exit_fee_tiers")
+	fd_PoolCreated_lock_tiers = md_PoolCreated.Fields().ByName("lock_tiers")
+	fd_PoolCreated_fee_splits = md_PoolCreated.Fields().ByName("fee_splits")
+	fd_PoolCreated_dynamic_fee = md_PoolCreated.Fields().ByName("dynamic_fee")
+	fd_PoolCreated_directional_fee = md_PoolCreated.Fields().ByName("directional_fee")
+	fd_PoolCreated_creator = md_PoolCreated.Fields().ByName("creator")
+	fd_PoolCreated_creation_fee = md_PoolCreated.Fields().ByName("creation_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_PoolCreated)(nil)
@@ -222,6 +493,54 @@ func (x *fastReflection_PoolCreated) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.UnbondingTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolCreated_11_list{list: &x.UnbondingTiers})
+		if !f(fd_PoolCreated_unbonding_tiers, value) {
+			return
+		}
+	}
+	if len(x.ExitFeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolCreated_12_list{list: &x.ExitFeeTiers})
+		if !f(fd_PoolCreated_exit_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.LockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolCreated_13_list{list: &x.LockTiers})
+		if !f(fd_PoolCreated_lock_tiers, value) {
+			return
+		}
+	}
+	if len(x.FeeSplits) != 0 {
+		value := protoreflect.ValueOfList(&_PoolCreated_14_list{list: &x.FeeSplits})
+		if !f(fd_PoolCreated_fee_splits, value) {
+			return
+		}
+	}
+	if x.DynamicFee != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+		if !f(fd_PoolCreated_dynamic_fee, value) {
+			return
+		}
+	}
+	if x.DirectionalFee != nil {
+		value := protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+		if !f(fd_PoolCreated_directional_fee, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_PoolCreated_creator, value) {
+			return
+		}
+	}
+	if len(x.CreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_PoolCreated_18_list{list: &x.CreationFee})
+		if !f(fd_PoolCreated_creation_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -257,6 +576,22 @@ func (x *fastReflection_PoolCreated) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.FutureATime != int64(0)
 	case "noble.swap.stableswap.v1.PoolCreated.rate_multipliers":
 		return len(x.RateMultipliers) != 0
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		return len(x.UnbondingTiers) != 0
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		return len(x.ExitFeeTiers) != 0
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		return len(x.LockTiers) != 0
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		return len(x.FeeSplits) != 0
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		return x.DynamicFee != nil
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		return x.DirectionalFee != nil
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		return x.Creator != ""
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		return len(x.CreationFee) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -293,6 +628,22 @@ func (x *fastReflection_PoolCreated) Clear(fd protoreflect.FieldDescriptor) {
 		x.FutureATime = int64(0)
 	case "noble.swap.stableswap.v1.PoolCreated.rate_multipliers":
 		x.RateMultipliers = nil
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		x.UnbondingTiers = nil
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		x.ExitFeeTiers = nil
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		x.LockTiers = nil
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		x.FeeSplits = nil
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		x.DynamicFee = nil
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		x.DirectionalFee = nil
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		x.Creator = ""
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		x.CreationFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -342,6 +693,45 @@ func (x *fastReflection_PoolCreated) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_PoolCreated_10_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		if len(x.UnbondingTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolCreated_11_list{})
+		}
+		listValue := &_PoolCreated_11_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		if len(x.ExitFeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolCreated_12_list{})
+		}
+		listValue := &_PoolCreated_12_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		if len(x.LockTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolCreated_13_list{})
+		}
+		listValue := &_PoolCreated_13_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		if len(x.FeeSplits) == 0 {
+			return protoreflect.ValueOfList(&_PoolCreated_14_list{})
+		}
+		listValue := &_PoolCreated_14_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		value := x.DynamicFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		value := x.DirectionalFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		if len(x.CreationFee) == 0 {
+			return protoreflect.ValueOfList(&_PoolCreated_18_list{})
+		}
+		listValue := &_PoolCreated_18_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -384,6 +774,32 @@ func (x *fastReflection_PoolCreated) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_PoolCreated_10_list)
 		x.RateMultipliers = *clv.list
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolCreated_11_list)
+		x.UnbondingTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolCreated_12_list)
+		x.ExitFeeTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolCreated_13_list)
+		x.LockTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		lv := value.List()
+		clv := lv.(*_PoolCreated_14_list)
+		x.FeeSplits = *clv.list
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		x.DynamicFee = value.Message().Interface().(*DynamicFee)
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		x.DirectionalFee = value.Message().Interface().(*DirectionalFee)
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		x.Creator = value.Interface().(string)
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		lv := value.List()
+		clv := lv.(*_PoolCreated_18_list)
+		x.CreationFee = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -410,6 +826,46 @@ func (x *fastReflection_PoolCreated) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_PoolCreated_10_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		if x.UnbondingTiers == nil {
+			x.UnbondingTiers = []*UnbondingTier{}
+		}
+		value := &_PoolCreated_11_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		if x.ExitFeeTiers == nil {
+			x.ExitFeeTiers = []*ExitFeeTier{}
+		}
+		value := &_PoolCreated_12_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		if x.LockTiers == nil {
+			x.LockTiers = []*LockTier{}
+		}
+		value := &_PoolCreated_13_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		if x.FeeSplits == nil {
+			x.FeeSplits = []*FeeSplit{}
+		}
+		value := &_PoolCreated_14_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		if x.DynamicFee == nil {
+			x.DynamicFee = new(DynamicFee)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		if x.DirectionalFee == nil {
+			x.DirectionalFee = new(DirectionalFee)
+		}
+		return protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		if x.CreationFee == nil {
+			x.CreationFee = []*v1beta1.Coin{}
+		}
+		value := &_PoolCreated_18_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(value)
 	case "noble.swap.stableswap.v1.PoolCreated.pool_id":
 		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.PoolCreated is not mutable"))
 	case "noble.swap.stableswap.v1.PoolCreated.algorithm":
@@ -428,6 +884,8 @@ func (x *fastReflection_PoolCreated) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field future_a of message noble.swap.stableswap.v1.PoolCreated is not mutable"))
 	case "noble.swap.stableswap.v1.PoolCreated.future_a_time":
 		panic(fmt.Errorf("field future_a_time of message noble.swap.stableswap.v1.PoolCreated is not mutable"))
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		panic(fmt.Errorf("field creator of message noble.swap.stableswap.v1.PoolCreated is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -462,6 +920,29 @@ func (x *fastReflection_PoolCreated) NewField(fd protoreflect.FieldDescriptor) p
 	case "noble.swap.stableswap.v1.PoolCreated.rate_multipliers":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_PoolCreated_10_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolCreated.unbonding_tiers":
+		list := []*UnbondingTier{}
+		return protoreflect.ValueOfList(&_PoolCreated_11_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers":
+		list := []*ExitFeeTier{}
+		return protoreflect.ValueOfList(&_PoolCreated_12_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolCreated.lock_tiers":
+		list := []*LockTier{}
+		return protoreflect.ValueOfList(&_PoolCreated_13_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolCreated.fee_splits":
+		list := []*FeeSplit{}
+		return protoreflect.ValueOfList(&_PoolCreated_14_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolCreated.dynamic_fee":
+		m := new(DynamicFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.directional_fee":
+		m := new(DirectionalFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolCreated.creator":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.PoolCreated.creation_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PoolCreated_18_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolCreated"))
@@ -566,15 +1047,57 @@ func (x *fastReflection_PoolCreated) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if len(x.UnbondingTiers) > 0 {
+			for _, e := range x.UnbondingTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if len(x.ExitFeeTiers) > 0 {
+			for _, e := range x.ExitFeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-	}
-
+		if len(x.LockTiers) > 0 {
+			for _, e := range x.LockTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSplits) > 0 {
+			for _, e := range x.FeeSplits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicFee != nil {
+			l = options.Size(x.DynamicFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DirectionalFee != nil {
+			l = options.Size(x.DirectionalFee)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CreationFee) > 0 {
+			for _, e := range x.CreationFee {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
 		x := input.Message.Interface().(*PoolCreated)
 		if x == nil {
@@ -595,6 +1118,127 @@ func (x *fastReflection_PoolCreated) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CreationFee) > 0 {
+			for iNdEx := len(x.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.DirectionalFee != nil {
+			encoded, err := options.Marshal(x.DirectionalFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.DynamicFee != nil {
+			encoded, err := options.Marshal(x.DynamicFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.FeeSplits) > 0 {
+			for iNdEx := len(x.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSplits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for iNdEx := len(x.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for iNdEx := len(x.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.UnbondingTiers) > 0 {
+			for iNdEx := len(x.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if len(x.RateMultipliers) > 0 {
 			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
@@ -940,142 +1584,632 @@ func (x *fastReflection_PoolCreated) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingTiers = append(x.UnbondingTiers, &UnbondingTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTiers[len(x.UnbondingTiers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ExitFeeTiers = append(x.ExitFeeTiers, &ExitFeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFeeTiers[len(x.ExitFeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_PoolUpdated_6_list)(nil)
-
-type _PoolUpdated_6_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_PoolUpdated_6_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_PoolUpdated_6_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_PoolUpdated_6_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_PoolUpdated_6_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_PoolUpdated_6_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_PoolUpdated_6_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_PoolUpdated_6_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_PoolUpdated_6_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_PoolUpdated                         protoreflect.MessageDescriptor
-	fd_PoolUpdated_pool_id                 protoreflect.FieldDescriptor
-	fd_PoolUpdated_protocol_fee_percentage protoreflect.FieldDescriptor
-	fd_PoolUpdated_rewards_fee             protoreflect.FieldDescriptor
-	fd_PoolUpdated_future_a                protoreflect.FieldDescriptor
-	fd_PoolUpdated_future_a_time           protoreflect.FieldDescriptor
-	fd_PoolUpdated_rate_multipliers        protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_noble_swap_stableswap_v1_events_proto_init()
-	md_PoolUpdated = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("PoolUpdated")
-	fd_PoolUpdated_pool_id = md_PoolUpdated.Fields().ByName("pool_id")
-	fd_PoolUpdated_protocol_fee_percentage = md_PoolUpdated.Fields().ByName("protocol_fee_percentage")
-	fd_PoolUpdated_rewards_fee = md_PoolUpdated.Fields().ByName("rewards_fee")
-	fd_PoolUpdated_future_a = md_PoolUpdated.Fields().ByName("future_a")
-	fd_PoolUpdated_future_a_time = md_PoolUpdated.Fields().ByName("future_a_time")
-	fd_PoolUpdated_rate_multipliers = md_PoolUpdated.Fields().ByName("rate_multipliers")
-}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTiers = append(x.LockTiers, &LockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockTiers[len(x.LockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSplits = append(x.FeeSplits, &FeeSplit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplits[len(x.FeeSplits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicFee == nil {
+					x.DynamicFee = &DynamicFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DirectionalFee == nil {
+					x.DirectionalFee = &DirectionalFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreationFee = append(x.CreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationFee[len(x.CreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-var _ protoreflect.Message = (*fastReflection_PoolUpdated)(nil)
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
 
-type fastReflection_PoolUpdated PoolUpdated
+var _ protoreflect.List = (*_PoolUpdated_6_list)(nil)
 
-func (x *PoolUpdated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PoolUpdated)(x)
+type _PoolUpdated_6_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *PoolUpdated) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_PoolUpdated_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return mi.MessageOf(x)
+	return len(*x.list)
 }
 
-var _fastReflection_PoolUpdated_messageType fastReflection_PoolUpdated_messageType
-var _ protoreflect.MessageType = fastReflection_PoolUpdated_messageType{}
-
-type fastReflection_PoolUpdated_messageType struct{}
+func (x *_PoolUpdated_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x fastReflection_PoolUpdated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PoolUpdated)(nil)
+func (x *_PoolUpdated_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
 }
-func (x fastReflection_PoolUpdated_messageType) New() protoreflect.Message {
+
+func (x *_PoolUpdated_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolUpdated_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolUpdated_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolUpdated_7_list)(nil)
+
+type _PoolUpdated_7_list struct {
+	list *[]*UnbondingTier
+}
+
+func (x *_PoolUpdated_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolUpdated_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolUpdated_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolUpdated_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolUpdated_7_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolUpdated_7_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolUpdated_8_list)(nil)
+
+type _PoolUpdated_8_list struct {
+	list *[]*ExitFeeTier
+}
+
+func (x *_PoolUpdated_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolUpdated_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolUpdated_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolUpdated_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolUpdated_8_list) AppendMutable() protoreflect.Value {
+	v := new(ExitFeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolUpdated_8_list) NewElement() protoreflect.Value {
+	v := new(ExitFeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolUpdated_9_list)(nil)
+
+type _PoolUpdated_9_list struct {
+	list *[]*LockTier
+}
+
+func (x *_PoolUpdated_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolUpdated_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolUpdated_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolUpdated_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolUpdated_9_list) AppendMutable() protoreflect.Value {
+	v := new(LockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolUpdated_9_list) NewElement() protoreflect.Value {
+	v := new(LockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PoolUpdated_10_list)(nil)
+
+type _PoolUpdated_10_list struct {
+	list *[]*FeeSplit
+}
+
+func (x *_PoolUpdated_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PoolUpdated_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PoolUpdated_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PoolUpdated_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PoolUpdated_10_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSplit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PoolUpdated_10_list) NewElement() protoreflect.Value {
+	v := new(FeeSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PoolUpdated_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PoolUpdated                         protoreflect.MessageDescriptor
+	fd_PoolUpdated_pool_id                 protoreflect.FieldDescriptor
+	fd_PoolUpdated_protocol_fee_percentage protoreflect.FieldDescriptor
+	fd_PoolUpdated_rewards_fee             protoreflect.FieldDescriptor
+	fd_PoolUpdated_future_a                protoreflect.FieldDescriptor
+	fd_PoolUpdated_future_a_time           protoreflect.FieldDescriptor
+	fd_PoolUpdated_rate_multipliers        protoreflect.FieldDescriptor
+	fd_PoolUpdated_unbonding_tiers         protoreflect.FieldDescriptor
+	fd_PoolUpdated_exit_fee_tiers          protoreflect.FieldDescriptor
+	fd_PoolUpdated_lock_tiers              protoreflect.FieldDescriptor
+	fd_PoolUpdated_fee_splits              protoreflect.FieldDescriptor
+	fd_PoolUpdated_dynamic_fee             protoreflect.FieldDescriptor
+	fd_PoolUpdated_directional_fee         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_PoolUpdated = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("PoolUpdated")
+	fd_PoolUpdated_pool_id = md_PoolUpdated.Fields().ByName("pool_id")
+	fd_PoolUpdated_protocol_fee_percentage = md_PoolUpdated.Fields().ByName("protocol_fee_percentage")
+	fd_PoolUpdated_rewards_fee = md_PoolUpdated.Fields().ByName("rewards_fee")
+	fd_PoolUpdated_future_a = md_PoolUpdated.Fields().ByName("future_a")
+	fd_PoolUpdated_future_a_time = md_PoolUpdated.Fields().ByName("future_a_time")
+	fd_PoolUpdated_rate_multipliers = md_PoolUpdated.Fields().ByName("rate_multipliers")
+	fd_PoolUpdated_unbonding_tiers = md_PoolUpdated.Fields().ByName("unbonding_tiers")
+	fd_PoolUpdated_exit_fee_tiers = md_PoolUpdated.Fields().ByName("exit_fee_tiers")
+	fd_PoolUpdated_lock_tiers = md_PoolUpdated.Fields().ByName("lock_tiers")
+	fd_PoolUpdated_fee_splits = md_PoolUpdated.Fields().ByName("fee_splits")
+	fd_PoolUpdated_dynamic_fee = md_PoolUpdated.Fields().ByName("dynamic_fee")
+	fd_PoolUpdated_directional_fee = md_PoolUpdated.Fields().ByName("directional_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolUpdated)(nil)
+
+type fastReflection_PoolUpdated PoolUpdated
+
+func (x *PoolUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolUpdated)(x)
+}
+
+func (x *PoolUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolUpdated_messageType fastReflection_PoolUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_PoolUpdated_messageType{}
+
+type fastReflection_PoolUpdated_messageType struct{}
+
+func (x fastReflection_PoolUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolUpdated)(nil)
+}
+func (x fastReflection_PoolUpdated_messageType) New() protoreflect.Message {
 	return new(fastReflection_PoolUpdated)
 }
 func (x fastReflection_PoolUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
@@ -1148,6 +2282,42 @@ func (x *fastReflection_PoolUpdated) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.UnbondingTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolUpdated_7_list{list: &x.UnbondingTiers})
+		if !f(fd_PoolUpdated_unbonding_tiers, value) {
+			return
+		}
+	}
+	if len(x.ExitFeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolUpdated_8_list{list: &x.ExitFeeTiers})
+		if !f(fd_PoolUpdated_exit_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.LockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_PoolUpdated_9_list{list: &x.LockTiers})
+		if !f(fd_PoolUpdated_lock_tiers, value) {
+			return
+		}
+	}
+	if len(x.FeeSplits) != 0 {
+		value := protoreflect.ValueOfList(&_PoolUpdated_10_list{list: &x.FeeSplits})
+		if !f(fd_PoolUpdated_fee_splits, value) {
+			return
+		}
+	}
+	if x.DynamicFee != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+		if !f(fd_PoolUpdated_dynamic_fee, value) {
+			return
+		}
+	}
+	if x.DirectionalFee != nil {
+		value := protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+		if !f(fd_PoolUpdated_directional_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1175,6 +2345,18 @@ func (x *fastReflection_PoolUpdated) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.FutureATime != int64(0)
 	case "noble.swap.stableswap.v1.PoolUpdated.rate_multipliers":
 		return len(x.RateMultipliers) != 0
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		return len(x.UnbondingTiers) != 0
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		return len(x.ExitFeeTiers) != 0
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		return len(x.LockTiers) != 0
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		return len(x.FeeSplits) != 0
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		return x.DynamicFee != nil
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		return x.DirectionalFee != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdated"))
@@ -1203,6 +2385,18 @@ func (x *fastReflection_PoolUpdated) Clear(fd protoreflect.FieldDescriptor) {
 		x.FutureATime = int64(0)
 	case "noble.swap.stableswap.v1.PoolUpdated.rate_multipliers":
 		x.RateMultipliers = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		x.UnbondingTiers = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		x.ExitFeeTiers = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		x.LockTiers = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		x.FeeSplits = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		x.DynamicFee = nil
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		x.DirectionalFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdated"))
@@ -1240,6 +2434,36 @@ func (x *fastReflection_PoolUpdated) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_PoolUpdated_6_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		if len(x.UnbondingTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolUpdated_7_list{})
+		}
+		listValue := &_PoolUpdated_7_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		if len(x.ExitFeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolUpdated_8_list{})
+		}
+		listValue := &_PoolUpdated_8_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		if len(x.LockTiers) == 0 {
+			return protoreflect.ValueOfList(&_PoolUpdated_9_list{})
+		}
+		listValue := &_PoolUpdated_9_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		if len(x.FeeSplits) == 0 {
+			return protoreflect.ValueOfList(&_PoolUpdated_10_list{})
+		}
+		listValue := &_PoolUpdated_10_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		value := x.DynamicFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		value := x.DirectionalFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdated"))
@@ -1274,6 +2498,26 @@ func (x *fastReflection_PoolUpdated) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_PoolUpdated_6_list)
 		x.RateMultipliers = *clv.list
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolUpdated_7_list)
+		x.UnbondingTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolUpdated_8_list)
+		x.ExitFeeTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		lv := value.List()
+		clv := lv.(*_PoolUpdated_9_list)
+		x.LockTiers = *clv.list
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		lv := value.List()
+		clv := lv.(*_PoolUpdated_10_list)
+		x.FeeSplits = *clv.list
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		x.DynamicFee = value.Message().Interface().(*DynamicFee)
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		x.DirectionalFee = value.Message().Interface().(*DirectionalFee)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdated"))
@@ -1300,7 +2544,41 @@ func (x *fastReflection_PoolUpdated) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_PoolUpdated_6_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(value)
-	case "noble.swap.stableswap.v1.PoolUpdated.pool_id":
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		if x.UnbondingTiers == nil {
+			x.UnbondingTiers = []*UnbondingTier{}
+		}
+		value := &_PoolUpdated_7_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		if x.ExitFeeTiers == nil {
+			x.ExitFeeTiers = []*ExitFeeTier{}
+		}
+		value := &_PoolUpdated_8_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		if x.LockTiers == nil {
+			x.LockTiers = []*LockTier{}
+		}
+		value := &_PoolUpdated_9_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		if x.FeeSplits == nil {
+			x.FeeSplits = []*FeeSplit{}
+		}
+		value := &_PoolUpdated_10_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		if x.DynamicFee == nil {
+			x.DynamicFee = new(DynamicFee)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		if x.DirectionalFee == nil {
+			x.DirectionalFee = new(DirectionalFee)
+		}
+		return protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolUpdated.pool_id":
 		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.PoolUpdated is not mutable"))
 	case "noble.swap.stableswap.v1.PoolUpdated.protocol_fee_percentage":
 		panic(fmt.Errorf("field protocol_fee_percentage of message noble.swap.stableswap.v1.PoolUpdated is not mutable"))
@@ -1336,6 +2614,24 @@ func (x *fastReflection_PoolUpdated) NewField(fd protoreflect.FieldDescriptor) p
 	case "noble.swap.stableswap.v1.PoolUpdated.rate_multipliers":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_PoolUpdated_6_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers":
+		list := []*UnbondingTier{}
+		return protoreflect.ValueOfList(&_PoolUpdated_7_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers":
+		list := []*ExitFeeTier{}
+		return protoreflect.ValueOfList(&_PoolUpdated_8_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolUpdated.lock_tiers":
+		list := []*LockTier{}
+		return protoreflect.ValueOfList(&_PoolUpdated_9_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolUpdated.fee_splits":
+		list := []*FeeSplit{}
+		return protoreflect.ValueOfList(&_PoolUpdated_10_list{list: &list})
+	case "noble.swap.stableswap.v1.PoolUpdated.dynamic_fee":
+		m := new(DynamicFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.PoolUpdated.directional_fee":
+		m := new(DirectionalFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdated"))
@@ -1426,6 +2722,38 @@ func (x *fastReflection_PoolUpdated) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.UnbondingTiers) > 0 {
+			for _, e := range x.UnbondingTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for _, e := range x.ExitFeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for _, e := range x.LockTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSplits) > 0 {
+			for _, e := range x.FeeSplits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicFee != nil {
+			l = options.Size(x.DynamicFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DirectionalFee != nil {
+			l = options.Size(x.DirectionalFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1455,6 +2783,98 @@ func (x *fastReflection_PoolUpdated) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DirectionalFee != nil {
+			encoded, err := options.Marshal(x.DirectionalFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.DynamicFee != nil {
+			encoded, err := options.Marshal(x.DynamicFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.FeeSplits) > 0 {
+			for iNdEx := len(x.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSplits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for iNdEx := len(x.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for iNdEx := len(x.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.UnbondingTiers) > 0 {
+			for iNdEx := len(x.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.RateMultipliers) > 0 {
 			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
@@ -1674,111 +3094,319 @@ func (x *fastReflection_PoolUpdated) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingTiers = append(x.UnbondingTiers, &UnbondingTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTiers[len(x.UnbondingTiers)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.ExitFeeTiers = append(x.ExitFeeTiers, &ExitFeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFeeTiers[len(x.ExitFeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_LiquidityAdded_3_list)(nil)
-
-type _LiquidityAdded_3_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_LiquidityAdded_3_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_LiquidityAdded_3_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_LiquidityAdded_3_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_LiquidityAdded_3_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_LiquidityAdded_3_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_LiquidityAdded_3_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_LiquidityAdded_3_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_LiquidityAdded_3_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_LiquidityAdded          protoreflect.MessageDescriptor
-	fd_LiquidityAdded_provider protoreflect.FieldDescriptor
-	fd_LiquidityAdded_pool_id  protoreflect.FieldDescriptor
-	fd_LiquidityAdded_amount   protoreflect.FieldDescriptor
-	fd_LiquidityAdded_shares   protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_noble_swap_stableswap_v1_events_proto_init()
-	md_LiquidityAdded = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("LiquidityAdded")
-	fd_LiquidityAdded_provider = md_LiquidityAdded.Fields().ByName("provider")
-	fd_LiquidityAdded_pool_id = md_LiquidityAdded.Fields().ByName("pool_id")
-	fd_LiquidityAdded_amount = md_LiquidityAdded.Fields().ByName("amount")
-	fd_LiquidityAdded_shares = md_LiquidityAdded.Fields().ByName("shares")
-}
-
-var _ protoreflect.Message = (*fastReflection_LiquidityAdded)(nil)
-
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTiers = append(x.LockTiers, &LockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockTiers[len(x.LockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSplits = append(x.FeeSplits, &FeeSplit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplits[len(x.FeeSplits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicFee == nil {
+					x.DynamicFee = &DynamicFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DirectionalFee == nil {
+					x.DirectionalFee = &DirectionalFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_LiquidityAdded_3_list)(nil)
+
+type _LiquidityAdded_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_LiquidityAdded_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_LiquidityAdded_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LiquidityAdded_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LiquidityAdded_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LiquidityAdded_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LiquidityAdded_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LiquidityAdded_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LiquidityAdded_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LiquidityAdded          protoreflect.MessageDescriptor
+	fd_LiquidityAdded_provider protoreflect.FieldDescriptor
+	fd_LiquidityAdded_pool_id  protoreflect.FieldDescriptor
+	fd_LiquidityAdded_amount   protoreflect.FieldDescriptor
+	fd_LiquidityAdded_shares   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_LiquidityAdded = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("LiquidityAdded")
+	fd_LiquidityAdded_provider = md_LiquidityAdded.Fields().ByName("provider")
+	fd_LiquidityAdded_pool_id = md_LiquidityAdded.Fields().ByName("pool_id")
+	fd_LiquidityAdded_amount = md_LiquidityAdded.Fields().ByName("amount")
+	fd_LiquidityAdded_shares = md_LiquidityAdded.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityAdded)(nil)
+
 type fastReflection_LiquidityAdded LiquidityAdded
 
 func (x *LiquidityAdded) ProtoReflect() protoreflect.Message {
@@ -2430,28 +4058,81 @@ func (x *_LiquidityRemoved_3_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_LiquidityRemoved             protoreflect.MessageDescriptor
-	fd_LiquidityRemoved_provider    protoreflect.FieldDescriptor
-	fd_LiquidityRemoved_pool_id     protoreflect.FieldDescriptor
-	fd_LiquidityRemoved_amount      protoreflect.FieldDescriptor
-	fd_LiquidityRemoved_shares      protoreflect.FieldDescriptor
-	fd_LiquidityRemoved_unlock_time protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_LiquidityRemoved_6_list)(nil)
 
-func init() {
-	file_noble_swap_stableswap_v1_events_proto_init()
-	md_LiquidityRemoved = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("LiquidityRemoved")
-	fd_LiquidityRemoved_provider = md_LiquidityRemoved.Fields().ByName("provider")
-	fd_LiquidityRemoved_pool_id = md_LiquidityRemoved.Fields().ByName("pool_id")
-	fd_LiquidityRemoved_amount = md_LiquidityRemoved.Fields().ByName("amount")
-	fd_LiquidityRemoved_shares = md_LiquidityRemoved.Fields().ByName("shares")
-	fd_LiquidityRemoved_unlock_time = md_LiquidityRemoved.Fields().ByName("unlock_time")
+type _LiquidityRemoved_6_list struct {
+	list *[]*v1beta1.Coin
 }
 
-var _ protoreflect.Message = (*fastReflection_LiquidityRemoved)(nil)
+func (x *_LiquidityRemoved_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_LiquidityRemoved LiquidityRemoved
+func (x *_LiquidityRemoved_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_LiquidityRemoved_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_LiquidityRemoved_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_LiquidityRemoved_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LiquidityRemoved_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_LiquidityRemoved_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_LiquidityRemoved_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_LiquidityRemoved             protoreflect.MessageDescriptor
+	fd_LiquidityRemoved_provider    protoreflect.FieldDescriptor
+	fd_LiquidityRemoved_pool_id     protoreflect.FieldDescriptor
+	fd_LiquidityRemoved_amount      protoreflect.FieldDescriptor
+	fd_LiquidityRemoved_shares      protoreflect.FieldDescriptor
+	fd_LiquidityRemoved_unlock_time protoreflect.FieldDescriptor
+	fd_LiquidityRemoved_exit_fee    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_LiquidityRemoved = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("LiquidityRemoved")
+	fd_LiquidityRemoved_provider = md_LiquidityRemoved.Fields().ByName("provider")
+	fd_LiquidityRemoved_pool_id = md_LiquidityRemoved.Fields().ByName("pool_id")
+	fd_LiquidityRemoved_amount = md_LiquidityRemoved.Fields().ByName("amount")
+	fd_LiquidityRemoved_shares = md_LiquidityRemoved.Fields().ByName("shares")
+	fd_LiquidityRemoved_unlock_time = md_LiquidityRemoved.Fields().ByName("unlock_time")
+	fd_LiquidityRemoved_exit_fee = md_LiquidityRemoved.Fields().ByName("exit_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityRemoved)(nil)
+
+type fastReflection_LiquidityRemoved LiquidityRemoved
 
 func (x *LiquidityRemoved) ProtoReflect() protoreflect.Message {
 	return (*fastReflection_LiquidityRemoved)(x)
@@ -2544,6 +4225,12 @@ func (x *fastReflection_LiquidityRemoved) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if len(x.ExitFee) != 0 {
+		value := protoreflect.ValueOfList(&_LiquidityRemoved_6_list{list: &x.ExitFee})
+		if !f(fd_LiquidityRemoved_exit_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2569,6 +4256,8 @@ func (x *fastReflection_LiquidityRemoved) Has(fd protoreflect.FieldDescriptor) b
 		return x.Shares != ""
 	case "noble.swap.stableswap.v1.LiquidityRemoved.unlock_time":
 		return x.UnlockTime != nil
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		return len(x.ExitFee) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LiquidityRemoved"))
@@ -2595,6 +4284,8 @@ func (x *fastReflection_LiquidityRemoved) Clear(fd protoreflect.FieldDescriptor)
 		x.Shares = ""
 	case "noble.swap.stableswap.v1.LiquidityRemoved.unlock_time":
 		x.UnlockTime = nil
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		x.ExitFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LiquidityRemoved"))
@@ -2629,6 +4320,12 @@ func (x *fastReflection_LiquidityRemoved) Get(descriptor protoreflect.FieldDescr
 	case "noble.swap.stableswap.v1.LiquidityRemoved.unlock_time":
 		value := x.UnlockTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		if len(x.ExitFee) == 0 {
+			return protoreflect.ValueOfList(&_LiquidityRemoved_6_list{})
+		}
+		listValue := &_LiquidityRemoved_6_list{list: &x.ExitFee}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LiquidityRemoved"))
@@ -2661,6 +4358,10 @@ func (x *fastReflection_LiquidityRemoved) Set(fd protoreflect.FieldDescriptor, v
 		x.Shares = value.Interface().(string)
 	case "noble.swap.stableswap.v1.LiquidityRemoved.unlock_time":
 		x.UnlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		lv := value.List()
+		clv := lv.(*_LiquidityRemoved_6_list)
+		x.ExitFee = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LiquidityRemoved"))
@@ -2692,6 +4393,12 @@ func (x *fastReflection_LiquidityRemoved) Mutable(fd protoreflect.FieldDescripto
 			x.UnlockTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.UnlockTime.ProtoReflect())
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		if x.ExitFee == nil {
+			x.ExitFee = []*v1beta1.Coin{}
+		}
+		value := &_LiquidityRemoved_6_list{list: &x.ExitFee}
+		return protoreflect.ValueOfList(value)
 	case "noble.swap.stableswap.v1.LiquidityRemoved.provider":
 		panic(fmt.Errorf("field provider of message noble.swap.stableswap.v1.LiquidityRemoved is not mutable"))
 	case "noble.swap.stableswap.v1.LiquidityRemoved.pool_id":
@@ -2723,6 +4430,9 @@ func (x *fastReflection_LiquidityRemoved) NewField(fd protoreflect.FieldDescript
 	case "noble.swap.stableswap.v1.LiquidityRemoved.unlock_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.LiquidityRemoved.exit_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_LiquidityRemoved_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LiquidityRemoved"))
@@ -2813,6 +4523,12 @@ func (x *fastReflection_LiquidityRemoved) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.UnlockTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ExitFee) > 0 {
+			for _, e := range x.ExitFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2842,6 +4558,22 @@ func (x *fastReflection_LiquidityRemoved) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExitFee) > 0 {
+			for iNdEx := len(x.ExitFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if x.UnlockTime != nil {
 			encoded, err := options.Marshal(x.UnlockTime)
 			if err != nil {
@@ -3093,6 +4825,40 @@ func (x *fastReflection_LiquidityRemoved) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExitFee = append(x.ExitFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFee[len(x.ExitFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3112,366 +4878,5458 @@ func (x *fastReflection_LiquidityRemoved) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PositionTransferred          protoreflect.MessageDescriptor
+	fd_PositionTransferred_pool_id  protoreflect.FieldDescriptor
+	fd_PositionTransferred_sender   protoreflect.FieldDescriptor
+	fd_PositionTransferred_receiver protoreflect.FieldDescriptor
+	fd_PositionTransferred_shares   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_PositionTransferred = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("PositionTransferred")
+	fd_PositionTransferred_pool_id = md_PositionTransferred.Fields().ByName("pool_id")
+	fd_PositionTransferred_sender = md_PositionTransferred.Fields().ByName("sender")
+	fd_PositionTransferred_receiver = md_PositionTransferred.Fields().ByName("receiver")
+	fd_PositionTransferred_shares = md_PositionTransferred.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_PositionTransferred)(nil)
+
+type fastReflection_PositionTransferred PositionTransferred
+
+func (x *PositionTransferred) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PositionTransferred)(x)
+}
+
+func (x *PositionTransferred) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PositionTransferred_messageType fastReflection_PositionTransferred_messageType
+var _ protoreflect.MessageType = fastReflection_PositionTransferred_messageType{}
+
+type fastReflection_PositionTransferred_messageType struct{}
+
+func (x fastReflection_PositionTransferred_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PositionTransferred)(nil)
+}
+func (x fastReflection_PositionTransferred_messageType) New() protoreflect.Message {
+	return new(fastReflection_PositionTransferred)
+}
+func (x fastReflection_PositionTransferred_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PositionTransferred
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PositionTransferred) Descriptor() protoreflect.MessageDescriptor {
+	return md_PositionTransferred
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PositionTransferred) Type() protoreflect.MessageType {
+	return _fastReflection_PositionTransferred_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PositionTransferred) New() protoreflect.Message {
+	return new(fastReflection_PositionTransferred)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PositionTransferred) Interface() protoreflect.ProtoMessage {
+	return (*PositionTransferred)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PositionTransferred) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_PositionTransferred_pool_id, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_PositionTransferred_sender, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_PositionTransferred_receiver, value) {
+			return
+		}
+	}
+	if x.Shares != "" {
+		value := protoreflect.ValueOfString(x.Shares)
+		if !f(fd_PositionTransferred_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PositionTransferred) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		return x.Sender != ""
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		return x.Receiver != ""
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		return x.Shares != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PositionTransferred) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		x.Sender = ""
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		x.Receiver = ""
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		x.Shares = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PositionTransferred) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		value := x.Shares
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PositionTransferred) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		x.Sender = value.Interface().(string)
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		x.Receiver = value.Interface().(string)
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		x.Shares = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PositionTransferred) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.PositionTransferred is not mutable"))
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		panic(fmt.Errorf("field sender of message noble.swap.stableswap.v1.PositionTransferred is not mutable"))
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		panic(fmt.Errorf("field receiver of message noble.swap.stableswap.v1.PositionTransferred is not mutable"))
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		panic(fmt.Errorf("field shares of message noble.swap.stableswap.v1.PositionTransferred is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PositionTransferred) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PositionTransferred.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.PositionTransferred.sender":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.PositionTransferred.receiver":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.PositionTransferred.shares":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PositionTransferred"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PositionTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PositionTransferred) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.PositionTransferred", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PositionTransferred) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PositionTransferred) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PositionTransferred) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PositionTransferred) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PositionTransferred)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Shares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PositionTransferred)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			i -= len(x.Shares)
+			copy(dAtA[i:], x.Shares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PositionTransferred)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionTransferred: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PositionTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AutoCompoundUpdated          protoreflect.MessageDescriptor
+	fd_AutoCompoundUpdated_pool_id  protoreflect.FieldDescriptor
+	fd_AutoCompoundUpdated_provider protoreflect.FieldDescriptor
+	fd_AutoCompoundUpdated_enabled  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_AutoCompoundUpdated = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("AutoCompoundUpdated")
+	fd_AutoCompoundUpdated_pool_id = md_AutoCompoundUpdated.Fields().ByName("pool_id")
+	fd_AutoCompoundUpdated_provider = md_AutoCompoundUpdated.Fields().ByName("provider")
+	fd_AutoCompoundUpdated_enabled = md_AutoCompoundUpdated.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_AutoCompoundUpdated)(nil)
+
+type fastReflection_AutoCompoundUpdated AutoCompoundUpdated
+
+func (x *AutoCompoundUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoCompoundUpdated)(x)
+}
+
+func (x *AutoCompoundUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AutoCompoundUpdated_messageType fastReflection_AutoCompoundUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_AutoCompoundUpdated_messageType{}
+
+type fastReflection_AutoCompoundUpdated_messageType struct{}
+
+func (x fastReflection_AutoCompoundUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoCompoundUpdated)(nil)
+}
+func (x fastReflection_AutoCompoundUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoCompoundUpdated)
+}
+func (x fastReflection_AutoCompoundUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoCompoundUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AutoCompoundUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoCompoundUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AutoCompoundUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_AutoCompoundUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AutoCompoundUpdated) New() protoreflect.Message {
+	return new(fastReflection_AutoCompoundUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AutoCompoundUpdated) Interface() protoreflect.ProtoMessage {
+	return (*AutoCompoundUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AutoCompoundUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_AutoCompoundUpdated_pool_id, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_AutoCompoundUpdated_provider, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_AutoCompoundUpdated_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AutoCompoundUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		return x.Provider != ""
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCompoundUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		x.Provider = ""
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AutoCompoundUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCompoundUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		x.Provider = value.Interface().(string)
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCompoundUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.AutoCompoundUpdated is not mutable"))
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		panic(fmt.Errorf("field provider of message noble.swap.stableswap.v1.AutoCompoundUpdated is not mutable"))
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		panic(fmt.Errorf("field enabled of message noble.swap.stableswap.v1.AutoCompoundUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AutoCompoundUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.provider":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.AutoCompoundUpdated.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.AutoCompoundUpdated"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.AutoCompoundUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AutoCompoundUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.AutoCompoundUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AutoCompoundUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoCompoundUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AutoCompoundUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AutoCompoundUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AutoCompoundUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AutoCompoundUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AutoCompoundUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoCompoundUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoCompoundUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RewardsCompounded_3_list)(nil)
+
+type _RewardsCompounded_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RewardsCompounded_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RewardsCompounded_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RewardsCompounded_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RewardsCompounded_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RewardsCompounded_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardsCompounded_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RewardsCompounded_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardsCompounded_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RewardsCompounded          protoreflect.MessageDescriptor
+	fd_RewardsCompounded_pool_id  protoreflect.FieldDescriptor
+	fd_RewardsCompounded_provider protoreflect.FieldDescriptor
+	fd_RewardsCompounded_amount   protoreflect.FieldDescriptor
+	fd_RewardsCompounded_shares   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_RewardsCompounded = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("RewardsCompounded")
+	fd_RewardsCompounded_pool_id = md_RewardsCompounded.Fields().ByName("pool_id")
+	fd_RewardsCompounded_provider = md_RewardsCompounded.Fields().ByName("provider")
+	fd_RewardsCompounded_amount = md_RewardsCompounded.Fields().ByName("amount")
+	fd_RewardsCompounded_shares = md_RewardsCompounded.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_RewardsCompounded)(nil)
+
+type fastReflection_RewardsCompounded RewardsCompounded
+
+func (x *RewardsCompounded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardsCompounded)(x)
+}
+
+func (x *RewardsCompounded) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RewardsCompounded_messageType fastReflection_RewardsCompounded_messageType
+var _ protoreflect.MessageType = fastReflection_RewardsCompounded_messageType{}
+
+type fastReflection_RewardsCompounded_messageType struct{}
+
+func (x fastReflection_RewardsCompounded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardsCompounded)(nil)
+}
+func (x fastReflection_RewardsCompounded_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardsCompounded)
+}
+func (x fastReflection_RewardsCompounded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardsCompounded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RewardsCompounded) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardsCompounded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RewardsCompounded) Type() protoreflect.MessageType {
+	return _fastReflection_RewardsCompounded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RewardsCompounded) New() protoreflect.Message {
+	return new(fastReflection_RewardsCompounded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RewardsCompounded) Interface() protoreflect.ProtoMessage {
+	return (*RewardsCompounded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RewardsCompounded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_RewardsCompounded_pool_id, value) {
+			return
+		}
+	}
+	if x.Provider != "" {
+		value := protoreflect.ValueOfString(x.Provider)
+		if !f(fd_RewardsCompounded_provider, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_RewardsCompounded_3_list{list: &x.Amount})
+		if !f(fd_RewardsCompounded_amount, value) {
+			return
+		}
+	}
+	if x.Shares != "" {
+		value := protoreflect.ValueOfString(x.Shares)
+		if !f(fd_RewardsCompounded_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RewardsCompounded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		return x.Provider != ""
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		return len(x.Amount) != 0
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		return x.Shares != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardsCompounded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		x.Provider = ""
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		x.Amount = nil
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		x.Shares = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RewardsCompounded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		value := x.Provider
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_RewardsCompounded_3_list{})
+		}
+		listValue := &_RewardsCompounded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		value := x.Shares
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardsCompounded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		x.Provider = value.Interface().(string)
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		lv := value.List()
+		clv := lv.(*_RewardsCompounded_3_list)
+		x.Amount = *clv.list
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		x.Shares = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardsCompounded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_RewardsCompounded_3_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.RewardsCompounded is not mutable"))
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		panic(fmt.Errorf("field provider of message noble.swap.stableswap.v1.RewardsCompounded is not mutable"))
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		panic(fmt.Errorf("field shares of message noble.swap.stableswap.v1.RewardsCompounded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RewardsCompounded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RewardsCompounded.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.RewardsCompounded.provider":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.RewardsCompounded.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RewardsCompounded_3_list{list: &list})
+	case "noble.swap.stableswap.v1.RewardsCompounded.shares":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RewardsCompounded"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RewardsCompounded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RewardsCompounded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.RewardsCompounded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RewardsCompounded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardsCompounded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RewardsCompounded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RewardsCompounded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RewardsCompounded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Provider)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Shares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RewardsCompounded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Shares) > 0 {
+			i -= len(x.Shares)
+			copy(dAtA[i:], x.Shares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Shares)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Provider) > 0 {
+			i -= len(x.Provider)
+			copy(dAtA[i:], x.Provider)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Provider)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RewardsCompounded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardsCompounded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardsCompounded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Provider = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PoolUpdateScheduled                protoreflect.MessageDescriptor
+	fd_PoolUpdateScheduled_id             protoreflect.FieldDescriptor
+	fd_PoolUpdateScheduled_pool_id        protoreflect.FieldDescriptor
+	fd_PoolUpdateScheduled_execution_time protoreflect.FieldDescriptor
+	fd_PoolUpdateScheduled_signer         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_PoolUpdateScheduled = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("PoolUpdateScheduled")
+	fd_PoolUpdateScheduled_id = md_PoolUpdateScheduled.Fields().ByName("id")
+	fd_PoolUpdateScheduled_pool_id = md_PoolUpdateScheduled.Fields().ByName("pool_id")
+	fd_PoolUpdateScheduled_execution_time = md_PoolUpdateScheduled.Fields().ByName("execution_time")
+	fd_PoolUpdateScheduled_signer = md_PoolUpdateScheduled.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolUpdateScheduled)(nil)
+
+type fastReflection_PoolUpdateScheduled PoolUpdateScheduled
+
+func (x *PoolUpdateScheduled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolUpdateScheduled)(x)
+}
+
+func (x *PoolUpdateScheduled) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolUpdateScheduled_messageType fastReflection_PoolUpdateScheduled_messageType
+var _ protoreflect.MessageType = fastReflection_PoolUpdateScheduled_messageType{}
+
+type fastReflection_PoolUpdateScheduled_messageType struct{}
+
+func (x fastReflection_PoolUpdateScheduled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolUpdateScheduled)(nil)
+}
+func (x fastReflection_PoolUpdateScheduled_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolUpdateScheduled)
+}
+func (x fastReflection_PoolUpdateScheduled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolUpdateScheduled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolUpdateScheduled) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolUpdateScheduled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolUpdateScheduled) Type() protoreflect.MessageType {
+	return _fastReflection_PoolUpdateScheduled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolUpdateScheduled) New() protoreflect.Message {
+	return new(fastReflection_PoolUpdateScheduled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolUpdateScheduled) Interface() protoreflect.ProtoMessage {
+	return (*PoolUpdateScheduled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolUpdateScheduled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PoolUpdateScheduled_id, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_PoolUpdateScheduled_pool_id, value) {
+			return
+		}
+	}
+	if x.ExecutionTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionTime)
+		if !f(fd_PoolUpdateScheduled_execution_time, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_PoolUpdateScheduled_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolUpdateScheduled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		return x.Id != uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		return x.ExecutionTime != int64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateScheduled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		x.Id = uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		x.ExecutionTime = int64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolUpdateScheduled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		value := x.ExecutionTime
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateScheduled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		x.Id = value.Uint()
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		x.ExecutionTime = value.Int()
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateScheduled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		panic(fmt.Errorf("field id of message noble.swap.stableswap.v1.PoolUpdateScheduled is not mutable"))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.PoolUpdateScheduled is not mutable"))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		panic(fmt.Errorf("field execution_time of message noble.swap.stableswap.v1.PoolUpdateScheduled is not mutable"))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.PoolUpdateScheduled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolUpdateScheduled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.execution_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.PoolUpdateScheduled.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateScheduled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateScheduled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolUpdateScheduled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.PoolUpdateScheduled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolUpdateScheduled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateScheduled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolUpdateScheduled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolUpdateScheduled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolUpdateScheduled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.ExecutionTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionTime))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolUpdateScheduled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ExecutionTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolUpdateScheduled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolUpdateScheduled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolUpdateScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+				}
+				x.ExecutionTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PoolUpdateCancelled         protoreflect.MessageDescriptor
+	fd_PoolUpdateCancelled_id      protoreflect.FieldDescriptor
+	fd_PoolUpdateCancelled_pool_id protoreflect.FieldDescriptor
+	fd_PoolUpdateCancelled_signer  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_PoolUpdateCancelled = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("PoolUpdateCancelled")
+	fd_PoolUpdateCancelled_id = md_PoolUpdateCancelled.Fields().ByName("id")
+	fd_PoolUpdateCancelled_pool_id = md_PoolUpdateCancelled.Fields().ByName("pool_id")
+	fd_PoolUpdateCancelled_signer = md_PoolUpdateCancelled.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_PoolUpdateCancelled)(nil)
+
+type fastReflection_PoolUpdateCancelled PoolUpdateCancelled
+
+func (x *PoolUpdateCancelled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PoolUpdateCancelled)(x)
+}
+
+func (x *PoolUpdateCancelled) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PoolUpdateCancelled_messageType fastReflection_PoolUpdateCancelled_messageType
+var _ protoreflect.MessageType = fastReflection_PoolUpdateCancelled_messageType{}
+
+type fastReflection_PoolUpdateCancelled_messageType struct{}
+
+func (x fastReflection_PoolUpdateCancelled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PoolUpdateCancelled)(nil)
+}
+func (x fastReflection_PoolUpdateCancelled_messageType) New() protoreflect.Message {
+	return new(fastReflection_PoolUpdateCancelled)
+}
+func (x fastReflection_PoolUpdateCancelled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolUpdateCancelled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PoolUpdateCancelled) Descriptor() protoreflect.MessageDescriptor {
+	return md_PoolUpdateCancelled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PoolUpdateCancelled) Type() protoreflect.MessageType {
+	return _fastReflection_PoolUpdateCancelled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PoolUpdateCancelled) New() protoreflect.Message {
+	return new(fastReflection_PoolUpdateCancelled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PoolUpdateCancelled) Interface() protoreflect.ProtoMessage {
+	return (*PoolUpdateCancelled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PoolUpdateCancelled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_PoolUpdateCancelled_id, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_PoolUpdateCancelled_pool_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_PoolUpdateCancelled_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PoolUpdateCancelled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		return x.Id != uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateCancelled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		x.Id = uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PoolUpdateCancelled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateCancelled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		x.Id = value.Uint()
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateCancelled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		panic(fmt.Errorf("field id of message noble.swap.stableswap.v1.PoolUpdateCancelled is not mutable"))
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.PoolUpdateCancelled is not mutable"))
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.PoolUpdateCancelled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PoolUpdateCancelled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.PoolUpdateCancelled.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.PoolUpdateCancelled"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.PoolUpdateCancelled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PoolUpdateCancelled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.PoolUpdateCancelled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PoolUpdateCancelled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PoolUpdateCancelled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PoolUpdateCancelled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PoolUpdateCancelled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PoolUpdateCancelled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PoolUpdateCancelled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PoolUpdateCancelled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolUpdateCancelled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PoolUpdateCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledPoolUpdateExecuted         protoreflect.MessageDescriptor
+	fd_ScheduledPoolUpdateExecuted_id      protoreflect.FieldDescriptor
+	fd_ScheduledPoolUpdateExecuted_pool_id protoreflect.FieldDescriptor
+	fd_ScheduledPoolUpdateExecuted_error   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_ScheduledPoolUpdateExecuted = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("ScheduledPoolUpdateExecuted")
+	fd_ScheduledPoolUpdateExecuted_id = md_ScheduledPoolUpdateExecuted.Fields().ByName("id")
+	fd_ScheduledPoolUpdateExecuted_pool_id = md_ScheduledPoolUpdateExecuted.Fields().ByName("pool_id")
+	fd_ScheduledPoolUpdateExecuted_error = md_ScheduledPoolUpdateExecuted.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledPoolUpdateExecuted)(nil)
+
+type fastReflection_ScheduledPoolUpdateExecuted ScheduledPoolUpdateExecuted
+
+func (x *ScheduledPoolUpdateExecuted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledPoolUpdateExecuted)(x)
+}
+
+func (x *ScheduledPoolUpdateExecuted) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledPoolUpdateExecuted_messageType fastReflection_ScheduledPoolUpdateExecuted_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledPoolUpdateExecuted_messageType{}
+
+type fastReflection_ScheduledPoolUpdateExecuted_messageType struct{}
+
+func (x fastReflection_ScheduledPoolUpdateExecuted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledPoolUpdateExecuted)(nil)
+}
+func (x fastReflection_ScheduledPoolUpdateExecuted_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledPoolUpdateExecuted)
+}
+func (x fastReflection_ScheduledPoolUpdateExecuted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledPoolUpdateExecuted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledPoolUpdateExecuted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledPoolUpdateExecuted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) New() protoreflect.Message {
+	return new(fastReflection_ScheduledPoolUpdateExecuted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledPoolUpdateExecuted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledPoolUpdateExecuted_id, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_ScheduledPoolUpdateExecuted_pool_id, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_ScheduledPoolUpdateExecuted_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		return x.Id != uint64(0)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		x.Id = uint64(0)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		x.Id = value.Uint()
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		panic(fmt.Errorf("field id of message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted is not mutable"))
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted is not mutable"))
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		panic(fmt.Errorf("field error of message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledPoolUpdateExecuted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledPoolUpdateExecuted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledPoolUpdateExecuted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledPoolUpdateExecuted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledPoolUpdateExecuted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledPoolUpdateExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RampAStarted                protoreflect.MessageDescriptor
+	fd_RampAStarted_pool_id        protoreflect.FieldDescriptor
+	fd_RampAStarted_initial_a      protoreflect.FieldDescriptor
+	fd_RampAStarted_future_a       protoreflect.FieldDescriptor
+	fd_RampAStarted_initial_a_time protoreflect.FieldDescriptor
+	fd_RampAStarted_future_a_time  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_RampAStarted = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("RampAStarted")
+	fd_RampAStarted_pool_id = md_RampAStarted.Fields().ByName("pool_id")
+	fd_RampAStarted_initial_a = md_RampAStarted.Fields().ByName("initial_a")
+	fd_RampAStarted_future_a = md_RampAStarted.Fields().ByName("future_a")
+	fd_RampAStarted_initial_a_time = md_RampAStarted.Fields().ByName("initial_a_time")
+	fd_RampAStarted_future_a_time = md_RampAStarted.Fields().ByName("future_a_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_RampAStarted)(nil)
+
+type fastReflection_RampAStarted RampAStarted
+
+func (x *RampAStarted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RampAStarted)(x)
+}
+
+func (x *RampAStarted) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RampAStarted_messageType fastReflection_RampAStarted_messageType
+var _ protoreflect.MessageType = fastReflection_RampAStarted_messageType{}
+
+type fastReflection_RampAStarted_messageType struct{}
+
+func (x fastReflection_RampAStarted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RampAStarted)(nil)
+}
+func (x fastReflection_RampAStarted_messageType) New() protoreflect.Message {
+	return new(fastReflection_RampAStarted)
+}
+func (x fastReflection_RampAStarted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampAStarted
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RampAStarted) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampAStarted
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RampAStarted) Type() protoreflect.MessageType {
+	return _fastReflection_RampAStarted_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RampAStarted) New() protoreflect.Message {
+	return new(fastReflection_RampAStarted)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RampAStarted) Interface() protoreflect.ProtoMessage {
+	return (*RampAStarted)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RampAStarted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_RampAStarted_pool_id, value) {
+			return
+		}
+	}
+	if x.InitialA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InitialA)
+		if !f(fd_RampAStarted_initial_a, value) {
+			return
+		}
+	}
+	if x.FutureA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureA)
+		if !f(fd_RampAStarted_future_a, value) {
+			return
+		}
+	}
+	if x.InitialATime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InitialATime)
+		if !f(fd_RampAStarted_initial_a_time, value) {
+			return
+		}
+	}
+	if x.FutureATime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureATime)
+		if !f(fd_RampAStarted_future_a_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RampAStarted) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		return x.InitialA != int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		return x.FutureA != int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		return x.InitialATime != int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		return x.FutureATime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStarted) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		x.InitialA = int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		x.FutureA = int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		x.InitialATime = int64(0)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		x.FutureATime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RampAStarted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		value := x.InitialA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		value := x.FutureA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		value := x.InitialATime
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		value := x.FutureATime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStarted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		x.InitialA = value.Int()
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		x.FutureA = value.Int()
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		x.InitialATime = value.Int()
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		x.FutureATime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStarted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.RampAStarted is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		panic(fmt.Errorf("field initial_a of message noble.swap.stableswap.v1.RampAStarted is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		panic(fmt.Errorf("field future_a of message noble.swap.stableswap.v1.RampAStarted is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		panic(fmt.Errorf("field initial_a_time of message noble.swap.stableswap.v1.RampAStarted is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		panic(fmt.Errorf("field future_a_time of message noble.swap.stableswap.v1.RampAStarted is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RampAStarted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStarted.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.RampAStarted.future_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.RampAStarted.initial_a_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.RampAStarted.future_a_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStarted"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStarted does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RampAStarted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.RampAStarted", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RampAStarted) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStarted) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RampAStarted) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RampAStarted) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RampAStarted)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.InitialA != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialA))
+		}
+		if x.FutureA != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureA))
+		}
+		if x.InitialATime != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialATime))
+		}
+		if x.FutureATime != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureATime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RampAStarted)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FutureATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.InitialATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialATime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FutureA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.InitialA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RampAStarted)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampAStarted: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampAStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+				}
+				x.InitialA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+				}
+				x.FutureA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+				}
+				x.InitialATime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialATime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				}
+				x.FutureATime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureATime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RampAStopped           protoreflect.MessageDescriptor
+	fd_RampAStopped_pool_id   protoreflect.FieldDescriptor
+	fd_RampAStopped_current_a protoreflect.FieldDescriptor
+	fd_RampAStopped_time      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_events_proto_init()
+	md_RampAStopped = File_noble_swap_stableswap_v1_events_proto.Messages().ByName("RampAStopped")
+	fd_RampAStopped_pool_id = md_RampAStopped.Fields().ByName("pool_id")
+	fd_RampAStopped_current_a = md_RampAStopped.Fields().ByName("current_a")
+	fd_RampAStopped_time = md_RampAStopped.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_RampAStopped)(nil)
+
+type fastReflection_RampAStopped RampAStopped
+
+func (x *RampAStopped) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RampAStopped)(x)
+}
+
+func (x *RampAStopped) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RampAStopped_messageType fastReflection_RampAStopped_messageType
+var _ protoreflect.MessageType = fastReflection_RampAStopped_messageType{}
+
+type fastReflection_RampAStopped_messageType struct{}
+
+func (x fastReflection_RampAStopped_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RampAStopped)(nil)
+}
+func (x fastReflection_RampAStopped_messageType) New() protoreflect.Message {
+	return new(fastReflection_RampAStopped)
+}
+func (x fastReflection_RampAStopped_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampAStopped
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RampAStopped) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampAStopped
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RampAStopped) Type() protoreflect.MessageType {
+	return _fastReflection_RampAStopped_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RampAStopped) New() protoreflect.Message {
+	return new(fastReflection_RampAStopped)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RampAStopped) Interface() protoreflect.ProtoMessage {
+	return (*RampAStopped)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RampAStopped) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_RampAStopped_pool_id, value) {
+			return
+		}
+	}
+	if x.CurrentA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CurrentA)
+		if !f(fd_RampAStopped_current_a, value) {
+			return
+		}
+	}
+	if x.Time != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Time)
+		if !f(fd_RampAStopped_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RampAStopped) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		return x.CurrentA != int64(0)
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		return x.Time != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStopped) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		x.CurrentA = int64(0)
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		x.Time = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RampAStopped) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		value := x.CurrentA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		value := x.Time
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStopped) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		x.CurrentA = value.Int()
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		x.Time = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStopped) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.RampAStopped is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		panic(fmt.Errorf("field current_a of message noble.swap.stableswap.v1.RampAStopped is not mutable"))
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		panic(fmt.Errorf("field time of message noble.swap.stableswap.v1.RampAStopped is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RampAStopped) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.RampAStopped.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.RampAStopped.current_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.RampAStopped.time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.RampAStopped"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.RampAStopped does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RampAStopped) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.RampAStopped", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RampAStopped) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampAStopped) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RampAStopped) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RampAStopped) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RampAStopped)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.CurrentA != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentA))
+		}
+		if x.Time != 0 {
+			n += 1 + runtime.Sov(uint64(x.Time))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RampAStopped)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Time))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CurrentA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentA))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RampAStopped)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampAStopped: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampAStopped: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentA", wireType)
+				}
+				x.CurrentA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				x.Time = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Time |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/stableswap/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PoolCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the newly created pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Algorithm of the pool.
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Pair asset denom in the pool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Protocol fee percentage for the pool.
+	ProtocolFeePercentage int64 `protobuf:"varint,4,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
+	// Rewards fee for the pool.
+	RewardsFee int64 `protobuf:"varint,5,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	// Initial amplification coefficient.
+	InitialA int64 `protobuf:"varint,6,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// Initial amplification coefficient.
+	InitialATime int64 `protobuf:"varint,7,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
+	// Future amplification coefficient.
+	FutureA int64 `protobuf:"varint,8,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Time when the amplification change will take full effect.
+	FutureATime int64 `protobuf:"varint,9,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// Rate multipliers applied to the coins.
+	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,10,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// Unbonding tiers of the pool.
+	UnbondingTiers []*UnbondingTier `protobuf:"bytes,11,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers,omitempty"`
+	// Exit fee tiers of the pool.
+	ExitFeeTiers []*ExitFeeTier `protobuf:"bytes,12,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers,omitempty"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []*LockTier `protobuf:"bytes,13,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers,omitempty"`
+	// Recipients of the protocol fees.
+	FeeSplits []*FeeSplit `protobuf:"bytes,14,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits,omitempty"`
+	// Dynamic rewards fee model.
+	DynamicFee *DynamicFee `protobuf:"bytes,15,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,16,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+	// Address of the account that created the pool.
+	Creator string `protobuf:"bytes,17,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Fee paid by the creator, empty when created by the authority or a pool manager.
+	CreationFee []*v1beta1.Coin `protobuf:"bytes,18,rep,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
+}
+
+func (x *PoolCreated) Reset() {
+	*x = PoolCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolCreated) ProtoMessage() {}
+
+// Deprecated: Use PoolCreated.ProtoReflect.Descriptor instead.
+func (*PoolCreated) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PoolCreated) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *PoolCreated) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *PoolCreated) GetProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.ProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetRewardsFee() int64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetInitialA() int64 {
+	if x != nil {
+		return x.InitialA
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetInitialATime() int64 {
+	if x != nil {
+		return x.InitialATime
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetFutureA() int64 {
+	if x != nil {
+		return x.FutureA
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+func (x *PoolCreated) GetRateMultipliers() []*v1beta1.Coin {
+	if x != nil {
+		return x.RateMultipliers
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetUnbondingTiers() []*UnbondingTier {
+	if x != nil {
+		return x.UnbondingTiers
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetExitFeeTiers() []*ExitFeeTier {
+	if x != nil {
+		return x.ExitFeeTiers
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetLockTiers() []*LockTier {
+	if x != nil {
+		return x.LockTiers
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetFeeSplits() []*FeeSplit {
+	if x != nil {
+		return x.FeeSplits
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetDynamicFee() *DynamicFee {
+	if x != nil {
+		return x.DynamicFee
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetDirectionalFee() *DirectionalFee {
+	if x != nil {
+		return x.DirectionalFee
+	}
+	return nil
+}
+
+func (x *PoolCreated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *PoolCreated) GetCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.CreationFee
+	}
+	return nil
+}
+
+type PoolUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the updated pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Protocol fee percentage for the pool.
+	ProtocolFeePercentage int64 `protobuf:"varint,2,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
+	// Rewards fee for the pool.
+	RewardsFee int64 `protobuf:"varint,3,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	// Future amplification coefficient.
+	FutureA int64 `protobuf:"varint,4,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Time when the amplification change will take full effect.
+	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// Rate multipliers applied to the coins.
+	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,6,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// Unbonding tiers of the pool.
+	UnbondingTiers []*UnbondingTier `protobuf:"bytes,7,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers,omitempty"`
+	// Exit fee tiers of the pool.
+	ExitFeeTiers []*ExitFeeTier `protobuf:"bytes,8,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers,omitempty"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []*LockTier `protobuf:"bytes,9,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers,omitempty"`
+	// Recipients of the protocol fees.
+	FeeSplits []*FeeSplit `protobuf:"bytes,10,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits,omitempty"`
+	// Dynamic rewards fee model.
+	DynamicFee *DynamicFee `protobuf:"bytes,11,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,12,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+}
+
+func (x *PoolUpdated) Reset() {
+	*x = PoolUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolUpdated) ProtoMessage() {}
+
+// Deprecated: Use PoolUpdated.ProtoReflect.Descriptor instead.
+func (*PoolUpdated) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PoolUpdated) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *PoolUpdated) GetProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.ProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *PoolUpdated) GetRewardsFee() int64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *PoolUpdated) GetFutureA() int64 {
+	if x != nil {
+		return x.FutureA
+	}
+	return 0
+}
+
+func (x *PoolUpdated) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+func (x *PoolUpdated) GetRateMultipliers() []*v1beta1.Coin {
+	if x != nil {
+		return x.RateMultipliers
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetUnbondingTiers() []*UnbondingTier {
+	if x != nil {
+		return x.UnbondingTiers
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetExitFeeTiers() []*ExitFeeTier {
+	if x != nil {
+		return x.ExitFeeTiers
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetLockTiers() []*LockTier {
+	if x != nil {
+		return x.LockTiers
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetFeeSplits() []*FeeSplit {
+	if x != nil {
+		return x.FeeSplits
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetDynamicFee() *DynamicFee {
+	if x != nil {
+		return x.DynamicFee
+	}
+	return nil
+}
+
+func (x *PoolUpdated) GetDirectionalFee() *DirectionalFee {
+	if x != nil {
+		return x.DirectionalFee
+	}
+	return nil
+}
+
+type LiquidityAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the liquidity provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Liquidity amount being added.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Shares issued for the added liquidity.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *LiquidityAdded) Reset() {
+	*x = LiquidityAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityAdded) ProtoMessage() {}
+
+// Deprecated: Use LiquidityAdded.ProtoReflect.Descriptor instead.
+func (*LiquidityAdded) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LiquidityAdded) GetProvider() string {
+	if x != nil {
+		return x.Provider
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return ""
+}
+
+func (x *LiquidityAdded) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
 	}
+	return 0
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: noble/swap/stableswap/v1/events.proto
+func (x *LiquidityAdded) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *LiquidityAdded) GetShares() string {
+	if x != nil {
+		return x.Shares
+	}
+	return ""
+}
 
-type PoolCreated struct {
+type LiquidityRemoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the newly created pool.
+	// Provider address of the liquidity
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Liquidity amount being removed.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Shares burned for the removed liquidity.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+	// Time when the removed liquidity will be unlocked.
+	UnlockTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// Exit fee paid for an instant liquidity removal.
+	ExitFee []*v1beta1.Coin `protobuf:"bytes,6,rep,name=exit_fee,json=exitFee,proto3" json:"exit_fee,omitempty"`
+}
+
+func (x *LiquidityRemoved) Reset() {
+	*x = LiquidityRemoved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityRemoved) ProtoMessage() {}
+
+// Deprecated: Use LiquidityRemoved.ProtoReflect.Descriptor instead.
+func (*LiquidityRemoved) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LiquidityRemoved) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LiquidityRemoved) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *LiquidityRemoved) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LiquidityRemoved) GetShares() string {
+	if x != nil {
+		return x.Shares
+	}
+	return ""
+}
+
+func (x *LiquidityRemoved) GetUnlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockTime
+	}
+	return nil
+}
+
+func (x *LiquidityRemoved) GetExitFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.ExitFee
+	}
+	return nil
+}
+
+type PositionTransferred struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// Algorithm of the pool.
-	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	// Pair asset denom in the pool.
-	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	// Protocol fee percentage for the pool.
-	ProtocolFeePercentage int64 `protobuf:"varint,4,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
-	// Rewards fee for the pool.
-	RewardsFee int64 `protobuf:"varint,5,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// Initial amplification coefficient.
-	InitialA int64 `protobuf:"varint,6,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
-	// Initial amplification coefficient.
-	InitialATime int64 `protobuf:"varint,7,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
-	// Future amplification coefficient.
-	FutureA int64 `protobuf:"varint,8,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// Time when the amplification change will take full effect.
-	FutureATime int64 `protobuf:"varint,9,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
-	// Rate multipliers applied to the coins.
-	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,10,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// Address of the provider transferring the position.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Address of the provider receiving the position.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Shares transferred to the receiver.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (x *PoolCreated) Reset() {
-	*x = PoolCreated{}
+func (x *PositionTransferred) Reset() {
+	*x = PositionTransferred{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[0]
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolCreated) String() string {
+func (x *PositionTransferred) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolCreated) ProtoMessage() {}
+func (*PositionTransferred) ProtoMessage() {}
 
-// Deprecated: Use PoolCreated.ProtoReflect.Descriptor instead.
-func (*PoolCreated) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PositionTransferred.ProtoReflect.Descriptor instead.
+func (*PositionTransferred) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PoolCreated) GetPoolId() uint64 {
+func (x *PositionTransferred) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetAlgorithm() string {
+func (x *PositionTransferred) GetSender() string {
 	if x != nil {
-		return x.Algorithm
+		return x.Sender
 	}
 	return ""
 }
 
-func (x *PoolCreated) GetPair() string {
+func (x *PositionTransferred) GetReceiver() string {
 	if x != nil {
-		return x.Pair
+		return x.Receiver
 	}
 	return ""
 }
 
-func (x *PoolCreated) GetProtocolFeePercentage() int64 {
+func (x *PositionTransferred) GetShares() string {
 	if x != nil {
-		return x.ProtocolFeePercentage
+		return x.Shares
+	}
+	return ""
+}
+
+type AutoCompoundUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the liquidity provider.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Whether the rewards are compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *AutoCompoundUpdated) Reset() {
+	*x = AutoCompoundUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoCompoundUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoCompoundUpdated) ProtoMessage() {}
+
+// Deprecated: Use AutoCompoundUpdated.ProtoReflect.Descriptor instead.
+func (*AutoCompoundUpdated) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AutoCompoundUpdated) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetRewardsFee() int64 {
+func (x *AutoCompoundUpdated) GetProvider() string {
 	if x != nil {
-		return x.RewardsFee
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AutoCompoundUpdated) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type RewardsCompounded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the liquidity provider.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Amount of rewards compounded into the pool.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Shares minted for the provider.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *RewardsCompounded) Reset() {
+	*x = RewardsCompounded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardsCompounded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardsCompounded) ProtoMessage() {}
+
+// Deprecated: Use RewardsCompounded.ProtoReflect.Descriptor instead.
+func (*RewardsCompounded) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RewardsCompounded) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetInitialA() int64 {
-	if x != nil {
-		return x.InitialA
-	}
-	return 0
+func (x *RewardsCompounded) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RewardsCompounded) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RewardsCompounded) GetShares() string {
+	if x != nil {
+		return x.Shares
+	}
+	return ""
+}
+
+type PoolUpdateScheduled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the pool to update.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Timestamp from which the update is executed.
+	ExecutionTime int64 `protobuf:"varint,3,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Address of the account that scheduled the update.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *PoolUpdateScheduled) Reset() {
+	*x = PoolUpdateScheduled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolUpdateScheduled) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PoolCreated) GetInitialATime() int64 {
+func (*PoolUpdateScheduled) ProtoMessage() {}
+
+// Deprecated: Use PoolUpdateScheduled.ProtoReflect.Descriptor instead.
+func (*PoolUpdateScheduled) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PoolUpdateScheduled) GetId() uint64 {
 	if x != nil {
-		return x.InitialATime
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetFutureA() int64 {
+func (x *PoolUpdateScheduled) GetPoolId() uint64 {
 	if x != nil {
-		return x.FutureA
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetFutureATime() int64 {
+func (x *PoolUpdateScheduled) GetExecutionTime() int64 {
 	if x != nil {
-		return x.FutureATime
+		return x.ExecutionTime
 	}
 	return 0
 }
 
-func (x *PoolCreated) GetRateMultipliers() []*v1beta1.Coin {
+func (x *PoolUpdateScheduled) GetSigner() string {
 	if x != nil {
-		return x.RateMultipliers
+		return x.Signer
 	}
-	return nil
+	return ""
 }
 
-type PoolUpdated struct {
+type PoolUpdateCancelled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the updated pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// Protocol fee percentage for the pool.
-	ProtocolFeePercentage int64 `protobuf:"varint,2,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
-	// Rewards fee for the pool.
-	RewardsFee int64 `protobuf:"varint,3,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// Future amplification coefficient.
-	FutureA int64 `protobuf:"varint,4,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// Time when the amplification change will take full effect.
-	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
-	// Rate multipliers applied to the coins.
-	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,6,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// ID of the cancelled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the pool the update was scheduled for.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the account that cancelled the update.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *PoolUpdated) Reset() {
-	*x = PoolUpdated{}
+func (x *PoolUpdateCancelled) Reset() {
+	*x = PoolUpdateCancelled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[1]
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PoolUpdated) String() string {
+func (x *PoolUpdateCancelled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoolUpdated) ProtoMessage() {}
+func (*PoolUpdateCancelled) ProtoMessage() {}
 
-// Deprecated: Use PoolUpdated.ProtoReflect.Descriptor instead.
-func (*PoolUpdated) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PoolUpdateCancelled.ProtoReflect.Descriptor instead.
+func (*PoolUpdateCancelled) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PoolUpdated) GetPoolId() uint64 {
+func (x *PoolUpdateCancelled) GetId() uint64 {
 	if x != nil {
-		return x.PoolId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PoolUpdated) GetProtocolFeePercentage() int64 {
+func (x *PoolUpdateCancelled) GetPoolId() uint64 {
 	if x != nil {
-		return x.ProtocolFeePercentage
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolUpdated) GetRewardsFee() int64 {
+func (x *PoolUpdateCancelled) GetSigner() string {
 	if x != nil {
-		return x.RewardsFee
+		return x.Signer
 	}
-	return 0
+	return ""
 }
 
-func (x *PoolUpdated) GetFutureA() int64 {
+type ScheduledPoolUpdateExecuted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the executed update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the updated pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Error of the execution, empty when the update has been applied.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ScheduledPoolUpdateExecuted) Reset() {
+	*x = ScheduledPoolUpdateExecuted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledPoolUpdateExecuted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPoolUpdateExecuted) ProtoMessage() {}
+
+// Deprecated: Use ScheduledPoolUpdateExecuted.ProtoReflect.Descriptor instead.
+func (*ScheduledPoolUpdateExecuted) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScheduledPoolUpdateExecuted) GetId() uint64 {
 	if x != nil {
-		return x.FutureA
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PoolUpdated) GetFutureATime() int64 {
+func (x *ScheduledPoolUpdateExecuted) GetPoolId() uint64 {
 	if x != nil {
-		return x.FutureATime
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *PoolUpdated) GetRateMultipliers() []*v1beta1.Coin {
+func (x *ScheduledPoolUpdateExecuted) GetError() string {
 	if x != nil {
-		return x.RateMultipliers
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type LiquidityAdded struct {
+type RampAStarted struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address of the liquidity provider.
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// ID of the pool.
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// Liquidity amount being added.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
-	// Shares issued for the added liquidity.
-	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+	// ID of the ramped pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amplification coefficient from which the ramp starts.
+	InitialA int64 `protobuf:"varint,2,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// Amplification coefficient reached at the end of the ramp.
+	FutureA int64 `protobuf:"varint,3,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Timestamp at which the ramp starts.
+	InitialATime int64 `protobuf:"varint,4,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
+	// Timestamp at which the ramp ends.
+	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 }
 
-func (x *LiquidityAdded) Reset() {
-	*x = LiquidityAdded{}
+func (x *RampAStarted) Reset() {
+	*x = RampAStarted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[2]
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityAdded) String() string {
+func (x *RampAStarted) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityAdded) ProtoMessage() {}
+func (*RampAStarted) ProtoMessage() {}
 
-// Deprecated: Use LiquidityAdded.ProtoReflect.Descriptor instead.
-func (*LiquidityAdded) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RampAStarted.ProtoReflect.Descriptor instead.
+func (*RampAStarted) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *LiquidityAdded) GetProvider() string {
+func (x *RampAStarted) GetPoolId() uint64 {
 	if x != nil {
-		return x.Provider
+		return x.PoolId
 	}
-	return ""
+	return 0
 }
 
-func (x *LiquidityAdded) GetPoolId() uint64 {
+func (x *RampAStarted) GetInitialA() int64 {
 	if x != nil {
-		return x.PoolId
+		return x.InitialA
 	}
 	return 0
 }
 
-func (x *LiquidityAdded) GetAmount() []*v1beta1.Coin {
+func (x *RampAStarted) GetFutureA() int64 {
 	if x != nil {
-		return x.Amount
+		return x.FutureA
 	}
-	return nil
+	return 0
 }
 
-func (x *LiquidityAdded) GetShares() string {
+func (x *RampAStarted) GetInitialATime() int64 {
 	if x != nil {
-		return x.Shares
+		return x.InitialATime
 	}
-	return ""
+	return 0
 }
 
-type LiquidityRemoved struct {
+func (x *RampAStarted) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+type RampAStopped struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Provider address of the liquidity
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// ID of the pool.
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// Liquidity amount being removed.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
-	// Shares burned for the removed liquidity.
-	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
-	// Time when the removed liquidity will be unlocked.
-	UnlockTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// ID of the pool whose ramp has been stopped.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amplification coefficient at which the pool has been frozen.
+	CurrentA int64 `protobuf:"varint,2,opt,name=current_a,json=currentA,proto3" json:"current_a,omitempty"`
+	// Timestamp at which the ramp has been stopped.
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *LiquidityRemoved) Reset() {
-	*x = LiquidityRemoved{}
+func (x *RampAStopped) Reset() {
+	*x = RampAStopped{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[3]
+		mi := &file_noble_swap_stableswap_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LiquidityRemoved) String() string {
+func (x *RampAStopped) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LiquidityRemoved) ProtoMessage() {}
-
-// Deprecated: Use LiquidityRemoved.ProtoReflect.Descriptor instead.
-func (*LiquidityRemoved) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{3}
-}
+func (*RampAStopped) ProtoMessage() {}
 
-func (x *LiquidityRemoved) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+// Deprecated: Use RampAStopped.ProtoReflect.Descriptor instead.
+func (*RampAStopped) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LiquidityRemoved) GetPoolId() uint64 {
+func (x *RampAStopped) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *LiquidityRemoved) GetAmount() []*v1beta1.Coin {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
-}
-
-func (x *LiquidityRemoved) GetShares() string {
+func (x *RampAStopped) GetCurrentA() int64 {
 	if x != nil {
-		return x.Shares
+		return x.CurrentA
 	}
-	return ""
+	return 0
 }
 
-func (x *LiquidityRemoved) GetUnlockTime() *timestamppb.Timestamp {
+func (x *RampAStopped) GetTime() int64 {
 	if x != nil {
-		return x.UnlockTime
+		return x.Time
 	}
-	return nil
+	return 0
 }
 
 var File_noble_swap_stableswap_v1_events_proto protoreflect.FileDescriptor
@@ -3489,110 +10347,263 @@ var file_noble_swap_stableswap_v1_events_proto_rawDesc = []byte{
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x03, 0x0a, 0x0b, 0x50, 0x6f, 0x6f, 0x6c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65,
-	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x8c, 0x01, 0x0a,
-	0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
-	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x0b,
-	0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77,
+	0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x08, 0x0a, 0x0b,
+	0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
 	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
-	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46,
-	0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x8c, 0x01, 0x0a,
-	0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
-	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x0e,
-	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f,
-	0x6c, 0x49, 0x64, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46,
-	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
-	0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
-	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e,
-	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xd9,
-	0x02, 0x0a, 0x10, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x12, 0x24, 0x0a, 0x0e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a,
+	0x0d, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x8c, 0x01, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0f, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x5b, 0x0a, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a,
+	0x0e, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x73, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x0a, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xb8, 0x06, 0x0a,
+	0x0b, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x8c, 0x01,
+	0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
 	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a,
-	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xe8, 0x01, 0x0a, 0x1c, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a,
-	0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
-	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x5b, 0x0a, 0x0f,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x65, 0x78, 0x69,
+	0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69,
+	0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x4c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a,
+	0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x0a, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xd7, 0x03, 0x0a, 0x10, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
+	0x45, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x7c, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x78, 0x69,
+	0x74, 0x46, 0x65, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x13, 0x41, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x93, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70,
+	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x13, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x1b,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x52,
+	0x61, 0x6d, 0x70, 0x41, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x41, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x24, 0x0a, 0x0e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x0c, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x53,
+	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x42, 0xe8, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x38, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53,
+	0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f,
+	0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53,
+	0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
+	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3607,26 +10618,55 @@ func file_noble_swap_stableswap_v1_events_proto_rawDescGZIP() []byte {
 	return file_noble_swap_stableswap_v1_events_proto_rawDescData
 }
 
-var file_noble_swap_stableswap_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_swap_stableswap_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_noble_swap_stableswap_v1_events_proto_goTypes = []interface{}{
-	(*PoolCreated)(nil),           // 0: noble.swap.stableswap.v1.PoolCreated
-	(*PoolUpdated)(nil),           // 1: noble.swap.stableswap.v1.PoolUpdated
-	(*LiquidityAdded)(nil),        // 2: noble.swap.stableswap.v1.LiquidityAdded
-	(*LiquidityRemoved)(nil),      // 3: noble.swap.stableswap.v1.LiquidityRemoved
-	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*PoolCreated)(nil),                 // 0: noble.swap.stableswap.v1.PoolCreated
+	(*PoolUpdated)(nil),                 // 1: noble.swap.stableswap.v1.PoolUpdated
+	(*LiquidityAdded)(nil),              // 2: noble.swap.stableswap.v1.LiquidityAdded
+	(*LiquidityRemoved)(nil),            // 3: noble.swap.stableswap.v1.LiquidityRemoved
+	(*PositionTransferred)(nil),         // 4: noble.swap.stableswap.v1.PositionTransferred
+	(*AutoCompoundUpdated)(nil),         // 5: noble.swap.stableswap.v1.AutoCompoundUpdated
+	(*RewardsCompounded)(nil),           // 6: noble.swap.stableswap.v1.RewardsCompounded
+	(*PoolUpdateScheduled)(nil),         // 7: noble.swap.stableswap.v1.PoolUpdateScheduled
+	(*PoolUpdateCancelled)(nil),         // 8: noble.swap.stableswap.v1.PoolUpdateCancelled
+	(*ScheduledPoolUpdateExecuted)(nil), // 9: noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted
+	(*RampAStarted)(nil),                // 10: noble.swap.stableswap.v1.RampAStarted
+	(*RampAStopped)(nil),                // 11: noble.swap.stableswap.v1.RampAStopped
+	(*v1beta1.Coin)(nil),                // 12: cosmos.base.v1beta1.Coin
+	(*UnbondingTier)(nil),               // 13: noble.swap.stableswap.v1.UnbondingTier
+	(*ExitFeeTier)(nil),                 // 14: noble.swap.stableswap.v1.ExitFeeTier
+	(*LockTier)(nil),                    // 15: noble.swap.stableswap.v1.LockTier
+	(*FeeSplit)(nil),                    // 16: noble.swap.stableswap.v1.FeeSplit
+	(*DynamicFee)(nil),                  // 17: noble.swap.stableswap.v1.DynamicFee
+	(*DirectionalFee)(nil),              // 18: noble.swap.stableswap.v1.DirectionalFee
+	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
 }
 var file_noble_swap_stableswap_v1_events_proto_depIdxs = []int32{
-	4, // 0: noble.swap.stableswap.v1.PoolCreated.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
-	4, // 1: noble.swap.stableswap.v1.PoolUpdated.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
-	4, // 2: noble.swap.stableswap.v1.LiquidityAdded.amount:type_name -> cosmos.base.v1beta1.Coin
-	4, // 3: noble.swap.stableswap.v1.LiquidityRemoved.amount:type_name -> cosmos.base.v1beta1.Coin
-	5, // 4: noble.swap.stableswap.v1.LiquidityRemoved.unlock_time:type_name -> google.protobuf.Timestamp
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	12, // 0: noble.swap.stableswap.v1.PoolCreated.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
+	13, // 1: noble.swap.stableswap.v1.PoolCreated.unbonding_tiers:type_name -> noble.swap.stableswap.v1.UnbondingTier
+	14, // 2: noble.swap.stableswap.v1.PoolCreated.exit_fee_tiers:type_name -> noble.swap.stableswap.v1.ExitFeeTier
+	15, // 3: noble.swap.stableswap.v1.PoolCreated.lock_tiers:type_name -> noble.swap.stableswap.v1.LockTier
+	16, // 4: noble.swap.stableswap.v1.PoolCreated.fee_splits:type_name -> noble.swap.stableswap.v1.FeeSplit
+	17, // 5: noble.swap.stableswap.v1.PoolCreated.dynamic_fee:type_name -> noble.swap.stableswap.v1.DynamicFee
+	18, // 6: noble.swap.stableswap.v1.PoolCreated.directional_fee:type_name -> noble.swap.stableswap.v1.DirectionalFee
+	12, // 7: noble.swap.stableswap.v1.PoolCreated.creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	12, // 8: noble.swap.stableswap.v1.PoolUpdated.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
+	13, // 9: noble.swap.stableswap.v1.PoolUpdated.unbonding_tiers:type_name -> noble.swap.stableswap.v1.UnbondingTier
+	14, // 10: noble.swap.stableswap.v1.PoolUpdated.exit_fee_tiers:type_name -> noble.swap.stableswap.v1.ExitFeeTier
+	15, // 11: noble.swap.stableswap.v1.PoolUpdated.lock_tiers:type_name -> noble.swap.stableswap.v1.LockTier
+	16, // 12: noble.swap.stableswap.v1.PoolUpdated.fee_splits:type_name -> noble.swap.stableswap.v1.FeeSplit
+	17, // 13: noble.swap.stableswap.v1.PoolUpdated.dynamic_fee:type_name -> noble.swap.stableswap.v1.DynamicFee
+	18, // 14: noble.swap.stableswap.v1.PoolUpdated.directional_fee:type_name -> noble.swap.stableswap.v1.DirectionalFee
+	12, // 15: noble.swap.stableswap.v1.LiquidityAdded.amount:type_name -> cosmos.base.v1beta1.Coin
+	12, // 16: noble.swap.stableswap.v1.LiquidityRemoved.amount:type_name -> cosmos.base.v1beta1.Coin
+	19, // 17: noble.swap.stableswap.v1.LiquidityRemoved.unlock_time:type_name -> google.protobuf.Timestamp
+	12, // 18: noble.swap.stableswap.v1.LiquidityRemoved.exit_fee:type_name -> cosmos.base.v1beta1.Coin
+	12, // 19: noble.swap.stableswap.v1.RewardsCompounded.amount:type_name -> cosmos.base.v1beta1.Coin
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_stableswap_v1_events_proto_init() }
@@ -3634,6 +10674,7 @@ func file_noble_swap_stableswap_v1_events_proto_init() {
 	if File_noble_swap_stableswap_v1_events_proto != nil {
 		return
 	}
+	file_noble_swap_stableswap_v1_pool_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_swap_stableswap_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PoolCreated); i {
@@ -3683,6 +10724,102 @@ func file_noble_swap_stableswap_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionTransferred); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoCompoundUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardsCompounded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolUpdateScheduled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolUpdateCancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledPoolUpdateExecuted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RampAStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RampAStopped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3690,7 +10827,7 @@ func file_noble_swap_stableswap_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_swap_stableswap_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
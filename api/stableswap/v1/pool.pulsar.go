@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
@@ -68,43 +69,4693 @@ func (x *_Pool_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Pool_10_list)(nil)
+
+type _Pool_10_list struct {
+	list *[]*UnbondingTier
+}
+
+func (x *_Pool_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_10_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_10_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_11_list)(nil)
+
+type _Pool_11_list struct {
+	list *[]*ExitFeeTier
+}
+
+func (x *_Pool_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_11_list) AppendMutable() protoreflect.Value {
+	v := new(ExitFeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_11_list) NewElement() protoreflect.Value {
+	v := new(ExitFeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_12_list)(nil)
+
+type _Pool_12_list struct {
+	list *[]*LockTier
+}
+
+func (x *_Pool_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_12_list) AppendMutable() protoreflect.Value {
+	v := new(LockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_12_list) NewElement() protoreflect.Value {
+	v := new(LockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_14_list)(nil)
+
+type _Pool_14_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Pool_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_14_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_14_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_15_list)(nil)
+
+type _Pool_15_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Pool_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_15_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_15_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_16_list)(nil)
+
+type _Pool_16_list struct {
+	list *[]*FeeSplit
+}
+
+func (x *_Pool_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_16_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSplit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_16_list) NewElement() protoreflect.Value {
+	v := new(FeeSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Pool_19_list)(nil)
+
+type _Pool_19_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Pool_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Pool_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Pool_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Pool_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Pool_19_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Pool_19_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Pool_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Pool                         protoreflect.MessageDescriptor
+	fd_Pool_protocol_fee_percentage protoreflect.FieldDescriptor
+	fd_Pool_rewards_fee             protoreflect.FieldDescriptor
+	fd_Pool_initial_a               protoreflect.FieldDescriptor
+	fd_Pool_future_a                protoreflect.FieldDescriptor
+	fd_Pool_initial_a_time          protoreflect.FieldDescriptor
+	fd_Pool_future_a_time           protoreflect.FieldDescriptor
+	fd_Pool_rate_multipliers        protoreflect.FieldDescriptor
+	fd_Pool_total_shares            protoreflect.FieldDescriptor
+	fd_Pool_initial_rewards_time    protoreflect.FieldDescriptor
+	fd_Pool_unbonding_tiers         protoreflect.FieldDescriptor
+	fd_Pool_exit_fee_tiers          protoreflect.FieldDescriptor
+	fd_Pool_lock_tiers              protoreflect.FieldDescriptor
+	fd_Pool_total_boosted_shares    protoreflect.FieldDescriptor
+	fd_Pool_reward_per_share        protoreflect.FieldDescriptor
+	fd_Pool_incentive_per_share     protoreflect.FieldDescriptor
+	fd_Pool_fee_splits              protoreflect.FieldDescriptor
+	fd_Pool_dynamic_fee             protoreflect.FieldDescriptor
+	fd_Pool_directional_fee         protoreflect.FieldDescriptor
+	fd_Pool_pending_rewards         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_Pool = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("Pool")
+	fd_Pool_protocol_fee_percentage = md_Pool.Fields().ByName("protocol_fee_percentage")
+	fd_Pool_rewards_fee = md_Pool.Fields().ByName("rewards_fee")
+	fd_Pool_initial_a = md_Pool.Fields().ByName("initial_a")
+	fd_Pool_future_a = md_Pool.Fields().ByName("future_a")
+	fd_Pool_initial_a_time = md_Pool.Fields().ByName("initial_a_time")
+	fd_Pool_future_a_time = md_Pool.Fields().ByName("future_a_time")
+	fd_Pool_rate_multipliers = md_Pool.Fields().ByName("rate_multipliers")
+	fd_Pool_total_shares = md_Pool.Fields().ByName("total_shares")
+	fd_Pool_initial_rewards_time = md_Pool.Fields().ByName("initial_rewards_time")
+	fd_Pool_unbonding_tiers = md_Pool.Fields().ByName("unbonding_tiers")
+	fd_Pool_exit_fee_tiers = md_Pool.Fields().ByName("exit_fee_tiers")
+	fd_Pool_lock_tiers = md_Pool.Fields().ByName("lock_tiers")
+	fd_Pool_total_boosted_shares = md_Pool.Fields().ByName("total_boosted_shares")
+	fd_Pool_reward_per_share = md_Pool.Fields().ByName("reward_per_share")
+	fd_Pool_incentive_per_share = md_Pool.Fields().ByName("incentive_per_share")
+	fd_Pool_fee_splits = md_Pool.Fields().ByName("fee_splits")
+	fd_Pool_dynamic_fee = md_Pool.Fields().ByName("dynamic_fee")
+	fd_Pool_directional_fee = md_Pool.Fields().ByName("directional_fee")
+	fd_Pool_pending_rewards = md_Pool.Fields().ByName("pending_rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+
+type fastReflection_Pool Pool
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Pool)(x)
+}
+
+func (x *Pool) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Pool_messageType fastReflection_Pool_messageType
+var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+
+type fastReflection_Pool_messageType struct{}
+
+func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Pool)(nil)
+}
+func (x fastReflection_Pool_messageType) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
+	return md_Pool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Pool) Type() protoreflect.MessageType {
+	return _fastReflection_Pool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Pool) New() protoreflect.Message {
+	return new(fastReflection_Pool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
+	return (*Pool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProtocolFeePercentage != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProtocolFeePercentage)
+		if !f(fd_Pool_protocol_fee_percentage, value) {
+			return
+		}
+	}
+	if x.RewardsFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RewardsFee)
+		if !f(fd_Pool_rewards_fee, value) {
+			return
+		}
+	}
+	if x.InitialA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InitialA)
+		if !f(fd_Pool_initial_a, value) {
+			return
+		}
+	}
+	if x.FutureA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureA)
+		if !f(fd_Pool_future_a, value) {
+			return
+		}
+	}
+	if x.InitialATime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InitialATime)
+		if !f(fd_Pool_initial_a_time, value) {
+			return
+		}
+	}
+	if x.FutureATime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureATime)
+		if !f(fd_Pool_future_a_time, value) {
+			return
+		}
+	}
+	if len(x.RateMultipliers) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_7_list{list: &x.RateMultipliers})
+		if !f(fd_Pool_rate_multipliers, value) {
+			return
+		}
+	}
+	if x.TotalShares != "" {
+		value := protoreflect.ValueOfString(x.TotalShares)
+		if !f(fd_Pool_total_shares, value) {
+			return
+		}
+	}
+	if x.InitialRewardsTime != nil {
+		value := protoreflect.ValueOfMessage(x.InitialRewardsTime.ProtoReflect())
+		if !f(fd_Pool_initial_rewards_time, value) {
+			return
+		}
+	}
+	if len(x.UnbondingTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_10_list{list: &x.UnbondingTiers})
+		if !f(fd_Pool_unbonding_tiers, value) {
+			return
+		}
+	}
+	if len(x.ExitFeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_11_list{list: &x.ExitFeeTiers})
+		if !f(fd_Pool_exit_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.LockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_12_list{list: &x.LockTiers})
+		if !f(fd_Pool_lock_tiers, value) {
+			return
+		}
+	}
+	if x.TotalBoostedShares != "" {
+		value := protoreflect.ValueOfString(x.TotalBoostedShares)
+		if !f(fd_Pool_total_boosted_shares, value) {
+			return
+		}
+	}
+	if len(x.RewardPerShare) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_14_list{list: &x.RewardPerShare})
+		if !f(fd_Pool_reward_per_share, value) {
+			return
+		}
+	}
+	if len(x.IncentivePerShare) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_15_list{list: &x.IncentivePerShare})
+		if !f(fd_Pool_incentive_per_share, value) {
+			return
+		}
+	}
+	if len(x.FeeSplits) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_16_list{list: &x.FeeSplits})
+		if !f(fd_Pool_fee_splits, value) {
+			return
+		}
+	}
+	if x.DynamicFee != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+		if !f(fd_Pool_dynamic_fee, value) {
+			return
+		}
+	}
+	if x.DirectionalFee != nil {
+		value := protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+		if !f(fd_Pool_directional_fee, value) {
+			return
+		}
+	}
+	if len(x.PendingRewards) != 0 {
+		value := protoreflect.ValueOfList(&_Pool_19_list{list: &x.PendingRewards})
+		if !f(fd_Pool_pending_rewards, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		return x.ProtocolFeePercentage != int64(0)
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		return x.RewardsFee != int64(0)
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		return x.InitialA != int64(0)
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		return x.FutureA != int64(0)
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		return x.InitialATime != int64(0)
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		return x.FutureATime != int64(0)
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		return len(x.RateMultipliers) != 0
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		return x.TotalShares != ""
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		return x.InitialRewardsTime != nil
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		return len(x.UnbondingTiers) != 0
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		return len(x.ExitFeeTiers) != 0
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		return len(x.LockTiers) != 0
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		return x.TotalBoostedShares != ""
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		return len(x.RewardPerShare) != 0
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		return len(x.IncentivePerShare) != 0
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		return len(x.FeeSplits) != 0
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		return x.DynamicFee != nil
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		return x.DirectionalFee != nil
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		return len(x.PendingRewards) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		x.ProtocolFeePercentage = int64(0)
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		x.RewardsFee = int64(0)
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		x.InitialA = int64(0)
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		x.FutureA = int64(0)
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		x.InitialATime = int64(0)
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		x.FutureATime = int64(0)
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		x.RateMultipliers = nil
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		x.TotalShares = ""
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		x.InitialRewardsTime = nil
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		x.UnbondingTiers = nil
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		x.ExitFeeTiers = nil
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		x.LockTiers = nil
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		x.TotalBoostedShares = ""
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		x.RewardPerShare = nil
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		x.IncentivePerShare = nil
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		x.FeeSplits = nil
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		x.DynamicFee = nil
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		x.DirectionalFee = nil
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		x.PendingRewards = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		value := x.ProtocolFeePercentage
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		value := x.RewardsFee
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		value := x.InitialA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		value := x.FutureA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		value := x.InitialATime
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		value := x.FutureATime
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		if len(x.RateMultipliers) == 0 {
+			return protoreflect.ValueOfList(&_Pool_7_list{})
+		}
+		listValue := &_Pool_7_list{list: &x.RateMultipliers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		value := x.TotalShares
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		value := x.InitialRewardsTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		if len(x.UnbondingTiers) == 0 {
+			return protoreflect.ValueOfList(&_Pool_10_list{})
+		}
+		listValue := &_Pool_10_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		if len(x.ExitFeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_Pool_11_list{})
+		}
+		listValue := &_Pool_11_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		if len(x.LockTiers) == 0 {
+			return protoreflect.ValueOfList(&_Pool_12_list{})
+		}
+		listValue := &_Pool_12_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		value := x.TotalBoostedShares
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		if len(x.RewardPerShare) == 0 {
+			return protoreflect.ValueOfList(&_Pool_14_list{})
+		}
+		listValue := &_Pool_14_list{list: &x.RewardPerShare}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		if len(x.IncentivePerShare) == 0 {
+			return protoreflect.ValueOfList(&_Pool_15_list{})
+		}
+		listValue := &_Pool_15_list{list: &x.IncentivePerShare}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		if len(x.FeeSplits) == 0 {
+			return protoreflect.ValueOfList(&_Pool_16_list{})
+		}
+		listValue := &_Pool_16_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		value := x.DynamicFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		value := x.DirectionalFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		if len(x.PendingRewards) == 0 {
+			return protoreflect.ValueOfList(&_Pool_19_list{})
+		}
+		listValue := &_Pool_19_list{list: &x.PendingRewards}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		x.ProtocolFeePercentage = value.Int()
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		x.RewardsFee = value.Int()
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		x.InitialA = value.Int()
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		x.FutureA = value.Int()
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		x.InitialATime = value.Int()
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		x.FutureATime = value.Int()
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		lv := value.List()
+		clv := lv.(*_Pool_7_list)
+		x.RateMultipliers = *clv.list
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		x.TotalShares = value.Interface().(string)
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		x.InitialRewardsTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		lv := value.List()
+		clv := lv.(*_Pool_10_list)
+		x.UnbondingTiers = *clv.list
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		lv := value.List()
+		clv := lv.(*_Pool_11_list)
+		x.ExitFeeTiers = *clv.list
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		lv := value.List()
+		clv := lv.(*_Pool_12_list)
+		x.LockTiers = *clv.list
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		x.TotalBoostedShares = value.Interface().(string)
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		lv := value.List()
+		clv := lv.(*_Pool_14_list)
+		x.RewardPerShare = *clv.list
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		lv := value.List()
+		clv := lv.(*_Pool_15_list)
+		x.IncentivePerShare = *clv.list
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		lv := value.List()
+		clv := lv.(*_Pool_16_list)
+		x.FeeSplits = *clv.list
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		x.DynamicFee = value.Message().Interface().(*DynamicFee)
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		x.DirectionalFee = value.Message().Interface().(*DirectionalFee)
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		lv := value.List()
+		clv := lv.(*_Pool_19_list)
+		x.PendingRewards = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		if x.RateMultipliers == nil {
+			x.RateMultipliers = []*v1beta1.Coin{}
+		}
+		value := &_Pool_7_list{list: &x.RateMultipliers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		if x.InitialRewardsTime == nil {
+			x.InitialRewardsTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.InitialRewardsTime.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		if x.UnbondingTiers == nil {
+			x.UnbondingTiers = []*UnbondingTier{}
+		}
+		value := &_Pool_10_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		if x.ExitFeeTiers == nil {
+			x.ExitFeeTiers = []*ExitFeeTier{}
+		}
+		value := &_Pool_11_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		if x.LockTiers == nil {
+			x.LockTiers = []*LockTier{}
+		}
+		value := &_Pool_12_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		if x.RewardPerShare == nil {
+			x.RewardPerShare = []*v1beta1.DecCoin{}
+		}
+		value := &_Pool_14_list{list: &x.RewardPerShare}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		if x.IncentivePerShare == nil {
+			x.IncentivePerShare = []*v1beta1.DecCoin{}
+		}
+		value := &_Pool_15_list{list: &x.IncentivePerShare}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		if x.FeeSplits == nil {
+			x.FeeSplits = []*FeeSplit{}
+		}
+		value := &_Pool_16_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		if x.DynamicFee == nil {
+			x.DynamicFee = new(DynamicFee)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		if x.DirectionalFee == nil {
+			x.DirectionalFee = new(DirectionalFee)
+		}
+		return protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		if x.PendingRewards == nil {
+			x.PendingRewards = []*v1beta1.Coin{}
+		}
+		value := &_Pool_19_list{list: &x.PendingRewards}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		panic(fmt.Errorf("field protocol_fee_percentage of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		panic(fmt.Errorf("field rewards_fee of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		panic(fmt.Errorf("field initial_a of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		panic(fmt.Errorf("field future_a of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		panic(fmt.Errorf("field initial_a_time of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		panic(fmt.Errorf("field future_a_time of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		panic(fmt.Errorf("field total_shares of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		panic(fmt.Errorf("field total_boosted_shares of message noble.swap.stableswap.v1.Pool is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.rewards_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.initial_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.future_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.future_a_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Pool_7_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.total_shares":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.unbonding_tiers":
+		list := []*UnbondingTier{}
+		return protoreflect.ValueOfList(&_Pool_10_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.exit_fee_tiers":
+		list := []*ExitFeeTier{}
+		return protoreflect.ValueOfList(&_Pool_11_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.lock_tiers":
+		list := []*LockTier{}
+		return protoreflect.ValueOfList(&_Pool_12_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.total_boosted_shares":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.Pool.reward_per_share":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Pool_14_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.incentive_per_share":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Pool_15_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.fee_splits":
+		list := []*FeeSplit{}
+		return protoreflect.ValueOfList(&_Pool_16_list{list: &list})
+	case "noble.swap.stableswap.v1.Pool.dynamic_fee":
+		m := new(DynamicFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.directional_fee":
+		m := new(DirectionalFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.Pool.pending_rewards":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Pool_19_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.Pool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Pool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProtocolFeePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolFeePercentage))
+		}
+		if x.RewardsFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.RewardsFee))
+		}
+		if x.InitialA != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialA))
+		}
+		if x.FutureA != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureA))
+		}
+		if x.InitialATime != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialATime))
+		}
+		if x.FutureATime != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureATime))
+		}
+		if len(x.RateMultipliers) > 0 {
+			for _, e := range x.RateMultipliers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InitialRewardsTime != nil {
+			l = options.Size(x.InitialRewardsTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.UnbondingTiers) > 0 {
+			for _, e := range x.UnbondingTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for _, e := range x.ExitFeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for _, e := range x.LockTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalBoostedShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardPerShare) > 0 {
+			for _, e := range x.RewardPerShare {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IncentivePerShare) > 0 {
+			for _, e := range x.IncentivePerShare {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSplits) > 0 {
+			for _, e := range x.FeeSplits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicFee != nil {
+			l = options.Size(x.DynamicFee)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.DirectionalFee != nil {
+			l = options.Size(x.DirectionalFee)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PendingRewards) > 0 {
+			for _, e := range x.PendingRewards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PendingRewards) > 0 {
+			for iNdEx := len(x.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.DirectionalFee != nil {
+			encoded, err := options.Marshal(x.DirectionalFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.DynamicFee != nil {
+			encoded, err := options.Marshal(x.DynamicFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.FeeSplits) > 0 {
+			for iNdEx := len(x.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSplits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.IncentivePerShare) > 0 {
+			for iNdEx := len(x.IncentivePerShare) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IncentivePerShare[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.RewardPerShare) > 0 {
+			for iNdEx := len(x.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardPerShare[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.TotalBoostedShares) > 0 {
+			i -= len(x.TotalBoostedShares)
+			copy(dAtA[i:], x.TotalBoostedShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBoostedShares)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.LockTiers) > 0 {
+			for iNdEx := len(x.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for iNdEx := len(x.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.UnbondingTiers) > 0 {
+			for iNdEx := len(x.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.InitialRewardsTime != nil {
+			encoded, err := options.Marshal(x.InitialRewardsTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.TotalShares) > 0 {
+			i -= len(x.TotalShares)
+			copy(dAtA[i:], x.TotalShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalShares)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.RateMultipliers) > 0 {
+			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.FutureATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.InitialATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialATime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.FutureA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.InitialA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RewardsFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardsFee))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ProtocolFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolFeePercentage))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Pool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+				}
+				x.ProtocolFeePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolFeePercentage |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+				}
+				x.RewardsFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardsFee |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+				}
+				x.InitialA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+				}
+				x.FutureA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+				}
+				x.InitialATime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialATime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				}
+				x.FutureATime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureATime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateMultipliers = append(x.RateMultipliers, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateMultipliers[len(x.RateMultipliers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialRewardsTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.InitialRewardsTime == nil {
+					x.InitialRewardsTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialRewardsTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingTiers = append(x.UnbondingTiers, &UnbondingTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTiers[len(x.UnbondingTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExitFeeTiers = append(x.ExitFeeTiers, &ExitFeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFeeTiers[len(x.ExitFeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTiers = append(x.LockTiers, &LockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockTiers[len(x.LockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBoostedShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalBoostedShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardPerShare = append(x.RewardPerShare, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardPerShare[len(x.RewardPerShare)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentivePerShare", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IncentivePerShare = append(x.IncentivePerShare, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncentivePerShare[len(x.IncentivePerShare)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSplits = append(x.FeeSplits, &FeeSplit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplits[len(x.FeeSplits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicFee == nil {
+					x.DynamicFee = &DynamicFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DirectionalFee == nil {
+					x.DirectionalFee = &DirectionalFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingRewards = append(x.PendingRewards, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingRewards[len(x.PendingRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_UnbondingTier           protoreflect.MessageDescriptor
+	fd_UnbondingTier_threshold protoreflect.FieldDescriptor
+	fd_UnbondingTier_duration  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_UnbondingTier = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("UnbondingTier")
+	fd_UnbondingTier_threshold = md_UnbondingTier.Fields().ByName("threshold")
+	fd_UnbondingTier_duration = md_UnbondingTier.Fields().ByName("duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_UnbondingTier)(nil)
+
+type fastReflection_UnbondingTier UnbondingTier
+
+func (x *UnbondingTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnbondingTier)(x)
+}
+
+func (x *UnbondingTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_UnbondingTier_messageType fastReflection_UnbondingTier_messageType
+var _ protoreflect.MessageType = fastReflection_UnbondingTier_messageType{}
+
+type fastReflection_UnbondingTier_messageType struct{}
+
+func (x fastReflection_UnbondingTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnbondingTier)(nil)
+}
+func (x fastReflection_UnbondingTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnbondingTier)
+}
+func (x fastReflection_UnbondingTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_UnbondingTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnbondingTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_UnbondingTier) Type() protoreflect.MessageType {
+	return _fastReflection_UnbondingTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_UnbondingTier) New() protoreflect.Message {
+	return new(fastReflection_UnbondingTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_UnbondingTier) Interface() protoreflect.ProtoMessage {
+	return (*UnbondingTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_UnbondingTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_UnbondingTier_threshold, value) {
+			return
+		}
+	}
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_UnbondingTier_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_UnbondingTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		return x.Threshold != ""
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		return x.Duration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		x.Threshold = ""
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		x.Duration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_UnbondingTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		x.Threshold = value.Interface().(string)
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		panic(fmt.Errorf("field threshold of message noble.swap.stableswap.v1.UnbondingTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_UnbondingTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.UnbondingTier.threshold":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.UnbondingTier.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.UnbondingTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.UnbondingTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_UnbondingTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.UnbondingTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_UnbondingTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_UnbondingTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_UnbondingTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_UnbondingTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*UnbondingTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*UnbondingTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnbondingTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExitFeeTier                protoreflect.MessageDescriptor
+	fd_ExitFeeTier_threshold      protoreflect.FieldDescriptor
+	fd_ExitFeeTier_fee_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_ExitFeeTier = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("ExitFeeTier")
+	fd_ExitFeeTier_threshold = md_ExitFeeTier.Fields().ByName("threshold")
+	fd_ExitFeeTier_fee_percentage = md_ExitFeeTier.Fields().ByName("fee_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExitFeeTier)(nil)
+
+type fastReflection_ExitFeeTier ExitFeeTier
+
+func (x *ExitFeeTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExitFeeTier)(x)
+}
+
+func (x *ExitFeeTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExitFeeTier_messageType fastReflection_ExitFeeTier_messageType
+var _ protoreflect.MessageType = fastReflection_ExitFeeTier_messageType{}
+
+type fastReflection_ExitFeeTier_messageType struct{}
+
+func (x fastReflection_ExitFeeTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExitFeeTier)(nil)
+}
+func (x fastReflection_ExitFeeTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExitFeeTier)
+}
+func (x fastReflection_ExitFeeTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExitFeeTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExitFeeTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExitFeeTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExitFeeTier) Type() protoreflect.MessageType {
+	return _fastReflection_ExitFeeTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExitFeeTier) New() protoreflect.Message {
+	return new(fastReflection_ExitFeeTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExitFeeTier) Interface() protoreflect.ProtoMessage {
+	return (*ExitFeeTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExitFeeTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_ExitFeeTier_threshold, value) {
+			return
+		}
+	}
+	if x.FeePercentage != "" {
+		value := protoreflect.ValueOfString(x.FeePercentage)
+		if !f(fd_ExitFeeTier_fee_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExitFeeTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		return x.Threshold != ""
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		return x.FeePercentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExitFeeTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		x.Threshold = ""
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		x.FeePercentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExitFeeTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		value := x.FeePercentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExitFeeTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		x.Threshold = value.Interface().(string)
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		x.FeePercentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExitFeeTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		panic(fmt.Errorf("field threshold of message noble.swap.stableswap.v1.ExitFeeTier is not mutable"))
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		panic(fmt.Errorf("field fee_percentage of message noble.swap.stableswap.v1.ExitFeeTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExitFeeTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.ExitFeeTier.threshold":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.ExitFeeTier.fee_percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.ExitFeeTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.ExitFeeTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExitFeeTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.ExitFeeTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExitFeeTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExitFeeTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExitFeeTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExitFeeTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExitFeeTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeePercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExitFeeTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FeePercentage) > 0 {
+			i -= len(x.FeePercentage)
+			copy(dAtA[i:], x.FeePercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeePercentage)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExitFeeTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExitFeeTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExitFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeePercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LockTier            protoreflect.MessageDescriptor
+	fd_LockTier_duration   protoreflect.FieldDescriptor
+	fd_LockTier_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_LockTier = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("LockTier")
+	fd_LockTier_duration = md_LockTier.Fields().ByName("duration")
+	fd_LockTier_multiplier = md_LockTier.Fields().ByName("multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_LockTier)(nil)
+
+type fastReflection_LockTier LockTier
+
+func (x *LockTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockTier)(x)
+}
+
+func (x *LockTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LockTier_messageType fastReflection_LockTier_messageType
+var _ protoreflect.MessageType = fastReflection_LockTier_messageType{}
+
+type fastReflection_LockTier_messageType struct{}
+
+func (x fastReflection_LockTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockTier)(nil)
+}
+func (x fastReflection_LockTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockTier)
+}
+func (x fastReflection_LockTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LockTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LockTier) Type() protoreflect.MessageType {
+	return _fastReflection_LockTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LockTier) New() protoreflect.Message {
+	return new(fastReflection_LockTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LockTier) Interface() protoreflect.ProtoMessage {
+	return (*LockTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LockTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Duration != nil {
+		value := protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+		if !f(fd_LockTier_duration, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_LockTier_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LockTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		return x.Duration != nil
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		return x.Multiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		x.Duration = nil
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		x.Multiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LockTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		value := x.Duration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		x.Duration = value.Message().Interface().(*durationpb.Duration)
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		x.Multiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		if x.Duration == nil {
+			x.Duration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Duration.ProtoReflect())
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		panic(fmt.Errorf("field multiplier of message noble.swap.stableswap.v1.LockTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LockTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.LockTier.duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.LockTier.multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.LockTier"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.LockTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LockTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.LockTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LockTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LockTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LockTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LockTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LockTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Duration != nil {
+			l = options.Size(x.Duration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LockTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Duration != nil {
+			encoded, err := options.Marshal(x.Duration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Duration == nil {
+					x.Duration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Duration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeSplit                protoreflect.MessageDescriptor
+	fd_FeeSplit_name           protoreflect.FieldDescriptor
+	fd_FeeSplit_recipient_type protoreflect.FieldDescriptor
+	fd_FeeSplit_recipient      protoreflect.FieldDescriptor
+	fd_FeeSplit_weight         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_FeeSplit = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("FeeSplit")
+	fd_FeeSplit_name = md_FeeSplit.Fields().ByName("name")
+	fd_FeeSplit_recipient_type = md_FeeSplit.Fields().ByName("recipient_type")
+	fd_FeeSplit_recipient = md_FeeSplit.Fields().ByName("recipient")
+	fd_FeeSplit_weight = md_FeeSplit.Fields().ByName("weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeSplit)(nil)
+
+type fastReflection_FeeSplit FeeSplit
+
+func (x *FeeSplit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(x)
+}
+
+func (x *FeeSplit) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeSplit_messageType fastReflection_FeeSplit_messageType
+var _ protoreflect.MessageType = fastReflection_FeeSplit_messageType{}
+
+type fastReflection_FeeSplit_messageType struct{}
+
+func (x fastReflection_FeeSplit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeSplit)(nil)
+}
+func (x fastReflection_FeeSplit_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+func (x fastReflection_FeeSplit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeSplit) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeSplit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeSplit) Type() protoreflect.MessageType {
+	return _fastReflection_FeeSplit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeSplit) New() protoreflect.Message {
+	return new(fastReflection_FeeSplit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeSplit) Interface() protoreflect.ProtoMessage {
+	return (*FeeSplit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeSplit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_FeeSplit_name, value) {
+			return
+		}
+	}
+	if x.RecipientType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RecipientType))
+		if !f(fd_FeeSplit_recipient_type, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_FeeSplit_recipient, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_FeeSplit_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeSplit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		return x.Name != ""
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		return x.RecipientType != 0
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		return x.Recipient != ""
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		x.Name = ""
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		x.RecipientType = 0
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		x.Recipient = ""
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeSplit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		value := x.RecipientType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		x.Name = value.Interface().(string)
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		x.RecipientType = (FeeRecipientType)(value.Enum())
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		x.Recipient = value.Interface().(string)
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		panic(fmt.Errorf("field name of message noble.swap.stableswap.v1.FeeSplit is not mutable"))
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		panic(fmt.Errorf("field recipient_type of message noble.swap.stableswap.v1.FeeSplit is not mutable"))
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		panic(fmt.Errorf("field recipient of message noble.swap.stableswap.v1.FeeSplit is not mutable"))
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		panic(fmt.Errorf("field weight of message noble.swap.stableswap.v1.FeeSplit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeSplit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.FeeSplit.name":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.FeeSplit.recipient_type":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.swap.stableswap.v1.FeeSplit.recipient":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.FeeSplit.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.FeeSplit"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.FeeSplit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeSplit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.FeeSplit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeSplit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeSplit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeSplit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeSplit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecipientType != 0 {
+			n += 1 + runtime.Sov(uint64(x.RecipientType))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.RecipientType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RecipientType))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeSplit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
+				}
+				x.RecipientType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RecipientType |= FeeRecipientType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DynamicFee            protoreflect.MessageDescriptor
+	fd_DynamicFee_multiplier protoreflect.FieldDescriptor
+	fd_DynamicFee_min_fee    protoreflect.FieldDescriptor
+	fd_DynamicFee_max_fee    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_pool_proto_init()
+	md_DynamicFee = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("DynamicFee")
+	fd_DynamicFee_multiplier = md_DynamicFee.Fields().ByName("multiplier")
+	fd_DynamicFee_min_fee = md_DynamicFee.Fields().ByName("min_fee")
+	fd_DynamicFee_max_fee = md_DynamicFee.Fields().ByName("max_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_DynamicFee)(nil)
+
+type fastReflection_DynamicFee DynamicFee
+
+func (x *DynamicFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DynamicFee)(x)
+}
+
+func (x *DynamicFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DynamicFee_messageType fastReflection_DynamicFee_messageType
+var _ protoreflect.MessageType = fastReflection_DynamicFee_messageType{}
+
+type fastReflection_DynamicFee_messageType struct{}
+
+func (x fastReflection_DynamicFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DynamicFee)(nil)
+}
+func (x fastReflection_DynamicFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_DynamicFee)
+}
+func (x fastReflection_DynamicFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DynamicFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_DynamicFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DynamicFee) Type() protoreflect.MessageType {
+	return _fastReflection_DynamicFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DynamicFee) New() protoreflect.Message {
+	return new(fastReflection_DynamicFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DynamicFee) Interface() protoreflect.ProtoMessage {
+	return (*DynamicFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DynamicFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_DynamicFee_multiplier, value) {
+			return
+		}
+	}
+	if x.MinFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinFee)
+		if !f(fd_DynamicFee_min_fee, value) {
+			return
+		}
+	}
+	if x.MaxFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxFee)
+		if !f(fd_DynamicFee_max_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DynamicFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		return x.Multiplier != ""
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		return x.MinFee != int64(0)
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		return x.MaxFee != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		x.Multiplier = ""
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		x.MinFee = int64(0)
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		x.MaxFee = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DynamicFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		value := x.MinFee
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		x.Multiplier = value.Interface().(string)
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		x.MinFee = value.Int()
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		x.MaxFee = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		panic(fmt.Errorf("field multiplier of message noble.swap.stableswap.v1.DynamicFee is not mutable"))
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		panic(fmt.Errorf("field min_fee of message noble.swap.stableswap.v1.DynamicFee is not mutable"))
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		panic(fmt.Errorf("field max_fee of message noble.swap.stableswap.v1.DynamicFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DynamicFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.DynamicFee.multiplier":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.DynamicFee.min_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.DynamicFee.max_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DynamicFee"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DynamicFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DynamicFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.DynamicFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DynamicFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DynamicFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DynamicFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DynamicFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DynamicFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinFee))
+		}
+		if x.MaxFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFee))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DynamicFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFee))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MinFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinFee))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DynamicFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DynamicFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				}
+				x.MinFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinFee |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				x.MaxFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFee |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Pool                         protoreflect.MessageDescriptor
-	fd_Pool_protocol_fee_percentage protoreflect.FieldDescriptor
-	fd_Pool_rewards_fee             protoreflect.FieldDescriptor
-	fd_Pool_initial_a               protoreflect.FieldDescriptor
-	fd_Pool_future_a                protoreflect.FieldDescriptor
-	fd_Pool_initial_a_time          protoreflect.FieldDescriptor
-	fd_Pool_future_a_time           protoreflect.FieldDescriptor
-	fd_Pool_rate_multipliers        protoreflect.FieldDescriptor
-	fd_Pool_total_shares            protoreflect.FieldDescriptor
-	fd_Pool_initial_rewards_time    protoreflect.FieldDescriptor
+	md_DirectionalFee                  protoreflect.MessageDescriptor
+	fd_DirectionalFee_pair_to_base_fee protoreflect.FieldDescriptor
+	fd_DirectionalFee_base_to_pair_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_swap_stableswap_v1_pool_proto_init()
-	md_Pool = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("Pool")
-	fd_Pool_protocol_fee_percentage = md_Pool.Fields().ByName("protocol_fee_percentage")
-	fd_Pool_rewards_fee = md_Pool.Fields().ByName("rewards_fee")
-	fd_Pool_initial_a = md_Pool.Fields().ByName("initial_a")
-	fd_Pool_future_a = md_Pool.Fields().ByName("future_a")
-	fd_Pool_initial_a_time = md_Pool.Fields().ByName("initial_a_time")
-	fd_Pool_future_a_time = md_Pool.Fields().ByName("future_a_time")
-	fd_Pool_rate_multipliers = md_Pool.Fields().ByName("rate_multipliers")
-	fd_Pool_total_shares = md_Pool.Fields().ByName("total_shares")
-	fd_Pool_initial_rewards_time = md_Pool.Fields().ByName("initial_rewards_time")
+	md_DirectionalFee = File_noble_swap_stableswap_v1_pool_proto.Messages().ByName("DirectionalFee")
+	fd_DirectionalFee_pair_to_base_fee = md_DirectionalFee.Fields().ByName("pair_to_base_fee")
+	fd_DirectionalFee_base_to_pair_fee = md_DirectionalFee.Fields().ByName("base_to_pair_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_Pool)(nil)
+var _ protoreflect.Message = (*fastReflection_DirectionalFee)(nil)
 
-type fastReflection_Pool Pool
+type fastReflection_DirectionalFee DirectionalFee
 
-func (x *Pool) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Pool)(x)
+func (x *DirectionalFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DirectionalFee)(x)
 }
 
-func (x *Pool) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[0]
+func (x *DirectionalFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,43 +4766,43 @@ func (x *Pool) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Pool_messageType fastReflection_Pool_messageType
-var _ protoreflect.MessageType = fastReflection_Pool_messageType{}
+var _fastReflection_DirectionalFee_messageType fastReflection_DirectionalFee_messageType
+var _ protoreflect.MessageType = fastReflection_DirectionalFee_messageType{}
 
-type fastReflection_Pool_messageType struct{}
+type fastReflection_DirectionalFee_messageType struct{}
 
-func (x fastReflection_Pool_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Pool)(nil)
+func (x fastReflection_DirectionalFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DirectionalFee)(nil)
 }
-func (x fastReflection_Pool_messageType) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x fastReflection_DirectionalFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_DirectionalFee)
 }
-func (x fastReflection_Pool_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x fastReflection_DirectionalFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DirectionalFee
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Pool) Descriptor() protoreflect.MessageDescriptor {
-	return md_Pool
+func (x *fastReflection_DirectionalFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_DirectionalFee
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Pool) Type() protoreflect.MessageType {
-	return _fastReflection_Pool_messageType
+func (x *fastReflection_DirectionalFee) Type() protoreflect.MessageType {
+	return _fastReflection_DirectionalFee_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Pool) New() protoreflect.Message {
-	return new(fastReflection_Pool)
+func (x *fastReflection_DirectionalFee) New() protoreflect.Message {
+	return new(fastReflection_DirectionalFee)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
-	return (*Pool)(x)
+func (x *fastReflection_DirectionalFee) Interface() protoreflect.ProtoMessage {
+	return (*DirectionalFee)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -159,58 +4810,16 @@ func (x *fastReflection_Pool) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProtocolFeePercentage != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ProtocolFeePercentage)
-		if !f(fd_Pool_protocol_fee_percentage, value) {
+func (x *fastReflection_DirectionalFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PairToBaseFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PairToBaseFee)
+		if !f(fd_DirectionalFee_pair_to_base_fee, value) {
 			return
 		}
 	}
-	if x.RewardsFee != int64(0) {
-		value := protoreflect.ValueOfInt64(x.RewardsFee)
-		if !f(fd_Pool_rewards_fee, value) {
-			return
-		}
-	}
-	if x.InitialA != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InitialA)
-		if !f(fd_Pool_initial_a, value) {
-			return
-		}
-	}
-	if x.FutureA != int64(0) {
-		value := protoreflect.ValueOfInt64(x.FutureA)
-		if !f(fd_Pool_future_a, value) {
-			return
-		}
-	}
-	if x.InitialATime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.InitialATime)
-		if !f(fd_Pool_initial_a_time, value) {
-			return
-		}
-	}
-	if x.FutureATime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.FutureATime)
-		if !f(fd_Pool_future_a_time, value) {
-			return
-		}
-	}
-	if len(x.RateMultipliers) != 0 {
-		value := protoreflect.ValueOfList(&_Pool_7_list{list: &x.RateMultipliers})
-		if !f(fd_Pool_rate_multipliers, value) {
-			return
-		}
-	}
-	if x.TotalShares != "" {
-		value := protoreflect.ValueOfString(x.TotalShares)
-		if !f(fd_Pool_total_shares, value) {
-			return
-		}
-	}
-	if x.InitialRewardsTime != nil {
-		value := protoreflect.ValueOfMessage(x.InitialRewardsTime.ProtoReflect())
-		if !f(fd_Pool_initial_rewards_time, value) {
+	if x.BaseToPairFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BaseToPairFee)
+		if !f(fd_DirectionalFee_base_to_pair_fee, value) {
 			return
 		}
 	}
@@ -227,31 +4836,17 @@ func (x *fastReflection_Pool) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DirectionalFee) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		return x.ProtocolFeePercentage != int64(0)
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		return x.RewardsFee != int64(0)
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		return x.InitialA != int64(0)
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		return x.FutureA != int64(0)
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
-		return x.InitialATime != int64(0)
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
-		return x.FutureATime != int64(0)
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		return len(x.RateMultipliers) != 0
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		return x.TotalShares != ""
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		return x.InitialRewardsTime != nil
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
+		return x.PairToBaseFee != int64(0)
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
+		return x.BaseToPairFee != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -261,31 +4856,17 @@ func (x *fastReflection_Pool) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DirectionalFee) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		x.ProtocolFeePercentage = int64(0)
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		x.RewardsFee = int64(0)
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		x.InitialA = int64(0)
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		x.FutureA = int64(0)
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
-		x.InitialATime = int64(0)
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
-		x.FutureATime = int64(0)
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		x.RateMultipliers = nil
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		x.TotalShares = ""
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		x.InitialRewardsTime = nil
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
+		x.PairToBaseFee = int64(0)
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
+		x.BaseToPairFee = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -295,43 +4876,19 @@ func (x *fastReflection_Pool) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DirectionalFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		value := x.ProtocolFeePercentage
-		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		value := x.RewardsFee
-		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		value := x.InitialA
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
+		value := x.PairToBaseFee
 		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		value := x.FutureA
-		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
-		value := x.InitialATime
-		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
-		value := x.FutureATime
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
+		value := x.BaseToPairFee
 		return protoreflect.ValueOfInt64(value)
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		if len(x.RateMultipliers) == 0 {
-			return protoreflect.ValueOfList(&_Pool_7_list{})
-		}
-		listValue := &_Pool_7_list{list: &x.RateMultipliers}
-		return protoreflect.ValueOfList(listValue)
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		value := x.TotalShares
-		return protoreflect.ValueOfString(value)
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		value := x.InitialRewardsTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -345,33 +4902,17 @@ func (x *fastReflection_Pool) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DirectionalFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		x.ProtocolFeePercentage = value.Int()
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		x.RewardsFee = value.Int()
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		x.InitialA = value.Int()
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		x.FutureA = value.Int()
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
-		x.InitialATime = value.Int()
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
-		x.FutureATime = value.Int()
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		lv := value.List()
-		clv := lv.(*_Pool_7_list)
-		x.RateMultipliers = *clv.list
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		x.TotalShares = value.Interface().(string)
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		x.InitialRewardsTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
+		x.PairToBaseFee = value.Int()
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
+		x.BaseToPairFee = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -385,81 +4926,44 @@ func (x *fastReflection_Pool) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DirectionalFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		if x.RateMultipliers == nil {
-			x.RateMultipliers = []*v1beta1.Coin{}
-		}
-		value := &_Pool_7_list{list: &x.RateMultipliers}
-		return protoreflect.ValueOfList(value)
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		if x.InitialRewardsTime == nil {
-			x.InitialRewardsTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.InitialRewardsTime.ProtoReflect())
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		panic(fmt.Errorf("field protocol_fee_percentage of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		panic(fmt.Errorf("field rewards_fee of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		panic(fmt.Errorf("field initial_a of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		panic(fmt.Errorf("field future_a of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
-		panic(fmt.Errorf("field initial_a_time of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
-		panic(fmt.Errorf("field future_a_time of message noble.swap.stableswap.v1.Pool is not mutable"))
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		panic(fmt.Errorf("field total_shares of message noble.swap.stableswap.v1.Pool is not mutable"))
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
+		panic(fmt.Errorf("field pair_to_base_fee of message noble.swap.stableswap.v1.DirectionalFee is not mutable"))
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
+		panic(fmt.Errorf("field base_to_pair_fee of message noble.swap.stableswap.v1.DirectionalFee is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Pool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DirectionalFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.swap.stableswap.v1.Pool.protocol_fee_percentage":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.rewards_fee":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.initial_a":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.future_a":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.initial_a_time":
+	case "noble.swap.stableswap.v1.DirectionalFee.pair_to_base_fee":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.future_a_time":
+	case "noble.swap.stableswap.v1.DirectionalFee.base_to_pair_fee":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "noble.swap.stableswap.v1.Pool.rate_multipliers":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Pool_7_list{list: &list})
-	case "noble.swap.stableswap.v1.Pool.total_shares":
-		return protoreflect.ValueOfString("")
-	case "noble.swap.stableswap.v1.Pool.initial_rewards_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.Pool"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.DirectionalFee"))
 		}
-		panic(fmt.Errorf("message noble.swap.stableswap.v1.Pool does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.DirectionalFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DirectionalFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.Pool", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.DirectionalFee", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -467,7 +4971,7 @@ func (x *fastReflection_Pool) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DirectionalFee) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -478,7 +4982,7 @@ func (x *fastReflection_Pool) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DirectionalFee) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -490,7 +4994,7 @@ func (x *fastReflection_Pool) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Pool) IsValid() bool {
+func (x *fastReflection_DirectionalFee) IsValid() bool {
 	return x != nil
 }
 
@@ -500,9 +5004,9 @@ func (x *fastReflection_Pool) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DirectionalFee) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*DirectionalFee)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -514,37 +5018,11 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProtocolFeePercentage != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProtocolFeePercentage))
-		}
-		if x.RewardsFee != 0 {
-			n += 1 + runtime.Sov(uint64(x.RewardsFee))
-		}
-		if x.InitialA != 0 {
-			n += 1 + runtime.Sov(uint64(x.InitialA))
-		}
-		if x.FutureA != 0 {
-			n += 1 + runtime.Sov(uint64(x.FutureA))
-		}
-		if x.InitialATime != 0 {
-			n += 1 + runtime.Sov(uint64(x.InitialATime))
-		}
-		if x.FutureATime != 0 {
-			n += 1 + runtime.Sov(uint64(x.FutureATime))
-		}
-		if len(x.RateMultipliers) > 0 {
-			for _, e := range x.RateMultipliers {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.TotalShares)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PairToBaseFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.PairToBaseFee))
 		}
-		if x.InitialRewardsTime != nil {
-			l = options.Size(x.InitialRewardsTime)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.BaseToPairFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseToPairFee))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -556,7 +5034,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*DirectionalFee)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -575,70 +5053,13 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.InitialRewardsTime != nil {
-			encoded, err := options.Marshal(x.InitialRewardsTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.TotalShares) > 0 {
-			i -= len(x.TotalShares)
-			copy(dAtA[i:], x.TotalShares)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalShares)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.RateMultipliers) > 0 {
-			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if x.FutureATime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.InitialATime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialATime))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.FutureA != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.InitialA != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.RewardsFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardsFee))
+		if x.BaseToPairFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseToPairFee))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.ProtocolFeePercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolFeePercentage))
+		if x.PairToBaseFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PairToBaseFee))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -653,7 +5074,7 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Pool)
+		x := input.Message.Interface().(*DirectionalFee)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -684,166 +5105,18 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			}
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
-				}
-				x.ProtocolFeePercentage = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProtocolFeePercentage |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
-				}
-				x.RewardsFee = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RewardsFee |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
-				}
-				x.InitialA = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InitialA |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
-				}
-				x.FutureA = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.FutureA |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
-				}
-				x.InitialATime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.InitialATime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
-				}
-				x.FutureATime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.FutureATime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RateMultipliers = append(x.RateMultipliers, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateMultipliers[len(x.RateMultipliers)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DirectionalFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DirectionalFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PairToBaseFee", wireType)
 				}
-				var stringLen uint64
+				x.PairToBaseFee = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -853,29 +5126,16 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PairToBaseFee |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TotalShares = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialRewardsTime", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseToPairFee", wireType)
 				}
-				var msglen int
+				x.BaseToPairFee = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -885,28 +5145,11 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.BaseToPairFee |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.InitialRewardsTime == nil {
-					x.InitialRewardsTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InitialRewardsTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -926,141 +5169,585 @@ func (x *fastReflection_Pool) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/stableswap/v1/pool.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// buf:lint:ignore ENUM_VALUE_PREFIX
+type FeeRecipientType int32
+
+const (
+	// buf:lint:ignore ENUM_ZERO_VALUE_SUFFIX
+	FeeRecipientType_FEE_RECIPIENT_UNSPECIFIED    FeeRecipientType = 0
+	FeeRecipientType_FEE_RECIPIENT_ADDRESS        FeeRecipientType = 1
+	FeeRecipientType_FEE_RECIPIENT_MODULE         FeeRecipientType = 2
+	FeeRecipientType_FEE_RECIPIENT_COMMUNITY_POOL FeeRecipientType = 3
+	FeeRecipientType_FEE_RECIPIENT_BURN           FeeRecipientType = 4
+)
+
+// Enum value maps for FeeRecipientType.
+var (
+	FeeRecipientType_name = map[int32]string{
+		0: "FEE_RECIPIENT_UNSPECIFIED",
+		1: "FEE_RECIPIENT_ADDRESS",
+		2: "FEE_RECIPIENT_MODULE",
+		3: "FEE_RECIPIENT_COMMUNITY_POOL",
+		4: "FEE_RECIPIENT_BURN",
+	}
+	FeeRecipientType_value = map[string]int32{
+		"FEE_RECIPIENT_UNSPECIFIED":    0,
+		"FEE_RECIPIENT_ADDRESS":        1,
+		"FEE_RECIPIENT_MODULE":         2,
+		"FEE_RECIPIENT_COMMUNITY_POOL": 3,
+		"FEE_RECIPIENT_BURN":           4,
+	}
+)
+
+func (x FeeRecipientType) Enum() *FeeRecipientType {
+	p := new(FeeRecipientType)
+	*p = x
+	return p
+}
+
+func (x FeeRecipientType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeRecipientType) Descriptor() protoreflect.EnumDescriptor {
+	return file_noble_swap_stableswap_v1_pool_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeRecipientType) Type() protoreflect.EnumType {
+	return &file_noble_swap_stableswap_v1_pool_proto_enumTypes[0]
+}
+
+func (x FeeRecipientType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeRecipientType.Descriptor instead.
+func (FeeRecipientType) EnumDescriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{0}
+}
+
+type Pool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Protocol fee percentage for the pool.
+	ProtocolFeePercentage int64 `protobuf:"varint,1,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
+	// Rewards fee for the pool.
+	RewardsFee int64 `protobuf:"varint,2,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	// Initial amplification coefficient.
+	InitialA int64 `protobuf:"varint,3,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// Future amplification coefficient.
+	FutureA int64 `protobuf:"varint,4,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Time when the amplification starts taking effect.
+	InitialATime int64 `protobuf:"varint,5,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
+	// Time when the amplification change will take full effect.
+	FutureATime int64 `protobuf:"varint,6,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// Rate multipliers applied to the coins.
+	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,7,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// Total shares issued within the Pool.
+	TotalShares string `protobuf:"bytes,8,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
+	// Time when the first liquidity was added to start tracking rewards.
+	InitialRewardsTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=initial_rewards_time,json=initialRewardsTime,proto3" json:"initial_rewards_time,omitempty"`
+	// Unbonding tiers applied when removing liquidity, the default schedule is used when empty.
+	UnbondingTiers []*UnbondingTier `protobuf:"bytes,10,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers,omitempty"`
+	// Exit fee tiers applied to instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []*ExitFeeTier `protobuf:"bytes,11,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers,omitempty"`
+	// Lock tiers available when adding liquidity, which is disabled when empty.
+	LockTiers []*LockTier `protobuf:"bytes,12,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers,omitempty"`
+	// Additional reward weight of the locked positions, on top of the total shares.
+	TotalBoostedShares string `protobuf:"bytes,13,opt,name=total_boosted_shares,json=totalBoostedShares,proto3" json:"total_boosted_shares,omitempty"`
+	// Cumulative rewards distributed per unit of reward weight, for each denom.
+	RewardPerShare []*v1beta1.DecCoin `protobuf:"bytes,14,rep,name=reward_per_share,json=rewardPerShare,proto3" json:"reward_per_share,omitempty"`
+	// Cumulative incentives distributed per unit of reward weight, for each denom.
+	IncentivePerShare []*v1beta1.DecCoin `protobuf:"bytes,15,rep,name=incentive_per_share,json=incentivePerShare,proto3" json:"incentive_per_share,omitempty"`
+	// Recipients of the protocol fees, which are kept in the pool when empty.
+	FeeSplits []*FeeSplit `protobuf:"bytes,16,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits,omitempty"`
+	// Dynamic rewards fee model based on the pool imbalance, which is disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,17,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Rewards fees applied to each swap direction, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,18,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+	// Rewards deposited while the pool had no reward weight, credited to the providers with the next deposit.
+	PendingRewards []*v1beta1.Coin `protobuf:"bytes,19,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards,omitempty"`
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pool) GetProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.ProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *Pool) GetRewardsFee() int64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *Pool) GetInitialA() int64 {
+	if x != nil {
+		return x.InitialA
+	}
+	return 0
+}
+
+func (x *Pool) GetFutureA() int64 {
+	if x != nil {
+		return x.FutureA
+	}
+	return 0
+}
+
+func (x *Pool) GetInitialATime() int64 {
+	if x != nil {
+		return x.InitialATime
+	}
+	return 0
+}
+
+func (x *Pool) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+func (x *Pool) GetRateMultipliers() []*v1beta1.Coin {
+	if x != nil {
+		return x.RateMultipliers
+	}
+	return nil
+}
+
+func (x *Pool) GetTotalShares() string {
+	if x != nil {
+		return x.TotalShares
+	}
+	return ""
+}
+
+func (x *Pool) GetInitialRewardsTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InitialRewardsTime
+	}
+	return nil
+}
+
+func (x *Pool) GetUnbondingTiers() []*UnbondingTier {
+	if x != nil {
+		return x.UnbondingTiers
+	}
+	return nil
+}
+
+func (x *Pool) GetExitFeeTiers() []*ExitFeeTier {
+	if x != nil {
+		return x.ExitFeeTiers
+	}
+	return nil
+}
+
+func (x *Pool) GetLockTiers() []*LockTier {
+	if x != nil {
+		return x.LockTiers
+	}
+	return nil
+}
+
+func (x *Pool) GetTotalBoostedShares() string {
+	if x != nil {
+		return x.TotalBoostedShares
+	}
+	return ""
+}
+
+func (x *Pool) GetRewardPerShare() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.RewardPerShare
+	}
+	return nil
+}
+
+func (x *Pool) GetIncentivePerShare() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.IncentivePerShare
+	}
+	return nil
+}
+
+func (x *Pool) GetFeeSplits() []*FeeSplit {
+	if x != nil {
+		return x.FeeSplits
+	}
+	return nil
+}
+
+func (x *Pool) GetDynamicFee() *DynamicFee {
+	if x != nil {
+		return x.DynamicFee
+	}
+	return nil
+}
+
+func (x *Pool) GetDirectionalFee() *DirectionalFee {
+	if x != nil {
+		return x.DirectionalFee
+	}
+	return nil
+}
+
+func (x *Pool) GetPendingRewards() []*v1beta1.Coin {
+	if x != nil {
+		return x.PendingRewards
+	}
+	return nil
+}
+
+type UnbondingTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of the pool total shares above which the tier applies.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Unbonding duration of the tier.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *UnbondingTier) Reset() {
+	*x = UnbondingTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+}
+
+func (x *UnbondingTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingTier) ProtoMessage() {}
+
+// Deprecated: Use UnbondingTier.ProtoReflect.Descriptor instead.
+func (*UnbondingTier) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnbondingTier) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
 	}
+	return ""
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: noble/swap/stableswap/v1/pool.proto
+func (x *UnbondingTier) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type ExitFeeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Pool struct {
+	// Percentage of the pool total shares above which the tier applies.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Percentage of the removed liquidity paid as exit fee.
+	FeePercentage string `protobuf:"bytes,2,opt,name=fee_percentage,json=feePercentage,proto3" json:"fee_percentage,omitempty"`
+}
+
+func (x *ExitFeeTier) Reset() {
+	*x = ExitFeeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitFeeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitFeeTier) ProtoMessage() {}
+
+// Deprecated: Use ExitFeeTier.ProtoReflect.Descriptor instead.
+func (*ExitFeeTier) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExitFeeTier) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *ExitFeeTier) GetFeePercentage() string {
+	if x != nil {
+		return x.FeePercentage
+	}
+	return ""
+}
+
+type LockTier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Protocol fee percentage for the pool.
-	ProtocolFeePercentage int64 `protobuf:"varint,1,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
-	// Rewards fee for the pool.
-	RewardsFee int64 `protobuf:"varint,2,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// Initial amplification coefficient.
-	InitialA int64 `protobuf:"varint,3,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
-	// Future amplification coefficient.
-	FutureA int64 `protobuf:"varint,4,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// Time when the amplification starts taking effect.
-	InitialATime int64 `protobuf:"varint,5,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
-	// Time when the amplification change will take full effect.
-	FutureATime int64 `protobuf:"varint,6,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
-	// Rate multipliers applied to the coins.
-	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,7,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
-	// Total shares issued within the Pool.
-	TotalShares string `protobuf:"bytes,8,opt,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
-	// Time when the first liquidity was added to start tracking rewards.
-	InitialRewardsTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=initial_rewards_time,json=initialRewardsTime,proto3" json:"initial_rewards_time,omitempty"`
+	// Duration for which the liquidity is locked.
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Multiplier applied to the reward weight of the locked shares.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
 }
 
-func (x *Pool) Reset() {
-	*x = Pool{}
+func (x *LockTier) Reset() {
+	*x = LockTier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[0]
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pool) String() string {
+func (x *LockTier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pool) ProtoMessage() {}
+func (*LockTier) ProtoMessage() {}
 
-// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
-func (*Pool) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LockTier.ProtoReflect.Descriptor instead.
+func (*LockTier) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Pool) GetProtocolFeePercentage() int64 {
+func (x *LockTier) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.ProtocolFeePercentage
+		return x.Duration
 	}
-	return 0
+	return nil
 }
 
-func (x *Pool) GetRewardsFee() int64 {
+func (x *LockTier) GetMultiplier() string {
 	if x != nil {
-		return x.RewardsFee
+		return x.Multiplier
 	}
-	return 0
+	return ""
 }
 
-func (x *Pool) GetInitialA() int64 {
+type FeeSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the split, used to identify it in events.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the recipient receiving the split.
+	RecipientType FeeRecipientType `protobuf:"varint,2,opt,name=recipient_type,json=recipientType,proto3,enum=noble.swap.stableswap.v1.FeeRecipientType" json:"recipient_type,omitempty"`
+	// Address or module name of the recipient, empty for the community pool and burn types.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Relative weight of the split over the protocol fees.
+	Weight string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *FeeSplit) Reset() {
+	*x = FeeSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSplit) ProtoMessage() {}
+
+// Deprecated: Use FeeSplit.ProtoReflect.Descriptor instead.
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeSplit) GetName() string {
 	if x != nil {
-		return x.InitialA
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Pool) GetFutureA() int64 {
+func (x *FeeSplit) GetRecipientType() FeeRecipientType {
 	if x != nil {
-		return x.FutureA
+		return x.RecipientType
 	}
-	return 0
+	return FeeRecipientType_FEE_RECIPIENT_UNSPECIFIED
 }
 
-func (x *Pool) GetInitialATime() int64 {
+func (x *FeeSplit) GetRecipient() string {
 	if x != nil {
-		return x.InitialATime
+		return x.Recipient
 	}
-	return 0
+	return ""
 }
 
-func (x *Pool) GetFutureATime() int64 {
+func (x *FeeSplit) GetWeight() string {
 	if x != nil {
-		return x.FutureATime
+		return x.Weight
+	}
+	return ""
+}
+
+type DynamicFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Multiplier applied to the pool imbalance to scale the rewards fee.
+	Multiplier string `protobuf:"bytes,1,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Minimum rewards fee applied to the swaps.
+	MinFee int64 `protobuf:"varint,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// Maximum rewards fee applied to the swaps.
+	MaxFee int64 `protobuf:"varint,3,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (x *DynamicFee) Reset() {
+	*x = DynamicFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicFee) ProtoMessage() {}
+
+// Deprecated: Use DynamicFee.ProtoReflect.Descriptor instead.
+func (*DynamicFee) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DynamicFee) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+func (x *DynamicFee) GetMinFee() int64 {
+	if x != nil {
+		return x.MinFee
 	}
 	return 0
 }
 
-func (x *Pool) GetRateMultipliers() []*v1beta1.Coin {
+func (x *DynamicFee) GetMaxFee() int64 {
 	if x != nil {
-		return x.RateMultipliers
+		return x.MaxFee
 	}
-	return nil
+	return 0
 }
 
-func (x *Pool) GetTotalShares() string {
+type DirectionalFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rewards fee applied to the swaps from the pair denom to the base denom.
+	PairToBaseFee int64 `protobuf:"varint,1,opt,name=pair_to_base_fee,json=pairToBaseFee,proto3" json:"pair_to_base_fee,omitempty"`
+	// Rewards fee applied to the swaps from the base denom to the pair denom.
+	BaseToPairFee int64 `protobuf:"varint,2,opt,name=base_to_pair_fee,json=baseToPairFee,proto3" json:"base_to_pair_fee,omitempty"`
+}
+
+func (x *DirectionalFee) Reset() {
+	*x = DirectionalFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_pool_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirectionalFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectionalFee) ProtoMessage() {}
+
+// Deprecated: Use DirectionalFee.ProtoReflect.Descriptor instead.
+func (*DirectionalFee) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DirectionalFee) GetPairToBaseFee() int64 {
 	if x != nil {
-		return x.TotalShares
+		return x.PairToBaseFee
 	}
-	return ""
+	return 0
 }
 
-func (x *Pool) GetInitialRewardsTime() *timestamppb.Timestamp {
+func (x *DirectionalFee) GetBaseToPairFee() int64 {
 	if x != nil {
-		return x.InitialRewardsTime
+		return x.BaseToPairFee
 	}
-	return nil
+	return 0
 }
 
 var File_noble_swap_stableswap_v1_pool_proto protoreflect.FileDescriptor
@@ -1076,9 +5763,11 @@ var file_noble_swap_stableswap_v1_pool_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x04, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x36, 0x0a,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x0c, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x36, 0x0a,
 	0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
 	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
@@ -1112,23 +5801,162 @@ var file_noble_swap_stableswap_v1_pool_proto_rawDesc = []byte{
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
 	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x10, 0xca, 0xb4, 0x2d, 0x0c,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0xe6, 0x01, 0x0a,
-	0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
-	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x50,
-	0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a,
-	0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
-	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x46,
+	0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x4c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x68, 0x0a,
+	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x65,
+	0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x50, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x13,
+	0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
+	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x0a,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52, 0x0e, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x8a, 0x01,
+	0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x3a, 0x10, 0xca, 0xb4, 0x2d, 0x0c,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0xa6, 0x01, 0x0a,
+	0x0d, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x12, 0x54,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x46, 0x65,
+	0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x5d, 0x0a, 0x0e, 0x66,
+	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x66, 0x65, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x08, 0x4c,
+	0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x22, 0xdf, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x51, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65,
+	0x65, 0x12, 0x56, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x22, 0x62, 0x0a, 0x0e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x27, 0x0a,
+	0x10, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x54, 0x6f, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x50, 0x61, 0x69, 0x72, 0x46, 0x65, 0x65, 0x2a,
+	0xa6, 0x01, 0x0a, 0x10, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x49,
+	0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50,
+	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x5f,
+	0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x52, 0x4e,
+	0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe6, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61,
+	0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1143,20 +5971,42 @@ func file_noble_swap_stableswap_v1_pool_proto_rawDescGZIP() []byte {
 	return file_noble_swap_stableswap_v1_pool_proto_rawDescData
 }
 
-var file_noble_swap_stableswap_v1_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_noble_swap_stableswap_v1_pool_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_noble_swap_stableswap_v1_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_noble_swap_stableswap_v1_pool_proto_goTypes = []interface{}{
-	(*Pool)(nil),                  // 0: noble.swap.stableswap.v1.Pool
-	(*v1beta1.Coin)(nil),          // 1: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(FeeRecipientType)(0),         // 0: noble.swap.stableswap.v1.FeeRecipientType
+	(*Pool)(nil),                  // 1: noble.swap.stableswap.v1.Pool
+	(*UnbondingTier)(nil),         // 2: noble.swap.stableswap.v1.UnbondingTier
+	(*ExitFeeTier)(nil),           // 3: noble.swap.stableswap.v1.ExitFeeTier
+	(*LockTier)(nil),              // 4: noble.swap.stableswap.v1.LockTier
+	(*FeeSplit)(nil),              // 5: noble.swap.stableswap.v1.FeeSplit
+	(*DynamicFee)(nil),            // 6: noble.swap.stableswap.v1.DynamicFee
+	(*DirectionalFee)(nil),        // 7: noble.swap.stableswap.v1.DirectionalFee
+	(*v1beta1.Coin)(nil),          // 8: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*v1beta1.DecCoin)(nil),       // 10: cosmos.base.v1beta1.DecCoin
+	(*durationpb.Duration)(nil),   // 11: google.protobuf.Duration
 }
 var file_noble_swap_stableswap_v1_pool_proto_depIdxs = []int32{
-	1, // 0: noble.swap.stableswap.v1.Pool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
-	2, // 1: noble.swap.stableswap.v1.Pool.initial_rewards_time:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	8,  // 0: noble.swap.stableswap.v1.Pool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
+	9,  // 1: noble.swap.stableswap.v1.Pool.initial_rewards_time:type_name -> google.protobuf.Timestamp
+	2,  // 2: noble.swap.stableswap.v1.Pool.unbonding_tiers:type_name -> noble.swap.stableswap.v1.UnbondingTier
+	3,  // 3: noble.swap.stableswap.v1.Pool.exit_fee_tiers:type_name -> noble.swap.stableswap.v1.ExitFeeTier
+	4,  // 4: noble.swap.stableswap.v1.Pool.lock_tiers:type_name -> noble.swap.stableswap.v1.LockTier
+	10, // 5: noble.swap.stableswap.v1.Pool.reward_per_share:type_name -> cosmos.base.v1beta1.DecCoin
+	10, // 6: noble.swap.stableswap.v1.Pool.incentive_per_share:type_name -> cosmos.base.v1beta1.DecCoin
+	5,  // 7: noble.swap.stableswap.v1.Pool.fee_splits:type_name -> noble.swap.stableswap.v1.FeeSplit
+	6,  // 8: noble.swap.stableswap.v1.Pool.dynamic_fee:type_name -> noble.swap.stableswap.v1.DynamicFee
+	7,  // 9: noble.swap.stableswap.v1.Pool.directional_fee:type_name -> noble.swap.stableswap.v1.DirectionalFee
+	8,  // 10: noble.swap.stableswap.v1.Pool.pending_rewards:type_name -> cosmos.base.v1beta1.Coin
+	11, // 11: noble.swap.stableswap.v1.UnbondingTier.duration:type_name -> google.protobuf.Duration
+	11, // 12: noble.swap.stableswap.v1.LockTier.duration:type_name -> google.protobuf.Duration
+	0,  // 13: noble.swap.stableswap.v1.FeeSplit.recipient_type:type_name -> noble.swap.stableswap.v1.FeeRecipientType
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_stableswap_v1_pool_proto_init() }
@@ -1177,19 +6027,92 @@ func file_noble_swap_stableswap_v1_pool_proto_init() {
 				return nil
 			}
 		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbondingTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitFeeTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_pool_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirectionalFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_swap_stableswap_v1_pool_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_noble_swap_stableswap_v1_pool_proto_goTypes,
 		DependencyIndexes: file_noble_swap_stableswap_v1_pool_proto_depIdxs,
+		EnumInfos:         file_noble_swap_stableswap_v1_pool_proto_enumTypes,
 		MessageInfos:      file_noble_swap_stableswap_v1_pool_proto_msgTypes,
 	}.Build()
 	File_noble_swap_stableswap_v1_pool_proto = out.File
@@ -17,11 +17,117 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_BondedPosition_6_list)(nil)
+
+type _BondedPosition_6_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_BondedPosition_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BondedPosition_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BondedPosition_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BondedPosition_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BondedPosition_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BondedPosition_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BondedPosition_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BondedPosition_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BondedPosition_7_list)(nil)
+
+type _BondedPosition_7_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_BondedPosition_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BondedPosition_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BondedPosition_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BondedPosition_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BondedPosition_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BondedPosition_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BondedPosition_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BondedPosition_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_BondedPosition                      protoreflect.MessageDescriptor
-	fd_BondedPosition_balance              protoreflect.FieldDescriptor
-	fd_BondedPosition_timestamp            protoreflect.FieldDescriptor
-	fd_BondedPosition_rewards_period_start protoreflect.FieldDescriptor
+	md_BondedPosition                                protoreflect.MessageDescriptor
+	fd_BondedPosition_balance                        protoreflect.FieldDescriptor
+	fd_BondedPosition_timestamp                      protoreflect.FieldDescriptor
+	fd_BondedPosition_rewards_period_start           protoreflect.FieldDescriptor
+	fd_BondedPosition_lock_end_time                  protoreflect.FieldDescriptor
+	fd_BondedPosition_multiplier                     protoreflect.FieldDescriptor
+	fd_BondedPosition_reward_per_share_checkpoint    protoreflect.FieldDescriptor
+	fd_BondedPosition_incentive_per_share_checkpoint protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +136,10 @@ func init() {
 	fd_BondedPosition_balance = md_BondedPosition.Fields().ByName("balance")
 	fd_BondedPosition_timestamp = md_BondedPosition.Fields().ByName("timestamp")
 	fd_BondedPosition_rewards_period_start = md_BondedPosition.Fields().ByName("rewards_period_start")
+	fd_BondedPosition_lock_end_time = md_BondedPosition.Fields().ByName("lock_end_time")
+	fd_BondedPosition_multiplier = md_BondedPosition.Fields().ByName("multiplier")
+	fd_BondedPosition_reward_per_share_checkpoint = md_BondedPosition.Fields().ByName("reward_per_share_checkpoint")
+	fd_BondedPosition_incentive_per_share_checkpoint = md_BondedPosition.Fields().ByName("incentive_per_share_checkpoint")
 }
 
 var _ protoreflect.Message = (*fastReflection_BondedPosition)(nil)
@@ -115,6 +225,30 @@ func (x *fastReflection_BondedPosition) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.LockEndTime != nil {
+		value := protoreflect.ValueOfMessage(x.LockEndTime.ProtoReflect())
+		if !f(fd_BondedPosition_lock_end_time, value) {
+			return
+		}
+	}
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_BondedPosition_multiplier, value) {
+			return
+		}
+	}
+	if len(x.RewardPerShareCheckpoint) != 0 {
+		value := protoreflect.ValueOfList(&_BondedPosition_6_list{list: &x.RewardPerShareCheckpoint})
+		if !f(fd_BondedPosition_reward_per_share_checkpoint, value) {
+			return
+		}
+	}
+	if len(x.IncentivePerShareCheckpoint) != 0 {
+		value := protoreflect.ValueOfList(&_BondedPosition_7_list{list: &x.IncentivePerShareCheckpoint})
+		if !f(fd_BondedPosition_incentive_per_share_checkpoint, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -136,6 +270,14 @@ func (x *fastReflection_BondedPosition) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Timestamp != nil
 	case "noble.swap.stableswap.v1.BondedPosition.rewards_period_start":
 		return x.RewardsPeriodStart != nil
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		return x.LockEndTime != nil
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		return x.Multiplier != ""
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		return len(x.RewardPerShareCheckpoint) != 0
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		return len(x.IncentivePerShareCheckpoint) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -158,6 +300,14 @@ func (x *fastReflection_BondedPosition) Clear(fd protoreflect.FieldDescriptor) {
 		x.Timestamp = nil
 	case "noble.swap.stableswap.v1.BondedPosition.rewards_period_start":
 		x.RewardsPeriodStart = nil
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		x.LockEndTime = nil
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		x.Multiplier = ""
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		x.RewardPerShareCheckpoint = nil
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		x.IncentivePerShareCheckpoint = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -183,6 +333,24 @@ func (x *fastReflection_BondedPosition) Get(descriptor protoreflect.FieldDescrip
 	case "noble.swap.stableswap.v1.BondedPosition.rewards_period_start":
 		value := x.RewardsPeriodStart
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		value := x.LockEndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		if len(x.RewardPerShareCheckpoint) == 0 {
+			return protoreflect.ValueOfList(&_BondedPosition_6_list{})
+		}
+		listValue := &_BondedPosition_6_list{list: &x.RewardPerShareCheckpoint}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		if len(x.IncentivePerShareCheckpoint) == 0 {
+			return protoreflect.ValueOfList(&_BondedPosition_7_list{})
+		}
+		listValue := &_BondedPosition_7_list{list: &x.IncentivePerShareCheckpoint}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -209,6 +377,18 @@ func (x *fastReflection_BondedPosition) Set(fd protoreflect.FieldDescriptor, val
 		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
 	case "noble.swap.stableswap.v1.BondedPosition.rewards_period_start":
 		x.RewardsPeriodStart = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		x.LockEndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		x.Multiplier = value.Interface().(string)
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		lv := value.List()
+		clv := lv.(*_BondedPosition_6_list)
+		x.RewardPerShareCheckpoint = *clv.list
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		lv := value.List()
+		clv := lv.(*_BondedPosition_7_list)
+		x.IncentivePerShareCheckpoint = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -239,8 +419,27 @@ func (x *fastReflection_BondedPosition) Mutable(fd protoreflect.FieldDescriptor)
 			x.RewardsPeriodStart = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.RewardsPeriodStart.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		if x.LockEndTime == nil {
+			x.LockEndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LockEndTime.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		if x.RewardPerShareCheckpoint == nil {
+			x.RewardPerShareCheckpoint = []*v1beta1.DecCoin{}
+		}
+		value := &_BondedPosition_6_list{list: &x.RewardPerShareCheckpoint}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		if x.IncentivePerShareCheckpoint == nil {
+			x.IncentivePerShareCheckpoint = []*v1beta1.DecCoin{}
+		}
+		value := &_BondedPosition_7_list{list: &x.IncentivePerShareCheckpoint}
+		return protoreflect.ValueOfList(value)
 	case "noble.swap.stableswap.v1.BondedPosition.balance":
 		panic(fmt.Errorf("field balance of message noble.swap.stableswap.v1.BondedPosition is not mutable"))
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		panic(fmt.Errorf("field multiplier of message noble.swap.stableswap.v1.BondedPosition is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -262,6 +461,17 @@ func (x *fastReflection_BondedPosition) NewField(fd protoreflect.FieldDescriptor
 	case "noble.swap.stableswap.v1.BondedPosition.rewards_period_start":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.lock_end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.BondedPosition.multiplier":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_BondedPosition_6_list{list: &list})
+	case "noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_BondedPosition_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.BondedPosition"))
@@ -343,6 +553,26 @@ func (x *fastReflection_BondedPosition) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.RewardsPeriodStart)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LockEndTime != nil {
+			l = options.Size(x.LockEndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardPerShareCheckpoint) > 0 {
+			for _, e := range x.RewardPerShareCheckpoint {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IncentivePerShareCheckpoint) > 0 {
+			for _, e := range x.IncentivePerShareCheckpoint {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -372,6 +602,59 @@ func (x *fastReflection_BondedPosition) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.IncentivePerShareCheckpoint) > 0 {
+			for iNdEx := len(x.IncentivePerShareCheckpoint) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.IncentivePerShareCheckpoint[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.RewardPerShareCheckpoint) > 0 {
+			for iNdEx := len(x.RewardPerShareCheckpoint) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardPerShareCheckpoint[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.LockEndTime != nil {
+			encoded, err := options.Marshal(x.LockEndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.RewardsPeriodStart != nil {
 			encoded, err := options.Marshal(x.RewardsPeriodStart)
 			if err != nil {
@@ -560,6 +843,142 @@ func (x *fastReflection_BondedPosition) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockEndTime == nil {
+					x.LockEndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockEndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardPerShareCheckpoint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardPerShareCheckpoint = append(x.RewardPerShareCheckpoint, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardPerShareCheckpoint[len(x.RewardPerShareCheckpoint)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentivePerShareCheckpoint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IncentivePerShareCheckpoint = append(x.IncentivePerShareCheckpoint, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncentivePerShareCheckpoint[len(x.IncentivePerShareCheckpoint)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1256,6 +1675,14 @@ type BondedPosition struct {
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// Time when the rewards were collected.
 	RewardsPeriodStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=rewards_period_start,json=rewardsPeriodStart,proto3" json:"rewards_period_start,omitempty"`
+	// Time until which the shares are locked, zero if not locked.
+	LockEndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lock_end_time,json=lockEndTime,proto3" json:"lock_end_time,omitempty"`
+	// Multiplier applied to the reward weight of the shares while locked, empty if not boosted.
+	Multiplier string `protobuf:"bytes,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Pool cumulative reward per share at the time the rewards were collected.
+	RewardPerShareCheckpoint []*v1beta1.DecCoin `protobuf:"bytes,6,rep,name=reward_per_share_checkpoint,json=rewardPerShareCheckpoint,proto3" json:"reward_per_share_checkpoint,omitempty"`
+	// Pool cumulative incentive per share at the time the incentives were collected.
+	IncentivePerShareCheckpoint []*v1beta1.DecCoin `protobuf:"bytes,7,rep,name=incentive_per_share_checkpoint,json=incentivePerShareCheckpoint,proto3" json:"incentive_per_share_checkpoint,omitempty"`
 }
 
 func (x *BondedPosition) Reset() {
@@ -1299,6 +1726,34 @@ func (x *BondedPosition) GetRewardsPeriodStart() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BondedPosition) GetLockEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockEndTime
+	}
+	return nil
+}
+
+func (x *BondedPosition) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
+func (x *BondedPosition) GetRewardPerShareCheckpoint() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.RewardPerShareCheckpoint
+	}
+	return nil
+}
+
+func (x *BondedPosition) GetIncentivePerShareCheckpoint() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.IncentivePerShareCheckpoint
+	}
+	return nil
+}
+
 type UnbondingPosition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1368,7 +1823,7 @@ var file_noble_swap_stableswap_v1_position_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x6e,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x05, 0x0a, 0x0e, 0x42, 0x6f, 0x6e,
 	0x64, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x07, 0x62,
 	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
@@ -1384,40 +1839,72 @@ var file_noble_swap_stableswap_v1_position_proto_rawDesc = []byte{
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
 	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x12, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x11, 0x55, 0x6e,
-	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12,
-	0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x01, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0xa6, 0x01, 0x0a, 0x1b, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00,
+	0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a,
-	0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
-	0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xea, 0x01, 0x0a, 0x1c,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0xac, 0x01, 0x0a, 0x1e, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c,
+	0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x1b, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x9f,
+	0x02, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0xea, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x42, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x38, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78,
+	0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e,
+	0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e,
+	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18,
+	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
 	0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70,
-	0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1437,18 +1924,22 @@ var file_noble_swap_stableswap_v1_position_proto_goTypes = []interface{}{
 	(*BondedPosition)(nil),        // 0: noble.swap.stableswap.v1.BondedPosition
 	(*UnbondingPosition)(nil),     // 1: noble.swap.stableswap.v1.UnbondingPosition
 	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*v1beta1.Coin)(nil),          // 3: cosmos.base.v1beta1.Coin
+	(*v1beta1.DecCoin)(nil),       // 3: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
 }
 var file_noble_swap_stableswap_v1_position_proto_depIdxs = []int32{
 	2, // 0: noble.swap.stableswap.v1.BondedPosition.timestamp:type_name -> google.protobuf.Timestamp
 	2, // 1: noble.swap.stableswap.v1.BondedPosition.rewards_period_start:type_name -> google.protobuf.Timestamp
-	3, // 2: noble.swap.stableswap.v1.UnbondingPosition.amount:type_name -> cosmos.base.v1beta1.Coin
-	2, // 3: noble.swap.stableswap.v1.UnbondingPosition.end_time:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 2: noble.swap.stableswap.v1.BondedPosition.lock_end_time:type_name -> google.protobuf.Timestamp
+	3, // 3: noble.swap.stableswap.v1.BondedPosition.reward_per_share_checkpoint:type_name -> cosmos.base.v1beta1.DecCoin
+	3, // 4: noble.swap.stableswap.v1.BondedPosition.incentive_per_share_checkpoint:type_name -> cosmos.base.v1beta1.DecCoin
+	4, // 5: noble.swap.stableswap.v1.UnbondingPosition.amount:type_name -> cosmos.base.v1beta1.Coin
+	2, // 6: noble.swap.stableswap.v1.UnbondingPosition.end_time:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_stableswap_v1_position_proto_init() }
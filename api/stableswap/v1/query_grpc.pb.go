@@ -23,6 +23,10 @@ const (
 	Query_BondedPositionsByProvider_FullMethodName    = "/noble.swap.stableswap.v1.Query/BondedPositionsByProvider"
 	Query_UnbondingPositionsByProvider_FullMethodName = "/noble.swap.stableswap.v1.Query/UnbondingPositionsByProvider"
 	Query_RewardsByProvider_FullMethodName            = "/noble.swap.stableswap.v1.Query/RewardsByProvider"
+	Query_IncentivesByProvider_FullMethodName         = "/noble.swap.stableswap.v1.Query/IncentivesByProvider"
+	Query_UnbondingPeriod_FullMethodName              = "/noble.swap.stableswap.v1.Query/UnbondingPeriod"
+	Query_ScheduledPoolUpdates_FullMethodName         = "/noble.swap.stableswap.v1.Query/ScheduledPoolUpdates"
+	Query_Amplification_FullMethodName                = "/noble.swap.stableswap.v1.Query/Amplification"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +41,14 @@ type QueryClient interface {
 	UnbondingPositionsByProvider(ctx context.Context, in *QueryUnbondingPositionsByProvider, opts ...grpc.CallOption) (*QueryUnbondingPositionsByProviderResponse, error)
 	// Retrieves all the rewards by a specific provider.
 	RewardsByProvider(ctx context.Context, in *QueryRewardsByProvider, opts ...grpc.CallOption) (*QueryRewardsByProviderResponse, error)
+	// Retrieves all the incentives accrued by a specific provider.
+	IncentivesByProvider(ctx context.Context, in *QueryIncentivesByProvider, opts ...grpc.CallOption) (*QueryIncentivesByProviderResponse, error)
+	// Retrieves the expected unbonding period for removing a given amount of shares from a pool.
+	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriod, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
+	// Retrieves the pending scheduled pool updates.
+	ScheduledPoolUpdates(ctx context.Context, in *QueryScheduledPoolUpdates, opts ...grpc.CallOption) (*QueryScheduledPoolUpdatesResponse, error)
+	// Retrieves the current effective amplification coefficient of a pool, along with its ramp schedule.
+	Amplification(ctx context.Context, in *QueryAmplification, opts ...grpc.CallOption) (*QueryAmplificationResponse, error)
 }
 
 type queryClient struct {
@@ -87,6 +99,46 @@ func (c *queryClient) RewardsByProvider(ctx context.Context, in *QueryRewardsByP
 	return out, nil
 }
 
+func (c *queryClient) IncentivesByProvider(ctx context.Context, in *QueryIncentivesByProvider, opts ...grpc.CallOption) (*QueryIncentivesByProviderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIncentivesByProviderResponse)
+	err := c.cc.Invoke(ctx, Query_IncentivesByProvider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriod, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnbondingPeriodResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledPoolUpdates(ctx context.Context, in *QueryScheduledPoolUpdates, opts ...grpc.CallOption) (*QueryScheduledPoolUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduledPoolUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledPoolUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Amplification(ctx context.Context, in *QueryAmplification, opts ...grpc.CallOption) (*QueryAmplificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAmplificationResponse)
+	err := c.cc.Invoke(ctx, Query_Amplification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -99,6 +151,14 @@ type QueryServer interface {
 	UnbondingPositionsByProvider(context.Context, *QueryUnbondingPositionsByProvider) (*QueryUnbondingPositionsByProviderResponse, error)
 	// Retrieves all the rewards by a specific provider.
 	RewardsByProvider(context.Context, *QueryRewardsByProvider) (*QueryRewardsByProviderResponse, error)
+	// Retrieves all the incentives accrued by a specific provider.
+	IncentivesByProvider(context.Context, *QueryIncentivesByProvider) (*QueryIncentivesByProviderResponse, error)
+	// Retrieves the expected unbonding period for removing a given amount of shares from a pool.
+	UnbondingPeriod(context.Context, *QueryUnbondingPeriod) (*QueryUnbondingPeriodResponse, error)
+	// Retrieves the pending scheduled pool updates.
+	ScheduledPoolUpdates(context.Context, *QueryScheduledPoolUpdates) (*QueryScheduledPoolUpdatesResponse, error)
+	// Retrieves the current effective amplification coefficient of a pool, along with its ramp schedule.
+	Amplification(context.Context, *QueryAmplification) (*QueryAmplificationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -121,6 +181,18 @@ func (UnimplementedQueryServer) UnbondingPositionsByProvider(context.Context, *Q
 func (UnimplementedQueryServer) RewardsByProvider(context.Context, *QueryRewardsByProvider) (*QueryRewardsByProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsByProvider not implemented")
 }
+func (UnimplementedQueryServer) IncentivesByProvider(context.Context, *QueryIncentivesByProvider) (*QueryIncentivesByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivesByProvider not implemented")
+}
+func (UnimplementedQueryServer) UnbondingPeriod(context.Context, *QueryUnbondingPeriod) (*QueryUnbondingPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+}
+func (UnimplementedQueryServer) ScheduledPoolUpdates(context.Context, *QueryScheduledPoolUpdates) (*QueryScheduledPoolUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledPoolUpdates not implemented")
+}
+func (UnimplementedQueryServer) Amplification(context.Context, *QueryAmplification) (*QueryAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Amplification not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -214,6 +286,78 @@ func _Query_RewardsByProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncentivesByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivesByProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivesByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IncentivesByProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivesByProvider(ctx, req.(*QueryIncentivesByProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledPoolUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledPoolUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledPoolUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledPoolUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledPoolUpdates(ctx, req.(*QueryScheduledPoolUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Amplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Amplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Amplification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Amplification(ctx, req.(*QueryAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +381,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsByProvider",
 			Handler:    _Query_RewardsByProvider_Handler,
 		},
+		{
+			MethodName: "IncentivesByProvider",
+			Handler:    _Query_IncentivesByProvider_Handler,
+		},
+		{
+			MethodName: "UnbondingPeriod",
+			Handler:    _Query_UnbondingPeriod_Handler,
+		},
+		{
+			MethodName: "ScheduledPoolUpdates",
+			Handler:    _Query_ScheduledPoolUpdates_Handler,
+		},
+		{
+			MethodName: "Amplification",
+			Handler:    _Query_Amplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/swap/stableswap/v1/query.proto",
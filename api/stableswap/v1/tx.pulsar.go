@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -68,6 +69,210 @@ func (x *_MsgCreatePool_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgCreatePool_9_list)(nil)
+
+type _MsgCreatePool_9_list struct {
+	list *[]*UnbondingTier
+}
+
+func (x *_MsgCreatePool_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePool_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreatePool_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePool_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePool_9_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePool_9_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreatePool_10_list)(nil)
+
+type _MsgCreatePool_10_list struct {
+	list *[]*ExitFeeTier
+}
+
+func (x *_MsgCreatePool_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePool_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreatePool_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePool_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePool_10_list) AppendMutable() protoreflect.Value {
+	v := new(ExitFeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePool_10_list) NewElement() protoreflect.Value {
+	v := new(ExitFeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreatePool_11_list)(nil)
+
+type _MsgCreatePool_11_list struct {
+	list *[]*LockTier
+}
+
+func (x *_MsgCreatePool_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePool_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreatePool_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePool_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePool_11_list) AppendMutable() protoreflect.Value {
+	v := new(LockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePool_11_list) NewElement() protoreflect.Value {
+	v := new(LockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgCreatePool_12_list)(nil)
+
+type _MsgCreatePool_12_list struct {
+	list *[]*FeeSplit
+}
+
+func (x *_MsgCreatePool_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgCreatePool_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgCreatePool_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgCreatePool_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgCreatePool_12_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSplit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgCreatePool_12_list) NewElement() protoreflect.Value {
+	v := new(FeeSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgCreatePool_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgCreatePool                         protoreflect.MessageDescriptor
 	fd_MsgCreatePool_signer                  protoreflect.FieldDescriptor
@@ -78,6 +283,12 @@ var (
 	fd_MsgCreatePool_future_a                protoreflect.FieldDescriptor
 	fd_MsgCreatePool_future_a_time           protoreflect.FieldDescriptor
 	fd_MsgCreatePool_rate_multipliers        protoreflect.FieldDescriptor
+	fd_MsgCreatePool_unbonding_tiers         protoreflect.FieldDescriptor
+	fd_MsgCreatePool_exit_fee_tiers          protoreflect.FieldDescriptor
+	fd_MsgCreatePool_lock_tiers              protoreflect.FieldDescriptor
+	fd_MsgCreatePool_fee_splits              protoreflect.FieldDescriptor
+	fd_MsgCreatePool_dynamic_fee             protoreflect.FieldDescriptor
+	fd_MsgCreatePool_directional_fee         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -91,6 +302,12 @@ func init() {
 	fd_MsgCreatePool_future_a = md_MsgCreatePool.Fields().ByName("future_a")
 	fd_MsgCreatePool_future_a_time = md_MsgCreatePool.Fields().ByName("future_a_time")
 	fd_MsgCreatePool_rate_multipliers = md_MsgCreatePool.Fields().ByName("rate_multipliers")
+	fd_MsgCreatePool_unbonding_tiers = md_MsgCreatePool.Fields().ByName("unbonding_tiers")
+	fd_MsgCreatePool_exit_fee_tiers = md_MsgCreatePool.Fields().ByName("exit_fee_tiers")
+	fd_MsgCreatePool_lock_tiers = md_MsgCreatePool.Fields().ByName("lock_tiers")
+	fd_MsgCreatePool_fee_splits = md_MsgCreatePool.Fields().ByName("fee_splits")
+	fd_MsgCreatePool_dynamic_fee = md_MsgCreatePool.Fields().ByName("dynamic_fee")
+	fd_MsgCreatePool_directional_fee = md_MsgCreatePool.Fields().ByName("directional_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreatePool)(nil)
@@ -206,6 +423,42 @@ func (x *fastReflection_MsgCreatePool) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if len(x.UnbondingTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePool_9_list{list: &x.UnbondingTiers})
+		if !f(fd_MsgCreatePool_unbonding_tiers, value) {
+			return
+		}
+	}
+	if len(x.ExitFeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePool_10_list{list: &x.ExitFeeTiers})
+		if !f(fd_MsgCreatePool_exit_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.LockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePool_11_list{list: &x.LockTiers})
+		if !f(fd_MsgCreatePool_lock_tiers, value) {
+			return
+		}
+	}
+	if len(x.FeeSplits) != 0 {
+		value := protoreflect.ValueOfList(&_MsgCreatePool_12_list{list: &x.FeeSplits})
+		if !f(fd_MsgCreatePool_fee_splits, value) {
+			return
+		}
+	}
+	if x.DynamicFee != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+		if !f(fd_MsgCreatePool_dynamic_fee, value) {
+			return
+		}
+	}
+	if x.DirectionalFee != nil {
+		value := protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+		if !f(fd_MsgCreatePool_directional_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -237,6 +490,18 @@ func (x *fastReflection_MsgCreatePool) Has(fd protoreflect.FieldDescriptor) bool
 		return x.FutureATime != int64(0)
 	case "noble.swap.stableswap.v1.MsgCreatePool.rate_multipliers":
 		return len(x.RateMultipliers) != 0
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		return len(x.UnbondingTiers) != 0
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		return len(x.ExitFeeTiers) != 0
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		return len(x.LockTiers) != 0
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		return len(x.FeeSplits) != 0
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		return x.DynamicFee != nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		return x.DirectionalFee != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCreatePool"))
@@ -269,6 +534,18 @@ func (x *fastReflection_MsgCreatePool) Clear(fd protoreflect.FieldDescriptor) {
 		x.FutureATime = int64(0)
 	case "noble.swap.stableswap.v1.MsgCreatePool.rate_multipliers":
 		x.RateMultipliers = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		x.UnbondingTiers = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		x.ExitFeeTiers = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		x.LockTiers = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		x.FeeSplits = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		x.DynamicFee = nil
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		x.DirectionalFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCreatePool"))
@@ -312,6 +589,36 @@ func (x *fastReflection_MsgCreatePool) Get(descriptor protoreflect.FieldDescript
 		}
 		listValue := &_MsgCreatePool_8_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		if len(x.UnbondingTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePool_9_list{})
+		}
+		listValue := &_MsgCreatePool_9_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		if len(x.ExitFeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePool_10_list{})
+		}
+		listValue := &_MsgCreatePool_10_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		if len(x.LockTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePool_11_list{})
+		}
+		listValue := &_MsgCreatePool_11_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		if len(x.FeeSplits) == 0 {
+			return protoreflect.ValueOfList(&_MsgCreatePool_12_list{})
+		}
+		listValue := &_MsgCreatePool_12_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		value := x.DynamicFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		value := x.DirectionalFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCreatePool"))
@@ -350,6 +657,26 @@ func (x *fastReflection_MsgCreatePool) Set(fd protoreflect.FieldDescriptor, valu
 		lv := value.List()
 		clv := lv.(*_MsgCreatePool_8_list)
 		x.RateMultipliers = *clv.list
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePool_9_list)
+		x.UnbondingTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePool_10_list)
+		x.ExitFeeTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePool_11_list)
+		x.LockTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		lv := value.List()
+		clv := lv.(*_MsgCreatePool_12_list)
+		x.FeeSplits = *clv.list
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		x.DynamicFee = value.Message().Interface().(*DynamicFee)
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		x.DirectionalFee = value.Message().Interface().(*DirectionalFee)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCreatePool"))
@@ -376,6 +703,40 @@ func (x *fastReflection_MsgCreatePool) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgCreatePool_8_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		if x.UnbondingTiers == nil {
+			x.UnbondingTiers = []*UnbondingTier{}
+		}
+		value := &_MsgCreatePool_9_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		if x.ExitFeeTiers == nil {
+			x.ExitFeeTiers = []*ExitFeeTier{}
+		}
+		value := &_MsgCreatePool_10_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		if x.LockTiers == nil {
+			x.LockTiers = []*LockTier{}
+		}
+		value := &_MsgCreatePool_11_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		if x.FeeSplits == nil {
+			x.FeeSplits = []*FeeSplit{}
+		}
+		value := &_MsgCreatePool_12_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		if x.DynamicFee == nil {
+			x.DynamicFee = new(DynamicFee)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		if x.DirectionalFee == nil {
+			x.DirectionalFee = new(DirectionalFee)
+		}
+		return protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
 	case "noble.swap.stableswap.v1.MsgCreatePool.signer":
 		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgCreatePool is not mutable"))
 	case "noble.swap.stableswap.v1.MsgCreatePool.pair":
@@ -420,6 +781,24 @@ func (x *fastReflection_MsgCreatePool) NewField(fd protoreflect.FieldDescriptor)
 	case "noble.swap.stableswap.v1.MsgCreatePool.rate_multipliers":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_MsgCreatePool_8_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers":
+		list := []*UnbondingTier{}
+		return protoreflect.ValueOfList(&_MsgCreatePool_9_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers":
+		list := []*ExitFeeTier{}
+		return protoreflect.ValueOfList(&_MsgCreatePool_10_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgCreatePool.lock_tiers":
+		list := []*LockTier{}
+		return protoreflect.ValueOfList(&_MsgCreatePool_11_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgCreatePool.fee_splits":
+		list := []*FeeSplit{}
+		return protoreflect.ValueOfList(&_MsgCreatePool_12_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee":
+		m := new(DynamicFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgCreatePool.directional_fee":
+		m := new(DirectionalFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCreatePool"))
@@ -518,6 +897,38 @@ func (x *fastReflection_MsgCreatePool) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.UnbondingTiers) > 0 {
+			for _, e := range x.UnbondingTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for _, e := range x.ExitFeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for _, e := range x.LockTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSplits) > 0 {
+			for _, e := range x.FeeSplits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicFee != nil {
+			l = options.Size(x.DynamicFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DirectionalFee != nil {
+			l = options.Size(x.DirectionalFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -547,9 +958,37 @@ func (x *fastReflection_MsgCreatePool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RateMultipliers) > 0 {
-			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
+		if x.DirectionalFee != nil {
+			encoded, err := options.Marshal(x.DirectionalFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.DynamicFee != nil {
+			encoded, err := options.Marshal(x.DynamicFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.FeeSplits) > 0 {
+			for iNdEx := len(x.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSplits[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -560,32 +999,96 @@ func (x *fastReflection_MsgCreatePool) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x42
+				dAtA[i] = 0x62
 			}
 		}
-		if x.FutureATime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.FutureA != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.InitialA != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
-			i--
-			dAtA[i] = 0x28
+		if len(x.LockTiers) > 0 {
+			for iNdEx := len(x.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
 		}
-		if x.RewardsFee != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardsFee))
-			i--
-			dAtA[i] = 0x20
+		if len(x.ExitFeeTiers) > 0 {
+			for iNdEx := len(x.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
 		}
-		if x.ProtocolFeePercentage != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolFeePercentage))
-			i--
+		if len(x.UnbondingTiers) > 0 {
+			for iNdEx := len(x.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.RateMultipliers) > 0 {
+			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.FutureATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FutureA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.InitialA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RewardsFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardsFee))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProtocolFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolFeePercentage))
+			i--
 			dAtA[i] = 0x18
 		}
 		if len(x.Pair) > 0 {
@@ -844,6 +1347,214 @@ func (x *fastReflection_MsgCreatePool) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingTiers = append(x.UnbondingTiers, &UnbondingTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTiers[len(x.UnbondingTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExitFeeTiers = append(x.ExitFeeTiers, &ExitFeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFeeTiers[len(x.ExitFeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTiers = append(x.LockTiers, &LockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockTiers[len(x.LockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSplits = append(x.FeeSplits, &FeeSplit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplits[len(x.FeeSplits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicFee == nil {
+					x.DynamicFee = &DynamicFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DirectionalFee == nil {
+					x.DirectionalFee = &DirectionalFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1286,32 +1997,248 @@ func (x *_MsgUpdatePool_8_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_MsgUpdatePool                         protoreflect.MessageDescriptor
-	fd_MsgUpdatePool_signer                  protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_pool_id                 protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_protocol_fee_percentage protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_rewards_fee             protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_initial_a               protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_future_a                protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_future_a_time           protoreflect.FieldDescriptor
-	fd_MsgUpdatePool_rate_multipliers        protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MsgUpdatePool_9_list)(nil)
 
-func init() {
-	file_noble_swap_stableswap_v1_tx_proto_init()
-	md_MsgUpdatePool = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgUpdatePool")
-	fd_MsgUpdatePool_signer = md_MsgUpdatePool.Fields().ByName("signer")
-	fd_MsgUpdatePool_pool_id = md_MsgUpdatePool.Fields().ByName("pool_id")
-	fd_MsgUpdatePool_protocol_fee_percentage = md_MsgUpdatePool.Fields().ByName("protocol_fee_percentage")
-	fd_MsgUpdatePool_rewards_fee = md_MsgUpdatePool.Fields().ByName("rewards_fee")
-	fd_MsgUpdatePool_initial_a = md_MsgUpdatePool.Fields().ByName("initial_a")
-	fd_MsgUpdatePool_future_a = md_MsgUpdatePool.Fields().ByName("future_a")
-	fd_MsgUpdatePool_future_a_time = md_MsgUpdatePool.Fields().ByName("future_a_time")
-	fd_MsgUpdatePool_rate_multipliers = md_MsgUpdatePool.Fields().ByName("rate_multipliers")
+type _MsgUpdatePool_9_list struct {
+	list *[]*UnbondingTier
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdatePool)(nil)
+func (x *_MsgUpdatePool_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdatePool_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdatePool_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnbondingTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdatePool_9_list) AppendMutable() protoreflect.Value {
+	v := new(UnbondingTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdatePool_9_list) NewElement() protoreflect.Value {
+	v := new(UnbondingTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdatePool_10_list)(nil)
+
+type _MsgUpdatePool_10_list struct {
+	list *[]*ExitFeeTier
+}
+
+func (x *_MsgUpdatePool_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdatePool_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdatePool_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExitFeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdatePool_10_list) AppendMutable() protoreflect.Value {
+	v := new(ExitFeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdatePool_10_list) NewElement() protoreflect.Value {
+	v := new(ExitFeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdatePool_11_list)(nil)
+
+type _MsgUpdatePool_11_list struct {
+	list *[]*LockTier
+}
+
+func (x *_MsgUpdatePool_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdatePool_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdatePool_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdatePool_11_list) AppendMutable() protoreflect.Value {
+	v := new(LockTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdatePool_11_list) NewElement() protoreflect.Value {
+	v := new(LockTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdatePool_12_list)(nil)
+
+type _MsgUpdatePool_12_list struct {
+	list *[]*FeeSplit
+}
+
+func (x *_MsgUpdatePool_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdatePool_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdatePool_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeSplit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdatePool_12_list) AppendMutable() protoreflect.Value {
+	v := new(FeeSplit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdatePool_12_list) NewElement() protoreflect.Value {
+	v := new(FeeSplit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdatePool_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgUpdatePool                         protoreflect.MessageDescriptor
+	fd_MsgUpdatePool_signer                  protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_pool_id                 protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_protocol_fee_percentage protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_rewards_fee             protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_initial_a               protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_future_a                protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_future_a_time           protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_rate_multipliers        protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_unbonding_tiers         protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_exit_fee_tiers          protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_lock_tiers              protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_fee_splits              protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_dynamic_fee             protoreflect.FieldDescriptor
+	fd_MsgUpdatePool_directional_fee         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgUpdatePool = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgUpdatePool")
+	fd_MsgUpdatePool_signer = md_MsgUpdatePool.Fields().ByName("signer")
+	fd_MsgUpdatePool_pool_id = md_MsgUpdatePool.Fields().ByName("pool_id")
+	fd_MsgUpdatePool_protocol_fee_percentage = md_MsgUpdatePool.Fields().ByName("protocol_fee_percentage")
+	fd_MsgUpdatePool_rewards_fee = md_MsgUpdatePool.Fields().ByName("rewards_fee")
+	fd_MsgUpdatePool_initial_a = md_MsgUpdatePool.Fields().ByName("initial_a")
+	fd_MsgUpdatePool_future_a = md_MsgUpdatePool.Fields().ByName("future_a")
+	fd_MsgUpdatePool_future_a_time = md_MsgUpdatePool.Fields().ByName("future_a_time")
+	fd_MsgUpdatePool_rate_multipliers = md_MsgUpdatePool.Fields().ByName("rate_multipliers")
+	fd_MsgUpdatePool_unbonding_tiers = md_MsgUpdatePool.Fields().ByName("unbonding_tiers")
+	fd_MsgUpdatePool_exit_fee_tiers = md_MsgUpdatePool.Fields().ByName("exit_fee_tiers")
+	fd_MsgUpdatePool_lock_tiers = md_MsgUpdatePool.Fields().ByName("lock_tiers")
+	fd_MsgUpdatePool_fee_splits = md_MsgUpdatePool.Fields().ByName("fee_splits")
+	fd_MsgUpdatePool_dynamic_fee = md_MsgUpdatePool.Fields().ByName("dynamic_fee")
+	fd_MsgUpdatePool_directional_fee = md_MsgUpdatePool.Fields().ByName("directional_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdatePool)(nil)
 
 type fastReflection_MsgUpdatePool MsgUpdatePool
 
@@ -1424,6 +2351,42 @@ func (x *fastReflection_MsgUpdatePool) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if len(x.UnbondingTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdatePool_9_list{list: &x.UnbondingTiers})
+		if !f(fd_MsgUpdatePool_unbonding_tiers, value) {
+			return
+		}
+	}
+	if len(x.ExitFeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdatePool_10_list{list: &x.ExitFeeTiers})
+		if !f(fd_MsgUpdatePool_exit_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.LockTiers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdatePool_11_list{list: &x.LockTiers})
+		if !f(fd_MsgUpdatePool_lock_tiers, value) {
+			return
+		}
+	}
+	if len(x.FeeSplits) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdatePool_12_list{list: &x.FeeSplits})
+		if !f(fd_MsgUpdatePool_fee_splits, value) {
+			return
+		}
+	}
+	if x.DynamicFee != nil {
+		value := protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+		if !f(fd_MsgUpdatePool_dynamic_fee, value) {
+			return
+		}
+	}
+	if x.DirectionalFee != nil {
+		value := protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
+		if !f(fd_MsgUpdatePool_directional_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1455,6 +2418,18 @@ func (x *fastReflection_MsgUpdatePool) Has(fd protoreflect.FieldDescriptor) bool
 		return x.FutureATime != int64(0)
 	case "noble.swap.stableswap.v1.MsgUpdatePool.rate_multipliers":
 		return len(x.RateMultipliers) != 0
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		return len(x.UnbondingTiers) != 0
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		return len(x.ExitFeeTiers) != 0
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		return len(x.LockTiers) != 0
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		return len(x.FeeSplits) != 0
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		return x.DynamicFee != nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		return x.DirectionalFee != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgUpdatePool"))
@@ -1487,6 +2462,18 @@ func (x *fastReflection_MsgUpdatePool) Clear(fd protoreflect.FieldDescriptor) {
 		x.FutureATime = int64(0)
 	case "noble.swap.stableswap.v1.MsgUpdatePool.rate_multipliers":
 		x.RateMultipliers = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		x.UnbondingTiers = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		x.ExitFeeTiers = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		x.LockTiers = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		x.FeeSplits = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		x.DynamicFee = nil
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		x.DirectionalFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgUpdatePool"))
@@ -1530,6 +2517,36 @@ func (x *fastReflection_MsgUpdatePool) Get(descriptor protoreflect.FieldDescript
 		}
 		listValue := &_MsgUpdatePool_8_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		if len(x.UnbondingTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdatePool_9_list{})
+		}
+		listValue := &_MsgUpdatePool_9_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		if len(x.ExitFeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdatePool_10_list{})
+		}
+		listValue := &_MsgUpdatePool_10_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		if len(x.LockTiers) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdatePool_11_list{})
+		}
+		listValue := &_MsgUpdatePool_11_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		if len(x.FeeSplits) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdatePool_12_list{})
+		}
+		listValue := &_MsgUpdatePool_12_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		value := x.DynamicFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		value := x.DirectionalFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgUpdatePool"))
@@ -1568,6 +2585,26 @@ func (x *fastReflection_MsgUpdatePool) Set(fd protoreflect.FieldDescriptor, valu
 		lv := value.List()
 		clv := lv.(*_MsgUpdatePool_8_list)
 		x.RateMultipliers = *clv.list
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgUpdatePool_9_list)
+		x.UnbondingTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgUpdatePool_10_list)
+		x.ExitFeeTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		lv := value.List()
+		clv := lv.(*_MsgUpdatePool_11_list)
+		x.LockTiers = *clv.list
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		lv := value.List()
+		clv := lv.(*_MsgUpdatePool_12_list)
+		x.FeeSplits = *clv.list
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		x.DynamicFee = value.Message().Interface().(*DynamicFee)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		x.DirectionalFee = value.Message().Interface().(*DirectionalFee)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgUpdatePool"))
@@ -1594,6 +2631,40 @@ func (x *fastReflection_MsgUpdatePool) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_MsgUpdatePool_8_list{list: &x.RateMultipliers}
 		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		if x.UnbondingTiers == nil {
+			x.UnbondingTiers = []*UnbondingTier{}
+		}
+		value := &_MsgUpdatePool_9_list{list: &x.UnbondingTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		if x.ExitFeeTiers == nil {
+			x.ExitFeeTiers = []*ExitFeeTier{}
+		}
+		value := &_MsgUpdatePool_10_list{list: &x.ExitFeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		if x.LockTiers == nil {
+			x.LockTiers = []*LockTier{}
+		}
+		value := &_MsgUpdatePool_11_list{list: &x.LockTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		if x.FeeSplits == nil {
+			x.FeeSplits = []*FeeSplit{}
+		}
+		value := &_MsgUpdatePool_12_list{list: &x.FeeSplits}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		if x.DynamicFee == nil {
+			x.DynamicFee = new(DynamicFee)
+		}
+		return protoreflect.ValueOfMessage(x.DynamicFee.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		if x.DirectionalFee == nil {
+			x.DirectionalFee = new(DirectionalFee)
+		}
+		return protoreflect.ValueOfMessage(x.DirectionalFee.ProtoReflect())
 	case "noble.swap.stableswap.v1.MsgUpdatePool.signer":
 		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgUpdatePool is not mutable"))
 	case "noble.swap.stableswap.v1.MsgUpdatePool.pool_id":
@@ -1638,6 +2709,24 @@ func (x *fastReflection_MsgUpdatePool) NewField(fd protoreflect.FieldDescriptor)
 	case "noble.swap.stableswap.v1.MsgUpdatePool.rate_multipliers":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_MsgUpdatePool_8_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers":
+		list := []*UnbondingTier{}
+		return protoreflect.ValueOfList(&_MsgUpdatePool_9_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers":
+		list := []*ExitFeeTier{}
+		return protoreflect.ValueOfList(&_MsgUpdatePool_10_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers":
+		list := []*LockTier{}
+		return protoreflect.ValueOfList(&_MsgUpdatePool_11_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgUpdatePool.fee_splits":
+		list := []*FeeSplit{}
+		return protoreflect.ValueOfList(&_MsgUpdatePool_12_list{list: &list})
+	case "noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee":
+		m := new(DynamicFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgUpdatePool.directional_fee":
+		m := new(DirectionalFee)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgUpdatePool"))
@@ -1735,6 +2824,38 @@ func (x *fastReflection_MsgUpdatePool) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.UnbondingTiers) > 0 {
+			for _, e := range x.UnbondingTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for _, e := range x.ExitFeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LockTiers) > 0 {
+			for _, e := range x.LockTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeSplits) > 0 {
+			for _, e := range x.FeeSplits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DynamicFee != nil {
+			l = options.Size(x.DynamicFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DirectionalFee != nil {
+			l = options.Size(x.DirectionalFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1764,9 +2885,37 @@ func (x *fastReflection_MsgUpdatePool) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RateMultipliers) > 0 {
-			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
+		if x.DirectionalFee != nil {
+			encoded, err := options.Marshal(x.DirectionalFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.DynamicFee != nil {
+			encoded, err := options.Marshal(x.DynamicFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.FeeSplits) > 0 {
+			for iNdEx := len(x.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeSplits[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1777,18 +2926,82 @@ func (x *fastReflection_MsgUpdatePool) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x42
+				dAtA[i] = 0x62
 			}
 		}
-		if x.FutureATime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.FutureA != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
-			i--
-			dAtA[i] = 0x30
+		if len(x.LockTiers) > 0 {
+			for iNdEx := len(x.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ExitFeeTiers) > 0 {
+			for iNdEx := len(x.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.UnbondingTiers) > 0 {
+			for iNdEx := len(x.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.RateMultipliers) > 0 {
+			for iNdEx := len(x.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.FutureATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FutureA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
+			i--
+			dAtA[i] = 0x30
 		}
 		if x.InitialA != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
@@ -2046,6 +3259,214 @@ func (x *fastReflection_MsgUpdatePool) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingTiers = append(x.UnbondingTiers, &UnbondingTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingTiers[len(x.UnbondingTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExitFeeTiers = append(x.ExitFeeTiers, &ExitFeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFeeTiers[len(x.ExitFeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTiers = append(x.LockTiers, &LockTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockTiers[len(x.LockTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeSplits = append(x.FeeSplits, &FeeSplit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeSplits[len(x.FeeSplits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DynamicFee == nil {
+					x.DynamicFee = &DynamicFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DynamicFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DirectionalFee == nil {
+					x.DirectionalFee = &DirectionalFee{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DirectionalFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2494,6 +3915,7 @@ var (
 	fd_MsgAddLiquidity_pool_id             protoreflect.FieldDescriptor
 	fd_MsgAddLiquidity_amount              protoreflect.FieldDescriptor
 	fd_MsgAddLiquidity_slippage_percentage protoreflect.FieldDescriptor
+	fd_MsgAddLiquidity_lock_duration       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2503,6 +3925,7 @@ func init() {
 	fd_MsgAddLiquidity_pool_id = md_MsgAddLiquidity.Fields().ByName("pool_id")
 	fd_MsgAddLiquidity_amount = md_MsgAddLiquidity.Fields().ByName("amount")
 	fd_MsgAddLiquidity_slippage_percentage = md_MsgAddLiquidity.Fields().ByName("slippage_percentage")
+	fd_MsgAddLiquidity_lock_duration = md_MsgAddLiquidity.Fields().ByName("lock_duration")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgAddLiquidity)(nil)
@@ -2594,6 +4017,12 @@ func (x *fastReflection_MsgAddLiquidity) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.LockDuration != nil {
+		value := protoreflect.ValueOfMessage(x.LockDuration.ProtoReflect())
+		if !f(fd_MsgAddLiquidity_lock_duration, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2617,6 +4046,8 @@ func (x *fastReflection_MsgAddLiquidity) Has(fd protoreflect.FieldDescriptor) bo
 		return len(x.Amount) != 0
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.slippage_percentage":
 		return x.SlippagePercentage != int64(0)
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		return x.LockDuration != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgAddLiquidity"))
@@ -2641,6 +4072,8 @@ func (x *fastReflection_MsgAddLiquidity) Clear(fd protoreflect.FieldDescriptor)
 		x.Amount = nil
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.slippage_percentage":
 		x.SlippagePercentage = int64(0)
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		x.LockDuration = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgAddLiquidity"))
@@ -2672,6 +4105,9 @@ func (x *fastReflection_MsgAddLiquidity) Get(descriptor protoreflect.FieldDescri
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.slippage_percentage":
 		value := x.SlippagePercentage
 		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		value := x.LockDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgAddLiquidity"))
@@ -2702,6 +4138,8 @@ func (x *fastReflection_MsgAddLiquidity) Set(fd protoreflect.FieldDescriptor, va
 		x.Amount = *clv.list
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.slippage_percentage":
 		x.SlippagePercentage = value.Int()
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		x.LockDuration = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgAddLiquidity"))
@@ -2728,6 +4166,11 @@ func (x *fastReflection_MsgAddLiquidity) Mutable(fd protoreflect.FieldDescriptor
 		}
 		value := &_MsgAddLiquidity_3_list{list: &x.Amount}
 		return protoreflect.ValueOfList(value)
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		if x.LockDuration == nil {
+			x.LockDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.LockDuration.ProtoReflect())
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.signer":
 		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgAddLiquidity is not mutable"))
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.pool_id":
@@ -2756,6 +4199,9 @@ func (x *fastReflection_MsgAddLiquidity) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfList(&_MsgAddLiquidity_3_list{list: &list})
 	case "noble.swap.stableswap.v1.MsgAddLiquidity.slippage_percentage":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgAddLiquidity"))
@@ -2841,6 +4287,10 @@ func (x *fastReflection_MsgAddLiquidity) ProtoMethods() *protoiface.Methods {
 		if x.SlippagePercentage != 0 {
 			n += 1 + runtime.Sov(uint64(x.SlippagePercentage))
 		}
+		if x.LockDuration != nil {
+			l = options.Size(x.LockDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2870,6 +4320,20 @@ func (x *fastReflection_MsgAddLiquidity) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LockDuration != nil {
+			encoded, err := options.Marshal(x.LockDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.SlippagePercentage != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlippagePercentage))
 			i--
@@ -3056,20 +4520,56 @@ func (x *fastReflection_MsgAddLiquidity) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockDuration == nil {
+					x.LockDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3500,6 +5000,7 @@ var (
 	fd_MsgRemoveLiquidity_signer     protoreflect.FieldDescriptor
 	fd_MsgRemoveLiquidity_pool_id    protoreflect.FieldDescriptor
 	fd_MsgRemoveLiquidity_percentage protoreflect.FieldDescriptor
+	fd_MsgRemoveLiquidity_instant    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3508,6 +5009,7 @@ func init() {
 	fd_MsgRemoveLiquidity_signer = md_MsgRemoveLiquidity.Fields().ByName("signer")
 	fd_MsgRemoveLiquidity_pool_id = md_MsgRemoveLiquidity.Fields().ByName("pool_id")
 	fd_MsgRemoveLiquidity_percentage = md_MsgRemoveLiquidity.Fields().ByName("percentage")
+	fd_MsgRemoveLiquidity_instant = md_MsgRemoveLiquidity.Fields().ByName("instant")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRemoveLiquidity)(nil)
@@ -3593,6 +5095,12 @@ func (x *fastReflection_MsgRemoveLiquidity) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.Instant != false {
+		value := protoreflect.ValueOfBool(x.Instant)
+		if !f(fd_MsgRemoveLiquidity_instant, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3614,6 +5122,8 @@ func (x *fastReflection_MsgRemoveLiquidity) Has(fd protoreflect.FieldDescriptor)
 		return x.PoolId != uint64(0)
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		return x.Percentage != ""
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		return x.Instant != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3636,6 +5146,8 @@ func (x *fastReflection_MsgRemoveLiquidity) Clear(fd protoreflect.FieldDescripto
 		x.PoolId = uint64(0)
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		x.Percentage = ""
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		x.Instant = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3661,6 +5173,9 @@ func (x *fastReflection_MsgRemoveLiquidity) Get(descriptor protoreflect.FieldDes
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		value := x.Percentage
 		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		value := x.Instant
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3687,6 +5202,8 @@ func (x *fastReflection_MsgRemoveLiquidity) Set(fd protoreflect.FieldDescriptor,
 		x.PoolId = value.Uint()
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		x.Percentage = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		x.Instant = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3713,6 +5230,8 @@ func (x *fastReflection_MsgRemoveLiquidity) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.MsgRemoveLiquidity is not mutable"))
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		panic(fmt.Errorf("field percentage of message noble.swap.stableswap.v1.MsgRemoveLiquidity is not mutable"))
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		panic(fmt.Errorf("field instant of message noble.swap.stableswap.v1.MsgRemoveLiquidity is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3732,6 +5251,8 @@ func (x *fastReflection_MsgRemoveLiquidity) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.percentage":
 		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidity.instant":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidity"))
@@ -3812,6 +5333,9 @@ func (x *fastReflection_MsgRemoveLiquidity) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Instant {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3841,6 +5365,16 @@ func (x *fastReflection_MsgRemoveLiquidity) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Instant {
+			i--
+			if x.Instant {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Percentage) > 0 {
 			i -= len(x.Percentage)
 			copy(dAtA[i:], x.Percentage)
@@ -3992,6 +5526,26 @@ func (x *fastReflection_MsgRemoveLiquidity) ProtoMethods() *protoiface.Methods {
 				}
 				x.Percentage = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Instant", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Instant = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4027,15 +5581,68 @@ func (x *fastReflection_MsgRemoveLiquidity) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgRemoveLiquidityResponse_2_list)(nil)
+
+type _MsgRemoveLiquidityResponse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRemoveLiquidityResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgRemoveLiquidityResponse                  protoreflect.MessageDescriptor
 	fd_MsgRemoveLiquidityResponse_unbonding_shares protoreflect.FieldDescriptor
+	fd_MsgRemoveLiquidityResponse_exit_fee         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_swap_stableswap_v1_tx_proto_init()
 	md_MsgRemoveLiquidityResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgRemoveLiquidityResponse")
 	fd_MsgRemoveLiquidityResponse_unbonding_shares = md_MsgRemoveLiquidityResponse.Fields().ByName("unbonding_shares")
+	fd_MsgRemoveLiquidityResponse_exit_fee = md_MsgRemoveLiquidityResponse.Fields().ByName("exit_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgRemoveLiquidityResponse)(nil)
@@ -4109,6 +5716,12 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if len(x.ExitFee) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRemoveLiquidityResponse_2_list{list: &x.ExitFee})
+		if !f(fd_MsgRemoveLiquidityResponse_exit_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4126,6 +5739,8 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Has(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		return x.UnbondingShares != ""
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		return len(x.ExitFee) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse"))
@@ -4144,6 +5759,8 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Clear(fd protoreflect.FieldD
 	switch fd.FullName() {
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		x.UnbondingShares = ""
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		x.ExitFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse"))
@@ -4163,6 +5780,12 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Get(descriptor protoreflect.
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		value := x.UnbondingShares
 		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		if len(x.ExitFee) == 0 {
+			return protoreflect.ValueOfList(&_MsgRemoveLiquidityResponse_2_list{})
+		}
+		listValue := &_MsgRemoveLiquidityResponse_2_list{list: &x.ExitFee}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse"))
@@ -4185,6 +5808,10 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Set(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		x.UnbondingShares = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		lv := value.List()
+		clv := lv.(*_MsgRemoveLiquidityResponse_2_list)
+		x.ExitFee = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse"))
@@ -4205,6 +5832,12 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) Set(fd protoreflect.FieldDes
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgRemoveLiquidityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		if x.ExitFee == nil {
+			x.ExitFee = []*v1beta1.Coin{}
+		}
+		value := &_MsgRemoveLiquidityResponse_2_list{list: &x.ExitFee}
+		return protoreflect.ValueOfList(value)
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		panic(fmt.Errorf("field unbonding_shares of message noble.swap.stableswap.v1.MsgRemoveLiquidityResponse is not mutable"))
 	default:
@@ -4222,6 +5855,9 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) NewField(fd protoreflect.Fie
 	switch fd.FullName() {
 	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.unbonding_shares":
 		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgRemoveLiquidityResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse"))
@@ -4295,6 +5931,12 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ExitFee) > 0 {
+			for _, e := range x.ExitFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4324,6 +5966,22 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExitFee) > 0 {
+			for iNdEx := len(x.ExitFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExitFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.UnbondingShares) > 0 {
 			i -= len(x.UnbondingShares)
 			copy(dAtA[i:], x.UnbondingShares)
@@ -4412,6 +6070,40 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) ProtoMethods() *protoiface.M
 				}
 				x.UnbondingShares = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExitFee = append(x.ExitFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExitFee[len(x.ExitFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4447,456 +6139,6620 @@ func (x *fastReflection_MsgRemoveLiquidityResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: noble/swap/stableswap/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgTransferPosition            protoreflect.MessageDescriptor
+	fd_MsgTransferPosition_signer     protoreflect.FieldDescriptor
+	fd_MsgTransferPosition_pool_id    protoreflect.FieldDescriptor
+	fd_MsgTransferPosition_receiver   protoreflect.FieldDescriptor
+	fd_MsgTransferPosition_percentage protoreflect.FieldDescriptor
 )
 
-type MsgCreatePool struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgTransferPosition = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgTransferPosition")
+	fd_MsgTransferPosition_signer = md_MsgTransferPosition.Fields().ByName("signer")
+	fd_MsgTransferPosition_pool_id = md_MsgTransferPosition.Fields().ByName("pool_id")
+	fd_MsgTransferPosition_receiver = md_MsgTransferPosition.Fields().ByName("receiver")
+	fd_MsgTransferPosition_percentage = md_MsgTransferPosition.Fields().ByName("percentage")
+}
 
-	// The address of the authority creating the pool.
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// The asset pair that will be part of the pool.
-	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	// The protocol fee percentage.
-	ProtocolFeePercentage int64 `protobuf:"varint,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
-	// The rewards fee value.
-	RewardsFee int64 `protobuf:"varint,4,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// The initial A parameter for the pool.
-	InitialA int64 `protobuf:"varint,5,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
-	// The future A parameter for pool rebalancing.
-	FutureA int64 `protobuf:"varint,6,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// The time to reach the future A parameter.
-	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
-	// The coins rate multipliers.
-	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgTransferPosition)(nil)
+
+type fastReflection_MsgTransferPosition MsgTransferPosition
+
+func (x *MsgTransferPosition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferPosition)(x)
 }
 
-func (x *MsgCreatePool) Reset() {
-	*x = MsgCreatePool{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[0]
+func (x *MsgTransferPosition) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgCreatePool) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgTransferPosition_messageType fastReflection_MsgTransferPosition_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferPosition_messageType{}
 
-func (*MsgCreatePool) ProtoMessage() {}
+type fastReflection_MsgTransferPosition_messageType struct{}
 
-// Deprecated: Use MsgCreatePool.ProtoReflect.Descriptor instead.
-func (*MsgCreatePool) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgTransferPosition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferPosition)(nil)
 }
-
-func (x *MsgCreatePool) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
+func (x fastReflection_MsgTransferPosition_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferPosition)
+}
+func (x fastReflection_MsgTransferPosition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferPosition
 }
 
-func (x *MsgCreatePool) GetPair() string {
-	if x != nil {
-		return x.Pair
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferPosition) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferPosition
 }
 
-func (x *MsgCreatePool) GetProtocolFeePercentage() int64 {
-	if x != nil {
-		return x.ProtocolFeePercentage
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferPosition) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferPosition_messageType
 }
 
-func (x *MsgCreatePool) GetRewardsFee() int64 {
-	if x != nil {
-		return x.RewardsFee
-	}
-	return 0
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferPosition) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferPosition)
 }
 
-func (x *MsgCreatePool) GetInitialA() int64 {
-	if x != nil {
-		return x.InitialA
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferPosition) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferPosition)(x)
 }
 
-func (x *MsgCreatePool) GetFutureA() int64 {
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferPosition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgTransferPosition_signer, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_MsgTransferPosition_pool_id, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_MsgTransferPosition_receiver, value) {
+			return
+		}
+	}
+	if x.Percentage != "" {
+		value := protoreflect.ValueOfString(x.Percentage)
+		if !f(fd_MsgTransferPosition_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferPosition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		return x.Receiver != ""
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		return x.Percentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPosition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		x.Receiver = ""
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		x.Percentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferPosition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		value := x.Percentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPosition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		x.Receiver = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		x.Percentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPosition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgTransferPosition is not mutable"))
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.MsgTransferPosition is not mutable"))
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		panic(fmt.Errorf("field receiver of message noble.swap.stableswap.v1.MsgTransferPosition is not mutable"))
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		panic(fmt.Errorf("field percentage of message noble.swap.stableswap.v1.MsgTransferPosition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferPosition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPosition.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgTransferPosition.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.MsgTransferPosition.receiver":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgTransferPosition.percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPosition"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPosition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferPosition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgTransferPosition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferPosition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPosition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferPosition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferPosition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferPosition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Percentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferPosition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Percentage) > 0 {
+			i -= len(x.Percentage)
+			copy(dAtA[i:], x.Percentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Percentage)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferPosition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Percentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferPositionResponse                    protoreflect.MessageDescriptor
+	fd_MsgTransferPositionResponse_transferred_shares protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgTransferPositionResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgTransferPositionResponse")
+	fd_MsgTransferPositionResponse_transferred_shares = md_MsgTransferPositionResponse.Fields().ByName("transferred_shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferPositionResponse)(nil)
+
+type fastReflection_MsgTransferPositionResponse MsgTransferPositionResponse
+
+func (x *MsgTransferPositionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferPositionResponse)(x)
+}
+
+func (x *MsgTransferPositionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferPositionResponse_messageType fastReflection_MsgTransferPositionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferPositionResponse_messageType{}
+
+type fastReflection_MsgTransferPositionResponse_messageType struct{}
+
+func (x fastReflection_MsgTransferPositionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferPositionResponse)(nil)
+}
+func (x fastReflection_MsgTransferPositionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferPositionResponse)
+}
+func (x fastReflection_MsgTransferPositionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferPositionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferPositionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferPositionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferPositionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferPositionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferPositionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferPositionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferPositionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferPositionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferPositionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TransferredShares != "" {
+		value := protoreflect.ValueOfString(x.TransferredShares)
+		if !f(fd_MsgTransferPositionResponse_transferred_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferPositionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		return x.TransferredShares != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPositionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		x.TransferredShares = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferPositionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		value := x.TransferredShares
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPositionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		x.TransferredShares = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPositionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		panic(fmt.Errorf("field transferred_shares of message noble.swap.stableswap.v1.MsgTransferPositionResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferPositionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgTransferPositionResponse.transferred_shares":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgTransferPositionResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgTransferPositionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferPositionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgTransferPositionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferPositionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferPositionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferPositionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferPositionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferPositionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TransferredShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferPositionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TransferredShares) > 0 {
+			i -= len(x.TransferredShares)
+			copy(dAtA[i:], x.TransferredShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransferredShares)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferPositionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferredShares", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferredShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoCompound         protoreflect.MessageDescriptor
+	fd_MsgSetAutoCompound_signer  protoreflect.FieldDescriptor
+	fd_MsgSetAutoCompound_pool_id protoreflect.FieldDescriptor
+	fd_MsgSetAutoCompound_enabled protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgSetAutoCompound = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgSetAutoCompound")
+	fd_MsgSetAutoCompound_signer = md_MsgSetAutoCompound.Fields().ByName("signer")
+	fd_MsgSetAutoCompound_pool_id = md_MsgSetAutoCompound.Fields().ByName("pool_id")
+	fd_MsgSetAutoCompound_enabled = md_MsgSetAutoCompound.Fields().ByName("enabled")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoCompound)(nil)
+
+type fastReflection_MsgSetAutoCompound MsgSetAutoCompound
+
+func (x *MsgSetAutoCompound) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoCompound)(x)
+}
+
+func (x *MsgSetAutoCompound) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoCompound_messageType fastReflection_MsgSetAutoCompound_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoCompound_messageType{}
+
+type fastReflection_MsgSetAutoCompound_messageType struct{}
+
+func (x fastReflection_MsgSetAutoCompound_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoCompound)(nil)
+}
+func (x fastReflection_MsgSetAutoCompound_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoCompound)
+}
+func (x fastReflection_MsgSetAutoCompound_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoCompound
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoCompound) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoCompound
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoCompound) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoCompound_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoCompound) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoCompound)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoCompound) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoCompound)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoCompound) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetAutoCompound_signer, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_MsgSetAutoCompound_pool_id, value) {
+			return
+		}
+	}
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_MsgSetAutoCompound_enabled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoCompound) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		return x.Enabled != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompound) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		x.Enabled = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoCompound) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompound) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		x.Enabled = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompound) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgSetAutoCompound is not mutable"))
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.MsgSetAutoCompound is not mutable"))
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		panic(fmt.Errorf("field enabled of message noble.swap.stableswap.v1.MsgSetAutoCompound is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoCompound) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.MsgSetAutoCompound.enabled":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompound"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompound does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoCompound) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgSetAutoCompound", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoCompound) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompound) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoCompound) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoCompound) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoCompound)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.Enabled {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoCompound)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoCompound)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoCompoundResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgSetAutoCompoundResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgSetAutoCompoundResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoCompoundResponse)(nil)
+
+type fastReflection_MsgSetAutoCompoundResponse MsgSetAutoCompoundResponse
+
+func (x *MsgSetAutoCompoundResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoCompoundResponse)(x)
+}
+
+func (x *MsgSetAutoCompoundResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoCompoundResponse_messageType fastReflection_MsgSetAutoCompoundResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoCompoundResponse_messageType{}
+
+type fastReflection_MsgSetAutoCompoundResponse_messageType struct{}
+
+func (x fastReflection_MsgSetAutoCompoundResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoCompoundResponse)(nil)
+}
+func (x fastReflection_MsgSetAutoCompoundResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoCompoundResponse)
+}
+func (x fastReflection_MsgSetAutoCompoundResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoCompoundResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoCompoundResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoCompoundResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoCompoundResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoCompoundResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoCompoundResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompoundResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoCompoundResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgSetAutoCompoundResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoCompoundResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgSetAutoCompoundResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoCompoundResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoCompoundResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoCompoundResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoCompoundResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoCompoundResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoCompoundResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoCompoundResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleUpdatePool                protoreflect.MessageDescriptor
+	fd_MsgScheduleUpdatePool_signer         protoreflect.FieldDescriptor
+	fd_MsgScheduleUpdatePool_execution_time protoreflect.FieldDescriptor
+	fd_MsgScheduleUpdatePool_update         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgScheduleUpdatePool = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgScheduleUpdatePool")
+	fd_MsgScheduleUpdatePool_signer = md_MsgScheduleUpdatePool.Fields().ByName("signer")
+	fd_MsgScheduleUpdatePool_execution_time = md_MsgScheduleUpdatePool.Fields().ByName("execution_time")
+	fd_MsgScheduleUpdatePool_update = md_MsgScheduleUpdatePool.Fields().ByName("update")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleUpdatePool)(nil)
+
+type fastReflection_MsgScheduleUpdatePool MsgScheduleUpdatePool
+
+func (x *MsgScheduleUpdatePool) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleUpdatePool)(x)
+}
+
+func (x *MsgScheduleUpdatePool) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleUpdatePool_messageType fastReflection_MsgScheduleUpdatePool_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleUpdatePool_messageType{}
+
+type fastReflection_MsgScheduleUpdatePool_messageType struct{}
+
+func (x fastReflection_MsgScheduleUpdatePool_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleUpdatePool)(nil)
+}
+func (x fastReflection_MsgScheduleUpdatePool_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleUpdatePool)
+}
+func (x fastReflection_MsgScheduleUpdatePool_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleUpdatePool
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleUpdatePool) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleUpdatePool
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleUpdatePool) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleUpdatePool_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleUpdatePool) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleUpdatePool)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleUpdatePool) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleUpdatePool)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleUpdatePool) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgScheduleUpdatePool_signer, value) {
+			return
+		}
+	}
+	if x.ExecutionTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionTime)
+		if !f(fd_MsgScheduleUpdatePool_execution_time, value) {
+			return
+		}
+	}
+	if x.Update != nil {
+		value := protoreflect.ValueOfMessage(x.Update.ProtoReflect())
+		if !f(fd_MsgScheduleUpdatePool_update, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleUpdatePool) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		return x.ExecutionTime != int64(0)
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		return x.Update != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePool) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		x.ExecutionTime = int64(0)
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		x.Update = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleUpdatePool) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		value := x.ExecutionTime
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		value := x.Update
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePool) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		x.ExecutionTime = value.Int()
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		x.Update = value.Message().Interface().(*MsgUpdatePool)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePool) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		if x.Update == nil {
+			x.Update = new(MsgUpdatePool)
+		}
+		return protoreflect.ValueOfMessage(x.Update.ProtoReflect())
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgScheduleUpdatePool is not mutable"))
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		panic(fmt.Errorf("field execution_time of message noble.swap.stableswap.v1.MsgScheduleUpdatePool is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleUpdatePool) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.execution_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePool.update":
+		m := new(MsgUpdatePool)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePool"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePool does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleUpdatePool) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgScheduleUpdatePool", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleUpdatePool) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePool) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleUpdatePool) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleUpdatePool) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleUpdatePool)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionTime))
+		}
+		if x.Update != nil {
+			l = options.Size(x.Update)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleUpdatePool)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Update != nil {
+			encoded, err := options.Marshal(x.Update)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ExecutionTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTime))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleUpdatePool)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleUpdatePool: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+				}
+				x.ExecutionTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Update == nil {
+					x.Update = &MsgUpdatePool{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Update); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgScheduleUpdatePoolResponse    protoreflect.MessageDescriptor
+	fd_MsgScheduleUpdatePoolResponse_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgScheduleUpdatePoolResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgScheduleUpdatePoolResponse")
+	fd_MsgScheduleUpdatePoolResponse_id = md_MsgScheduleUpdatePoolResponse.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgScheduleUpdatePoolResponse)(nil)
+
+type fastReflection_MsgScheduleUpdatePoolResponse MsgScheduleUpdatePoolResponse
+
+func (x *MsgScheduleUpdatePoolResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgScheduleUpdatePoolResponse)(x)
+}
+
+func (x *MsgScheduleUpdatePoolResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgScheduleUpdatePoolResponse_messageType fastReflection_MsgScheduleUpdatePoolResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgScheduleUpdatePoolResponse_messageType{}
+
+type fastReflection_MsgScheduleUpdatePoolResponse_messageType struct{}
+
+func (x fastReflection_MsgScheduleUpdatePoolResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgScheduleUpdatePoolResponse)(nil)
+}
+func (x fastReflection_MsgScheduleUpdatePoolResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleUpdatePoolResponse)
+}
+func (x fastReflection_MsgScheduleUpdatePoolResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleUpdatePoolResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgScheduleUpdatePoolResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgScheduleUpdatePoolResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgScheduleUpdatePoolResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgScheduleUpdatePoolResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgScheduleUpdatePoolResponse_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		panic(fmt.Errorf("field id of message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgScheduleUpdatePoolResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgScheduleUpdatePoolResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleUpdatePoolResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgScheduleUpdatePoolResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleUpdatePoolResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgScheduleUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelPoolUpdate        protoreflect.MessageDescriptor
+	fd_MsgCancelPoolUpdate_signer protoreflect.FieldDescriptor
+	fd_MsgCancelPoolUpdate_id     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgCancelPoolUpdate = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgCancelPoolUpdate")
+	fd_MsgCancelPoolUpdate_signer = md_MsgCancelPoolUpdate.Fields().ByName("signer")
+	fd_MsgCancelPoolUpdate_id = md_MsgCancelPoolUpdate.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelPoolUpdate)(nil)
+
+type fastReflection_MsgCancelPoolUpdate MsgCancelPoolUpdate
+
+func (x *MsgCancelPoolUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelPoolUpdate)(x)
+}
+
+func (x *MsgCancelPoolUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelPoolUpdate_messageType fastReflection_MsgCancelPoolUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelPoolUpdate_messageType{}
+
+type fastReflection_MsgCancelPoolUpdate_messageType struct{}
+
+func (x fastReflection_MsgCancelPoolUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelPoolUpdate)(nil)
+}
+func (x fastReflection_MsgCancelPoolUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelPoolUpdate)
+}
+func (x fastReflection_MsgCancelPoolUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelPoolUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelPoolUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelPoolUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelPoolUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelPoolUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelPoolUpdate) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelPoolUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelPoolUpdate) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelPoolUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelPoolUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgCancelPoolUpdate_signer, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MsgCancelPoolUpdate_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelPoolUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelPoolUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgCancelPoolUpdate is not mutable"))
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		panic(fmt.Errorf("field id of message noble.swap.stableswap.v1.MsgCancelPoolUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelPoolUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgCancelPoolUpdate.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdate"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelPoolUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgCancelPoolUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelPoolUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelPoolUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelPoolUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelPoolUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelPoolUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelPoolUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelPoolUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelPoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelPoolUpdateResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgCancelPoolUpdateResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgCancelPoolUpdateResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelPoolUpdateResponse)(nil)
+
+type fastReflection_MsgCancelPoolUpdateResponse MsgCancelPoolUpdateResponse
+
+func (x *MsgCancelPoolUpdateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelPoolUpdateResponse)(x)
+}
+
+func (x *MsgCancelPoolUpdateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelPoolUpdateResponse_messageType fastReflection_MsgCancelPoolUpdateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelPoolUpdateResponse_messageType{}
+
+type fastReflection_MsgCancelPoolUpdateResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelPoolUpdateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelPoolUpdateResponse)(nil)
+}
+func (x fastReflection_MsgCancelPoolUpdateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelPoolUpdateResponse)
+}
+func (x fastReflection_MsgCancelPoolUpdateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelPoolUpdateResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelPoolUpdateResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelPoolUpdateResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelPoolUpdateResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelPoolUpdateResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelPoolUpdateResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelPoolUpdateResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelPoolUpdateResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelPoolUpdateResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelPoolUpdateResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelPoolUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRampA               protoreflect.MessageDescriptor
+	fd_MsgRampA_signer        protoreflect.FieldDescriptor
+	fd_MsgRampA_pool_id       protoreflect.FieldDescriptor
+	fd_MsgRampA_future_a      protoreflect.FieldDescriptor
+	fd_MsgRampA_future_a_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgRampA = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgRampA")
+	fd_MsgRampA_signer = md_MsgRampA.Fields().ByName("signer")
+	fd_MsgRampA_pool_id = md_MsgRampA.Fields().ByName("pool_id")
+	fd_MsgRampA_future_a = md_MsgRampA.Fields().ByName("future_a")
+	fd_MsgRampA_future_a_time = md_MsgRampA.Fields().ByName("future_a_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRampA)(nil)
+
+type fastReflection_MsgRampA MsgRampA
+
+func (x *MsgRampA) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRampA)(x)
+}
+
+func (x *MsgRampA) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRampA_messageType fastReflection_MsgRampA_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRampA_messageType{}
+
+type fastReflection_MsgRampA_messageType struct{}
+
+func (x fastReflection_MsgRampA_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRampA)(nil)
+}
+func (x fastReflection_MsgRampA_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRampA)
+}
+func (x fastReflection_MsgRampA_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRampA
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRampA) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRampA
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRampA) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRampA_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRampA) New() protoreflect.Message {
+	return new(fastReflection_MsgRampA)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRampA) Interface() protoreflect.ProtoMessage {
+	return (*MsgRampA)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRampA) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgRampA_signer, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_MsgRampA_pool_id, value) {
+			return
+		}
+	}
+	if x.FutureA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureA)
+		if !f(fd_MsgRampA_future_a, value) {
+			return
+		}
+	}
+	if x.FutureATime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FutureATime)
+		if !f(fd_MsgRampA_future_a_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRampA) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		return x.FutureA != int64(0)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		return x.FutureATime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampA) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		x.FutureA = int64(0)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		x.FutureATime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRampA) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		value := x.FutureA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		value := x.FutureATime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampA) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		x.FutureA = value.Int()
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		x.FutureATime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampA) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgRampA is not mutable"))
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.MsgRampA is not mutable"))
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		panic(fmt.Errorf("field future_a of message noble.swap.stableswap.v1.MsgRampA is not mutable"))
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		panic(fmt.Errorf("field future_a_time of message noble.swap.stableswap.v1.MsgRampA is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRampA) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampA.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgRampA.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.stableswap.v1.MsgRampA.future_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.stableswap.v1.MsgRampA.future_a_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRampA) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgRampA", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRampA) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampA) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRampA) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRampA) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRampA)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.FutureA != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureA))
+		}
+		if x.FutureATime != 0 {
+			n += 1 + runtime.Sov(uint64(x.FutureATime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRampA)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FutureATime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureATime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FutureA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FutureA))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRampA)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRampA: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRampA: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+				}
+				x.FutureA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				}
+				x.FutureATime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FutureATime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRampAResponse           protoreflect.MessageDescriptor
+	fd_MsgRampAResponse_initial_a protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgRampAResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgRampAResponse")
+	fd_MsgRampAResponse_initial_a = md_MsgRampAResponse.Fields().ByName("initial_a")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRampAResponse)(nil)
+
+type fastReflection_MsgRampAResponse MsgRampAResponse
+
+func (x *MsgRampAResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRampAResponse)(x)
+}
+
+func (x *MsgRampAResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRampAResponse_messageType fastReflection_MsgRampAResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRampAResponse_messageType{}
+
+type fastReflection_MsgRampAResponse_messageType struct{}
+
+func (x fastReflection_MsgRampAResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRampAResponse)(nil)
+}
+func (x fastReflection_MsgRampAResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRampAResponse)
+}
+func (x fastReflection_MsgRampAResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRampAResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRampAResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRampAResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRampAResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRampAResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRampAResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRampAResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRampAResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRampAResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRampAResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InitialA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.InitialA)
+		if !f(fd_MsgRampAResponse_initial_a, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRampAResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		return x.InitialA != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampAResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		x.InitialA = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRampAResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		value := x.InitialA
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampAResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		x.InitialA = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampAResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		panic(fmt.Errorf("field initial_a of message noble.swap.stableswap.v1.MsgRampAResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRampAResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgRampAResponse.initial_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRampAResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgRampAResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRampAResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRampAResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRampAResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRampAResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRampAResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.InitialA != 0 {
+			n += 1 + runtime.Sov(uint64(x.InitialA))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRampAResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InitialA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InitialA))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRampAResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRampAResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRampAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+				}
+				x.InitialA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InitialA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgStopRampA         protoreflect.MessageDescriptor
+	fd_MsgStopRampA_signer  protoreflect.FieldDescriptor
+	fd_MsgStopRampA_pool_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgStopRampA = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgStopRampA")
+	fd_MsgStopRampA_signer = md_MsgStopRampA.Fields().ByName("signer")
+	fd_MsgStopRampA_pool_id = md_MsgStopRampA.Fields().ByName("pool_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgStopRampA)(nil)
+
+type fastReflection_MsgStopRampA MsgStopRampA
+
+func (x *MsgStopRampA) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgStopRampA)(x)
+}
+
+func (x *MsgStopRampA) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgStopRampA_messageType fastReflection_MsgStopRampA_messageType
+var _ protoreflect.MessageType = fastReflection_MsgStopRampA_messageType{}
+
+type fastReflection_MsgStopRampA_messageType struct{}
+
+func (x fastReflection_MsgStopRampA_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgStopRampA)(nil)
+}
+func (x fastReflection_MsgStopRampA_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgStopRampA)
+}
+func (x fastReflection_MsgStopRampA_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgStopRampA
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgStopRampA) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgStopRampA
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgStopRampA) Type() protoreflect.MessageType {
+	return _fastReflection_MsgStopRampA_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgStopRampA) New() protoreflect.Message {
+	return new(fastReflection_MsgStopRampA)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgStopRampA) Interface() protoreflect.ProtoMessage {
+	return (*MsgStopRampA)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgStopRampA) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgStopRampA_signer, value) {
+			return
+		}
+	}
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_MsgStopRampA_pool_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgStopRampA) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		return x.Signer != ""
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		return x.PoolId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampA) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		x.Signer = ""
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		x.PoolId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgStopRampA) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampA) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		x.Signer = value.Interface().(string)
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		x.PoolId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampA) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		panic(fmt.Errorf("field signer of message noble.swap.stableswap.v1.MsgStopRampA is not mutable"))
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.stableswap.v1.MsgStopRampA is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgStopRampA) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampA.signer":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.stableswap.v1.MsgStopRampA.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampA"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampA does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgStopRampA) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgStopRampA", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgStopRampA) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampA) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgStopRampA) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgStopRampA) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgStopRampA)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgStopRampA)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgStopRampA)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgStopRampA: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgStopRampA: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgStopRampAResponse           protoreflect.MessageDescriptor
+	fd_MsgStopRampAResponse_current_a protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_stableswap_v1_tx_proto_init()
+	md_MsgStopRampAResponse = File_noble_swap_stableswap_v1_tx_proto.Messages().ByName("MsgStopRampAResponse")
+	fd_MsgStopRampAResponse_current_a = md_MsgStopRampAResponse.Fields().ByName("current_a")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgStopRampAResponse)(nil)
+
+type fastReflection_MsgStopRampAResponse MsgStopRampAResponse
+
+func (x *MsgStopRampAResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgStopRampAResponse)(x)
+}
+
+func (x *MsgStopRampAResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgStopRampAResponse_messageType fastReflection_MsgStopRampAResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgStopRampAResponse_messageType{}
+
+type fastReflection_MsgStopRampAResponse_messageType struct{}
+
+func (x fastReflection_MsgStopRampAResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgStopRampAResponse)(nil)
+}
+func (x fastReflection_MsgStopRampAResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgStopRampAResponse)
+}
+func (x fastReflection_MsgStopRampAResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgStopRampAResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgStopRampAResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgStopRampAResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgStopRampAResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgStopRampAResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgStopRampAResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgStopRampAResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgStopRampAResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgStopRampAResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgStopRampAResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrentA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CurrentA)
+		if !f(fd_MsgStopRampAResponse_current_a, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgStopRampAResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		return x.CurrentA != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampAResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		x.CurrentA = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgStopRampAResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		value := x.CurrentA
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampAResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		x.CurrentA = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampAResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		panic(fmt.Errorf("field current_a of message noble.swap.stableswap.v1.MsgStopRampAResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgStopRampAResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.stableswap.v1.MsgStopRampAResponse.current_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.stableswap.v1.MsgStopRampAResponse"))
+		}
+		panic(fmt.Errorf("message noble.swap.stableswap.v1.MsgStopRampAResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgStopRampAResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.stableswap.v1.MsgStopRampAResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgStopRampAResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgStopRampAResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgStopRampAResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgStopRampAResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgStopRampAResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrentA != 0 {
+			n += 1 + runtime.Sov(uint64(x.CurrentA))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgStopRampAResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CurrentA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentA))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgStopRampAResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgStopRampAResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgStopRampAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentA", wireType)
+				}
+				x.CurrentA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CurrentA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/stableswap/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MsgCreatePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the authority creating the pool.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The asset pair that will be part of the pool.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The protocol fee percentage.
+	ProtocolFeePercentage int64 `protobuf:"varint,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
+	// The rewards fee value.
+	RewardsFee int64 `protobuf:"varint,4,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	// The initial A parameter for the pool.
+	InitialA int64 `protobuf:"varint,5,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// The future A parameter for pool rebalancing.
+	FutureA int64 `protobuf:"varint,6,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// The time to reach the future A parameter.
+	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// The coins rate multipliers.
+	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// The unbonding tiers of the pool, the default schedule is used when empty.
+	UnbondingTiers []*UnbondingTier `protobuf:"bytes,9,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers,omitempty"`
+	// The exit fee tiers of the pool for instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []*ExitFeeTier `protobuf:"bytes,10,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers,omitempty"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []*LockTier `protobuf:"bytes,11,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers,omitempty"`
+	// Recipients of the protocol fees.
+	FeeSplits []*FeeSplit `protobuf:"bytes,12,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits,omitempty"`
+	// Dynamic rewards fee model, disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,13,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,14,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+}
+
+func (x *MsgCreatePool) Reset() {
+	*x = MsgCreatePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreatePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreatePool) ProtoMessage() {}
+
+// Deprecated: Use MsgCreatePool.ProtoReflect.Descriptor instead.
+func (*MsgCreatePool) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreatePool) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgCreatePool) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *MsgCreatePool) GetProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.ProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *MsgCreatePool) GetRewardsFee() int64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *MsgCreatePool) GetInitialA() int64 {
+	if x != nil {
+		return x.InitialA
+	}
+	return 0
+}
+
+func (x *MsgCreatePool) GetFutureA() int64 {
+	if x != nil {
+		return x.FutureA
+	}
+	return 0
+}
+
+func (x *MsgCreatePool) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+func (x *MsgCreatePool) GetRateMultipliers() []*v1beta1.Coin {
+	if x != nil {
+		return x.RateMultipliers
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetUnbondingTiers() []*UnbondingTier {
+	if x != nil {
+		return x.UnbondingTiers
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetExitFeeTiers() []*ExitFeeTier {
+	if x != nil {
+		return x.ExitFeeTiers
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetLockTiers() []*LockTier {
+	if x != nil {
+		return x.LockTiers
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetFeeSplits() []*FeeSplit {
+	if x != nil {
+		return x.FeeSplits
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetDynamicFee() *DynamicFee {
+	if x != nil {
+		return x.DynamicFee
+	}
+	return nil
+}
+
+func (x *MsgCreatePool) GetDirectionalFee() *DirectionalFee {
+	if x != nil {
+		return x.DirectionalFee
+	}
+	return nil
+}
+
+type MsgCreatePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCreatePoolResponse) Reset() {
+	*x = MsgCreatePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreatePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreatePoolResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreatePoolResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgUpdatePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the authority updating the pool.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the pool being updated.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The new protocol fee percentage.
+	ProtocolFeePercentage int64 `protobuf:"varint,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
+	// The new rewards fee value.
+	RewardsFee int64 `protobuf:"varint,4,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
+	// The current initial A parameter, which can only be updated through MsgRampA.
+	InitialA int64 `protobuf:"varint,5,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// The current future A parameter, which can only be updated through MsgRampA.
+	FutureA int64 `protobuf:"varint,6,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// The current time to reach the future A parameter, which can only be updated through MsgRampA.
+	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// The coins rate multipliers.
+	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// The unbonding tiers of the pool, the default schedule is used when empty.
+	UnbondingTiers []*UnbondingTier `protobuf:"bytes,9,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers,omitempty"`
+	// The exit fee tiers of the pool for instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []*ExitFeeTier `protobuf:"bytes,10,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers,omitempty"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []*LockTier `protobuf:"bytes,11,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers,omitempty"`
+	// Recipients of the protocol fees.
+	FeeSplits []*FeeSplit `protobuf:"bytes,12,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits,omitempty"`
+	// Dynamic rewards fee model, disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,13,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,14,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+}
+
+func (x *MsgUpdatePool) Reset() {
+	*x = MsgUpdatePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdatePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdatePool) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdatePool.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePool) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdatePool) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgUpdatePool) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.ProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetRewardsFee() int64 {
+	if x != nil {
+		return x.RewardsFee
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetInitialA() int64 {
+	if x != nil {
+		return x.InitialA
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetFutureA() int64 {
+	if x != nil {
+		return x.FutureA
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetFutureATime() int64 {
+	if x != nil {
+		return x.FutureATime
+	}
+	return 0
+}
+
+func (x *MsgUpdatePool) GetRateMultipliers() []*v1beta1.Coin {
+	if x != nil {
+		return x.RateMultipliers
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetUnbondingTiers() []*UnbondingTier {
+	if x != nil {
+		return x.UnbondingTiers
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetExitFeeTiers() []*ExitFeeTier {
+	if x != nil {
+		return x.ExitFeeTiers
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetLockTiers() []*LockTier {
+	if x != nil {
+		return x.LockTiers
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetFeeSplits() []*FeeSplit {
+	if x != nil {
+		return x.FeeSplits
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetDynamicFee() *DynamicFee {
+	if x != nil {
+		return x.DynamicFee
+	}
+	return nil
+}
+
+func (x *MsgUpdatePool) GetDirectionalFee() *DirectionalFee {
+	if x != nil {
+		return x.DirectionalFee
+	}
+	return nil
+}
+
+type MsgUpdatePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdatePoolResponse) Reset() {
+	*x = MsgUpdatePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdatePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdatePoolResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdatePoolResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePoolResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+type MsgAddLiquidity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the user adding liquidity.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The ID of the pool to which liquidity is being added.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The coins amount to add to the pool.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Maximum acceptable slippage percentage.
+	SlippagePercentage int64 `protobuf:"varint,4,opt,name=slippage_percentage,json=slippagePercentage,proto3" json:"slippage_percentage,omitempty"`
+	// Optional duration for which the liquidity is locked, matching one of the pool lock tiers.
+	LockDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`
+}
+
+func (x *MsgAddLiquidity) Reset() {
+	*x = MsgAddLiquidity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddLiquidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddLiquidity) ProtoMessage() {}
+
+// Deprecated: Use MsgAddLiquidity.ProtoReflect.Descriptor instead.
+func (*MsgAddLiquidity) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgAddLiquidity) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgAddLiquidity) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *MsgAddLiquidity) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *MsgAddLiquidity) GetSlippagePercentage() int64 {
+	if x != nil {
+		return x.SlippagePercentage
+	}
+	return 0
+}
+
+func (x *MsgAddLiquidity) GetLockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockDuration
+	}
+	return nil
+}
+
+type MsgAddLiquidityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of liquidity pool shares minted for the user.
+	MintedShares int64 `protobuf:"varint,1,opt,name=minted_shares,json=mintedShares,proto3" json:"minted_shares,omitempty"`
+}
+
+func (x *MsgAddLiquidityResponse) Reset() {
+	*x = MsgAddLiquidityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddLiquidityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddLiquidityResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAddLiquidityResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddLiquidityResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgAddLiquidityResponse) GetMintedShares() int64 {
+	if x != nil {
+		return x.MintedShares
+	}
+	return 0
+}
+
+type MsgRemoveLiquidity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the user removing liquidity.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The ID of the pool from which liquidity is being removed.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The percentage of liquidity to remove.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Whether to skip the unbonding period by paying the pool exit fee.
+	Instant bool `protobuf:"varint,4,opt,name=instant,proto3" json:"instant,omitempty"`
+}
+
+func (x *MsgRemoveLiquidity) Reset() {
+	*x = MsgRemoveLiquidity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRemoveLiquidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveLiquidity) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveLiquidity.ProtoReflect.Descriptor instead.
+func (*MsgRemoveLiquidity) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgRemoveLiquidity) GetSigner() string {
 	if x != nil {
-		return x.FutureA
+		return x.Signer
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgCreatePool) GetFutureATime() int64 {
+func (x *MsgRemoveLiquidity) GetPoolId() uint64 {
 	if x != nil {
-		return x.FutureATime
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MsgCreatePool) GetRateMultipliers() []*v1beta1.Coin {
+func (x *MsgRemoveLiquidity) GetPercentage() string {
 	if x != nil {
-		return x.RateMultipliers
+		return x.Percentage
 	}
-	return nil
+	return ""
 }
 
-type MsgCreatePoolResponse struct {
+func (x *MsgRemoveLiquidity) GetInstant() bool {
+	if x != nil {
+		return x.Instant
+	}
+	return false
+}
+
+type MsgRemoveLiquidityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The amount of shares that are unbonding.
+	UnbondingShares string `protobuf:"bytes,1,opt,name=unbonding_shares,json=unbondingShares,proto3" json:"unbonding_shares,omitempty"`
+	// The exit fee paid for an instant liquidity removal.
+	ExitFee []*v1beta1.Coin `protobuf:"bytes,2,rep,name=exit_fee,json=exitFee,proto3" json:"exit_fee,omitempty"`
 }
 
-func (x *MsgCreatePoolResponse) Reset() {
-	*x = MsgCreatePoolResponse{}
+func (x *MsgRemoveLiquidityResponse) Reset() {
+	*x = MsgRemoveLiquidityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[1]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreatePoolResponse) String() string {
+func (x *MsgRemoveLiquidityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreatePoolResponse) ProtoMessage() {}
+func (*MsgRemoveLiquidityResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreatePoolResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRemoveLiquidityResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveLiquidityResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
-type MsgUpdatePool struct {
+func (x *MsgRemoveLiquidityResponse) GetUnbondingShares() string {
+	if x != nil {
+		return x.UnbondingShares
+	}
+	return ""
+}
+
+func (x *MsgRemoveLiquidityResponse) GetExitFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.ExitFee
+	}
+	return nil
+}
+
+type MsgTransferPosition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Address of the authority updating the pool.
+	// The address of the user transferring the bonded position.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// ID of the pool being updated.
+	// The ID of the pool of the bonded position.
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// The new protocol fee percentage.
-	ProtocolFeePercentage int64 `protobuf:"varint,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
-	// The new rewards fee value.
-	RewardsFee int64 `protobuf:"varint,4,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// The new initial A parameter.
-	InitialA int64 `protobuf:"varint,5,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
-	// The new future A parameter.
-	FutureA int64 `protobuf:"varint,6,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// The time to reach the future A parameter.
-	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
-	// The coins rate multipliers.
-	RateMultipliers []*v1beta1.Coin `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3" json:"rate_multipliers,omitempty"`
+	// The address receiving the bonded position.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// The percentage of the available bonded shares to transfer.
+	Percentage string `protobuf:"bytes,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
 }
 
-func (x *MsgUpdatePool) Reset() {
-	*x = MsgUpdatePool{}
+func (x *MsgTransferPosition) Reset() {
+	*x = MsgTransferPosition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[2]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdatePool) String() string {
+func (x *MsgTransferPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdatePool) ProtoMessage() {}
+func (*MsgTransferPosition) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdatePool.ProtoReflect.Descriptor instead.
-func (*MsgUpdatePool) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgTransferPosition.ProtoReflect.Descriptor instead.
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MsgUpdatePool) GetSigner() string {
+func (x *MsgTransferPosition) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgUpdatePool) GetPoolId() uint64 {
+func (x *MsgTransferPosition) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MsgUpdatePool) GetProtocolFeePercentage() int64 {
+func (x *MsgTransferPosition) GetReceiver() string {
 	if x != nil {
-		return x.ProtocolFeePercentage
+		return x.Receiver
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgUpdatePool) GetRewardsFee() int64 {
+func (x *MsgTransferPosition) GetPercentage() string {
 	if x != nil {
-		return x.RewardsFee
+		return x.Percentage
+	}
+	return ""
+}
+
+type MsgTransferPositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of shares transferred to the receiver.
+	TransferredShares string `protobuf:"bytes,1,opt,name=transferred_shares,json=transferredShares,proto3" json:"transferred_shares,omitempty"`
+}
+
+func (x *MsgTransferPositionResponse) Reset() {
+	*x = MsgTransferPositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferPositionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferPositionResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgTransferPositionResponse) GetTransferredShares() string {
+	if x != nil {
+		return x.TransferredShares
+	}
+	return ""
+}
+
+type MsgSetAutoCompound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the liquidity provider.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The ID of the pool to compound the rewards into.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Whether the rewards should be compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *MsgSetAutoCompound) Reset() {
+	*x = MsgSetAutoCompound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetAutoCompound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetAutoCompound) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAutoCompound.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgSetAutoCompound) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetAutoCompound) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MsgUpdatePool) GetInitialA() int64 {
+func (x *MsgSetAutoCompound) GetEnabled() bool {
 	if x != nil {
-		return x.InitialA
+		return x.Enabled
+	}
+	return false
+}
+
+type MsgSetAutoCompoundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetAutoCompoundResponse) Reset() {
+	*x = MsgSetAutoCompoundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetAutoCompoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetAutoCompoundResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAutoCompoundResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgScheduleUpdatePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the account scheduling the update.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Timestamp from which the update is executed.
+	ExecutionTime int64 `protobuf:"varint,2,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Pool update to execute, whose signer is ignored and replaced by the scheduling signer.
+	Update *MsgUpdatePool `protobuf:"bytes,3,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (x *MsgScheduleUpdatePool) Reset() {
+	*x = MsgScheduleUpdatePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgScheduleUpdatePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgScheduleUpdatePool) ProtoMessage() {}
+
+// Deprecated: Use MsgScheduleUpdatePool.ProtoReflect.Descriptor instead.
+func (*MsgScheduleUpdatePool) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgScheduleUpdatePool) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgScheduleUpdatePool) GetExecutionTime() int64 {
+	if x != nil {
+		return x.ExecutionTime
 	}
 	return 0
 }
 
-func (x *MsgUpdatePool) GetFutureA() int64 {
+func (x *MsgScheduleUpdatePool) GetUpdate() *MsgUpdatePool {
 	if x != nil {
-		return x.FutureA
+		return x.Update
+	}
+	return nil
+}
+
+type MsgScheduleUpdatePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgScheduleUpdatePoolResponse) Reset() {
+	*x = MsgScheduleUpdatePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgScheduleUpdatePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgScheduleUpdatePoolResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgScheduleUpdatePoolResponse.ProtoReflect.Descriptor instead.
+func (*MsgScheduleUpdatePoolResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgScheduleUpdatePoolResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MsgUpdatePool) GetFutureATime() int64 {
+type MsgCancelPoolUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address of the account cancelling the update.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the scheduled update to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgCancelPoolUpdate) Reset() {
+	*x = MsgCancelPoolUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelPoolUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelPoolUpdate) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelPoolUpdate.ProtoReflect.Descriptor instead.
+func (*MsgCancelPoolUpdate) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgCancelPoolUpdate) GetSigner() string {
 	if x != nil {
-		return x.FutureATime
+		return x.Signer
 	}
-	return 0
+	return ""
 }
 
-func (x *MsgUpdatePool) GetRateMultipliers() []*v1beta1.Coin {
+func (x *MsgCancelPoolUpdate) GetId() uint64 {
 	if x != nil {
-		return x.RateMultipliers
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type MsgUpdatePoolResponse struct {
+type MsgCancelPoolUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdatePoolResponse) Reset() {
-	*x = MsgUpdatePoolResponse{}
+func (x *MsgCancelPoolUpdateResponse) Reset() {
+	*x = MsgCancelPoolUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[3]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdatePoolResponse) String() string {
+func (x *MsgCancelPoolUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdatePoolResponse) ProtoMessage() {}
+func (*MsgCancelPoolUpdateResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdatePoolResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdatePoolResponse) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgCancelPoolUpdateResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelPoolUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
-type MsgAddLiquidity struct {
+type MsgRampA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address of the user adding liquidity.
+	// Address of the account starting the ramp.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// The ID of the pool to which liquidity is being added.
+	// ID of the pool being ramped.
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// The coins amount to add to the pool.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
-	// Maximum acceptable slippage percentage.
-	SlippagePercentage int64 `protobuf:"varint,4,opt,name=slippage_percentage,json=slippagePercentage,proto3" json:"slippage_percentage,omitempty"`
+	// Amplification coefficient reached at the end of the ramp.
+	FutureA int64 `protobuf:"varint,3,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Timestamp at which the ramp ends.
+	FutureATime int64 `protobuf:"varint,4,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 }
 
-func (x *MsgAddLiquidity) Reset() {
-	*x = MsgAddLiquidity{}
+func (x *MsgRampA) Reset() {
+	*x = MsgRampA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[4]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddLiquidity) String() string {
+func (x *MsgRampA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddLiquidity) ProtoMessage() {}
+func (*MsgRampA) ProtoMessage() {}
 
-// Deprecated: Use MsgAddLiquidity.ProtoReflect.Descriptor instead.
-func (*MsgAddLiquidity) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgRampA.ProtoReflect.Descriptor instead.
+func (*MsgRampA) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgAddLiquidity) GetSigner() string {
+func (x *MsgRampA) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgAddLiquidity) GetPoolId() uint64 {
+func (x *MsgRampA) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MsgAddLiquidity) GetAmount() []*v1beta1.Coin {
+func (x *MsgRampA) GetFutureA() int64 {
 	if x != nil {
-		return x.Amount
+		return x.FutureA
 	}
-	return nil
+	return 0
 }
 
-func (x *MsgAddLiquidity) GetSlippagePercentage() int64 {
+func (x *MsgRampA) GetFutureATime() int64 {
 	if x != nil {
-		return x.SlippagePercentage
+		return x.FutureATime
 	}
 	return 0
 }
 
-type MsgAddLiquidityResponse struct {
+type MsgRampAResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The amount of liquidity pool shares minted for the user.
-	MintedShares int64 `protobuf:"varint,1,opt,name=minted_shares,json=mintedShares,proto3" json:"minted_shares,omitempty"`
+	// Amplification coefficient from which the ramp starts.
+	InitialA int64 `protobuf:"varint,1,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
 }
 
-func (x *MsgAddLiquidityResponse) Reset() {
-	*x = MsgAddLiquidityResponse{}
+func (x *MsgRampAResponse) Reset() {
+	*x = MsgRampAResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[5]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddLiquidityResponse) String() string {
+func (x *MsgRampAResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddLiquidityResponse) ProtoMessage() {}
+func (*MsgRampAResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgAddLiquidityResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddLiquidityResponse) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgRampAResponse.ProtoReflect.Descriptor instead.
+func (*MsgRampAResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgAddLiquidityResponse) GetMintedShares() int64 {
+func (x *MsgRampAResponse) GetInitialA() int64 {
 	if x != nil {
-		return x.MintedShares
+		return x.InitialA
 	}
 	return 0
 }
 
-type MsgRemoveLiquidity struct {
+type MsgStopRampA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The address of the user removing liquidity.
+	// Address of the account stopping the ramp.
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// The ID of the pool from which liquidity is being removed.
+	// ID of the pool whose ramp is stopped.
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// The percentage of liquidity to remove.
-	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
 }
 
-func (x *MsgRemoveLiquidity) Reset() {
-	*x = MsgRemoveLiquidity{}
+func (x *MsgStopRampA) Reset() {
+	*x = MsgStopRampA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[6]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveLiquidity) String() string {
+func (x *MsgStopRampA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveLiquidity) ProtoMessage() {}
+func (*MsgStopRampA) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveLiquidity.ProtoReflect.Descriptor instead.
-func (*MsgRemoveLiquidity) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgStopRampA.ProtoReflect.Descriptor instead.
+func (*MsgStopRampA) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgRemoveLiquidity) GetSigner() string {
+func (x *MsgStopRampA) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgRemoveLiquidity) GetPoolId() uint64 {
+func (x *MsgStopRampA) GetPoolId() uint64 {
 	if x != nil {
 		return x.PoolId
 	}
 	return 0
 }
 
-func (x *MsgRemoveLiquidity) GetPercentage() string {
-	if x != nil {
-		return x.Percentage
-	}
-	return ""
-}
-
-type MsgRemoveLiquidityResponse struct {
+type MsgStopRampAResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The amount of shares that are unbonding.
-	UnbondingShares string `protobuf:"bytes,1,opt,name=unbonding_shares,json=unbondingShares,proto3" json:"unbonding_shares,omitempty"`
+	// Amplification coefficient at which the pool has been frozen.
+	CurrentA int64 `protobuf:"varint,1,opt,name=current_a,json=currentA,proto3" json:"current_a,omitempty"`
 }
 
-func (x *MsgRemoveLiquidityResponse) Reset() {
-	*x = MsgRemoveLiquidityResponse{}
+func (x *MsgStopRampAResponse) Reset() {
+	*x = MsgStopRampAResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[7]
+		mi := &file_noble_swap_stableswap_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveLiquidityResponse) String() string {
+func (x *MsgStopRampAResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveLiquidityResponse) ProtoMessage() {}
+func (*MsgStopRampAResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveLiquidityResponse.ProtoReflect.Descriptor instead.
-func (*MsgRemoveLiquidityResponse) Descriptor() ([]byte, []int) {
-	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgStopRampAResponse.ProtoReflect.Descriptor instead.
+func (*MsgStopRampAResponse) Descriptor() ([]byte, []int) {
+	return file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgRemoveLiquidityResponse) GetUnbondingShares() string {
+func (x *MsgStopRampAResponse) GetCurrentA() int64 {
 	if x != nil {
-		return x.UnbondingShares
+		return x.CurrentA
 	}
-	return ""
+	return 0
 }
 
 var File_noble_swap_stableswap_v1_tx_proto protoreflect.FileDescriptor
@@ -4913,38 +12769,73 @@ var file_noble_swap_stableswap_v1_tx_proto_rawDesc = []byte{
 	0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x03, 0x0a, 0x0d, 0x4d,
-	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x46, 0x65, 0x65,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
-	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
-	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xb8, 0x07, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x8c, 0x01, 0x0a,
+	0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74,
+	0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x4c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4c,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x52, 0x0a, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
 	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a,
 	0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73,
 	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x6e, 0x73, 0x65, 0x22, 0xbd, 0x07, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
@@ -4970,51 +12861,88 @@ var file_noble_swap_stableswap_v1_tx_proto_rawDesc = []byte{
 	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
 	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
 	0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x73, 0x3a, 0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7,
-	0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x73, 0x77,
-	0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xbe, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
-	0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f,
-	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0,
-	0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73,
-	0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61,
-	0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x34, 0x88, 0xa0,
-	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x22, 0x3e, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f,
-	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x37, 0x88, 0xa0,
-	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x7f, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x69, 0x65, 0x72, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0e, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x56, 0x0a, 0x0e, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x69,
+	0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x65,
+	0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65,
+	0x52, 0x0a, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x0f,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x52,
+	0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x3a,
+	0x32, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x88, 0x03, 0x0a,
+	0x0f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x79, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98,
+	0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x34, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x41, 0x64, 0x64, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x3e, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x30,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x56, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x3a, 0x37, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x22, 0xfd, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
 	0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
 	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
@@ -5022,50 +12950,198 @@ var file_noble_swap_stableswap_v1_tx_proto_rawDesc = []byte{
 	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
 	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
 	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x32, 0xc1, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x66,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x2e, 0x6e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x65, 0x78, 0x69,
+	0x74, 0x46, 0x65, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x56, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73,
+	0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x84, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x3a, 0x37, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x73, 0x77, 0x61, 0x70,
+	0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x15, 0x4d, 0x73,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x06,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e,
 	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x2f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x22, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x2f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x3a,
+	0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50,
+	0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x08, 0x4d, 0x73, 0x67,
+	0x52, 0x61, 0x6d, 0x70, 0x41, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x12, 0x22, 0x0a, 0x0d, 0x66,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x3a,
+	0x2d, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x15, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x22, 0x2f,
+	0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x22,
+	0x8c, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70, 0x41,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x3a, 0x31, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2f, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x22, 0x33,
+	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x41, 0x32, 0xea, 0x08, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x66, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x1a, 0x2f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x2f, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0c, 0x41,
+	0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x29, 0x2e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x1a, 0x34, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
 	0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x2f, 0x2e,
+	0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c, 0x2e,
 	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
-	0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x29,
-	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
-	0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x34, 0x2e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7e, 0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x37, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x78, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x1a, 0x35, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x05, 0x52,
+	0x61, 0x6d, 0x70, 0x41, 0x12, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x1a, 0x2a, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70,
+	0x41, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70, 0x41, 0x1a, 0x2e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
 	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0f,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
-	0x2c, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x1a, 0x34, 0x2e,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xe4, 0x01, 0x0a, 0x1c, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
-	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
-	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53,
-	0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e,
-	0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61,
-	0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6d, 0x70,
+	0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
+	0x42, 0xe4, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x53, 0xaa, 0x02, 0x18, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c,
+	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4e, 0x6f, 0x62, 0x6c,
+	0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5080,35 +13156,81 @@ func file_noble_swap_stableswap_v1_tx_proto_rawDescGZIP() []byte {
 	return file_noble_swap_stableswap_v1_tx_proto_rawDescData
 }
 
-var file_noble_swap_stableswap_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_noble_swap_stableswap_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_noble_swap_stableswap_v1_tx_proto_goTypes = []interface{}{
-	(*MsgCreatePool)(nil),              // 0: noble.swap.stableswap.v1.MsgCreatePool
-	(*MsgCreatePoolResponse)(nil),      // 1: noble.swap.stableswap.v1.MsgCreatePoolResponse
-	(*MsgUpdatePool)(nil),              // 2: noble.swap.stableswap.v1.MsgUpdatePool
-	(*MsgUpdatePoolResponse)(nil),      // 3: noble.swap.stableswap.v1.MsgUpdatePoolResponse
-	(*MsgAddLiquidity)(nil),            // 4: noble.swap.stableswap.v1.MsgAddLiquidity
-	(*MsgAddLiquidityResponse)(nil),    // 5: noble.swap.stableswap.v1.MsgAddLiquidityResponse
-	(*MsgRemoveLiquidity)(nil),         // 6: noble.swap.stableswap.v1.MsgRemoveLiquidity
-	(*MsgRemoveLiquidityResponse)(nil), // 7: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse
-	(*v1beta1.Coin)(nil),               // 8: cosmos.base.v1beta1.Coin
+	(*MsgCreatePool)(nil),                 // 0: noble.swap.stableswap.v1.MsgCreatePool
+	(*MsgCreatePoolResponse)(nil),         // 1: noble.swap.stableswap.v1.MsgCreatePoolResponse
+	(*MsgUpdatePool)(nil),                 // 2: noble.swap.stableswap.v1.MsgUpdatePool
+	(*MsgUpdatePoolResponse)(nil),         // 3: noble.swap.stableswap.v1.MsgUpdatePoolResponse
+	(*MsgAddLiquidity)(nil),               // 4: noble.swap.stableswap.v1.MsgAddLiquidity
+	(*MsgAddLiquidityResponse)(nil),       // 5: noble.swap.stableswap.v1.MsgAddLiquidityResponse
+	(*MsgRemoveLiquidity)(nil),            // 6: noble.swap.stableswap.v1.MsgRemoveLiquidity
+	(*MsgRemoveLiquidityResponse)(nil),    // 7: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse
+	(*MsgTransferPosition)(nil),           // 8: noble.swap.stableswap.v1.MsgTransferPosition
+	(*MsgTransferPositionResponse)(nil),   // 9: noble.swap.stableswap.v1.MsgTransferPositionResponse
+	(*MsgSetAutoCompound)(nil),            // 10: noble.swap.stableswap.v1.MsgSetAutoCompound
+	(*MsgSetAutoCompoundResponse)(nil),    // 11: noble.swap.stableswap.v1.MsgSetAutoCompoundResponse
+	(*MsgScheduleUpdatePool)(nil),         // 12: noble.swap.stableswap.v1.MsgScheduleUpdatePool
+	(*MsgScheduleUpdatePoolResponse)(nil), // 13: noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse
+	(*MsgCancelPoolUpdate)(nil),           // 14: noble.swap.stableswap.v1.MsgCancelPoolUpdate
+	(*MsgCancelPoolUpdateResponse)(nil),   // 15: noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse
+	(*MsgRampA)(nil),                      // 16: noble.swap.stableswap.v1.MsgRampA
+	(*MsgRampAResponse)(nil),              // 17: noble.swap.stableswap.v1.MsgRampAResponse
+	(*MsgStopRampA)(nil),                  // 18: noble.swap.stableswap.v1.MsgStopRampA
+	(*MsgStopRampAResponse)(nil),          // 19: noble.swap.stableswap.v1.MsgStopRampAResponse
+	(*v1beta1.Coin)(nil),                  // 20: cosmos.base.v1beta1.Coin
+	(*UnbondingTier)(nil),                 // 21: noble.swap.stableswap.v1.UnbondingTier
+	(*ExitFeeTier)(nil),                   // 22: noble.swap.stableswap.v1.ExitFeeTier
+	(*LockTier)(nil),                      // 23: noble.swap.stableswap.v1.LockTier
+	(*FeeSplit)(nil),                      // 24: noble.swap.stableswap.v1.FeeSplit
+	(*DynamicFee)(nil),                    // 25: noble.swap.stableswap.v1.DynamicFee
+	(*DirectionalFee)(nil),                // 26: noble.swap.stableswap.v1.DirectionalFee
+	(*durationpb.Duration)(nil),           // 27: google.protobuf.Duration
 }
 var file_noble_swap_stableswap_v1_tx_proto_depIdxs = []int32{
-	8, // 0: noble.swap.stableswap.v1.MsgCreatePool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
-	8, // 1: noble.swap.stableswap.v1.MsgUpdatePool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
-	8, // 2: noble.swap.stableswap.v1.MsgAddLiquidity.amount:type_name -> cosmos.base.v1beta1.Coin
-	0, // 3: noble.swap.stableswap.v1.Msg.CreatePool:input_type -> noble.swap.stableswap.v1.MsgCreatePool
-	2, // 4: noble.swap.stableswap.v1.Msg.UpdatePool:input_type -> noble.swap.stableswap.v1.MsgUpdatePool
-	4, // 5: noble.swap.stableswap.v1.Msg.AddLiquidity:input_type -> noble.swap.stableswap.v1.MsgAddLiquidity
-	6, // 6: noble.swap.stableswap.v1.Msg.RemoveLiquidity:input_type -> noble.swap.stableswap.v1.MsgRemoveLiquidity
-	1, // 7: noble.swap.stableswap.v1.Msg.CreatePool:output_type -> noble.swap.stableswap.v1.MsgCreatePoolResponse
-	3, // 8: noble.swap.stableswap.v1.Msg.UpdatePool:output_type -> noble.swap.stableswap.v1.MsgUpdatePoolResponse
-	5, // 9: noble.swap.stableswap.v1.Msg.AddLiquidity:output_type -> noble.swap.stableswap.v1.MsgAddLiquidityResponse
-	7, // 10: noble.swap.stableswap.v1.Msg.RemoveLiquidity:output_type -> noble.swap.stableswap.v1.MsgRemoveLiquidityResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	20, // 0: noble.swap.stableswap.v1.MsgCreatePool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
+	21, // 1: noble.swap.stableswap.v1.MsgCreatePool.unbonding_tiers:type_name -> noble.swap.stableswap.v1.UnbondingTier
+	22, // 2: noble.swap.stableswap.v1.MsgCreatePool.exit_fee_tiers:type_name -> noble.swap.stableswap.v1.ExitFeeTier
+	23, // 3: noble.swap.stableswap.v1.MsgCreatePool.lock_tiers:type_name -> noble.swap.stableswap.v1.LockTier
+	24, // 4: noble.swap.stableswap.v1.MsgCreatePool.fee_splits:type_name -> noble.swap.stableswap.v1.FeeSplit
+	25, // 5: noble.swap.stableswap.v1.MsgCreatePool.dynamic_fee:type_name -> noble.swap.stableswap.v1.DynamicFee
+	26, // 6: noble.swap.stableswap.v1.MsgCreatePool.directional_fee:type_name -> noble.swap.stableswap.v1.DirectionalFee
+	20, // 7: noble.swap.stableswap.v1.MsgUpdatePool.rate_multipliers:type_name -> cosmos.base.v1beta1.Coin
+	21, // 8: noble.swap.stableswap.v1.MsgUpdatePool.unbonding_tiers:type_name -> noble.swap.stableswap.v1.UnbondingTier
+	22, // 9: noble.swap.stableswap.v1.MsgUpdatePool.exit_fee_tiers:type_name -> noble.swap.stableswap.v1.ExitFeeTier
+	23, // 10: noble.swap.stableswap.v1.MsgUpdatePool.lock_tiers:type_name -> noble.swap.stableswap.v1.LockTier
+	24, // 11: noble.swap.stableswap.v1.MsgUpdatePool.fee_splits:type_name -> noble.swap.stableswap.v1.FeeSplit
+	25, // 12: noble.swap.stableswap.v1.MsgUpdatePool.dynamic_fee:type_name -> noble.swap.stableswap.v1.DynamicFee
+	26, // 13: noble.swap.stableswap.v1.MsgUpdatePool.directional_fee:type_name -> noble.swap.stableswap.v1.DirectionalFee
+	20, // 14: noble.swap.stableswap.v1.MsgAddLiquidity.amount:type_name -> cosmos.base.v1beta1.Coin
+	27, // 15: noble.swap.stableswap.v1.MsgAddLiquidity.lock_duration:type_name -> google.protobuf.Duration
+	20, // 16: noble.swap.stableswap.v1.MsgRemoveLiquidityResponse.exit_fee:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 17: noble.swap.stableswap.v1.MsgScheduleUpdatePool.update:type_name -> noble.swap.stableswap.v1.MsgUpdatePool
+	0,  // 18: noble.swap.stableswap.v1.Msg.CreatePool:input_type -> noble.swap.stableswap.v1.MsgCreatePool
+	2,  // 19: noble.swap.stableswap.v1.Msg.UpdatePool:input_type -> noble.swap.stableswap.v1.MsgUpdatePool
+	4,  // 20: noble.swap.stableswap.v1.Msg.AddLiquidity:input_type -> noble.swap.stableswap.v1.MsgAddLiquidity
+	6,  // 21: noble.swap.stableswap.v1.Msg.RemoveLiquidity:input_type -> noble.swap.stableswap.v1.MsgRemoveLiquidity
+	8,  // 22: noble.swap.stableswap.v1.Msg.TransferPosition:input_type -> noble.swap.stableswap.v1.MsgTransferPosition
+	10, // 23: noble.swap.stableswap.v1.Msg.SetAutoCompound:input_type -> noble.swap.stableswap.v1.MsgSetAutoCompound
+	12, // 24: noble.swap.stableswap.v1.Msg.ScheduleUpdatePool:input_type -> noble.swap.stableswap.v1.MsgScheduleUpdatePool
+	14, // 25: noble.swap.stableswap.v1.Msg.CancelPoolUpdate:input_type -> noble.swap.stableswap.v1.MsgCancelPoolUpdate
+	16, // 26: noble.swap.stableswap.v1.Msg.RampA:input_type -> noble.swap.stableswap.v1.MsgRampA
+	18, // 27: noble.swap.stableswap.v1.Msg.StopRampA:input_type -> noble.swap.stableswap.v1.MsgStopRampA
+	1,  // 28: noble.swap.stableswap.v1.Msg.CreatePool:output_type -> noble.swap.stableswap.v1.MsgCreatePoolResponse
+	3,  // 29: noble.swap.stableswap.v1.Msg.UpdatePool:output_type -> noble.swap.stableswap.v1.MsgUpdatePoolResponse
+	5,  // 30: noble.swap.stableswap.v1.Msg.AddLiquidity:output_type -> noble.swap.stableswap.v1.MsgAddLiquidityResponse
+	7,  // 31: noble.swap.stableswap.v1.Msg.RemoveLiquidity:output_type -> noble.swap.stableswap.v1.MsgRemoveLiquidityResponse
+	9,  // 32: noble.swap.stableswap.v1.Msg.TransferPosition:output_type -> noble.swap.stableswap.v1.MsgTransferPositionResponse
+	11, // 33: noble.swap.stableswap.v1.Msg.SetAutoCompound:output_type -> noble.swap.stableswap.v1.MsgSetAutoCompoundResponse
+	13, // 34: noble.swap.stableswap.v1.Msg.ScheduleUpdatePool:output_type -> noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse
+	15, // 35: noble.swap.stableswap.v1.Msg.CancelPoolUpdate:output_type -> noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse
+	17, // 36: noble.swap.stableswap.v1.Msg.RampA:output_type -> noble.swap.stableswap.v1.MsgRampAResponse
+	19, // 37: noble.swap.stableswap.v1.Msg.StopRampA:output_type -> noble.swap.stableswap.v1.MsgStopRampAResponse
+	28, // [28:38] is the sub-list for method output_type
+	18, // [18:28] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_stableswap_v1_tx_proto_init() }
@@ -5116,6 +13238,7 @@ func file_noble_swap_stableswap_v1_tx_proto_init() {
 	if File_noble_swap_stableswap_v1_tx_proto != nil {
 		return
 	}
+	file_noble_swap_stableswap_v1_pool_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_swap_stableswap_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgCreatePool); i {
@@ -5213,6 +13336,150 @@ func file_noble_swap_stableswap_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferPositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoCompound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoCompoundResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleUpdatePool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgScheduleUpdatePoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelPoolUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelPoolUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRampA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRampAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgStopRampA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_stableswap_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgStopRampAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5220,7 +13487,7 @@ func file_noble_swap_stableswap_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_swap_stableswap_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreatePool_FullMethodName      = "/noble.swap.stableswap.v1.Msg/CreatePool"
-	Msg_UpdatePool_FullMethodName      = "/noble.swap.stableswap.v1.Msg/UpdatePool"
-	Msg_AddLiquidity_FullMethodName    = "/noble.swap.stableswap.v1.Msg/AddLiquidity"
-	Msg_RemoveLiquidity_FullMethodName = "/noble.swap.stableswap.v1.Msg/RemoveLiquidity"
+	Msg_CreatePool_FullMethodName         = "/noble.swap.stableswap.v1.Msg/CreatePool"
+	Msg_UpdatePool_FullMethodName         = "/noble.swap.stableswap.v1.Msg/UpdatePool"
+	Msg_AddLiquidity_FullMethodName       = "/noble.swap.stableswap.v1.Msg/AddLiquidity"
+	Msg_RemoveLiquidity_FullMethodName    = "/noble.swap.stableswap.v1.Msg/RemoveLiquidity"
+	Msg_TransferPosition_FullMethodName   = "/noble.swap.stableswap.v1.Msg/TransferPosition"
+	Msg_SetAutoCompound_FullMethodName    = "/noble.swap.stableswap.v1.Msg/SetAutoCompound"
+	Msg_ScheduleUpdatePool_FullMethodName = "/noble.swap.stableswap.v1.Msg/ScheduleUpdatePool"
+	Msg_CancelPoolUpdate_FullMethodName   = "/noble.swap.stableswap.v1.Msg/CancelPoolUpdate"
+	Msg_RampA_FullMethodName              = "/noble.swap.stableswap.v1.Msg/RampA"
+	Msg_StopRampA_FullMethodName          = "/noble.swap.stableswap.v1.Msg/StopRampA"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +43,18 @@ type MsgClient interface {
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	// TransferPosition allows a user to transfer bonded positions of a `StableSwap` liquidity pool to another address.
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+	// SetAutoCompound enables or disables the auto-compounding of the user rewards into bonded shares.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleUpdatePool schedules an update of the params of a `StableSwap` Pool, executed at a future time.
+	ScheduleUpdatePool(ctx context.Context, in *MsgScheduleUpdatePool, opts ...grpc.CallOption) (*MsgScheduleUpdatePoolResponse, error)
+	// CancelPoolUpdate cancels a pending scheduled update of a `StableSwap` Pool.
+	CancelPoolUpdate(ctx context.Context, in *MsgCancelPoolUpdate, opts ...grpc.CallOption) (*MsgCancelPoolUpdateResponse, error)
+	// RampA starts a bounded ramp of the amplification coefficient of a `StableSwap` Pool from its current value.
+	RampA(ctx context.Context, in *MsgRampA, opts ...grpc.CallOption) (*MsgRampAResponse, error)
+	// StopRampA stops the ongoing amplification coefficient ramp of a `StableSwap` Pool, freezing its current value.
+	StopRampA(ctx context.Context, in *MsgStopRampA, opts ...grpc.CallOption) (*MsgStopRampAResponse, error)
 }
 
 type msgClient struct {
@@ -87,6 +105,66 @@ func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity,
 	return out, nil
 }
 
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleUpdatePool(ctx context.Context, in *MsgScheduleUpdatePool, opts ...grpc.CallOption) (*MsgScheduleUpdatePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleUpdatePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleUpdatePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPoolUpdate(ctx context.Context, in *MsgCancelPoolUpdate, opts ...grpc.CallOption) (*MsgCancelPoolUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelPoolUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPoolUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RampA(ctx context.Context, in *MsgRampA, opts ...grpc.CallOption) (*MsgRampAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRampAResponse)
+	err := c.cc.Invoke(ctx, Msg_RampA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StopRampA(ctx context.Context, in *MsgStopRampA, opts ...grpc.CallOption) (*MsgStopRampAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStopRampAResponse)
+	err := c.cc.Invoke(ctx, Msg_StopRampA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -99,6 +177,18 @@ type MsgServer interface {
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	// TransferPosition allows a user to transfer bonded positions of a `StableSwap` liquidity pool to another address.
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
+	// SetAutoCompound enables or disables the auto-compounding of the user rewards into bonded shares.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleUpdatePool schedules an update of the params of a `StableSwap` Pool, executed at a future time.
+	ScheduleUpdatePool(context.Context, *MsgScheduleUpdatePool) (*MsgScheduleUpdatePoolResponse, error)
+	// CancelPoolUpdate cancels a pending scheduled update of a `StableSwap` Pool.
+	CancelPoolUpdate(context.Context, *MsgCancelPoolUpdate) (*MsgCancelPoolUpdateResponse, error)
+	// RampA starts a bounded ramp of the amplification coefficient of a `StableSwap` Pool from its current value.
+	RampA(context.Context, *MsgRampA) (*MsgRampAResponse, error)
+	// StopRampA stops the ongoing amplification coefficient ramp of a `StableSwap` Pool, freezing its current value.
+	StopRampA(context.Context, *MsgStopRampA) (*MsgStopRampAResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -121,6 +211,24 @@ func (UnimplementedMsgServer) AddLiquidity(context.Context, *MsgAddLiquidity) (*
 func (UnimplementedMsgServer) RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
 }
+func (UnimplementedMsgServer) TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
+}
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) ScheduleUpdatePool(context.Context, *MsgScheduleUpdatePool) (*MsgScheduleUpdatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpdatePool not implemented")
+}
+func (UnimplementedMsgServer) CancelPoolUpdate(context.Context, *MsgCancelPoolUpdate) (*MsgCancelPoolUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPoolUpdate not implemented")
+}
+func (UnimplementedMsgServer) RampA(context.Context, *MsgRampA) (*MsgRampAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RampA not implemented")
+}
+func (UnimplementedMsgServer) StopRampA(context.Context, *MsgStopRampA) (*MsgStopRampAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRampA not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -214,6 +322,114 @@ func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleUpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleUpdatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleUpdatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleUpdatePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleUpdatePool(ctx, req.(*MsgScheduleUpdatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPoolUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPoolUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPoolUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPoolUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPoolUpdate(ctx, req.(*MsgCancelPoolUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RampA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRampA)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RampA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RampA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RampA(ctx, req.(*MsgRampA))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StopRampA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStopRampA)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StopRampA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StopRampA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StopRampA(ctx, req.(*MsgStopRampA))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +453,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLiquidity",
 			Handler:    _Msg_RemoveLiquidity_Handler,
 		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "ScheduleUpdatePool",
+			Handler:    _Msg_ScheduleUpdatePool_Handler,
+		},
+		{
+			MethodName: "CancelPoolUpdate",
+			Handler:    _Msg_CancelPoolUpdate_Handler,
+		},
+		{
+			MethodName: "RampA",
+			Handler:    _Msg_RampA_Handler,
+		},
+		{
+			MethodName: "StopRampA",
+			Handler:    _Msg_StopRampA_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/swap/stableswap/v1/tx.proto",
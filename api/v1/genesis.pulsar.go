@@ -187,422 +187,1498 @@ func (x *_GenesisState_3_map) IsValid() bool {
 	return x.m != nil
 }
 
-var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_next_pool_id     protoreflect.FieldDescriptor
-	fd_GenesisState_pools            protoreflect.FieldDescriptor
-	fd_GenesisState_paused           protoreflect.FieldDescriptor
-	fd_GenesisState_stableswap_state protoreflect.FieldDescriptor
-)
+var _ protoreflect.Map = (*_GenesisState_6_map)(nil)
 
-func init() {
-	file_noble_swap_v1_genesis_proto_init()
-	md_GenesisState = File_noble_swap_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_next_pool_id = md_GenesisState.Fields().ByName("next_pool_id")
-	fd_GenesisState_pools = md_GenesisState.Fields().ByName("pools")
-	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
-	fd_GenesisState_stableswap_state = md_GenesisState.Fields().ByName("stableswap_state")
+type _GenesisState_6_map struct {
+	m *map[uint64]*Incentive
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
-
-type fastReflection_GenesisState GenesisState
-
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_6_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_swap_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (x *_GenesisState_6_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint64(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_6_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_6_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_6_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_6_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Incentive)
+	(*x.m)[concreteKey] = concreteValue
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_6_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(Incentive)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_6_map) NewValue() protoreflect.Value {
+	v := new(Incentive)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_6_map) IsValid() bool {
+	return x.m != nil
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+var _ protoreflect.Map = (*_GenesisState_7_map)(nil)
+
+type _GenesisState_7_map struct {
+	m *map[string]string
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_7_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.NextPoolId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NextPoolId)
-		if !f(fd_GenesisState_next_pool_id, value) {
-			return
-		}
+func (x *_GenesisState_7_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
 	}
-	if len(x.Pools) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.Pools})
-		if !f(fd_GenesisState_pools, value) {
-			return
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
 		}
 	}
-	if len(x.Paused) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_3_map{m: &x.Paused})
-		if !f(fd_GenesisState_paused, value) {
-			return
-		}
+}
+
+func (x *_GenesisState_7_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
 	}
-	if x.StableswapState != nil {
-		value := protoreflect.ValueOfMessage(x.StableswapState.ProtoReflect())
-		if !f(fd_GenesisState_stableswap_state, value) {
-			return
-		}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_7_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
 	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		return x.NextPoolId != uint64(0)
-	case "noble.swap.v1.GenesisState.pools":
-		return len(x.Pools) != 0
-	case "noble.swap.v1.GenesisState.paused":
-		return len(x.Paused) != 0
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		return x.StableswapState != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
 	}
+	return protoreflect.ValueOfString(v)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		x.NextPoolId = uint64(0)
-	case "noble.swap.v1.GenesisState.pools":
-		x.Pools = nil
-	case "noble.swap.v1.GenesisState.paused":
-		x.Paused = nil
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		x.StableswapState = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
 	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		value := x.NextPoolId
-		return protoreflect.ValueOfUint64(value)
-	case "noble.swap.v1.GenesisState.pools":
-		if len(x.Pools) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
-		}
-		mapValue := &_GenesisState_2_map{m: &x.Pools}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.swap.v1.GenesisState.paused":
-		if len(x.Paused) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_3_map{})
-		}
-		mapValue := &_GenesisState_3_map{m: &x.Paused}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		value := x.StableswapState
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_7_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_7_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_7_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*FeeTier
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		x.NextPoolId = value.Uint()
-	case "noble.swap.v1.GenesisState.pools":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_2_map)
-		x.Pools = *cmv.m
-	case "noble.swap.v1.GenesisState.paused":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_3_map)
-		x.Paused = *cmv.m
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		x.StableswapState = value.Message().Interface().(*v1.GenesisState)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(FeeTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.swap.v1.GenesisState.pools":
-		if x.Pools == nil {
-			x.Pools = make(map[uint64]*Pool)
-		}
-		value := &_GenesisState_2_map{m: &x.Pools}
-		return protoreflect.ValueOfMap(value)
-	case "noble.swap.v1.GenesisState.paused":
-		if x.Paused == nil {
-			x.Paused = make(map[uint64]bool)
-		}
-		value := &_GenesisState_3_map{m: &x.Paused}
-		return protoreflect.ValueOfMap(value)
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		if x.StableswapState == nil {
-			x.StableswapState = new(v1.GenesisState)
-		}
-		return protoreflect.ValueOfMessage(x.StableswapState.ProtoReflect())
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		panic(fmt.Errorf("field next_pool_id of message noble.swap.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(FeeTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.swap.v1.GenesisState.next_pool_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "noble.swap.v1.GenesisState.pools":
-		m := make(map[uint64]*Pool)
-		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
-	case "noble.swap.v1.GenesisState.paused":
-		m := make(map[uint64]bool)
-		return protoreflect.ValueOfMap(&_GenesisState_3_map{m: &m})
-	case "noble.swap.v1.GenesisState.stableswap_state":
-		m := new(v1.GenesisState)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field FeeExempt as it is not of Message kind"))
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*SwapVolume
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SwapVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(SwapVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(SwapVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*RoleGrant
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.NextPoolId != 0 {
-			n += 1 + runtime.Sov(uint64(x.NextPoolId))
-		}
-		if len(x.Pools) > 0 {
-			SiZeMaP := func(k uint64, v *Pool) {
-				l := 0
-				if v != nil {
-					l = options.Size(v)
-				}
-				l += 1 + runtime.Sov(uint64(l))
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.Pools))
-				for k := range x.Pools {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.Pools[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.Pools {
-					SiZeMaP(k, v)
-				}
-			}
-		}
-		if len(x.Paused) > 0 {
-			SiZeMaP := func(k uint64, v bool) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 1
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint64, 0, len(x.Paused))
-				for k := range x.Paused {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.Paused[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.Paused {
-					SiZeMaP(k, v)
-				}
-			}
-		}
-		if x.StableswapState != nil {
-			l = options.Size(x.StableswapState)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RoleGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(RoleGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(RoleGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field WithdrawOnly as it is not of Message kind"))
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*PausedActivities
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PausedActivities)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PausedActivities)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(PausedActivities)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(PausedActivities)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*AllowedDenom
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedDenom)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AllowedDenom)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(AllowedDenom)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(AllowedDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field BlockedDenoms as it is not of Message kind"))
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_next_pool_id       protoreflect.FieldDescriptor
+	fd_GenesisState_pools              protoreflect.FieldDescriptor
+	fd_GenesisState_paused             protoreflect.FieldDescriptor
+	fd_GenesisState_stableswap_state   protoreflect.FieldDescriptor
+	fd_GenesisState_next_incentive_id  protoreflect.FieldDescriptor
+	fd_GenesisState_incentives         protoreflect.FieldDescriptor
+	fd_GenesisState_withdraw_addresses protoreflect.FieldDescriptor
+	fd_GenesisState_fee_tiers          protoreflect.FieldDescriptor
+	fd_GenesisState_fee_exempt         protoreflect.FieldDescriptor
+	fd_GenesisState_swap_volumes       protoreflect.FieldDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_role_grants        protoreflect.FieldDescriptor
+	fd_GenesisState_withdraw_only      protoreflect.FieldDescriptor
+	fd_GenesisState_paused_activities  protoreflect.FieldDescriptor
+	fd_GenesisState_allowed_denoms     protoreflect.FieldDescriptor
+	fd_GenesisState_blocked_denoms     protoreflect.FieldDescriptor
+	fd_GenesisState_authority          protoreflect.FieldDescriptor
+	fd_GenesisState_pending_authority  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_genesis_proto_init()
+	md_GenesisState = File_noble_swap_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_next_pool_id = md_GenesisState.Fields().ByName("next_pool_id")
+	fd_GenesisState_pools = md_GenesisState.Fields().ByName("pools")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_stableswap_state = md_GenesisState.Fields().ByName("stableswap_state")
+	fd_GenesisState_next_incentive_id = md_GenesisState.Fields().ByName("next_incentive_id")
+	fd_GenesisState_incentives = md_GenesisState.Fields().ByName("incentives")
+	fd_GenesisState_withdraw_addresses = md_GenesisState.Fields().ByName("withdraw_addresses")
+	fd_GenesisState_fee_tiers = md_GenesisState.Fields().ByName("fee_tiers")
+	fd_GenesisState_fee_exempt = md_GenesisState.Fields().ByName("fee_exempt")
+	fd_GenesisState_swap_volumes = md_GenesisState.Fields().ByName("swap_volumes")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_role_grants = md_GenesisState.Fields().ByName("role_grants")
+	fd_GenesisState_withdraw_only = md_GenesisState.Fields().ByName("withdraw_only")
+	fd_GenesisState_paused_activities = md_GenesisState.Fields().ByName("paused_activities")
+	fd_GenesisState_allowed_denoms = md_GenesisState.Fields().ByName("allowed_denoms")
+	fd_GenesisState_blocked_denoms = md_GenesisState.Fields().ByName("blocked_denoms")
+	fd_GenesisState_authority = md_GenesisState.Fields().ByName("authority")
+	fd_GenesisState_pending_authority = md_GenesisState.Fields().ByName("pending_authority")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NextPoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPoolId)
+		if !f(fd_GenesisState_next_pool_id, value) {
+			return
+		}
+	}
+	if len(x.Pools) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.Pools})
+		if !f(fd_GenesisState_pools, value) {
+			return
+		}
+	}
+	if len(x.Paused) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_3_map{m: &x.Paused})
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if x.StableswapState != nil {
+		value := protoreflect.ValueOfMessage(x.StableswapState.ProtoReflect())
+		if !f(fd_GenesisState_stableswap_state, value) {
+			return
+		}
+	}
+	if x.NextIncentiveId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextIncentiveId)
+		if !f(fd_GenesisState_next_incentive_id, value) {
+			return
+		}
+	}
+	if len(x.Incentives) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_6_map{m: &x.Incentives})
+		if !f(fd_GenesisState_incentives, value) {
+			return
+		}
+	}
+	if len(x.WithdrawAddresses) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_7_map{m: &x.WithdrawAddresses})
+		if !f(fd_GenesisState_withdraw_addresses, value) {
+			return
+		}
+	}
+	if len(x.FeeTiers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.FeeTiers})
+		if !f(fd_GenesisState_fee_tiers, value) {
+			return
+		}
+	}
+	if len(x.FeeExempt) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.FeeExempt})
+		if !f(fd_GenesisState_fee_exempt, value) {
+			return
+		}
+	}
+	if len(x.SwapVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.SwapVolumes})
+		if !f(fd_GenesisState_swap_volumes, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.RoleGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.RoleGrants})
+		if !f(fd_GenesisState_role_grants, value) {
+			return
+		}
+	}
+	if len(x.WithdrawOnly) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.WithdrawOnly})
+		if !f(fd_GenesisState_withdraw_only, value) {
+			return
+		}
+	}
+	if len(x.PausedActivities) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.PausedActivities})
+		if !f(fd_GenesisState_paused_activities, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.AllowedDenoms})
+		if !f(fd_GenesisState_allowed_denoms, value) {
+			return
+		}
+	}
+	if len(x.BlockedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.BlockedDenoms})
+		if !f(fd_GenesisState_blocked_denoms, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_GenesisState_authority, value) {
+			return
+		}
+	}
+	if x.PendingAuthority != "" {
+		value := protoreflect.ValueOfString(x.PendingAuthority)
+		if !f(fd_GenesisState_pending_authority, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		return x.NextPoolId != uint64(0)
+	case "noble.swap.v1.GenesisState.pools":
+		return len(x.Pools) != 0
+	case "noble.swap.v1.GenesisState.paused":
+		return len(x.Paused) != 0
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		return x.StableswapState != nil
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		return x.NextIncentiveId != uint64(0)
+	case "noble.swap.v1.GenesisState.incentives":
+		return len(x.Incentives) != 0
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		return len(x.WithdrawAddresses) != 0
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		return len(x.FeeTiers) != 0
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		return len(x.FeeExempt) != 0
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		return len(x.SwapVolumes) != 0
+	case "noble.swap.v1.GenesisState.params":
+		return x.Params != nil
+	case "noble.swap.v1.GenesisState.role_grants":
+		return len(x.RoleGrants) != 0
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		return len(x.WithdrawOnly) != 0
+	case "noble.swap.v1.GenesisState.paused_activities":
+		return len(x.PausedActivities) != 0
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		return len(x.BlockedDenoms) != 0
+	case "noble.swap.v1.GenesisState.authority":
+		return x.Authority != ""
+	case "noble.swap.v1.GenesisState.pending_authority":
+		return x.PendingAuthority != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		x.NextPoolId = uint64(0)
+	case "noble.swap.v1.GenesisState.pools":
+		x.Pools = nil
+	case "noble.swap.v1.GenesisState.paused":
+		x.Paused = nil
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		x.StableswapState = nil
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		x.NextIncentiveId = uint64(0)
+	case "noble.swap.v1.GenesisState.incentives":
+		x.Incentives = nil
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		x.WithdrawAddresses = nil
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		x.FeeTiers = nil
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		x.FeeExempt = nil
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		x.SwapVolumes = nil
+	case "noble.swap.v1.GenesisState.params":
+		x.Params = nil
+	case "noble.swap.v1.GenesisState.role_grants":
+		x.RoleGrants = nil
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		x.WithdrawOnly = nil
+	case "noble.swap.v1.GenesisState.paused_activities":
+		x.PausedActivities = nil
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		x.BlockedDenoms = nil
+	case "noble.swap.v1.GenesisState.authority":
+		x.Authority = ""
+	case "noble.swap.v1.GenesisState.pending_authority":
+		x.PendingAuthority = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		value := x.NextPoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.v1.GenesisState.pools":
+		if len(x.Pools) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
+		}
+		mapValue := &_GenesisState_2_map{m: &x.Pools}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.swap.v1.GenesisState.paused":
+		if len(x.Paused) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_3_map{})
+		}
+		mapValue := &_GenesisState_3_map{m: &x.Paused}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		value := x.StableswapState
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		value := x.NextIncentiveId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.v1.GenesisState.incentives":
+		if len(x.Incentives) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_6_map{})
+		}
+		mapValue := &_GenesisState_6_map{m: &x.Incentives}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		if len(x.WithdrawAddresses) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_7_map{})
+		}
+		mapValue := &_GenesisState_7_map{m: &x.WithdrawAddresses}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		if len(x.FeeTiers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.FeeTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		if len(x.FeeExempt) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.FeeExempt}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		if len(x.SwapVolumes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.SwapVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.v1.GenesisState.role_grants":
+		if len(x.RoleGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.RoleGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		if len(x.WithdrawOnly) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.WithdrawOnly}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.paused_activities":
+		if len(x.PausedActivities) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.PausedActivities}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		if len(x.BlockedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.BlockedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.GenesisState.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.v1.GenesisState.pending_authority":
+		value := x.PendingAuthority
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		x.NextPoolId = value.Uint()
+	case "noble.swap.v1.GenesisState.pools":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_2_map)
+		x.Pools = *cmv.m
+	case "noble.swap.v1.GenesisState.paused":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_3_map)
+		x.Paused = *cmv.m
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		x.StableswapState = value.Message().Interface().(*v1.GenesisState)
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		x.NextIncentiveId = value.Uint()
+	case "noble.swap.v1.GenesisState.incentives":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_6_map)
+		x.Incentives = *cmv.m
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_7_map)
+		x.WithdrawAddresses = *cmv.m
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.FeeTiers = *clv.list
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.FeeExempt = *clv.list
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.SwapVolumes = *clv.list
+	case "noble.swap.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "noble.swap.v1.GenesisState.role_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.RoleGrants = *clv.list
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.WithdrawOnly = *clv.list
+	case "noble.swap.v1.GenesisState.paused_activities":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.PausedActivities = *clv.list
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.AllowedDenoms = *clv.list
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.BlockedDenoms = *clv.list
+	case "noble.swap.v1.GenesisState.authority":
+		x.Authority = value.Interface().(string)
+	case "noble.swap.v1.GenesisState.pending_authority":
+		x.PendingAuthority = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.GenesisState.pools":
+		if x.Pools == nil {
+			x.Pools = make(map[uint64]*Pool)
+		}
+		value := &_GenesisState_2_map{m: &x.Pools}
+		return protoreflect.ValueOfMap(value)
+	case "noble.swap.v1.GenesisState.paused":
+		if x.Paused == nil {
+			x.Paused = make(map[uint64]bool)
+		}
+		value := &_GenesisState_3_map{m: &x.Paused}
+		return protoreflect.ValueOfMap(value)
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		if x.StableswapState == nil {
+			x.StableswapState = new(v1.GenesisState)
+		}
+		return protoreflect.ValueOfMessage(x.StableswapState.ProtoReflect())
+	case "noble.swap.v1.GenesisState.incentives":
+		if x.Incentives == nil {
+			x.Incentives = make(map[uint64]*Incentive)
+		}
+		value := &_GenesisState_6_map{m: &x.Incentives}
+		return protoreflect.ValueOfMap(value)
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		if x.WithdrawAddresses == nil {
+			x.WithdrawAddresses = make(map[string]string)
+		}
+		value := &_GenesisState_7_map{m: &x.WithdrawAddresses}
+		return protoreflect.ValueOfMap(value)
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		if x.FeeTiers == nil {
+			x.FeeTiers = []*FeeTier{}
+		}
+		value := &_GenesisState_8_list{list: &x.FeeTiers}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		if x.FeeExempt == nil {
+			x.FeeExempt = []string{}
+		}
+		value := &_GenesisState_9_list{list: &x.FeeExempt}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		if x.SwapVolumes == nil {
+			x.SwapVolumes = []*SwapVolume{}
+		}
+		value := &_GenesisState_10_list{list: &x.SwapVolumes}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "noble.swap.v1.GenesisState.role_grants":
+		if x.RoleGrants == nil {
+			x.RoleGrants = []*RoleGrant{}
+		}
+		value := &_GenesisState_12_list{list: &x.RoleGrants}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		if x.WithdrawOnly == nil {
+			x.WithdrawOnly = []uint64{}
+		}
+		value := &_GenesisState_13_list{list: &x.WithdrawOnly}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.paused_activities":
+		if x.PausedActivities == nil {
+			x.PausedActivities = []*PausedActivities{}
+		}
+		value := &_GenesisState_14_list{list: &x.PausedActivities}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []*AllowedDenom{}
+		}
+		value := &_GenesisState_15_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		if x.BlockedDenoms == nil {
+			x.BlockedDenoms = []string{}
+		}
+		value := &_GenesisState_16_list{list: &x.BlockedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		panic(fmt.Errorf("field next_pool_id of message noble.swap.v1.GenesisState is not mutable"))
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		panic(fmt.Errorf("field next_incentive_id of message noble.swap.v1.GenesisState is not mutable"))
+	case "noble.swap.v1.GenesisState.authority":
+		panic(fmt.Errorf("field authority of message noble.swap.v1.GenesisState is not mutable"))
+	case "noble.swap.v1.GenesisState.pending_authority":
+		panic(fmt.Errorf("field pending_authority of message noble.swap.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.GenesisState.next_pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.v1.GenesisState.pools":
+		m := make(map[uint64]*Pool)
+		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
+	case "noble.swap.v1.GenesisState.paused":
+		m := make(map[uint64]bool)
+		return protoreflect.ValueOfMap(&_GenesisState_3_map{m: &m})
+	case "noble.swap.v1.GenesisState.stableswap_state":
+		m := new(v1.GenesisState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.v1.GenesisState.next_incentive_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.v1.GenesisState.incentives":
+		m := make(map[uint64]*Incentive)
+		return protoreflect.ValueOfMap(&_GenesisState_6_map{m: &m})
+	case "noble.swap.v1.GenesisState.withdraw_addresses":
+		m := make(map[string]string)
+		return protoreflect.ValueOfMap(&_GenesisState_7_map{m: &m})
+	case "noble.swap.v1.GenesisState.fee_tiers":
+		list := []*FeeTier{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "noble.swap.v1.GenesisState.fee_exempt":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "noble.swap.v1.GenesisState.swap_volumes":
+		list := []*SwapVolume{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "noble.swap.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.v1.GenesisState.role_grants":
+		list := []*RoleGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "noble.swap.v1.GenesisState.withdraw_only":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "noble.swap.v1.GenesisState.paused_activities":
+		list := []*PausedActivities{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "noble.swap.v1.GenesisState.allowed_denoms":
+		list := []*AllowedDenom{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "noble.swap.v1.GenesisState.blocked_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "noble.swap.v1.GenesisState.authority":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.v1.GenesisState.pending_authority":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NextPoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPoolId))
+		}
+		if len(x.Pools) > 0 {
+			SiZeMaP := func(k uint64, v *Pool) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Pools))
+				for k := range x.Pools {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Pools[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Pools {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.Paused) > 0 {
+			SiZeMaP := func(k uint64, v bool) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + 1
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Paused))
+				for k := range x.Paused {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Paused[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Paused {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.StableswapState != nil {
+			l = options.Size(x.StableswapState)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextIncentiveId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextIncentiveId))
+		}
+		if len(x.Incentives) > 0 {
+			SiZeMaP := func(k uint64, v *Incentive) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint64, 0, len(x.Incentives))
+				for k := range x.Incentives {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.Incentives[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Incentives {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.WithdrawAddresses) > 0 {
+			SiZeMaP := func(k string, v string) {
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.WithdrawAddresses))
+				for k := range x.WithdrawAddresses {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.WithdrawAddresses[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.WithdrawAddresses {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.FeeTiers) > 0 {
+			for _, e := range x.FeeTiers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeeExempt) > 0 {
+			for _, s := range x.FeeExempt {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SwapVolumes) > 0 {
+			for _, e := range x.SwapVolumes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RoleGrants) > 0 {
+			for _, e := range x.RoleGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WithdrawOnly) > 0 {
+			l = 0
+			for _, e := range x.WithdrawOnly {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.PausedActivities) > 0 {
+			for _, e := range x.PausedActivities {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, e := range x.AllowedDenoms {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BlockedDenoms) > 0 {
+			for _, s := range x.BlockedDenoms {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingAuthority)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
@@ -613,17 +1689,265 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PendingAuthority) > 0 {
+			i -= len(x.PendingAuthority)
+			copy(dAtA[i:], x.PendingAuthority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingAuthority)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BlockedDenoms) > 0 {
+			for iNdEx := len(x.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BlockedDenoms[iNdEx])
+				copy(dAtA[i:], x.BlockedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AllowedDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.PausedActivities) > 0 {
+			for iNdEx := len(x.PausedActivities) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PausedActivities[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.WithdrawOnly) > 0 {
+			var pksize2 int
+			for _, num := range x.WithdrawOnly {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.WithdrawOnly {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.RoleGrants) > 0 {
+			for iNdEx := len(x.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RoleGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.SwapVolumes) > 0 {
+			for iNdEx := len(x.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SwapVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.FeeExempt) > 0 {
+			for iNdEx := len(x.FeeExempt) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FeeExempt[iNdEx])
+				copy(dAtA[i:], x.FeeExempt[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeExempt[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.FeeTiers) > 0 {
+			for iNdEx := len(x.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.WithdrawAddresses) > 0 {
+			MaRsHaLmAp := func(k string, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x3a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForWithdrawAddresses := make([]string, 0, len(x.WithdrawAddresses))
+				for k := range x.WithdrawAddresses {
+					keysForWithdrawAddresses = append(keysForWithdrawAddresses, string(k))
+				}
+				sort.Slice(keysForWithdrawAddresses, func(i, j int) bool {
+					return keysForWithdrawAddresses[i] < keysForWithdrawAddresses[j]
+				})
+				for iNdEx := len(keysForWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.WithdrawAddresses[string(keysForWithdrawAddresses[iNdEx])]
+					out, err := MaRsHaLmAp(keysForWithdrawAddresses[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.WithdrawAddresses {
+					v := x.WithdrawAddresses[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.Incentives) > 0 {
+			MaRsHaLmAp := func(k uint64, v *Incentive) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x32
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForIncentives := make([]uint64, 0, len(x.Incentives))
+				for k := range x.Incentives {
+					keysForIncentives = append(keysForIncentives, uint64(k))
+				}
+				sort.Slice(keysForIncentives, func(i, j int) bool {
+					return keysForIncentives[i] < keysForIncentives[j]
+				})
+				for iNdEx := len(keysForIncentives) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Incentives[uint64(keysForIncentives[iNdEx])]
+					out, err := MaRsHaLmAp(keysForIncentives[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Incentives {
+					v := x.Incentives[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.NextIncentiveId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextIncentiveId))
+			i--
+			dAtA[i] = 0x28
 		}
 		if x.StableswapState != nil {
 			encoded, err := options.Marshal(x.StableswapState)
@@ -648,148 +1972,419 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				} else {
 					dAtA[i] = 0
 				}
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x1a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForPaused := make([]uint64, 0, len(x.Paused))
-				for k := range x.Paused {
-					keysForPaused = append(keysForPaused, uint64(k))
+				i--
+				dAtA[i] = 0x10
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1a
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForPaused := make([]uint64, 0, len(x.Paused))
+				for k := range x.Paused {
+					keysForPaused = append(keysForPaused, uint64(k))
+				}
+				sort.Slice(keysForPaused, func(i, j int) bool {
+					return keysForPaused[i] < keysForPaused[j]
+				})
+				for iNdEx := len(keysForPaused) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Paused[uint64(keysForPaused[iNdEx])]
+					out, err := MaRsHaLmAp(keysForPaused[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Paused {
+					v := x.Paused[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.Pools) > 0 {
+			MaRsHaLmAp := func(k uint64, v *Pool) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x12
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForPools := make([]uint64, 0, len(x.Pools))
+				for k := range x.Pools {
+					keysForPools = append(keysForPools, uint64(k))
+				}
+				sort.Slice(keysForPools, func(i, j int) bool {
+					return keysForPools[i] < keysForPools[j]
+				})
+				for iNdEx := len(keysForPools) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Pools[uint64(keysForPools[iNdEx])]
+					out, err := MaRsHaLmAp(keysForPools[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Pools {
+					v := x.Pools[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.NextPoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+				}
+				x.NextPoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pools == nil {
+					x.Pools = make(map[uint64]*Pool)
+				}
+				var mapkey uint64
+				var mapvalue *Pool
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &Pool{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.Pools[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 				}
-				sort.Slice(keysForPaused, func(i, j int) bool {
-					return keysForPaused[i] < keysForPaused[j]
-				})
-				for iNdEx := len(keysForPaused) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.Paused[uint64(keysForPaused[iNdEx])]
-					out, err := MaRsHaLmAp(keysForPaused[iNdEx], v)
-					if err != nil {
-						return out, err
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-				}
-			} else {
-				for k := range x.Paused {
-					v := x.Paused[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-			}
-		}
-		if len(x.Pools) > 0 {
-			MaRsHaLmAp := func(k uint64, v *Pool) (protoiface.MarshalOutput, error) {
-				baseI := i
-				encoded, err := options.Marshal(v)
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x12
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForPools := make([]uint64, 0, len(x.Pools))
-				for k := range x.Pools {
-					keysForPools = append(keysForPools, uint64(k))
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				sort.Slice(keysForPools, func(i, j int) bool {
-					return keysForPools[i] < keysForPools[j]
-				})
-				for iNdEx := len(keysForPools) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.Pools[uint64(keysForPools[iNdEx])]
-					out, err := MaRsHaLmAp(keysForPools[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			} else {
-				for k := range x.Pools {
-					v := x.Pools[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
+				if x.Paused == nil {
+					x.Paused = make(map[uint64]bool)
+				}
+				var mapkey uint64
+				var mapvalue bool
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapvaluetemp int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvaluetemp |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						mapvalue = bool(mapvaluetemp != 0)
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
 					}
 				}
-			}
-		}
-		if x.NextPoolId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPoolId))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				x.Paused[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StableswapState", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if x.StableswapState == nil {
+					x.StableswapState = &v1.GenesisState{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StableswapState); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextIncentiveId", wireType)
 				}
-				x.NextPoolId = 0
+				x.NextIncentiveId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -799,14 +2394,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NextPoolId |= uint64(b&0x7F) << shift
+					x.NextIncentiveId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -833,11 +2428,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pools == nil {
-					x.Pools = make(map[uint64]*Pool)
+				if x.Incentives == nil {
+					x.Incentives = make(map[uint64]*Incentive)
 				}
 				var mapkey uint64
-				var mapvalue *Pool
+				var mapvalue *Incentive
 				for iNdEx < postIndex {
 					entryPreIndex := iNdEx
 					var wire uint64
@@ -897,7 +2492,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						if postmsgIndex > l {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
-						mapvalue = &Pool{}
+						mapvalue = &Incentive{}
 						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 						}
@@ -917,11 +2512,274 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						iNdEx += skippy
 					}
 				}
-				x.Pools[mapkey] = mapvalue
+				x.Incentives[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.WithdrawAddresses == nil {
+					x.WithdrawAddresses = make(map[string]string)
+				}
+				var mapkey string
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.WithdrawAddresses[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTiers = append(x.FeeTiers, &FeeTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeTiers[len(x.FeeTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeExempt", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeExempt = append(x.FeeExempt, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwapVolumes = append(x.SwapVolumes, &SwapVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SwapVolumes[len(x.SwapVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -948,14 +2806,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Paused == nil {
-					x.Paused = make(map[uint64]bool)
+				x.RoleGrants = append(x.RoleGrants, &RoleGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RoleGrants[len(x.RoleGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var mapkey uint64
-				var mapvalue bool
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
+				iNdEx = postIndex
+			case 13:
+				if wireType == 0 {
+					var v uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -965,29 +2823,51 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						wire |= uint64(b&0x7F) << shift
+						v |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
+					x.WithdrawOnly = append(x.WithdrawOnly, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 						}
-					} else if fieldNum == 2 {
-						var mapvaluetemp int
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.WithdrawOnly) == 0 {
+						x.WithdrawOnly = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
 						for shift := uint(0); ; shift += 7 {
 							if shift >= 64 {
 								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -997,32 +2877,19 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 							}
 							b := dAtA[iNdEx]
 							iNdEx++
-							mapvaluetemp |= int(b&0x7F) << shift
+							v |= uint64(b&0x7F) << shift
 							if b < 0x80 {
 								break
 							}
 						}
-						mapvalue = bool(mapvaluetemp != 0)
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+						x.WithdrawOnly = append(x.WithdrawOnly, v)
 					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawOnly", wireType)
 				}
-				x.Paused[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 4:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StableswapState", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedActivities", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1049,13 +2916,141 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.StableswapState == nil {
-					x.StableswapState = &v1.GenesisState{}
+				x.PausedActivities = append(x.PausedActivities, &PausedActivities{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedActivities[len(x.PausedActivities)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StableswapState); err != nil {
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, &AllowedDenom{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AllowedDenoms[len(x.AllowedDenoms)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockedDenoms = append(x.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAuthority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingAuthority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1110,10 +3105,26 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextPoolId      uint64           `protobuf:"varint,1,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
-	Pools           map[uint64]*Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Paused          map[uint64]bool  `protobuf:"bytes,3,rep,name=paused,proto3" json:"paused,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	StableswapState *v1.GenesisState `protobuf:"bytes,4,opt,name=stableswap_state,json=stableswapState,proto3" json:"stableswap_state,omitempty"`
+	NextPoolId        uint64                `protobuf:"varint,1,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
+	Pools             map[uint64]*Pool      `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Paused            map[uint64]bool       `protobuf:"bytes,3,rep,name=paused,proto3" json:"paused,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	StableswapState   *v1.GenesisState      `protobuf:"bytes,4,opt,name=stableswap_state,json=stableswapState,proto3" json:"stableswap_state,omitempty"`
+	NextIncentiveId   uint64                `protobuf:"varint,5,opt,name=next_incentive_id,json=nextIncentiveId,proto3" json:"next_incentive_id,omitempty"`
+	Incentives        map[uint64]*Incentive `protobuf:"bytes,6,rep,name=incentives,proto3" json:"incentives,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WithdrawAddresses map[string]string     `protobuf:"bytes,7,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FeeTiers          []*FeeTier            `protobuf:"bytes,8,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
+	FeeExempt         []string              `protobuf:"bytes,9,rep,name=fee_exempt,json=feeExempt,proto3" json:"fee_exempt,omitempty"`
+	SwapVolumes       []*SwapVolume         `protobuf:"bytes,10,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes,omitempty"`
+	// Module params, which default to the values of the module config when unset.
+	Params           *Params             `protobuf:"bytes,11,opt,name=params,proto3" json:"params,omitempty"`
+	RoleGrants       []*RoleGrant        `protobuf:"bytes,12,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants,omitempty"`
+	WithdrawOnly     []uint64            `protobuf:"varint,13,rep,packed,name=withdraw_only,json=withdrawOnly,proto3" json:"withdraw_only,omitempty"`
+	PausedActivities []*PausedActivities `protobuf:"bytes,14,rep,name=paused_activities,json=pausedActivities,proto3" json:"paused_activities,omitempty"`
+	AllowedDenoms    []*AllowedDenom     `protobuf:"bytes,15,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	BlockedDenoms    []string            `protobuf:"bytes,16,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+	// Module authority, which defaults to the value of the module config when unset.
+	Authority        string `protobuf:"bytes,17,opt,name=authority,proto3" json:"authority,omitempty"`
+	PendingAuthority string `protobuf:"bytes,18,opt,name=pending_authority,json=pendingAuthority,proto3" json:"pending_authority,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1164,6 +3175,104 @@ func (x *GenesisState) GetStableswapState() *v1.GenesisState {
 	return nil
 }
 
+func (x *GenesisState) GetNextIncentiveId() uint64 {
+	if x != nil {
+		return x.NextIncentiveId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetIncentives() map[uint64]*Incentive {
+	if x != nil {
+		return x.Incentives
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWithdrawAddresses() map[string]string {
+	if x != nil {
+		return x.WithdrawAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeTiers() []*FeeTier {
+	if x != nil {
+		return x.FeeTiers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeExempt() []string {
+	if x != nil {
+		return x.FeeExempt
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSwapVolumes() []*SwapVolume {
+	if x != nil {
+		return x.SwapVolumes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRoleGrants() []*RoleGrant {
+	if x != nil {
+		return x.RoleGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWithdrawOnly() []uint64 {
+	if x != nil {
+		return x.WithdrawOnly
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPausedActivities() []*PausedActivities {
+	if x != nil {
+		return x.PausedActivities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAllowedDenoms() []*AllowedDenom {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlockedDenoms() []string {
+	if x != nil {
+		return x.BlockedDenoms
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *GenesisState) GetPendingAuthority() string {
+	if x != nil {
+		return x.PendingAuthority
+	}
+	return ""
+}
+
 var File_noble_swap_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_noble_swap_v1_genesis_proto_rawDesc = []byte{
@@ -1173,45 +3282,116 @@ var file_noble_swap_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x26, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73,
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
-	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x12, 0x57, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x4d, 0x0a, 0x0a, 0x50,
-	0x6f, 0x6f, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xa0, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x77,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a,
-	0x53, 0x77, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x0a,
+	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
+	0x12, 0x42, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x10, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x49, 0x64,
+	0x12, 0x51, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x65, 0x65, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74,
+	0x12, 0x42, 0x0a, 0x0c, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x11, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x48, 0x0a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x4d, 0x0a, 0x0a, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0xa0, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x77, 0x61, 0x70, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1226,24 +3406,42 @@ func file_noble_swap_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_noble_swap_v1_genesis_proto_rawDescData
 }
 
-var file_noble_swap_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_noble_swap_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_noble_swap_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: noble.swap.v1.GenesisState
-	nil,                     // 1: noble.swap.v1.GenesisState.PoolsEntry
-	nil,                     // 2: noble.swap.v1.GenesisState.PausedEntry
-	(*v1.GenesisState)(nil), // 3: noble.swap.stableswap.v1.GenesisState
-	(*Pool)(nil),            // 4: noble.swap.v1.Pool
+	(*GenesisState)(nil),     // 0: noble.swap.v1.GenesisState
+	nil,                      // 1: noble.swap.v1.GenesisState.PoolsEntry
+	nil,                      // 2: noble.swap.v1.GenesisState.PausedEntry
+	nil,                      // 3: noble.swap.v1.GenesisState.IncentivesEntry
+	nil,                      // 4: noble.swap.v1.GenesisState.WithdrawAddressesEntry
+	(*v1.GenesisState)(nil),  // 5: noble.swap.stableswap.v1.GenesisState
+	(*FeeTier)(nil),          // 6: noble.swap.v1.FeeTier
+	(*SwapVolume)(nil),       // 7: noble.swap.v1.SwapVolume
+	(*Params)(nil),           // 8: noble.swap.v1.Params
+	(*RoleGrant)(nil),        // 9: noble.swap.v1.RoleGrant
+	(*PausedActivities)(nil), // 10: noble.swap.v1.PausedActivities
+	(*AllowedDenom)(nil),     // 11: noble.swap.v1.AllowedDenom
+	(*Pool)(nil),             // 12: noble.swap.v1.Pool
+	(*Incentive)(nil),        // 13: noble.swap.v1.Incentive
 }
 var file_noble_swap_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: noble.swap.v1.GenesisState.pools:type_name -> noble.swap.v1.GenesisState.PoolsEntry
-	2, // 1: noble.swap.v1.GenesisState.paused:type_name -> noble.swap.v1.GenesisState.PausedEntry
-	3, // 2: noble.swap.v1.GenesisState.stableswap_state:type_name -> noble.swap.stableswap.v1.GenesisState
-	4, // 3: noble.swap.v1.GenesisState.PoolsEntry.value:type_name -> noble.swap.v1.Pool
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: noble.swap.v1.GenesisState.pools:type_name -> noble.swap.v1.GenesisState.PoolsEntry
+	2,  // 1: noble.swap.v1.GenesisState.paused:type_name -> noble.swap.v1.GenesisState.PausedEntry
+	5,  // 2: noble.swap.v1.GenesisState.stableswap_state:type_name -> noble.swap.stableswap.v1.GenesisState
+	3,  // 3: noble.swap.v1.GenesisState.incentives:type_name -> noble.swap.v1.GenesisState.IncentivesEntry
+	4,  // 4: noble.swap.v1.GenesisState.withdraw_addresses:type_name -> noble.swap.v1.GenesisState.WithdrawAddressesEntry
+	6,  // 5: noble.swap.v1.GenesisState.fee_tiers:type_name -> noble.swap.v1.FeeTier
+	7,  // 6: noble.swap.v1.GenesisState.swap_volumes:type_name -> noble.swap.v1.SwapVolume
+	8,  // 7: noble.swap.v1.GenesisState.params:type_name -> noble.swap.v1.Params
+	9,  // 8: noble.swap.v1.GenesisState.role_grants:type_name -> noble.swap.v1.RoleGrant
+	10, // 9: noble.swap.v1.GenesisState.paused_activities:type_name -> noble.swap.v1.PausedActivities
+	11, // 10: noble.swap.v1.GenesisState.allowed_denoms:type_name -> noble.swap.v1.AllowedDenom
+	12, // 11: noble.swap.v1.GenesisState.PoolsEntry.value:type_name -> noble.swap.v1.Pool
+	13, // 12: noble.swap.v1.GenesisState.IncentivesEntry.value:type_name -> noble.swap.v1.Incentive
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_noble_swap_v1_genesis_proto_init() }
@@ -1251,7 +3449,13 @@ func file_noble_swap_v1_genesis_proto_init() {
 	if File_noble_swap_v1_genesis_proto != nil {
 		return
 	}
+	file_noble_swap_v1_denom_proto_init()
+	file_noble_swap_v1_fee_tier_proto_init()
+	file_noble_swap_v1_incentive_proto_init()
+	file_noble_swap_v1_params_proto_init()
+	file_noble_swap_v1_pause_proto_init()
 	file_noble_swap_v1_pool_proto_init()
+	file_noble_swap_v1_role_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_swap_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1272,7 +3476,7 @@ func file_noble_swap_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_swap_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,3433 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package swapv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_Params                                       protoreflect.MessageDescriptor
+	fd_Params_base_minimum_deposit                  protoreflect.FieldDescriptor
+	fd_Params_max_add_liquidity_slippage_percentage protoreflect.FieldDescriptor
+	fd_Params_unbonding_block_delta                 protoreflect.FieldDescriptor
+	fd_Params_permissionless_pool_creation          protoreflect.FieldDescriptor
+	fd_Params_min_pool_update_delay                 protoreflect.FieldDescriptor
+	fd_Params_ramp_limits                           protoreflect.FieldDescriptor
+	fd_Params_incentive_limits                      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_params_proto_init()
+	md_Params = File_noble_swap_v1_params_proto.Messages().ByName("Params")
+	fd_Params_base_minimum_deposit = md_Params.Fields().ByName("base_minimum_deposit")
+	fd_Params_max_add_liquidity_slippage_percentage = md_Params.Fields().ByName("max_add_liquidity_slippage_percentage")
+	fd_Params_unbonding_block_delta = md_Params.Fields().ByName("unbonding_block_delta")
+	fd_Params_permissionless_pool_creation = md_Params.Fields().ByName("permissionless_pool_creation")
+	fd_Params_min_pool_update_delay = md_Params.Fields().ByName("min_pool_update_delay")
+	fd_Params_ramp_limits = md_Params.Fields().ByName("ramp_limits")
+	fd_Params_incentive_limits = md_Params.Fields().ByName("incentive_limits")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseMinimumDeposit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BaseMinimumDeposit)
+		if !f(fd_Params_base_minimum_deposit, value) {
+			return
+		}
+	}
+	if x.MaxAddLiquiditySlippagePercentage != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxAddLiquiditySlippagePercentage)
+		if !f(fd_Params_max_add_liquidity_slippage_percentage, value) {
+			return
+		}
+	}
+	if x.UnbondingBlockDelta != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingBlockDelta)
+		if !f(fd_Params_unbonding_block_delta, value) {
+			return
+		}
+	}
+	if x.PermissionlessPoolCreation != nil {
+		value := protoreflect.ValueOfMessage(x.PermissionlessPoolCreation.ProtoReflect())
+		if !f(fd_Params_permissionless_pool_creation, value) {
+			return
+		}
+	}
+	if x.MinPoolUpdateDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinPoolUpdateDelay)
+		if !f(fd_Params_min_pool_update_delay, value) {
+			return
+		}
+	}
+	if x.RampLimits != nil {
+		value := protoreflect.ValueOfMessage(x.RampLimits.ProtoReflect())
+		if !f(fd_Params_ramp_limits, value) {
+			return
+		}
+	}
+	if x.IncentiveLimits != nil {
+		value := protoreflect.ValueOfMessage(x.IncentiveLimits.ProtoReflect())
+		if !f(fd_Params_incentive_limits, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		return x.BaseMinimumDeposit != int64(0)
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		return x.MaxAddLiquiditySlippagePercentage != int64(0)
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		return x.UnbondingBlockDelta != int64(0)
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		return x.PermissionlessPoolCreation != nil
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		return x.MinPoolUpdateDelay != int64(0)
+	case "noble.swap.v1.Params.ramp_limits":
+		return x.RampLimits != nil
+	case "noble.swap.v1.Params.incentive_limits":
+		return x.IncentiveLimits != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		x.BaseMinimumDeposit = int64(0)
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		x.MaxAddLiquiditySlippagePercentage = int64(0)
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		x.UnbondingBlockDelta = int64(0)
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		x.PermissionlessPoolCreation = nil
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		x.MinPoolUpdateDelay = int64(0)
+	case "noble.swap.v1.Params.ramp_limits":
+		x.RampLimits = nil
+	case "noble.swap.v1.Params.incentive_limits":
+		x.IncentiveLimits = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		value := x.BaseMinimumDeposit
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		value := x.MaxAddLiquiditySlippagePercentage
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		value := x.UnbondingBlockDelta
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		value := x.PermissionlessPoolCreation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		value := x.MinPoolUpdateDelay
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.Params.ramp_limits":
+		value := x.RampLimits
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.v1.Params.incentive_limits":
+		value := x.IncentiveLimits
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		x.BaseMinimumDeposit = value.Int()
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		x.MaxAddLiquiditySlippagePercentage = value.Int()
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		x.UnbondingBlockDelta = value.Int()
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		x.PermissionlessPoolCreation = value.Message().Interface().(*PermissionlessPoolCreation)
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		x.MinPoolUpdateDelay = value.Int()
+	case "noble.swap.v1.Params.ramp_limits":
+		x.RampLimits = value.Message().Interface().(*RampLimits)
+	case "noble.swap.v1.Params.incentive_limits":
+		x.IncentiveLimits = value.Message().Interface().(*IncentiveLimits)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		if x.PermissionlessPoolCreation == nil {
+			x.PermissionlessPoolCreation = new(PermissionlessPoolCreation)
+		}
+		return protoreflect.ValueOfMessage(x.PermissionlessPoolCreation.ProtoReflect())
+	case "noble.swap.v1.Params.ramp_limits":
+		if x.RampLimits == nil {
+			x.RampLimits = new(RampLimits)
+		}
+		return protoreflect.ValueOfMessage(x.RampLimits.ProtoReflect())
+	case "noble.swap.v1.Params.incentive_limits":
+		if x.IncentiveLimits == nil {
+			x.IncentiveLimits = new(IncentiveLimits)
+		}
+		return protoreflect.ValueOfMessage(x.IncentiveLimits.ProtoReflect())
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		panic(fmt.Errorf("field base_minimum_deposit of message noble.swap.v1.Params is not mutable"))
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		panic(fmt.Errorf("field max_add_liquidity_slippage_percentage of message noble.swap.v1.Params is not mutable"))
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		panic(fmt.Errorf("field unbonding_block_delta of message noble.swap.v1.Params is not mutable"))
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		panic(fmt.Errorf("field min_pool_update_delay of message noble.swap.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.Params.base_minimum_deposit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.Params.max_add_liquidity_slippage_percentage":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.Params.unbonding_block_delta":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.Params.permissionless_pool_creation":
+		m := new(PermissionlessPoolCreation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.v1.Params.min_pool_update_delay":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.Params.ramp_limits":
+		m := new(RampLimits)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.v1.Params.incentive_limits":
+		m := new(IncentiveLimits)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BaseMinimumDeposit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseMinimumDeposit))
+		}
+		if x.MaxAddLiquiditySlippagePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAddLiquiditySlippagePercentage))
+		}
+		if x.UnbondingBlockDelta != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingBlockDelta))
+		}
+		if x.PermissionlessPoolCreation != nil {
+			l = options.Size(x.PermissionlessPoolCreation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinPoolUpdateDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinPoolUpdateDelay))
+		}
+		if x.RampLimits != nil {
+			l = options.Size(x.RampLimits)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IncentiveLimits != nil {
+			l = options.Size(x.IncentiveLimits)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.IncentiveLimits != nil {
+			encoded, err := options.Marshal(x.IncentiveLimits)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.RampLimits != nil {
+			encoded, err := options.Marshal(x.RampLimits)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MinPoolUpdateDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinPoolUpdateDelay))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PermissionlessPoolCreation != nil {
+			encoded, err := options.Marshal(x.PermissionlessPoolCreation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.UnbondingBlockDelta != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingBlockDelta))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxAddLiquiditySlippagePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAddLiquiditySlippagePercentage))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BaseMinimumDeposit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseMinimumDeposit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseMinimumDeposit", wireType)
+				}
+				x.BaseMinimumDeposit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseMinimumDeposit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAddLiquiditySlippagePercentage", wireType)
+				}
+				x.MaxAddLiquiditySlippagePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAddLiquiditySlippagePercentage |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingBlockDelta", wireType)
+				}
+				x.UnbondingBlockDelta = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingBlockDelta |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PermissionlessPoolCreation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PermissionlessPoolCreation == nil {
+					x.PermissionlessPoolCreation = &PermissionlessPoolCreation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PermissionlessPoolCreation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPoolUpdateDelay", wireType)
+				}
+				x.MinPoolUpdateDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinPoolUpdateDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RampLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RampLimits == nil {
+					x.RampLimits = &RampLimits{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RampLimits); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncentiveLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IncentiveLimits == nil {
+					x.IncentiveLimits = &IncentiveLimits{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncentiveLimits); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_IncentiveLimits_1_list)(nil)
+
+type _IncentiveLimits_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_IncentiveLimits_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_IncentiveLimits_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_IncentiveLimits_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_IncentiveLimits_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_IncentiveLimits_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_IncentiveLimits_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_IncentiveLimits_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_IncentiveLimits_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_IncentiveLimits                     protoreflect.MessageDescriptor
+	fd_IncentiveLimits_creation_fee        protoreflect.FieldDescriptor
+	fd_IncentiveLimits_fund_community_pool protoreflect.FieldDescriptor
+	fd_IncentiveLimits_max_duration        protoreflect.FieldDescriptor
+	fd_IncentiveLimits_max_active_per_pool protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_params_proto_init()
+	md_IncentiveLimits = File_noble_swap_v1_params_proto.Messages().ByName("IncentiveLimits")
+	fd_IncentiveLimits_creation_fee = md_IncentiveLimits.Fields().ByName("creation_fee")
+	fd_IncentiveLimits_fund_community_pool = md_IncentiveLimits.Fields().ByName("fund_community_pool")
+	fd_IncentiveLimits_max_duration = md_IncentiveLimits.Fields().ByName("max_duration")
+	fd_IncentiveLimits_max_active_per_pool = md_IncentiveLimits.Fields().ByName("max_active_per_pool")
+}
+
+var _ protoreflect.Message = (*fastReflection_IncentiveLimits)(nil)
+
+type fastReflection_IncentiveLimits IncentiveLimits
+
+func (x *IncentiveLimits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IncentiveLimits)(x)
+}
+
+func (x *IncentiveLimits) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_IncentiveLimits_messageType fastReflection_IncentiveLimits_messageType
+var _ protoreflect.MessageType = fastReflection_IncentiveLimits_messageType{}
+
+type fastReflection_IncentiveLimits_messageType struct{}
+
+func (x fastReflection_IncentiveLimits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IncentiveLimits)(nil)
+}
+func (x fastReflection_IncentiveLimits_messageType) New() protoreflect.Message {
+	return new(fastReflection_IncentiveLimits)
+}
+func (x fastReflection_IncentiveLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IncentiveLimits
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IncentiveLimits) Descriptor() protoreflect.MessageDescriptor {
+	return md_IncentiveLimits
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IncentiveLimits) Type() protoreflect.MessageType {
+	return _fastReflection_IncentiveLimits_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IncentiveLimits) New() protoreflect.Message {
+	return new(fastReflection_IncentiveLimits)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IncentiveLimits) Interface() protoreflect.ProtoMessage {
+	return (*IncentiveLimits)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IncentiveLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_IncentiveLimits_1_list{list: &x.CreationFee})
+		if !f(fd_IncentiveLimits_creation_fee, value) {
+			return
+		}
+	}
+	if x.FundCommunityPool != false {
+		value := protoreflect.ValueOfBool(x.FundCommunityPool)
+		if !f(fd_IncentiveLimits_fund_community_pool, value) {
+			return
+		}
+	}
+	if x.MaxDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDuration.ProtoReflect())
+		if !f(fd_IncentiveLimits_max_duration, value) {
+			return
+		}
+	}
+	if x.MaxActivePerPool != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxActivePerPool)
+		if !f(fd_IncentiveLimits_max_active_per_pool, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IncentiveLimits) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		return len(x.CreationFee) != 0
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		return x.FundCommunityPool != false
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		return x.MaxDuration != nil
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		return x.MaxActivePerPool != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncentiveLimits) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		x.CreationFee = nil
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		x.FundCommunityPool = false
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		x.MaxDuration = nil
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		x.MaxActivePerPool = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IncentiveLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		if len(x.CreationFee) == 0 {
+			return protoreflect.ValueOfList(&_IncentiveLimits_1_list{})
+		}
+		listValue := &_IncentiveLimits_1_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		value := x.FundCommunityPool
+		return protoreflect.ValueOfBool(value)
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		value := x.MaxDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		value := x.MaxActivePerPool
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncentiveLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		lv := value.List()
+		clv := lv.(*_IncentiveLimits_1_list)
+		x.CreationFee = *clv.list
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		x.FundCommunityPool = value.Bool()
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		x.MaxDuration = value.Message().Interface().(*durationpb.Duration)
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		x.MaxActivePerPool = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncentiveLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		if x.CreationFee == nil {
+			x.CreationFee = []*v1beta1.Coin{}
+		}
+		value := &_IncentiveLimits_1_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		if x.MaxDuration == nil {
+			x.MaxDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDuration.ProtoReflect())
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		panic(fmt.Errorf("field fund_community_pool of message noble.swap.v1.IncentiveLimits is not mutable"))
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		panic(fmt.Errorf("field max_active_per_pool of message noble.swap.v1.IncentiveLimits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IncentiveLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.IncentiveLimits.creation_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_IncentiveLimits_1_list{list: &list})
+	case "noble.swap.v1.IncentiveLimits.fund_community_pool":
+		return protoreflect.ValueOfBool(false)
+	case "noble.swap.v1.IncentiveLimits.max_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.swap.v1.IncentiveLimits.max_active_per_pool":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.IncentiveLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.IncentiveLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IncentiveLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.IncentiveLimits", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IncentiveLimits) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncentiveLimits) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IncentiveLimits) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IncentiveLimits) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IncentiveLimits)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CreationFee) > 0 {
+			for _, e := range x.CreationFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FundCommunityPool {
+			n += 2
+		}
+		if x.MaxDuration != nil {
+			l = options.Size(x.MaxDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxActivePerPool != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxActivePerPool))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IncentiveLimits)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxActivePerPool != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxActivePerPool))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxDuration != nil {
+			encoded, err := options.Marshal(x.MaxDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.FundCommunityPool {
+			i--
+			if x.FundCommunityPool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.CreationFee) > 0 {
+			for iNdEx := len(x.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IncentiveLimits)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IncentiveLimits: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IncentiveLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreationFee = append(x.CreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationFee[len(x.CreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundCommunityPool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FundCommunityPool = bool(v != 0)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDuration == nil {
+					x.MaxDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerPool", wireType)
+				}
+				x.MaxActivePerPool = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxActivePerPool |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RampLimits                   protoreflect.MessageDescriptor
+	fd_RampLimits_max_a_change      protoreflect.FieldDescriptor
+	fd_RampLimits_min_ramp_duration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_params_proto_init()
+	md_RampLimits = File_noble_swap_v1_params_proto.Messages().ByName("RampLimits")
+	fd_RampLimits_max_a_change = md_RampLimits.Fields().ByName("max_a_change")
+	fd_RampLimits_min_ramp_duration = md_RampLimits.Fields().ByName("min_ramp_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_RampLimits)(nil)
+
+type fastReflection_RampLimits RampLimits
+
+func (x *RampLimits) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RampLimits)(x)
+}
+
+func (x *RampLimits) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RampLimits_messageType fastReflection_RampLimits_messageType
+var _ protoreflect.MessageType = fastReflection_RampLimits_messageType{}
+
+type fastReflection_RampLimits_messageType struct{}
+
+func (x fastReflection_RampLimits_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RampLimits)(nil)
+}
+func (x fastReflection_RampLimits_messageType) New() protoreflect.Message {
+	return new(fastReflection_RampLimits)
+}
+func (x fastReflection_RampLimits_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampLimits
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RampLimits) Descriptor() protoreflect.MessageDescriptor {
+	return md_RampLimits
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RampLimits) Type() protoreflect.MessageType {
+	return _fastReflection_RampLimits_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RampLimits) New() protoreflect.Message {
+	return new(fastReflection_RampLimits)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RampLimits) Interface() protoreflect.ProtoMessage {
+	return (*RampLimits)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RampLimits) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxAChange != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxAChange)
+		if !f(fd_RampLimits_max_a_change, value) {
+			return
+		}
+	}
+	if x.MinRampDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinRampDuration)
+		if !f(fd_RampLimits_min_ramp_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RampLimits) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		return x.MaxAChange != int64(0)
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		return x.MinRampDuration != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampLimits) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		x.MaxAChange = int64(0)
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		x.MinRampDuration = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RampLimits) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		value := x.MaxAChange
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		value := x.MinRampDuration
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampLimits) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		x.MaxAChange = value.Int()
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		x.MinRampDuration = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampLimits) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		panic(fmt.Errorf("field max_a_change of message noble.swap.v1.RampLimits is not mutable"))
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		panic(fmt.Errorf("field min_ramp_duration of message noble.swap.v1.RampLimits is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RampLimits) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.RampLimits.max_a_change":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.RampLimits.min_ramp_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.RampLimits"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.RampLimits does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RampLimits) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.RampLimits", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RampLimits) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RampLimits) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RampLimits) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RampLimits) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RampLimits)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxAChange != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAChange))
+		}
+		if x.MinRampDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinRampDuration))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RampLimits)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinRampDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinRampDuration))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxAChange != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAChange))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RampLimits)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampLimits: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RampLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAChange", wireType)
+				}
+				x.MaxAChange = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAChange |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRampDuration", wireType)
+				}
+				x.MinRampDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinRampDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PermissionlessPoolCreation_1_list)(nil)
+
+type _PermissionlessPoolCreation_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PermissionlessPoolCreation_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PermissionlessPoolCreation_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PermissionlessPoolCreation_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PermissionlessPoolCreation_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PermissionlessPoolCreation_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PermissionlessPoolCreation_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PermissionlessPoolCreation_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PermissionlessPoolCreation_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PermissionlessPoolCreation                               protoreflect.MessageDescriptor
+	fd_PermissionlessPoolCreation_creation_fee                  protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_fund_community_pool           protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_min_a                         protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_a                         protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_rewards_fee               protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_protocol_fee_percentage   protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_rate_multiplier_deviation protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_exit_fee_percentage       protoreflect.FieldDescriptor
+	fd_PermissionlessPoolCreation_max_unbonding_duration        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_params_proto_init()
+	md_PermissionlessPoolCreation = File_noble_swap_v1_params_proto.Messages().ByName("PermissionlessPoolCreation")
+	fd_PermissionlessPoolCreation_creation_fee = md_PermissionlessPoolCreation.Fields().ByName("creation_fee")
+	fd_PermissionlessPoolCreation_fund_community_pool = md_PermissionlessPoolCreation.Fields().ByName("fund_community_pool")
+	fd_PermissionlessPoolCreation_min_a = md_PermissionlessPoolCreation.Fields().ByName("min_a")
+	fd_PermissionlessPoolCreation_max_a = md_PermissionlessPoolCreation.Fields().ByName("max_a")
+	fd_PermissionlessPoolCreation_max_rewards_fee = md_PermissionlessPoolCreation.Fields().ByName("max_rewards_fee")
+	fd_PermissionlessPoolCreation_max_protocol_fee_percentage = md_PermissionlessPoolCreation.Fields().ByName("max_protocol_fee_percentage")
+	fd_PermissionlessPoolCreation_max_rate_multiplier_deviation = md_PermissionlessPoolCreation.Fields().ByName("max_rate_multiplier_deviation")
+	fd_PermissionlessPoolCreation_max_exit_fee_percentage = md_PermissionlessPoolCreation.Fields().ByName("max_exit_fee_percentage")
+	fd_PermissionlessPoolCreation_max_unbonding_duration = md_PermissionlessPoolCreation.Fields().ByName("max_unbonding_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_PermissionlessPoolCreation)(nil)
+
+type fastReflection_PermissionlessPoolCreation PermissionlessPoolCreation
+
+func (x *PermissionlessPoolCreation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PermissionlessPoolCreation)(x)
+}
+
+func (x *PermissionlessPoolCreation) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PermissionlessPoolCreation_messageType fastReflection_PermissionlessPoolCreation_messageType
+var _ protoreflect.MessageType = fastReflection_PermissionlessPoolCreation_messageType{}
+
+type fastReflection_PermissionlessPoolCreation_messageType struct{}
+
+func (x fastReflection_PermissionlessPoolCreation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PermissionlessPoolCreation)(nil)
+}
+func (x fastReflection_PermissionlessPoolCreation_messageType) New() protoreflect.Message {
+	return new(fastReflection_PermissionlessPoolCreation)
+}
+func (x fastReflection_PermissionlessPoolCreation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionlessPoolCreation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PermissionlessPoolCreation) Descriptor() protoreflect.MessageDescriptor {
+	return md_PermissionlessPoolCreation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PermissionlessPoolCreation) Type() protoreflect.MessageType {
+	return _fastReflection_PermissionlessPoolCreation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PermissionlessPoolCreation) New() protoreflect.Message {
+	return new(fastReflection_PermissionlessPoolCreation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PermissionlessPoolCreation) Interface() protoreflect.ProtoMessage {
+	return (*PermissionlessPoolCreation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PermissionlessPoolCreation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.CreationFee) != 0 {
+		value := protoreflect.ValueOfList(&_PermissionlessPoolCreation_1_list{list: &x.CreationFee})
+		if !f(fd_PermissionlessPoolCreation_creation_fee, value) {
+			return
+		}
+	}
+	if x.FundCommunityPool != false {
+		value := protoreflect.ValueOfBool(x.FundCommunityPool)
+		if !f(fd_PermissionlessPoolCreation_fund_community_pool, value) {
+			return
+		}
+	}
+	if x.MinA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MinA)
+		if !f(fd_PermissionlessPoolCreation_min_a, value) {
+			return
+		}
+	}
+	if x.MaxA != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxA)
+		if !f(fd_PermissionlessPoolCreation_max_a, value) {
+			return
+		}
+	}
+	if x.MaxRewardsFee != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxRewardsFee)
+		if !f(fd_PermissionlessPoolCreation_max_rewards_fee, value) {
+			return
+		}
+	}
+	if x.MaxProtocolFeePercentage != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxProtocolFeePercentage)
+		if !f(fd_PermissionlessPoolCreation_max_protocol_fee_percentage, value) {
+			return
+		}
+	}
+	if x.MaxRateMultiplierDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxRateMultiplierDeviation)
+		if !f(fd_PermissionlessPoolCreation_max_rate_multiplier_deviation, value) {
+			return
+		}
+	}
+	if x.MaxExitFeePercentage != "" {
+		value := protoreflect.ValueOfString(x.MaxExitFeePercentage)
+		if !f(fd_PermissionlessPoolCreation_max_exit_fee_percentage, value) {
+			return
+		}
+	}
+	if x.MaxUnbondingDuration != nil {
+		value := protoreflect.ValueOfMessage(x.MaxUnbondingDuration.ProtoReflect())
+		if !f(fd_PermissionlessPoolCreation_max_unbonding_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PermissionlessPoolCreation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		return len(x.CreationFee) != 0
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		return x.FundCommunityPool != false
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		return x.MinA != int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		return x.MaxA != int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		return x.MaxRewardsFee != int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		return x.MaxProtocolFeePercentage != int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		return x.MaxRateMultiplierDeviation != ""
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		return x.MaxExitFeePercentage != ""
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		return x.MaxUnbondingDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionlessPoolCreation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		x.CreationFee = nil
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		x.FundCommunityPool = false
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		x.MinA = int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		x.MaxA = int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		x.MaxRewardsFee = int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		x.MaxProtocolFeePercentage = int64(0)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		x.MaxRateMultiplierDeviation = ""
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		x.MaxExitFeePercentage = ""
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		x.MaxUnbondingDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PermissionlessPoolCreation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		if len(x.CreationFee) == 0 {
+			return protoreflect.ValueOfList(&_PermissionlessPoolCreation_1_list{})
+		}
+		listValue := &_PermissionlessPoolCreation_1_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		value := x.FundCommunityPool
+		return protoreflect.ValueOfBool(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		value := x.MinA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		value := x.MaxA
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		value := x.MaxRewardsFee
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		value := x.MaxProtocolFeePercentage
+		return protoreflect.ValueOfInt64(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		value := x.MaxRateMultiplierDeviation
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		value := x.MaxExitFeePercentage
+		return protoreflect.ValueOfString(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		value := x.MaxUnbondingDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionlessPoolCreation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		lv := value.List()
+		clv := lv.(*_PermissionlessPoolCreation_1_list)
+		x.CreationFee = *clv.list
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		x.FundCommunityPool = value.Bool()
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		x.MinA = value.Int()
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		x.MaxA = value.Int()
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		x.MaxRewardsFee = value.Int()
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		x.MaxProtocolFeePercentage = value.Int()
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		x.MaxRateMultiplierDeviation = value.Interface().(string)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		x.MaxExitFeePercentage = value.Interface().(string)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		x.MaxUnbondingDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionlessPoolCreation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		if x.CreationFee == nil {
+			x.CreationFee = []*v1beta1.Coin{}
+		}
+		value := &_PermissionlessPoolCreation_1_list{list: &x.CreationFee}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		if x.MaxUnbondingDuration == nil {
+			x.MaxUnbondingDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxUnbondingDuration.ProtoReflect())
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		panic(fmt.Errorf("field fund_community_pool of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		panic(fmt.Errorf("field min_a of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		panic(fmt.Errorf("field max_a of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		panic(fmt.Errorf("field max_rewards_fee of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		panic(fmt.Errorf("field max_protocol_fee_percentage of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		panic(fmt.Errorf("field max_rate_multiplier_deviation of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		panic(fmt.Errorf("field max_exit_fee_percentage of message noble.swap.v1.PermissionlessPoolCreation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PermissionlessPoolCreation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.PermissionlessPoolCreation.creation_fee":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PermissionlessPoolCreation_1_list{list: &list})
+	case "noble.swap.v1.PermissionlessPoolCreation.fund_community_pool":
+		return protoreflect.ValueOfBool(false)
+	case "noble.swap.v1.PermissionlessPoolCreation.min_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_a":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rewards_fee":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_protocol_fee_percentage":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "noble.swap.v1.PermissionlessPoolCreation.max_rate_multiplier_deviation":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.v1.PermissionlessPoolCreation.max_exit_fee_percentage":
+		return protoreflect.ValueOfString("")
+	case "noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PermissionlessPoolCreation"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PermissionlessPoolCreation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PermissionlessPoolCreation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.PermissionlessPoolCreation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PermissionlessPoolCreation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PermissionlessPoolCreation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PermissionlessPoolCreation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PermissionlessPoolCreation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PermissionlessPoolCreation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.CreationFee) > 0 {
+			for _, e := range x.CreationFee {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FundCommunityPool {
+			n += 2
+		}
+		if x.MinA != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinA))
+		}
+		if x.MaxA != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxA))
+		}
+		if x.MaxRewardsFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRewardsFee))
+		}
+		if x.MaxProtocolFeePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxProtocolFeePercentage))
+		}
+		l = len(x.MaxRateMultiplierDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxExitFeePercentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxUnbondingDuration != nil {
+			l = options.Size(x.MaxUnbondingDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionlessPoolCreation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxUnbondingDuration != nil {
+			encoded, err := options.Marshal(x.MaxUnbondingDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.MaxExitFeePercentage) > 0 {
+			i -= len(x.MaxExitFeePercentage)
+			copy(dAtA[i:], x.MaxExitFeePercentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxExitFeePercentage)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MaxRateMultiplierDeviation) > 0 {
+			i -= len(x.MaxRateMultiplierDeviation)
+			copy(dAtA[i:], x.MaxRateMultiplierDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRateMultiplierDeviation)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.MaxProtocolFeePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProtocolFeePercentage))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxRewardsFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRewardsFee))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxA))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MinA != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinA))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.FundCommunityPool {
+			i--
+			if x.FundCommunityPool {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.CreationFee) > 0 {
+			for iNdEx := len(x.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreationFee[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PermissionlessPoolCreation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionlessPoolCreation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PermissionlessPoolCreation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreationFee = append(x.CreationFee, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreationFee[len(x.CreationFee)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FundCommunityPool", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FundCommunityPool = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinA", wireType)
+				}
+				x.MinA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxA", wireType)
+				}
+				x.MaxA = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxA |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRewardsFee", wireType)
+				}
+				x.MaxRewardsFee = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRewardsFee |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxProtocolFeePercentage", wireType)
+				}
+				x.MaxProtocolFeePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxProtocolFeePercentage |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRateMultiplierDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRateMultiplierDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExitFeePercentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxExitFeePercentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxUnbondingDuration == nil {
+					x.MaxUnbondingDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxUnbondingDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum amount of the base token required for depositing into liquidity pools.
+	BaseMinimumDeposit int64 `protobuf:"varint,1,opt,name=base_minimum_deposit,json=baseMinimumDeposit,proto3" json:"base_minimum_deposit,omitempty"`
+	// Maximum slippage a user may encounter when adding liquidity, where 1e6 is 100%.
+	MaxAddLiquiditySlippagePercentage int64 `protobuf:"varint,2,opt,name=max_add_liquidity_slippage_percentage,json=maxAddLiquiditySlippagePercentage,proto3" json:"max_add_liquidity_slippage_percentage,omitempty"`
+	// Number of blocks between the StableSwap unbonding BeginBlocker executions.
+	UnbondingBlockDelta int64 `protobuf:"varint,3,opt,name=unbonding_block_delta,json=unbondingBlockDelta,proto3" json:"unbonding_block_delta,omitempty"`
+	// Settings of the permissionless pool creation, which is disabled when unset.
+	PermissionlessPoolCreation *PermissionlessPoolCreation `protobuf:"bytes,4,opt,name=permissionless_pool_creation,json=permissionlessPoolCreation,proto3" json:"permissionless_pool_creation,omitempty"`
+	// Minimum delay, in seconds, between the scheduling of a pool update and its execution.
+	MinPoolUpdateDelay int64 `protobuf:"varint,5,opt,name=min_pool_update_delay,json=minPoolUpdateDelay,proto3" json:"min_pool_update_delay,omitempty"`
+	// Limits of the amplification coefficient ramps, the default limits are used when unset.
+	RampLimits *RampLimits `protobuf:"bytes,6,opt,name=ramp_limits,json=rampLimits,proto3" json:"ramp_limits,omitempty"`
+	// Limits of the incentive programs, the default limits are used when unset.
+	IncentiveLimits *IncentiveLimits `protobuf:"bytes,7,opt,name=incentive_limits,json=incentiveLimits,proto3" json:"incentive_limits,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_noble_swap_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBaseMinimumDeposit() int64 {
+	if x != nil {
+		return x.BaseMinimumDeposit
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAddLiquiditySlippagePercentage() int64 {
+	if x != nil {
+		return x.MaxAddLiquiditySlippagePercentage
+	}
+	return 0
+}
+
+func (x *Params) GetUnbondingBlockDelta() int64 {
+	if x != nil {
+		return x.UnbondingBlockDelta
+	}
+	return 0
+}
+
+func (x *Params) GetPermissionlessPoolCreation() *PermissionlessPoolCreation {
+	if x != nil {
+		return x.PermissionlessPoolCreation
+	}
+	return nil
+}
+
+func (x *Params) GetMinPoolUpdateDelay() int64 {
+	if x != nil {
+		return x.MinPoolUpdateDelay
+	}
+	return 0
+}
+
+func (x *Params) GetRampLimits() *RampLimits {
+	if x != nil {
+		return x.RampLimits
+	}
+	return nil
+}
+
+func (x *Params) GetIncentiveLimits() *IncentiveLimits {
+	if x != nil {
+		return x.IncentiveLimits
+	}
+	return nil
+}
+
+type IncentiveLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fee paid by the accounts creating an incentive program.
+	CreationFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
+	// Whether the creation fee funds the community pool, it is burned otherwise.
+	FundCommunityPool bool `protobuf:"varint,2,opt,name=fund_community_pool,json=fundCommunityPool,proto3" json:"fund_community_pool,omitempty"`
+	// Maximum duration between the start and the end time of an incentive program.
+	MaxDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	// Maximum number of incentive programs, started or not, streaming to the same pool.
+	MaxActivePerPool uint64 `protobuf:"varint,4,opt,name=max_active_per_pool,json=maxActivePerPool,proto3" json:"max_active_per_pool,omitempty"`
+}
+
+func (x *IncentiveLimits) Reset() {
+	*x = IncentiveLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncentiveLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncentiveLimits) ProtoMessage() {}
+
+// Deprecated: Use IncentiveLimits.ProtoReflect.Descriptor instead.
+func (*IncentiveLimits) Descriptor() ([]byte, []int) {
+	return file_noble_swap_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IncentiveLimits) GetCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.CreationFee
+	}
+	return nil
+}
+
+func (x *IncentiveLimits) GetFundCommunityPool() bool {
+	if x != nil {
+		return x.FundCommunityPool
+	}
+	return false
+}
+
+func (x *IncentiveLimits) GetMaxDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return nil
+}
+
+func (x *IncentiveLimits) GetMaxActivePerPool() uint64 {
+	if x != nil {
+		return x.MaxActivePerPool
+	}
+	return 0
+}
+
+type RampLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum factor by which the amplification coefficient can increase or decrease over a single ramp.
+	MaxAChange int64 `protobuf:"varint,1,opt,name=max_a_change,json=maxAChange,proto3" json:"max_a_change,omitempty"`
+	// Minimum duration, in seconds, of a ramp, which is also required between the start of two ramps.
+	MinRampDuration int64 `protobuf:"varint,2,opt,name=min_ramp_duration,json=minRampDuration,proto3" json:"min_ramp_duration,omitempty"`
+}
+
+func (x *RampLimits) Reset() {
+	*x = RampLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_v1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RampLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RampLimits) ProtoMessage() {}
+
+// Deprecated: Use RampLimits.ProtoReflect.Descriptor instead.
+func (*RampLimits) Descriptor() ([]byte, []int) {
+	return file_noble_swap_v1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RampLimits) GetMaxAChange() int64 {
+	if x != nil {
+		return x.MaxAChange
+	}
+	return 0
+}
+
+func (x *RampLimits) GetMinRampDuration() int64 {
+	if x != nil {
+		return x.MinRampDuration
+	}
+	return 0
+}
+
+type PermissionlessPoolCreation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fee paid by the accounts creating a pool without the pool manager role.
+	CreationFee []*v1beta1.Coin `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3" json:"creation_fee,omitempty"`
+	// Whether the creation fee funds the community pool, it is burned otherwise.
+	FundCommunityPool bool `protobuf:"varint,2,opt,name=fund_community_pool,json=fundCommunityPool,proto3" json:"fund_community_pool,omitempty"`
+	// Minimum amplification coefficient of the created pools.
+	MinA int64 `protobuf:"varint,3,opt,name=min_a,json=minA,proto3" json:"min_a,omitempty"`
+	// Maximum amplification coefficient of the created pools.
+	MaxA int64 `protobuf:"varint,4,opt,name=max_a,json=maxA,proto3" json:"max_a,omitempty"`
+	// Maximum rewards fee of the created pools.
+	MaxRewardsFee int64 `protobuf:"varint,5,opt,name=max_rewards_fee,json=maxRewardsFee,proto3" json:"max_rewards_fee,omitempty"`
+	// Maximum protocol fee percentage of the created pools.
+	MaxProtocolFeePercentage int64 `protobuf:"varint,6,opt,name=max_protocol_fee_percentage,json=maxProtocolFeePercentage,proto3" json:"max_protocol_fee_percentage,omitempty"`
+	// Maximum relative deviation between the pair and the base rate multipliers of the created pools.
+	MaxRateMultiplierDeviation string `protobuf:"bytes,7,opt,name=max_rate_multiplier_deviation,json=maxRateMultiplierDeviation,proto3" json:"max_rate_multiplier_deviation,omitempty"`
+	// Maximum exit fee percentage of the created pools exit fee tiers, which cannot be set when zero.
+	MaxExitFeePercentage string `protobuf:"bytes,8,opt,name=max_exit_fee_percentage,json=maxExitFeePercentage,proto3" json:"max_exit_fee_percentage,omitempty"`
+	// Maximum unbonding duration of the created pools unbonding tiers, which cannot be set when zero.
+	MaxUnbondingDuration *durationpb.Duration `protobuf:"bytes,9,opt,name=max_unbonding_duration,json=maxUnbondingDuration,proto3" json:"max_unbonding_duration,omitempty"`
+}
+
+func (x *PermissionlessPoolCreation) Reset() {
+	*x = PermissionlessPoolCreation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_v1_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionlessPoolCreation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionlessPoolCreation) ProtoMessage() {}
+
+// Deprecated: Use PermissionlessPoolCreation.ProtoReflect.Descriptor instead.
+func (*PermissionlessPoolCreation) Descriptor() ([]byte, []int) {
+	return file_noble_swap_v1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PermissionlessPoolCreation) GetCreationFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.CreationFee
+	}
+	return nil
+}
+
+func (x *PermissionlessPoolCreation) GetFundCommunityPool() bool {
+	if x != nil {
+		return x.FundCommunityPool
+	}
+	return false
+}
+
+func (x *PermissionlessPoolCreation) GetMinA() int64 {
+	if x != nil {
+		return x.MinA
+	}
+	return 0
+}
+
+func (x *PermissionlessPoolCreation) GetMaxA() int64 {
+	if x != nil {
+		return x.MaxA
+	}
+	return 0
+}
+
+func (x *PermissionlessPoolCreation) GetMaxRewardsFee() int64 {
+	if x != nil {
+		return x.MaxRewardsFee
+	}
+	return 0
+}
+
+func (x *PermissionlessPoolCreation) GetMaxProtocolFeePercentage() int64 {
+	if x != nil {
+		return x.MaxProtocolFeePercentage
+	}
+	return 0
+}
+
+func (x *PermissionlessPoolCreation) GetMaxRateMultiplierDeviation() string {
+	if x != nil {
+		return x.MaxRateMultiplierDeviation
+	}
+	return ""
+}
+
+func (x *PermissionlessPoolCreation) GetMaxExitFeePercentage() string {
+	if x != nil {
+		return x.MaxExitFeePercentage
+	}
+	return ""
+}
+
+func (x *PermissionlessPoolCreation) GetMaxUnbondingDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaxUnbondingDuration
+	}
+	return nil
+}
+
+var File_noble_swap_v1_params_proto protoreflect.FileDescriptor
+
+var file_noble_swap_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69,
+	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe7, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x50, 0x0a, 0x25,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x21, 0x6d, 0x61, 0x78,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x6c, 0x69, 0x70,
+	0x70, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x6b, 0x0a, 0x1c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x6c, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6d, 0x70, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x0a, 0x72, 0x61, 0x6d, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x49,
+	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x0f, 0x49, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x84, 0x01,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x65, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x5a, 0x0a, 0x0a, 0x52,
+	0x61, 0x6d, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x41, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x6d, 0x70, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x05, 0x0a, 0x1a, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x84, 0x01, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x66, 0x75, 0x6e, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x13, 0x0a,
+	0x05, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6d, 0x69,
+	0x6e, 0x41, 0x12, 0x13, 0x0a, 0x05, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x6d, 0x61, 0x78, 0x41, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x46, 0x65, 0x65, 0x12,
+	0x3d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x79,
+	0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x6d,
+	0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x69, 0x74, 0x46, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x14, 0x6d,
+	0x61, 0x78, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x9f, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x77, 0x61, 0x70, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_noble_swap_v1_params_proto_rawDescOnce sync.Once
+	file_noble_swap_v1_params_proto_rawDescData = file_noble_swap_v1_params_proto_rawDesc
+)
+
+func file_noble_swap_v1_params_proto_rawDescGZIP() []byte {
+	file_noble_swap_v1_params_proto_rawDescOnce.Do(func() {
+		file_noble_swap_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_noble_swap_v1_params_proto_rawDescData)
+	})
+	return file_noble_swap_v1_params_proto_rawDescData
+}
+
+var file_noble_swap_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_swap_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),                     // 0: noble.swap.v1.Params
+	(*IncentiveLimits)(nil),            // 1: noble.swap.v1.IncentiveLimits
+	(*RampLimits)(nil),                 // 2: noble.swap.v1.RampLimits
+	(*PermissionlessPoolCreation)(nil), // 3: noble.swap.v1.PermissionlessPoolCreation
+	(*v1beta1.Coin)(nil),               // 4: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),        // 5: google.protobuf.Duration
+}
+var file_noble_swap_v1_params_proto_depIdxs = []int32{
+	3, // 0: noble.swap.v1.Params.permissionless_pool_creation:type_name -> noble.swap.v1.PermissionlessPoolCreation
+	2, // 1: noble.swap.v1.Params.ramp_limits:type_name -> noble.swap.v1.RampLimits
+	1, // 2: noble.swap.v1.Params.incentive_limits:type_name -> noble.swap.v1.IncentiveLimits
+	4, // 3: noble.swap.v1.IncentiveLimits.creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	5, // 4: noble.swap.v1.IncentiveLimits.max_duration:type_name -> google.protobuf.Duration
+	4, // 5: noble.swap.v1.PermissionlessPoolCreation.creation_fee:type_name -> cosmos.base.v1beta1.Coin
+	5, // 6: noble.swap.v1.PermissionlessPoolCreation.max_unbonding_duration:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_noble_swap_v1_params_proto_init() }
+func file_noble_swap_v1_params_proto_init() {
+	if File_noble_swap_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_noble_swap_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncentiveLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_v1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RampLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_swap_v1_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionlessPoolCreation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_noble_swap_v1_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noble_swap_v1_params_proto_goTypes,
+		DependencyIndexes: file_noble_swap_v1_params_proto_depIdxs,
+		MessageInfos:      file_noble_swap_v1_params_proto_msgTypes,
+	}.Build()
+	File_noble_swap_v1_params_proto = out.File
+	file_noble_swap_v1_params_proto_rawDesc = nil
+	file_noble_swap_v1_params_proto_goTypes = nil
+	file_noble_swap_v1_params_proto_depIdxs = nil
+}
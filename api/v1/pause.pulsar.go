@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package swapv1
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_PausedActivities_2_list)(nil)
+
+type _PausedActivities_2_list struct {
+	list *[]PauseActivity
+}
+
+func (x *_PausedActivities_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PausedActivities_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)((*x.list)[i]))
+}
+
+func (x *_PausedActivities_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseActivity)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PausedActivities_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Enum()
+	concreteValue := (PauseActivity)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PausedActivities_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PausedActivities at list field Activities as it is not of Message kind"))
+}
+
+func (x *_PausedActivities_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PausedActivities_2_list) NewElement() protoreflect.Value {
+	v := 0
+	return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(v))
+}
+
+func (x *_PausedActivities_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PausedActivities            protoreflect.MessageDescriptor
+	fd_PausedActivities_pool_id    protoreflect.FieldDescriptor
+	fd_PausedActivities_activities protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_swap_v1_pause_proto_init()
+	md_PausedActivities = File_noble_swap_v1_pause_proto.Messages().ByName("PausedActivities")
+	fd_PausedActivities_pool_id = md_PausedActivities.Fields().ByName("pool_id")
+	fd_PausedActivities_activities = md_PausedActivities.Fields().ByName("activities")
+}
+
+var _ protoreflect.Message = (*fastReflection_PausedActivities)(nil)
+
+type fastReflection_PausedActivities PausedActivities
+
+func (x *PausedActivities) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PausedActivities)(x)
+}
+
+func (x *PausedActivities) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_swap_v1_pause_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PausedActivities_messageType fastReflection_PausedActivities_messageType
+var _ protoreflect.MessageType = fastReflection_PausedActivities_messageType{}
+
+type fastReflection_PausedActivities_messageType struct{}
+
+func (x fastReflection_PausedActivities_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PausedActivities)(nil)
+}
+func (x fastReflection_PausedActivities_messageType) New() protoreflect.Message {
+	return new(fastReflection_PausedActivities)
+}
+func (x fastReflection_PausedActivities_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PausedActivities
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PausedActivities) Descriptor() protoreflect.MessageDescriptor {
+	return md_PausedActivities
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PausedActivities) Type() protoreflect.MessageType {
+	return _fastReflection_PausedActivities_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PausedActivities) New() protoreflect.Message {
+	return new(fastReflection_PausedActivities)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PausedActivities) Interface() protoreflect.ProtoMessage {
+	return (*PausedActivities)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PausedActivities) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PoolId)
+		if !f(fd_PausedActivities_pool_id, value) {
+			return
+		}
+	}
+	if len(x.Activities) != 0 {
+		value := protoreflect.ValueOfList(&_PausedActivities_2_list{list: &x.Activities})
+		if !f(fd_PausedActivities_activities, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PausedActivities) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.swap.v1.PausedActivities.pool_id":
+		return x.PoolId != uint64(0)
+	case "noble.swap.v1.PausedActivities.activities":
+		return len(x.Activities) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PausedActivities) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.swap.v1.PausedActivities.pool_id":
+		x.PoolId = uint64(0)
+	case "noble.swap.v1.PausedActivities.activities":
+		x.Activities = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PausedActivities) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.swap.v1.PausedActivities.pool_id":
+		value := x.PoolId
+		return protoreflect.ValueOfUint64(value)
+	case "noble.swap.v1.PausedActivities.activities":
+		if len(x.Activities) == 0 {
+			return protoreflect.ValueOfList(&_PausedActivities_2_list{})
+		}
+		listValue := &_PausedActivities_2_list{list: &x.Activities}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PausedActivities) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.swap.v1.PausedActivities.pool_id":
+		x.PoolId = value.Uint()
+	case "noble.swap.v1.PausedActivities.activities":
+		lv := value.List()
+		clv := lv.(*_PausedActivities_2_list)
+		x.Activities = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PausedActivities) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.PausedActivities.activities":
+		if x.Activities == nil {
+			x.Activities = []PauseActivity{}
+		}
+		value := &_PausedActivities_2_list{list: &x.Activities}
+		return protoreflect.ValueOfList(value)
+	case "noble.swap.v1.PausedActivities.pool_id":
+		panic(fmt.Errorf("field pool_id of message noble.swap.v1.PausedActivities is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PausedActivities) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.swap.v1.PausedActivities.pool_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.swap.v1.PausedActivities.activities":
+		list := []PauseActivity{}
+		return protoreflect.ValueOfList(&_PausedActivities_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.swap.v1.PausedActivities"))
+		}
+		panic(fmt.Errorf("message noble.swap.v1.PausedActivities does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PausedActivities) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.swap.v1.PausedActivities", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PausedActivities) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PausedActivities) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PausedActivities) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PausedActivities) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PausedActivities)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PoolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PoolId))
+		}
+		if len(x.Activities) > 0 {
+			l = 0
+			for _, e := range x.Activities {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PausedActivities)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Activities) > 0 {
+			var pksize2 int
+			for _, num := range x.Activities {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.Activities {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PoolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PoolId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PausedActivities)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PausedActivities: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PausedActivities: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				}
+				x.PoolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PoolId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType == 0 {
+					var v PauseActivity
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseActivity(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.Activities = append(x.Activities, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.Activities) == 0 {
+						x.Activities = make([]PauseActivity, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v PauseActivity
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= PauseActivity(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.Activities = append(x.Activities, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Activities", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/swap/v1/pause.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PauseActivity defines the pool activities that can be paused independently, each represented by a bit of the
+// pool pause flags.
+type PauseActivity int32
+
+const (
+	PauseActivity_PAUSE_ACTIVITY_UNSPECIFIED PauseActivity = 0
+	// Swaps routed through the pool, including the protocol fees conversions.
+	PauseActivity_PAUSE_ACTIVITY_SWAPS PauseActivity = 1
+	// Deposits of liquidity into the pool.
+	PauseActivity_PAUSE_ACTIVITY_ADD_LIQUIDITY PauseActivity = 2
+	// Removals of liquidity from the pool.
+	PauseActivity_PAUSE_ACTIVITY_REMOVE_LIQUIDITY PauseActivity = 4
+	// Claims of the pool rewards.
+	PauseActivity_PAUSE_ACTIVITY_REWARD_CLAIMS PauseActivity = 8
+	// Processing of the pool expired locks and pending unbondings.
+	PauseActivity_PAUSE_ACTIVITY_UNBONDING PauseActivity = 16
+)
+
+// Enum value maps for PauseActivity.
+var (
+	PauseActivity_name = map[int32]string{
+		0:  "PAUSE_ACTIVITY_UNSPECIFIED",
+		1:  "PAUSE_ACTIVITY_SWAPS",
+		2:  "PAUSE_ACTIVITY_ADD_LIQUIDITY",
+		4:  "PAUSE_ACTIVITY_REMOVE_LIQUIDITY",
+		8:  "PAUSE_ACTIVITY_REWARD_CLAIMS",
+		16: "PAUSE_ACTIVITY_UNBONDING",
+	}
+	PauseActivity_value = map[string]int32{
+		"PAUSE_ACTIVITY_UNSPECIFIED":      0,
+		"PAUSE_ACTIVITY_SWAPS":            1,
+		"PAUSE_ACTIVITY_ADD_LIQUIDITY":    2,
+		"PAUSE_ACTIVITY_REMOVE_LIQUIDITY": 4,
+		"PAUSE_ACTIVITY_REWARD_CLAIMS":    8,
+		"PAUSE_ACTIVITY_UNBONDING":        16,
+	}
+)
+
+func (x PauseActivity) Enum() *PauseActivity {
+	p := new(PauseActivity)
+	*p = x
+	return p
+}
+
+func (x PauseActivity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PauseActivity) Descriptor() protoreflect.EnumDescriptor {
+	return file_noble_swap_v1_pause_proto_enumTypes[0].Descriptor()
+}
+
+func (PauseActivity) Type() protoreflect.EnumType {
+	return &file_noble_swap_v1_pause_proto_enumTypes[0]
+}
+
+func (x PauseActivity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PauseActivity.Descriptor instead.
+func (PauseActivity) EnumDescriptor() ([]byte, []int) {
+	return file_noble_swap_v1_pause_proto_rawDescGZIP(), []int{0}
+}
+
+type PausedActivities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Activities paused in the pool.
+	Activities []PauseActivity `protobuf:"varint,2,rep,packed,name=activities,proto3,enum=noble.swap.v1.PauseActivity" json:"activities,omitempty"`
+}
+
+func (x *PausedActivities) Reset() {
+	*x = PausedActivities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_swap_v1_pause_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausedActivities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausedActivities) ProtoMessage() {}
+
+// Deprecated: Use PausedActivities.ProtoReflect.Descriptor instead.
+func (*PausedActivities) Descriptor() ([]byte, []int) {
+	return file_noble_swap_v1_pause_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PausedActivities) GetPoolId() uint64 {
+	if x != nil {
+		return x.PoolId
+	}
+	return 0
+}
+
+func (x *PausedActivities) GetActivities() []PauseActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+var File_noble_swap_v1_pause_proto protoreflect.FileDescriptor
+
+var file_noble_swap_v1_pause_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x69, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2a, 0xd6, 0x01, 0x0a, 0x0d,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a,
+	0x1a, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f,
+	0x53, 0x57, 0x41, 0x50, 0x53, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x4c, 0x49,
+	0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x5f, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59, 0x10, 0x04, 0x12, 0x20,
+	0x0a, 0x1c, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x53, 0x10, 0x08,
+	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x10, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x42, 0x9e, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x78, 0x79, 0x7a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x77, 0x61, 0x70, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x53, 0x77, 0x61,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_noble_swap_v1_pause_proto_rawDescOnce sync.Once
+	file_noble_swap_v1_pause_proto_rawDescData = file_noble_swap_v1_pause_proto_rawDesc
+)
+
+func file_noble_swap_v1_pause_proto_rawDescGZIP() []byte {
+	file_noble_swap_v1_pause_proto_rawDescOnce.Do(func() {
+		file_noble_swap_v1_pause_proto_rawDescData = protoimpl.X.CompressGZIP(file_noble_swap_v1_pause_proto_rawDescData)
+	})
+	return file_noble_swap_v1_pause_proto_rawDescData
+}
+
+var file_noble_swap_v1_pause_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_noble_swap_v1_pause_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_noble_swap_v1_pause_proto_goTypes = []interface{}{
+	(PauseActivity)(0),       // 0: noble.swap.v1.PauseActivity
+	(*PausedActivities)(nil), // 1: noble.swap.v1.PausedActivities
+}
+var file_noble_swap_v1_pause_proto_depIdxs = []int32{
+	0, // 0: noble.swap.v1.PausedActivities.activities:type_name -> noble.swap.v1.PauseActivity
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_noble_swap_v1_pause_proto_init() }
+func file_noble_swap_v1_pause_proto_init() {
+	if File_noble_swap_v1_pause_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_noble_swap_v1_pause_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PausedActivities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_noble_swap_v1_pause_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noble_swap_v1_pause_proto_goTypes,
+		DependencyIndexes: file_noble_swap_v1_pause_proto_depIdxs,
+		EnumInfos:         file_noble_swap_v1_pause_proto_enumTypes,
+		MessageInfos:      file_noble_swap_v1_pause_proto_msgTypes,
+	}.Build()
+	File_noble_swap_v1_pause_proto = out.File
+	file_noble_swap_v1_pause_proto_rawDesc = nil
+	file_noble_swap_v1_pause_proto_goTypes = nil
+	file_noble_swap_v1_pause_proto_depIdxs = nil
+}
@@ -19,12 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Paused_FullMethodName       = "/noble.swap.v1.Query/Paused"
-	Query_Pools_FullMethodName        = "/noble.swap.v1.Query/Pools"
-	Query_Pool_FullMethodName         = "/noble.swap.v1.Query/Pool"
-	Query_SimulateSwap_FullMethodName = "/noble.swap.v1.Query/SimulateSwap"
-	Query_Rates_FullMethodName        = "/noble.swap.v1.Query/Rates"
-	Query_Rate_FullMethodName         = "/noble.swap.v1.Query/Rate"
+	Query_Paused_FullMethodName           = "/noble.swap.v1.Query/Paused"
+	Query_Pools_FullMethodName            = "/noble.swap.v1.Query/Pools"
+	Query_Pool_FullMethodName             = "/noble.swap.v1.Query/Pool"
+	Query_SimulateSwap_FullMethodName     = "/noble.swap.v1.Query/SimulateSwap"
+	Query_Rates_FullMethodName            = "/noble.swap.v1.Query/Rates"
+	Query_Rate_FullMethodName             = "/noble.swap.v1.Query/Rate"
+	Query_Incentives_FullMethodName       = "/noble.swap.v1.Query/Incentives"
+	Query_IncentivesByPool_FullMethodName = "/noble.swap.v1.Query/IncentivesByPool"
+	Query_WithdrawAddress_FullMethodName  = "/noble.swap.v1.Query/WithdrawAddress"
+	Query_FeeTiers_FullMethodName         = "/noble.swap.v1.Query/FeeTiers"
+	Query_AccountFeeTier_FullMethodName   = "/noble.swap.v1.Query/AccountFeeTier"
+	Query_Params_FullMethodName           = "/noble.swap.v1.Query/Params"
+	Query_Roles_FullMethodName            = "/noble.swap.v1.Query/Roles"
+	Query_RolesByAddress_FullMethodName   = "/noble.swap.v1.Query/RolesByAddress"
+	Query_WithdrawOnly_FullMethodName     = "/noble.swap.v1.Query/WithdrawOnly"
+	Query_AllowedDenoms_FullMethodName    = "/noble.swap.v1.Query/AllowedDenoms"
+	Query_BlockedDenoms_FullMethodName    = "/noble.swap.v1.Query/BlockedDenoms"
+	Query_Authority_FullMethodName        = "/noble.swap.v1.Query/Authority"
 )
 
 // QueryClient is the client API for Query service.
@@ -43,6 +55,30 @@ type QueryClient interface {
 	Rates(ctx context.Context, in *QueryRates, opts ...grpc.CallOption) (*QueryRatesResponse, error)
 	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
 	Rate(ctx context.Context, in *QueryRate, opts ...grpc.CallOption) (*QueryRateResponse, error)
+	// Retrieves all the active incentive programs.
+	Incentives(ctx context.Context, in *QueryIncentives, opts ...grpc.CallOption) (*QueryIncentivesResponse, error)
+	// Retrieves the active incentive programs of a specific Pool.
+	IncentivesByPool(ctx context.Context, in *QueryIncentivesByPool, opts ...grpc.CallOption) (*QueryIncentivesResponse, error)
+	// Retrieves the address receiving the rewards of a specific user.
+	WithdrawAddress(ctx context.Context, in *QueryWithdrawAddress, opts ...grpc.CallOption) (*QueryWithdrawAddressResponse, error)
+	// Retrieves the swap fee discount tiers and the fee exempt accounts.
+	FeeTiers(ctx context.Context, in *QueryFeeTiers, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error)
+	// Retrieves the rolling swap volume and the fee discount of a specific account.
+	AccountFeeTier(ctx context.Context, in *QueryAccountFeeTier, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error)
+	// Retrieves the module params.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Retrieves all the role grants.
+	Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	// Retrieves the roles granted to a specific address.
+	RolesByAddress(ctx context.Context, in *QueryRolesByAddress, opts ...grpc.CallOption) (*QueryRolesByAddressResponse, error)
+	// Retrieves the IDs of the pools in withdraw-only mode.
+	WithdrawOnly(ctx context.Context, in *QueryWithdrawOnly, opts ...grpc.CallOption) (*QueryWithdrawOnlyResponse, error)
+	// Retrieves the registry of the denoms allowed as pool pairs.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenoms, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Retrieves the denoms blocked from the swaps.
+	BlockedDenoms(ctx context.Context, in *QueryBlockedDenoms, opts ...grpc.CallOption) (*QueryBlockedDenomsResponse, error)
+	// Retrieves the current authority, and the pending one if any.
+	Authority(ctx context.Context, in *QueryAuthority, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
 }
 
 type queryClient struct {
@@ -113,6 +149,126 @@ func (c *queryClient) Rate(ctx context.Context, in *QueryRate, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *queryClient) Incentives(ctx context.Context, in *QueryIncentives, opts ...grpc.CallOption) (*QueryIncentivesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIncentivesResponse)
+	err := c.cc.Invoke(ctx, Query_Incentives_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivesByPool(ctx context.Context, in *QueryIncentivesByPool, opts ...grpc.CallOption) (*QueryIncentivesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIncentivesResponse)
+	err := c.cc.Invoke(ctx, Query_IncentivesByPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawAddress(ctx context.Context, in *QueryWithdrawAddress, opts ...grpc.CallOption) (*QueryWithdrawAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTiers(ctx context.Context, in *QueryFeeTiers, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeTiersResponse)
+	err := c.cc.Invoke(ctx, Query_FeeTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeTier(ctx context.Context, in *QueryAccountFeeTier, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountFeeTierResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFeeTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, Query_Roles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RolesByAddress(ctx context.Context, in *QueryRolesByAddress, opts ...grpc.CallOption) (*QueryRolesByAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRolesByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_RolesByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawOnly(ctx context.Context, in *QueryWithdrawOnly, opts ...grpc.CallOption) (*QueryWithdrawOnlyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryWithdrawOnlyResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawOnly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenoms, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedDenoms(ctx context.Context, in *QueryBlockedDenoms, opts ...grpc.CallOption) (*QueryBlockedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBlockedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authority(ctx context.Context, in *QueryAuthority, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuthorityResponse)
+	err := c.cc.Invoke(ctx, Query_Authority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -129,6 +285,30 @@ type QueryServer interface {
 	Rates(context.Context, *QueryRates) (*QueryRatesResponse, error)
 	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
 	Rate(context.Context, *QueryRate) (*QueryRateResponse, error)
+	// Retrieves all the active incentive programs.
+	Incentives(context.Context, *QueryIncentives) (*QueryIncentivesResponse, error)
+	// Retrieves the active incentive programs of a specific Pool.
+	IncentivesByPool(context.Context, *QueryIncentivesByPool) (*QueryIncentivesResponse, error)
+	// Retrieves the address receiving the rewards of a specific user.
+	WithdrawAddress(context.Context, *QueryWithdrawAddress) (*QueryWithdrawAddressResponse, error)
+	// Retrieves the swap fee discount tiers and the fee exempt accounts.
+	FeeTiers(context.Context, *QueryFeeTiers) (*QueryFeeTiersResponse, error)
+	// Retrieves the rolling swap volume and the fee discount of a specific account.
+	AccountFeeTier(context.Context, *QueryAccountFeeTier) (*QueryAccountFeeTierResponse, error)
+	// Retrieves the module params.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Retrieves all the role grants.
+	Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error)
+	// Retrieves the roles granted to a specific address.
+	RolesByAddress(context.Context, *QueryRolesByAddress) (*QueryRolesByAddressResponse, error)
+	// Retrieves the IDs of the pools in withdraw-only mode.
+	WithdrawOnly(context.Context, *QueryWithdrawOnly) (*QueryWithdrawOnlyResponse, error)
+	// Retrieves the registry of the denoms allowed as pool pairs.
+	AllowedDenoms(context.Context, *QueryAllowedDenoms) (*QueryAllowedDenomsResponse, error)
+	// Retrieves the denoms blocked from the swaps.
+	BlockedDenoms(context.Context, *QueryBlockedDenoms) (*QueryBlockedDenomsResponse, error)
+	// Retrieves the current authority, and the pending one if any.
+	Authority(context.Context, *QueryAuthority) (*QueryAuthorityResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -157,6 +337,42 @@ func (UnimplementedQueryServer) Rates(context.Context, *QueryRates) (*QueryRates
 func (UnimplementedQueryServer) Rate(context.Context, *QueryRate) (*QueryRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
 }
+func (UnimplementedQueryServer) Incentives(context.Context, *QueryIncentives) (*QueryIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incentives not implemented")
+}
+func (UnimplementedQueryServer) IncentivesByPool(context.Context, *QueryIncentivesByPool) (*QueryIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivesByPool not implemented")
+}
+func (UnimplementedQueryServer) WithdrawAddress(context.Context, *QueryWithdrawAddress) (*QueryWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddress not implemented")
+}
+func (UnimplementedQueryServer) FeeTiers(context.Context, *QueryFeeTiers) (*QueryFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTiers not implemented")
+}
+func (UnimplementedQueryServer) AccountFeeTier(context.Context, *QueryAccountFeeTier) (*QueryAccountFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeTier not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (UnimplementedQueryServer) RolesByAddress(context.Context, *QueryRolesByAddress) (*QueryRolesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolesByAddress not implemented")
+}
+func (UnimplementedQueryServer) WithdrawOnly(context.Context, *QueryWithdrawOnly) (*QueryWithdrawOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOnly not implemented")
+}
+func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenoms) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (UnimplementedQueryServer) BlockedDenoms(context.Context, *QueryBlockedDenoms) (*QueryBlockedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedDenoms not implemented")
+}
+func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthority) (*QueryAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -286,6 +502,222 @@ func _Query_Rate_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Incentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentives)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Incentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Incentives_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Incentives(ctx, req.(*QueryIncentives))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivesByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivesByPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivesByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IncentivesByPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivesByPool(ctx, req.(*QueryIncentivesByPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawAddress(ctx, req.(*QueryWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTiers(ctx, req.(*QueryFeeTiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeeTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFeeTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeTier(ctx, req.(*QueryAccountFeeTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Roles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RolesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRolesByAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RolesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RolesByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RolesByAddress(ctx, req.(*QueryRolesByAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawOnly(ctx, req.(*QueryWithdrawOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedDenoms(ctx, req.(*QueryBlockedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Authority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authority(ctx, req.(*QueryAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +749,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Rate",
 			Handler:    _Query_Rate_Handler,
 		},
+		{
+			MethodName: "Incentives",
+			Handler:    _Query_Incentives_Handler,
+		},
+		{
+			MethodName: "IncentivesByPool",
+			Handler:    _Query_IncentivesByPool_Handler,
+		},
+		{
+			MethodName: "WithdrawAddress",
+			Handler:    _Query_WithdrawAddress_Handler,
+		},
+		{
+			MethodName: "FeeTiers",
+			Handler:    _Query_FeeTiers_Handler,
+		},
+		{
+			MethodName: "AccountFeeTier",
+			Handler:    _Query_AccountFeeTier_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "RolesByAddress",
+			Handler:    _Query_RolesByAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawOnly",
+			Handler:    _Query_WithdrawOnly_Handler,
+		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "BlockedDenoms",
+			Handler:    _Query_BlockedDenoms_Handler,
+		},
+		{
+			MethodName: "Authority",
+			Handler:    _Query_Authority_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/swap/v1/query.proto",
@@ -22,10 +22,25 @@ const (
 	Msg_Swap_FullMethodName                 = "/noble.swap.v1.Msg/Swap"
 	Msg_WithdrawProtocolFees_FullMethodName = "/noble.swap.v1.Msg/WithdrawProtocolFees"
 	Msg_WithdrawRewards_FullMethodName      = "/noble.swap.v1.Msg/WithdrawRewards"
+	Msg_SetWithdrawAddress_FullMethodName   = "/noble.swap.v1.Msg/SetWithdrawAddress"
 	Msg_PauseByAlgorithm_FullMethodName     = "/noble.swap.v1.Msg/PauseByAlgorithm"
 	Msg_PauseByPoolIds_FullMethodName       = "/noble.swap.v1.Msg/PauseByPoolIds"
 	Msg_UnpauseByAlgorithm_FullMethodName   = "/noble.swap.v1.Msg/UnpauseByAlgorithm"
 	Msg_UnpauseByPoolIds_FullMethodName     = "/noble.swap.v1.Msg/UnpauseByPoolIds"
+	Msg_CreateIncentive_FullMethodName      = "/noble.swap.v1.Msg/CreateIncentive"
+	Msg_SetFeeTiers_FullMethodName          = "/noble.swap.v1.Msg/SetFeeTiers"
+	Msg_SetFeeExemptions_FullMethodName     = "/noble.swap.v1.Msg/SetFeeExemptions"
+	Msg_UpdateParams_FullMethodName         = "/noble.swap.v1.Msg/UpdateParams"
+	Msg_GrantRole_FullMethodName            = "/noble.swap.v1.Msg/GrantRole"
+	Msg_RevokeRole_FullMethodName           = "/noble.swap.v1.Msg/RevokeRole"
+	Msg_ClosePool_FullMethodName            = "/noble.swap.v1.Msg/ClosePool"
+	Msg_SetWithdrawOnly_FullMethodName      = "/noble.swap.v1.Msg/SetWithdrawOnly"
+	Msg_PauseActivities_FullMethodName      = "/noble.swap.v1.Msg/PauseActivities"
+	Msg_UnpauseActivities_FullMethodName    = "/noble.swap.v1.Msg/UnpauseActivities"
+	Msg_SetAllowedDenoms_FullMethodName     = "/noble.swap.v1.Msg/SetAllowedDenoms"
+	Msg_SetBlockedDenoms_FullMethodName     = "/noble.swap.v1.Msg/SetBlockedDenoms"
+	Msg_ProposeAuthority_FullMethodName     = "/noble.swap.v1.Msg/ProposeAuthority"
+	Msg_AcceptAuthority_FullMethodName      = "/noble.swap.v1.Msg/AcceptAuthority"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +53,8 @@ type MsgClient interface {
 	WithdrawProtocolFees(ctx context.Context, in *MsgWithdrawProtocolFees, opts ...grpc.CallOption) (*MsgWithdrawProtocolFeesResponse, error)
 	// WithdrawRewards allows a user to claim their accumulated rewards.
 	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// SetWithdrawAddress allows a user to set the address receiving their rewards.
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
 	// PauseByAlgorithm pauses all pools using a specific algorithm.
 	PauseByAlgorithm(ctx context.Context, in *MsgPauseByAlgorithm, opts ...grpc.CallOption) (*MsgPauseByAlgorithmResponse, error)
 	// PauseByPoolIds pauses specific pools identified by their pool IDs.
@@ -46,6 +63,34 @@ type MsgClient interface {
 	UnpauseByAlgorithm(ctx context.Context, in *MsgUnpauseByAlgorithm, opts ...grpc.CallOption) (*MsgUnpauseByAlgorithmResponse, error)
 	// UnpauseByPoolIds unpauses specific pools identified by their pool IDs.
 	UnpauseByPoolIds(ctx context.Context, in *MsgUnpauseByPoolIds, opts ...grpc.CallOption) (*MsgUnpauseByPoolIdsResponse, error)
+	// CreateIncentive allows any account to fund an incentive streamed to the bonded liquidity providers of a pool.
+	CreateIncentive(ctx context.Context, in *MsgCreateIncentive, opts ...grpc.CallOption) (*MsgCreateIncentiveResponse, error)
+	// SetFeeTiers sets the swap fee discount tiers based on the accounts rolling volume.
+	SetFeeTiers(ctx context.Context, in *MsgSetFeeTiers, opts ...grpc.CallOption) (*MsgSetFeeTiersResponse, error)
+	// SetFeeExemptions adds and removes the accounts exempt from the swap fees.
+	SetFeeExemptions(ctx context.Context, in *MsgSetFeeExemptions, opts ...grpc.CallOption) (*MsgSetFeeExemptionsResponse, error)
+	// UpdateParams updates the module params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// GrantRole grants a role to an address.
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole revokes a role from an address.
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// ClosePool permanently closes a pool, settling its liquidity providers.
+	ClosePool(ctx context.Context, in *MsgClosePool, opts ...grpc.CallOption) (*MsgClosePoolResponse, error)
+	// SetWithdrawOnly enables or disables the withdraw-only mode of specific pools.
+	SetWithdrawOnly(ctx context.Context, in *MsgSetWithdrawOnly, opts ...grpc.CallOption) (*MsgSetWithdrawOnlyResponse, error)
+	// PauseActivities pauses specific activities of the pools identified by their pool IDs.
+	PauseActivities(ctx context.Context, in *MsgPauseActivities, opts ...grpc.CallOption) (*MsgPauseActivitiesResponse, error)
+	// UnpauseActivities unpauses specific activities of the pools identified by their pool IDs.
+	UnpauseActivities(ctx context.Context, in *MsgUnpauseActivities, opts ...grpc.CallOption) (*MsgUnpauseActivitiesResponse, error)
+	// SetAllowedDenoms adds and removes the denoms of the registry allowed as pool pairs.
+	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
+	// SetBlockedDenoms adds and removes the denoms blocked from the swaps across all the pools.
+	SetBlockedDenoms(ctx context.Context, in *MsgSetBlockedDenoms, opts ...grpc.CallOption) (*MsgSetBlockedDenomsResponse, error)
+	// ProposeAuthority proposes a new authority, which takes over once it accepts the handover.
+	ProposeAuthority(ctx context.Context, in *MsgProposeAuthority, opts ...grpc.CallOption) (*MsgProposeAuthorityResponse, error)
+	// AcceptAuthority accepts the pending authority handover.
+	AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error)
 }
 
 type msgClient struct {
@@ -86,6 +131,16 @@ func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards,
 	return out, nil
 }
 
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWithdrawAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) PauseByAlgorithm(ctx context.Context, in *MsgPauseByAlgorithm, opts ...grpc.CallOption) (*MsgPauseByAlgorithmResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgPauseByAlgorithmResponse)
@@ -126,6 +181,146 @@ func (c *msgClient) UnpauseByPoolIds(ctx context.Context, in *MsgUnpauseByPoolId
 	return out, nil
 }
 
+func (c *msgClient) CreateIncentive(ctx context.Context, in *MsgCreateIncentive, opts ...grpc.CallOption) (*MsgCreateIncentiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateIncentiveResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateIncentive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTiers(ctx context.Context, in *MsgSetFeeTiers, opts ...grpc.CallOption) (*MsgSetFeeTiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFeeTiersResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeExemptions(ctx context.Context, in *MsgSetFeeExemptions, opts ...grpc.CallOption) (*MsgSetFeeExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFeeExemptionsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClosePool(ctx context.Context, in *MsgClosePool, opts ...grpc.CallOption) (*MsgClosePoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClosePoolResponse)
+	err := c.cc.Invoke(ctx, Msg_ClosePool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawOnly(ctx context.Context, in *MsgSetWithdrawOnly, opts ...grpc.CallOption) (*MsgSetWithdrawOnlyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetWithdrawOnlyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWithdrawOnly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseActivities(ctx context.Context, in *MsgPauseActivities, opts ...grpc.CallOption) (*MsgPauseActivitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPauseActivitiesResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseActivities(ctx context.Context, in *MsgUnpauseActivities, opts ...grpc.CallOption) (*MsgUnpauseActivitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnpauseActivitiesResponse)
+	err := c.cc.Invoke(ctx, Msg_UnpauseActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAllowedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBlockedDenoms(ctx context.Context, in *MsgSetBlockedDenoms, opts ...grpc.CallOption) (*MsgSetBlockedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBlockedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBlockedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeAuthority(ctx context.Context, in *MsgProposeAuthority, opts ...grpc.CallOption) (*MsgProposeAuthorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposeAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeAuthority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAcceptAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAuthority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -136,6 +331,8 @@ type MsgServer interface {
 	WithdrawProtocolFees(context.Context, *MsgWithdrawProtocolFees) (*MsgWithdrawProtocolFeesResponse, error)
 	// WithdrawRewards allows a user to claim their accumulated rewards.
 	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// SetWithdrawAddress allows a user to set the address receiving their rewards.
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
 	// PauseByAlgorithm pauses all pools using a specific algorithm.
 	PauseByAlgorithm(context.Context, *MsgPauseByAlgorithm) (*MsgPauseByAlgorithmResponse, error)
 	// PauseByPoolIds pauses specific pools identified by their pool IDs.
@@ -144,6 +341,34 @@ type MsgServer interface {
 	UnpauseByAlgorithm(context.Context, *MsgUnpauseByAlgorithm) (*MsgUnpauseByAlgorithmResponse, error)
 	// UnpauseByPoolIds unpauses specific pools identified by their pool IDs.
 	UnpauseByPoolIds(context.Context, *MsgUnpauseByPoolIds) (*MsgUnpauseByPoolIdsResponse, error)
+	// CreateIncentive allows any account to fund an incentive streamed to the bonded liquidity providers of a pool.
+	CreateIncentive(context.Context, *MsgCreateIncentive) (*MsgCreateIncentiveResponse, error)
+	// SetFeeTiers sets the swap fee discount tiers based on the accounts rolling volume.
+	SetFeeTiers(context.Context, *MsgSetFeeTiers) (*MsgSetFeeTiersResponse, error)
+	// SetFeeExemptions adds and removes the accounts exempt from the swap fees.
+	SetFeeExemptions(context.Context, *MsgSetFeeExemptions) (*MsgSetFeeExemptionsResponse, error)
+	// UpdateParams updates the module params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// GrantRole grants a role to an address.
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	// RevokeRole revokes a role from an address.
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	// ClosePool permanently closes a pool, settling its liquidity providers.
+	ClosePool(context.Context, *MsgClosePool) (*MsgClosePoolResponse, error)
+	// SetWithdrawOnly enables or disables the withdraw-only mode of specific pools.
+	SetWithdrawOnly(context.Context, *MsgSetWithdrawOnly) (*MsgSetWithdrawOnlyResponse, error)
+	// PauseActivities pauses specific activities of the pools identified by their pool IDs.
+	PauseActivities(context.Context, *MsgPauseActivities) (*MsgPauseActivitiesResponse, error)
+	// UnpauseActivities unpauses specific activities of the pools identified by their pool IDs.
+	UnpauseActivities(context.Context, *MsgUnpauseActivities) (*MsgUnpauseActivitiesResponse, error)
+	// SetAllowedDenoms adds and removes the denoms of the registry allowed as pool pairs.
+	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
+	// SetBlockedDenoms adds and removes the denoms blocked from the swaps across all the pools.
+	SetBlockedDenoms(context.Context, *MsgSetBlockedDenoms) (*MsgSetBlockedDenomsResponse, error)
+	// ProposeAuthority proposes a new authority, which takes over once it accepts the handover.
+	ProposeAuthority(context.Context, *MsgProposeAuthority) (*MsgProposeAuthorityResponse, error)
+	// AcceptAuthority accepts the pending authority handover.
+	AcceptAuthority(context.Context, *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -163,6 +388,9 @@ func (UnimplementedMsgServer) WithdrawProtocolFees(context.Context, *MsgWithdraw
 func (UnimplementedMsgServer) WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
 }
+func (UnimplementedMsgServer) SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
 func (UnimplementedMsgServer) PauseByAlgorithm(context.Context, *MsgPauseByAlgorithm) (*MsgPauseByAlgorithmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PauseByAlgorithm not implemented")
 }
@@ -175,6 +403,48 @@ func (UnimplementedMsgServer) UnpauseByAlgorithm(context.Context, *MsgUnpauseByA
 func (UnimplementedMsgServer) UnpauseByPoolIds(context.Context, *MsgUnpauseByPoolIds) (*MsgUnpauseByPoolIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseByPoolIds not implemented")
 }
+func (UnimplementedMsgServer) CreateIncentive(context.Context, *MsgCreateIncentive) (*MsgCreateIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIncentive not implemented")
+}
+func (UnimplementedMsgServer) SetFeeTiers(context.Context, *MsgSetFeeTiers) (*MsgSetFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTiers not implemented")
+}
+func (UnimplementedMsgServer) SetFeeExemptions(context.Context, *MsgSetFeeExemptions) (*MsgSetFeeExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeExemptions not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedMsgServer) RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedMsgServer) ClosePool(context.Context, *MsgClosePool) (*MsgClosePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePool not implemented")
+}
+func (UnimplementedMsgServer) SetWithdrawOnly(context.Context, *MsgSetWithdrawOnly) (*MsgSetWithdrawOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawOnly not implemented")
+}
+func (UnimplementedMsgServer) PauseActivities(context.Context, *MsgPauseActivities) (*MsgPauseActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivities not implemented")
+}
+func (UnimplementedMsgServer) UnpauseActivities(context.Context, *MsgUnpauseActivities) (*MsgUnpauseActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivities not implemented")
+}
+func (UnimplementedMsgServer) SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetBlockedDenoms(context.Context, *MsgSetBlockedDenoms) (*MsgSetBlockedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockedDenoms not implemented")
+}
+func (UnimplementedMsgServer) ProposeAuthority(context.Context, *MsgProposeAuthority) (*MsgProposeAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAuthority not implemented")
+}
+func (UnimplementedMsgServer) AcceptAuthority(context.Context, *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAuthority not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -250,6 +520,24 @@ func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_PauseByAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgPauseByAlgorithm)
 	if err := dec(in); err != nil {
@@ -322,6 +610,258 @@ func _Msg_UnpauseByPoolIds_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateIncentive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIncentive(ctx, req.(*MsgCreateIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTiers(ctx, req.(*MsgSetFeeTiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeExemptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeExemptions(ctx, req.(*MsgSetFeeExemptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClosePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClosePool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePool(ctx, req.(*MsgClosePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWithdrawOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawOnly(ctx, req.(*MsgSetWithdrawOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseActivities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseActivities(ctx, req.(*MsgPauseActivities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseActivities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnpauseActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseActivities(ctx, req.(*MsgUnpauseActivities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowedDenoms(ctx, req.(*MsgSetAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBlockedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlockedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlockedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBlockedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlockedDenoms(ctx, req.(*MsgSetBlockedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAuthority(ctx, req.(*MsgProposeAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAuthority(ctx, req.(*MsgAcceptAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +881,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawRewards",
 			Handler:    _Msg_WithdrawRewards_Handler,
 		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
 		{
 			MethodName: "PauseByAlgorithm",
 			Handler:    _Msg_PauseByAlgorithm_Handler,
@@ -357,6 +901,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseByPoolIds",
 			Handler:    _Msg_UnpauseByPoolIds_Handler,
 		},
+		{
+			MethodName: "CreateIncentive",
+			Handler:    _Msg_CreateIncentive_Handler,
+		},
+		{
+			MethodName: "SetFeeTiers",
+			Handler:    _Msg_SetFeeTiers_Handler,
+		},
+		{
+			MethodName: "SetFeeExemptions",
+			Handler:    _Msg_SetFeeExemptions_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ClosePool",
+			Handler:    _Msg_ClosePool_Handler,
+		},
+		{
+			MethodName: "SetWithdrawOnly",
+			Handler:    _Msg_SetWithdrawOnly_Handler,
+		},
+		{
+			MethodName: "PauseActivities",
+			Handler:    _Msg_PauseActivities_Handler,
+		},
+		{
+			MethodName: "UnpauseActivities",
+			Handler:    _Msg_UnpauseActivities_Handler,
+		},
+		{
+			MethodName: "SetAllowedDenoms",
+			Handler:    _Msg_SetAllowedDenoms_Handler,
+		},
+		{
+			MethodName: "SetBlockedDenoms",
+			Handler:    _Msg_SetBlockedDenoms_Handler,
+		},
+		{
+			MethodName: "ProposeAuthority",
+			Handler:    _Msg_ProposeAuthority_Handler,
+		},
+		{
+			MethodName: "AcceptAuthority",
+			Handler:    _Msg_AcceptAuthority_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/swap/v1/tx.proto",
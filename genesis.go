@@ -50,6 +50,103 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gen types.GenesisState)
 		}
 	}
 
+	// Set the WithdrawOnly State.
+	for _, poolId := range gen.GetWithdrawOnly() {
+		if err := k.SetPoolWithdrawOnly(ctx, poolId, true); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the PauseFlags State.
+	for _, entry := range gen.GetPausedActivities() {
+		flags, err := types.ActivitiesToFlags(entry.Activities)
+		if err != nil {
+			panic(err)
+		}
+		if err = k.SetPoolPauseFlags(ctx, entry.PoolId, flags); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the DenomRegistry State.
+	for _, denom := range gen.GetAllowedDenoms() {
+		if err := k.SetAllowedDenom(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the DenomBlocklist State.
+	for _, denom := range gen.GetBlockedDenoms() {
+		if err := k.SetBlockedDenom(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the NextIncentiveID State.
+	if err := k.SetNextIncentiveID(ctx, gen.GetNextIncentiveId()); err != nil {
+		panic(err)
+	}
+
+	// Set the Incentives State.
+	for incentiveId, incentive := range gen.GetIncentives() {
+		if err := k.SetIncentive(ctx, incentiveId, incentive); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the WithdrawAddresses State.
+	for address, withdrawAddress := range gen.GetWithdrawAddresses() {
+		if err := k.SetWithdrawAddress(ctx, address, withdrawAddress); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the FeeTiers State.
+	if err := k.SetFeeTiers(ctx, gen.GetFeeTiers()); err != nil {
+		panic(err)
+	}
+
+	// Set the FeeExempt State.
+	for _, address := range gen.GetFeeExempt() {
+		if err := k.SetFeeExempt(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the SwapVolumes State.
+	for _, entry := range gen.GetSwapVolumes() {
+		if err := k.SetSwapVolume(ctx, entry.Address, entry.Day, entry.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the Params State.
+	if gen.Params != nil {
+		if err := types.ValidateParams(*gen.Params); err != nil {
+			panic(err)
+		}
+		if err := k.SetParams(ctx, *gen.Params); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the Roles State.
+	for _, grant := range gen.GetRoleGrants() {
+		if err := k.SetRole(ctx, grant.Address, grant.Role); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the Authority State.
+	if gen.Authority != "" {
+		if err := k.SetAuthority(ctx, gen.Authority); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetPendingAuthority(ctx, gen.PendingAuthority); err != nil {
+		panic(err)
+	}
+
 	// Set the StableSwap State.
 	for poolId, pool := range gen.StableswapState.GetPools() {
 		if err := k.Stableswap.SetPool(ctx, poolId, pool); err != nil {
@@ -92,14 +189,59 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gen types.GenesisState)
 		}
 	}
 
-	//
+	// Set the LockedPositions State.
+	for _, entry := range gen.StableswapState.GetLockedPositions() {
+		if err := k.Stableswap.SetLockedPosition(ctx, entry.LockEndTime, entry.Address, entry.PoolId); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the AutoCompound State.
+	for _, entry := range gen.StableswapState.GetAutoCompound() {
+		if err := k.Stableswap.SetAutoCompound(ctx, entry.PoolId, entry.Address, true); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the ScheduledUpdates State.
+	if err := k.Stableswap.SetNextScheduledUpdateID(ctx, gen.StableswapState.GetNextScheduledUpdateId()); err != nil {
+		panic(err)
+	}
+	for _, scheduled := range gen.StableswapState.GetScheduledUpdates() {
+		if err := k.Stableswap.SetScheduledUpdate(ctx, scheduled); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	params := k.GetParams(ctx)
 	return &types.GenesisState{
 		NextPoolId: k.GetNextPoolID(ctx),
 		Pools:      k.GetPools(ctx),
 		Paused:     k.GetPaused(ctx),
+
+		WithdrawOnly:     k.GetWithdrawOnly(ctx),
+		PausedActivities: k.GetPausedActivities(ctx),
+
+		AllowedDenoms: k.GetAllowedDenoms(ctx),
+		BlockedDenoms: k.GetBlockedDenoms(ctx),
+
+		NextIncentiveId: k.GetNextIncentiveID(ctx),
+		Incentives:      k.GetIncentives(ctx),
+
+		WithdrawAddresses: k.GetWithdrawAddresses(ctx),
+
+		FeeTiers:    k.GetFeeTiers(ctx),
+		FeeExempt:   k.GetFeeExempt(ctx),
+		SwapVolumes: k.GetSwapVolumes(ctx),
+
+		Params:     &params,
+		RoleGrants: k.GetRoleGrants(ctx),
+
+		Authority:        k.GetAuthority(ctx),
+		PendingAuthority: k.GetPendingAuthority(ctx),
+
 		StableswapState: stableswaptypes.GenesisState{
 			Pools:                     k.Stableswap.GetPools(ctx),
 			PoolsTotalUnbondingShares: k.Stableswap.GetPoolsTotalUnbondingShares(ctx),
@@ -107,6 +249,10 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 			UsersTotalUnbondingShares: k.Stableswap.GetUsersTotalUnbondingShares(ctx),
 			BondedPositions:           k.Stableswap.GetBondedPositions(ctx),
 			UnbondingPositions:        k.Stableswap.GetUnbondingPositions(ctx),
+			AutoCompound:              k.Stableswap.GetAutoCompoundEntries(ctx),
+			LockedPositions:           k.Stableswap.GetLockedPositions(ctx),
+			NextScheduledUpdateId:     k.Stableswap.GetNextScheduledUpdateID(ctx),
+			ScheduledUpdates:          k.Stableswap.GetScheduledUpdates(ctx),
 		},
 	}
 }
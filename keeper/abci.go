@@ -22,13 +22,270 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/sortkeys"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"swap.noble.xyz/types"
 )
 
 // BeginBlocker is called at the beginning of each block. It performs the necessary
 // state transitions and processing required for each Swap Submodule (ex. StableSwap).
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
+	// Process the StableSwap scheduled pool updates BeginBlocker.
+	k.ScheduledPoolUpdatesBeginBlocker(ctx)
+
 	// Process the StableSwap BeginBlocker.
 	k.StableSwapBeginBlocker(ctx)
 
+	// Process the StableSwap auto-compounding BeginBlocker.
+	k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// Process the incentives streaming BeginBlocker.
+	k.IncentivesBeginBlocker(ctx)
+
+	// Process the protocol fees conversion BeginBlocker.
+	k.ProtocolFeesConversionBeginBlocker(ctx)
+
 	return nil
 }
+
+// IncentivesBeginBlocker streams the active incentive programs to the bonded liquidity
+// providers of their pools. This function is invoked at the beginning of each block.
+//
+// Each incentive releases its escrowed amount linearly between its start and end time.
+// The amount released since the last distribution is moved to the pool incentives address
+// and accounted to the liquidity providers. Pools that are paused or without bonded shares
+// are skipped without pausing the stream: the amount released in the meantime is accumulated,
+// and paid at once to the liquidity providers bonded when the pool becomes eligible again.
+// Only the started incentives are loaded, through their start time index, and each of them
+// is processed in isolation and in order of its ID, so that a failure does not affect the others.
+func (k *Keeper) IncentivesBeginBlocker(ctx context.Context) {
+	currentTime := k.headerService.GetHeaderInfo(ctx).Time
+
+	for _, id := range k.GetStartedIncentiveIDs(ctx, currentTime) {
+		incentive, err := k.Incentives.Get(ctx, id)
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("Incentive %d does not exists", id))
+			continue
+		}
+		// Skip processing if the distribution has not started yet.
+		if currentTime.Before(incentive.StartTime) {
+			continue
+		}
+
+		cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+		// Get the specific Pool Controller.
+		controller, err := GetGenericController(cacheCtx, k, incentive.PoolId)
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("Pool %d Controller does not exists", incentive.PoolId))
+			continue
+		}
+
+		// Skip processing if the pool is paused or has no liquidity providers.
+		if controller.IsPaused() || !controller.GetTotalRewardWeight().IsPositive() {
+			continue
+		}
+
+		// Distribute the amount released since the last distribution.
+		amount := ComputeReleasedIncentive(incentive, currentTime).Sub(incentive.Distributed...)
+		if !amount.IsZero() {
+			if err = k.bankKeeper.SendCoins(cacheCtx, IncentivesEscrowAddress(), controller.GetIncentivesAddress(), amount); err != nil {
+				k.Logger().Error(fmt.Sprintf("failed to distribute incentive %d: %s", id, err.Error()))
+				continue
+			}
+			if err = controller.DepositIncentives(cacheCtx, amount); err != nil {
+				k.Logger().Error(fmt.Sprintf("failed to deposit incentive %d: %s", id, err.Error()))
+				continue
+			}
+			incentive.Distributed = incentive.Distributed.Add(amount...)
+		}
+
+		// Remove the incentive once fully distributed, otherwise update it.
+		if incentive.Distributed.Equal(incentive.Amount) {
+			if err = k.RemoveIncentive(cacheCtx, id); err != nil {
+				continue
+			}
+			if err = k.eventService.EventManager(cacheCtx).Emit(cacheCtx, &types.IncentiveCompleted{
+				Id:     id,
+				PoolId: incentive.PoolId,
+			}); err != nil {
+				continue
+			}
+		} else if err = k.SetIncentive(cacheCtx, id, incentive); err != nil {
+			continue
+		}
+		writeCache()
+	}
+}
+
+// ComputeReleasedIncentive computes the cumulative amount of an incentive released at the given time,
+// linearly between its start and end time.
+func ComputeReleasedIncentive(incentive types.Incentive, currentTime time.Time) sdk.Coins {
+	if !currentTime.Before(incentive.EndTime) {
+		return incentive.Amount
+	}
+	if !currentTime.After(incentive.StartTime) {
+		return sdk.Coins{}
+	}
+
+	elapsed := math.NewInt(int64(currentTime.Sub(incentive.StartTime)))
+	duration := math.NewInt(int64(incentive.EndTime.Sub(incentive.StartTime)))
+
+	released := sdk.Coins{}
+	for _, coin := range incentive.Amount {
+		released = released.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return released
+}
+
+// IncentivesEscrowAddress retrieves the address where the incentives are escrowed before being distributed.
+func IncentivesEscrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/incentives", types.ModuleName))
+}
+
+// ProtocolFeesConversionBeginBlocker converts the protocol fees accrued by the pools into the base
+// denom, swapping them through the pools themselves, and sends the proceeds to the configured treasury
+// or burns them. This function is invoked at the beginning of each block, and is executed every
+// `block_delta` blocks when the conversion is configured.
+//
+// Conversions exceeding the maximum price impact are skipped, and retried in the next epochs. Each
+// conversion is processed in isolation, so that a failure does not affect the others.
+func (k *Keeper) ProtocolFeesConversionBeginBlocker(ctx context.Context) (executed bool) {
+	if k.feeConversionConfig == nil {
+		return false
+	}
+	headerInfo := k.headerService.GetHeaderInfo(ctx)
+	if headerInfo.Height%k.feeConversionConfig.BlockDelta != 0 {
+		return false
+	}
+	k.Logger().Info("processing protocol fees conversion epoch")
+
+	var poolIds []uint64
+	for poolId := range k.GetPools(ctx) {
+		poolIds = append(poolIds, poolId)
+	}
+	sortkeys.Uint64s(poolIds)
+
+	for _, poolId := range poolIds {
+		// Get the specific Pool Controller.
+		controller, err := GetGenericController(ctx, k, poolId)
+		if err != nil {
+			k.Logger().Error(fmt.Sprintf("Pool %d Controller does not exists", poolId))
+			continue
+		}
+
+		// Skip processing if the pool swaps are paused.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_SWAPS) {
+			continue
+		}
+
+		for _, protocolFeesAddress := range controller.GetProtocolFeesAddresses() {
+			for _, coin := range k.bankKeeper.GetAllBalances(ctx, protocolFeesAddress) {
+				if coin.Denom == k.baseDenom || k.IsDenomBlocked(ctx, coin.Denom) {
+					continue
+				}
+
+				cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+				if err = k.convertProtocolFees(cacheCtx, controller, protocolFeesAddress, coin, headerInfo.Time); err != nil {
+					k.Logger().Error(fmt.Sprintf("failed to convert %s protocol fees of Pool %d: %s", coin.String(), poolId, err.Error()))
+					continue
+				}
+				writeCache()
+			}
+		}
+	}
+
+	return true
+}
+
+// convertProtocolFees swaps the protocol fees coin into the base denom through its pool, and sends
+// the proceeds to the treasury or burns them.
+func (k *Keeper) convertProtocolFees(ctx context.Context, controller Controller, protocolFeesAddress sdk.AccAddress, coin sdk.Coin, currentTime time.Time) error {
+	// Ensure that the price impact of the conversion is within the limit.
+	feeDiscount := k.GetFeeDiscount(ctx, protocolFeesAddress.String(), currentTime.Unix())
+	commitment, err := controller.Swap(ctx, currentTime.Unix(), coin, k.baseDenom, feeDiscount)
+	if err != nil {
+		return err
+	}
+	reference, err := controller.Swap(ctx, currentTime.Unix(), sdk.NewCoin(coin.Denom, math.NewInt(1_000_000)), k.baseDenom, feeDiscount)
+	if err != nil {
+		return err
+	}
+	maxPriceImpact := math.LegacyNewDec(k.feeConversionConfig.MaxPriceImpactPercentage).QuoInt64(1e6)
+	if priceImpact := computePriceImpact(commitment, reference); priceImpact.GT(maxPriceImpact) {
+		return sdkerrors.Wrapf(types.ErrInvalidSlippage, "price impact %s exceeds %s", priceImpact, maxPriceImpact)
+	}
+
+	// Execute the conversion.
+	result, err := k.Swap(ctx, &types.MsgSwap{
+		Signer: protocolFeesAddress.String(),
+		Amount: coin,
+		Routes: []types.Route{{PoolId: controller.GetId(), DenomTo: k.baseDenom}},
+		Min:    commitment.Out,
+	})
+	if err != nil {
+		return err
+	}
+	output := sdk.NewCoins(result.Result)
+
+	// Send the proceeds to the treasury, or burn them.
+	recipient := k.feeConversionConfig.Treasury
+	if recipient != "" {
+		treasury, err := k.addressCodec.StringToBytes(recipient)
+		if err != nil {
+			return err
+		}
+		if err = k.bankKeeper.SendCoins(ctx, protocolFeesAddress, treasury, output); err != nil {
+			return err
+		}
+	} else {
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, protocolFeesAddress, types.ModuleName, output); err != nil {
+			return err
+		}
+		if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, output); err != nil {
+			return err
+		}
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.ProtocolFeesConverted{
+		PoolId:    controller.GetId(),
+		Input:     coin,
+		Output:    result.Result,
+		Recipient: recipient,
+	})
+}
+
+// computePriceImpact computes the relative decrease of the execution price of a swap compared to
+// the price of a reference swap, both net of fees.
+func computePriceImpact(commitment *types.SwapCommitment, reference *types.SwapCommitment) math.LegacyDec {
+	price := func(c *types.SwapCommitment) math.LegacyDec {
+		in := c.In.Amount
+		for _, fee := range c.Fees {
+			if fee.Amount.Denom == c.In.Denom {
+				in = in.Sub(fee.Amount.Amount)
+			}
+		}
+		if !in.IsPositive() {
+			return math.LegacyZeroDec()
+		}
+		return c.Out.Amount.ToLegacyDec().QuoInt(in)
+	}
+
+	referencePrice := price(reference)
+	if !referencePrice.IsPositive() {
+		return math.LegacyOneDec()
+	}
+	impact := math.LegacyOneDec().Sub(price(commitment).Quo(referencePrice))
+	if impact.IsNegative() {
+		return math.LegacyZeroDec()
+	}
+	return impact
+}
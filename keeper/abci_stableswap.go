@@ -23,6 +23,11 @@ package keeper
 import (
 	"context"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swap.noble.xyz/types"
+	"swap.noble.xyz/types/stableswap"
 )
 
 // StableSwapBeginBlocker handles the periodic updates for the StableSwap submodule,
@@ -30,26 +35,50 @@ import (
 // of their unbonding period. This function is invoked at the beginning of each block.
 //
 // This function checks whether the current block height aligns with the unbonding block
-// interval configured for StableSwap. If not, the function exits early. When the condition
-// is met, it processes all eligible unbonding positions across active stableswap pools.
+// interval set in the module params. If not, the function exits early. When the condition
+// is met, it releases all the expired locks and processes all eligible unbonding positions
+// across active stableswap pools.
 func (k *Keeper) StableSwapBeginBlocker(ctx context.Context) (executed bool) {
 	headerInfo := k.headerService.GetHeaderInfo(ctx)
-	if headerInfo.Height%k.stableswapConfig.UnbondingBlockDelta != 0 {
+	if headerInfo.Height%k.GetParams(ctx).UnbondingBlockDelta != 0 {
 		return false
 	}
 	k.Stableswap.Logger().Info("processing unbondings epoch")
 
-	// Get all the UnbondingPositions expiring at the current block time.
+	// Get all the LockedPositions and UnbondingPositions expiring at the current block time.
+	lockedPositions := k.Stableswap.GetLockedPositionsUntil(ctx, headerInfo.Time.Unix())
 	unbondingPositions := k.Stableswap.GetUnbondingPositionsUntil(ctx, headerInfo.Time.Unix())
 
-	// If no unbonding entries, early exit.
-	if len(unbondingPositions) == 0 {
+	// If no expiring entries, early exit.
+	if len(lockedPositions) == 0 && len(unbondingPositions) == 0 {
 		return true
 	}
 
 	// Get all the StableSwap Controllers.
 	controllers := GetStableSwapControllers(ctx, k)
 
+	// Iterate through the LockedPositions and release the expired locks.
+	for _, position := range lockedPositions {
+		// Get the specific Pool Controller
+		controller, exists := controllers[position.PoolId]
+		if !exists {
+			k.Stableswap.Logger().Error(fmt.Sprintf("Pool %d Controller does not exists", position.PoolId))
+			continue
+		}
+
+		// Skip processing if the pool unbondings are paused.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_UNBONDING) {
+			continue
+		}
+
+		// Release the expired locks.
+		err := controller.ReleaseExpiredLocks(ctx, position.Address, headerInfo.Time)
+		if err != nil {
+			k.Stableswap.Logger().Error(fmt.Sprintf("failed to release %s locks in Pool %d: %s", position.Address, position.PoolId, err.Error()))
+			continue
+		}
+	}
+
 	// Iterate through the UnbondingPositions and process eligible unbondings.
 	for _, position := range unbondingPositions {
 		// Get the specific Pool Controller
@@ -59,8 +88,8 @@ func (k *Keeper) StableSwapBeginBlocker(ctx context.Context) (executed bool) {
 			continue
 		}
 
-		// Skip processing if the pool is paused.
-		if controller.IsPaused() {
+		// Skip processing if the pool unbondings are paused.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_UNBONDING) {
 			continue
 		}
 
@@ -73,3 +102,102 @@ func (k *Keeper) StableSwapBeginBlocker(ctx context.Context) (executed bool) {
 	}
 	return true
 }
+
+// StableSwapCompoundingBeginBlocker handles the periodic auto-compounding of the StableSwap
+// user rewards. This function is invoked at the beginning of each block.
+//
+// This function checks whether the current block height aligns with the compounding block
+// interval configured for StableSwap. If not, the function exits early. When the condition
+// is met, the pending rewards of every user that opted in are deposited back into the pool.
+// Each user is processed in isolation, so that a failure does not affect the others.
+func (k *Keeper) StableSwapCompoundingBeginBlocker(ctx context.Context) (executed bool) {
+	headerInfo := k.headerService.GetHeaderInfo(ctx)
+	if headerInfo.Height%k.stableswapConfig.CompoundingBlockDelta != 0 {
+		return false
+	}
+	k.Stableswap.Logger().Info("processing auto-compounding epoch")
+	minimumDeposit := k.GetParams(ctx).BaseMinimumDeposit
+
+	for _, entry := range k.Stableswap.GetAutoCompoundEntries(ctx) {
+		cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+		// Get the specific Pool Controller.
+		controller, err := GetStableSwapController(cacheCtx, k, entry.PoolId)
+		if err != nil {
+			k.Stableswap.Logger().Error(fmt.Sprintf("Pool %d Controller does not exists", entry.PoolId))
+			continue
+		}
+
+		// Skip processing if the pool reward claims or liquidity deposits are paused.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_REWARD_CLAIMS) || controller.IsActivityPaused(types.PAUSE_ACTIVITY_ADD_LIQUIDITY) {
+			continue
+		}
+
+		// Skip processing if the user has no bonded shares.
+		if !k.Stableswap.GetUserTotalBondedShares(cacheCtx, entry.PoolId, entry.Address).IsPositive() {
+			continue
+		}
+
+		// Compound the user rewards.
+		amount, shares, err := controller.CompoundUserRewards(cacheCtx, entry.Address, headerInfo.Time, minimumDeposit)
+		if err != nil {
+			k.Stableswap.Logger().Error(fmt.Sprintf("failed to compound %s rewards in Pool %d: %s", entry.Address, entry.PoolId, err.Error()))
+			continue
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		if err = k.eventService.EventManager(cacheCtx).Emit(cacheCtx, &stableswap.RewardsCompounded{
+			PoolId:   entry.PoolId,
+			Provider: entry.Address,
+			Amount:   amount,
+			Shares:   shares,
+		}); err != nil {
+			continue
+		}
+		writeCache()
+	}
+	return true
+}
+
+// ScheduledPoolUpdatesBeginBlocker executes the scheduled StableSwap pool updates whose
+// execution time has been reached. This function is invoked at the beginning of each block.
+//
+// The due updates are executed in order of their ID, each in isolation, so that a failure
+// does not affect the others. Every due update is removed from the state once processed,
+// and its outcome is reported through an event. The signer of an update is authorized once,
+// when scheduling it, so that the update is still executed after the revocation of its role
+// or a transfer of the authority, unless cancelled in the meantime through CancelPoolUpdate.
+func (k *Keeper) ScheduledPoolUpdatesBeginBlocker(ctx context.Context) {
+	currentTime := k.headerService.GetHeaderInfo(ctx).Time.Unix()
+	server := stableswapMsgServer{Keeper: k}
+
+	for _, scheduled := range k.Stableswap.GetScheduledUpdates(ctx) {
+		// Skip processing if the execution time has not been reached yet.
+		if scheduled.ExecutionTime > currentTime {
+			continue
+		}
+
+		if err := k.Stableswap.RemoveScheduledUpdate(ctx, scheduled.Id); err != nil {
+			k.Stableswap.Logger().Error(fmt.Sprintf("failed to remove scheduled update %d: %s", scheduled.Id, err.Error()))
+			continue
+		}
+
+		// Execute the update, persisting its effects only on success.
+		cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+		executionErr := ""
+		if _, err := server.updatePool(cacheCtx, &scheduled.Update); err != nil {
+			k.Stableswap.Logger().Error(fmt.Sprintf("failed to execute scheduled update %d of Pool %d: %s", scheduled.Id, scheduled.Update.PoolId, err.Error()))
+			executionErr = err.Error()
+		} else {
+			writeCache()
+		}
+
+		_ = k.eventService.EventManager(ctx).Emit(ctx, &stableswap.ScheduledPoolUpdateExecuted{
+			Id:     scheduled.Id,
+			PoolId: scheduled.Update.PoolId,
+			Error:  executionErr,
+		})
+	}
+}
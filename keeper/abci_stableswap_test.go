@@ -21,16 +21,20 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"swap.noble.xyz/keeper"
 	"swap.noble.xyz/types"
 	"swap.noble.xyz/types/stableswap"
 	"swap.noble.xyz/utils"
@@ -122,3 +126,239 @@ func TestStableSwapBeginBlocker(t *testing.T) {
 	executed = k.StableSwapBeginBlocker(ctx)
 	assert.True(t, executed)
 }
+
+func TestStableSwapCompoundingBeginBlocker(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, alice := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool and add liquidity for Bob and Alice.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+		InitialA: 100,
+		FutureA:  100,
+	})
+	assert.NoError(t, err)
+	pool, _ := k.Pools.Get(ctx, 0)
+	for _, user := range []utils.Account{bob, alice} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: user.Address,
+			PoolId: 0,
+			Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+		})
+		assert.NoError(t, err)
+	}
+
+	// ACT: Enable the auto-compounding for Alice on a non-existing Pool.
+	_, err = stableswapServer.SetAutoCompound(ctx, &stableswap.MsgSetAutoCompound{
+		Signer:  alice.Address,
+		PoolId:  1,
+		Enabled: true,
+	})
+	// ASSERT: The action should've failed due to the invalid Pool.
+	assert.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ACT: Enable the auto-compounding for Alice.
+	_, err = stableswapServer.SetAutoCompound(ctx, &stableswap.MsgSetAutoCompound{
+		Signer:  alice.Address,
+		PoolId:  0,
+		Enabled: true,
+	})
+	assert.NoError(t, err)
+	assert.True(t, k.Stableswap.IsAutoCompoundEnabled(ctx, 0, alice.Address))
+	assert.False(t, k.Stableswap.IsAutoCompoundEnabled(ctx, 0, bob.Address))
+
+	// ARRANGE: Accrue balanced rewards in the Pool.
+	rewardsAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, 0)).String()
+	depositRewards(t, ctx, k, bank, rewardsAddress, sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(2*ONE)), sdk.NewCoin("uusdn", math.NewInt(2*ONE))))
+	aliceShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	bobShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address)
+
+	// ACT: Trigger the BeginBlocker with an invalid height.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+	executed := k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// ASSERT: The execution should've been skipped.
+	assert.False(t, executed)
+	assert.Equal(t, aliceShares, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address))
+
+	// ACT: Trigger the BeginBlocker with a valid height.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20, Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+	executed = k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// ASSERT: Alice's rewards have been deposited into the Pool, minting new bonded shares.
+	assert.True(t, executed)
+	assert.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).GT(aliceShares))
+	assert.Equal(t, bobShares, k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address))
+	assert.True(t, bank.Balances[alice.Address].IsZero())
+	assert.Equal(t, math.NewInt(201*ONE), bank.Balances[pool.Address].AmountOf("uusdc"))
+	assert.Equal(t, math.NewInt(201*ONE), bank.Balances[pool.Address].AmountOf("uusdn"))
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(ONE)), sdk.NewCoin("uusdn", math.NewInt(ONE))), bank.Balances[rewardsAddress])
+	assert.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 1)
+	stableswapPool, _ := k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, stableswapPool.TotalShares, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).Add(bobShares))
+
+	// ARRANGE: Accrue one-sided rewards in the Pool.
+	aliceShares = k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	depositRewards(t, ctx, k, bank, rewardsAddress, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(2*ONE))))
+
+	// ACT: Trigger the BeginBlocker in the next epoch.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 40, Time: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)})
+	executed = k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// ASSERT: The one-sided rewards have been deposited into the Pool.
+	assert.True(t, executed)
+	assert.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).GT(aliceShares))
+	assert.True(t, bank.Balances[alice.Address].IsZero())
+	assert.Equal(t, math.NewInt(201*ONE), bank.Balances[pool.Address].AmountOf("uusdc"))
+	assert.True(t, bank.Balances[pool.Address].AmountOf("uusdn").GT(math.NewInt(201*ONE)))
+
+	// ARRANGE: Pause the Pool.
+	aliceShares = k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	depositRewards(t, ctx, k, bank, rewardsAddress, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(2*ONE))))
+	err = k.Paused.Set(ctx, 0, true)
+	assert.NoError(t, err)
+
+	// ACT: Trigger the BeginBlocker in the next epoch.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 60, Time: time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC)})
+	executed = k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// ASSERT: The paused Pool has been skipped.
+	assert.True(t, executed)
+	assert.Equal(t, aliceShares, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address))
+	err = k.Paused.Set(ctx, 0, false)
+	assert.NoError(t, err)
+
+	// ARRANGE: Add a Position for Alice in the same block.
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(ONE)), sdk.NewCoin("uusdn", math.NewInt(ONE)))
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer:             alice.Address,
+		PoolId:             0,
+		Amount:             sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(ONE)), sdk.NewCoin("uusdn", math.NewInt(ONE))),
+		SlippagePercentage: 0.5e4,
+	})
+	assert.NoError(t, err)
+	aliceShares = k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, controller.GetTotalPoolUserRewards(ctx, alice.Address))
+
+	// ACT: Trigger the BeginBlocker again.
+	executed = k.StableSwapCompoundingBeginBlocker(ctx)
+
+	// ASSERT: The rewards have been compounded into the Position of the same block.
+	assert.True(t, executed)
+	assert.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).GT(aliceShares))
+	assert.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 2)
+	controller, err = keeper.GetStableSwapController(ctx, k, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, controller.GetTotalPoolUserRewards(ctx, alice.Address))
+
+	// ACT: Disable the auto-compounding for Alice.
+	_, err = stableswapServer.SetAutoCompound(ctx, &stableswap.MsgSetAutoCompound{
+		Signer:  alice.Address,
+		PoolId:  0,
+		Enabled: false,
+	})
+
+	// ASSERT: The preference has been removed.
+	assert.NoError(t, err)
+	assert.False(t, k.Stableswap.IsAutoCompoundEnabled(ctx, 0, alice.Address))
+	assert.Empty(t, k.Stableswap.GetAutoCompoundEntries(ctx))
+}
+
+func TestStableSwapCompoundingPositions(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice := utils.TestAccount()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+
+	// ARRANGE: Create a Pool with lock tiers, and add locked liquidity for Alice with auto-compounding.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: startTime})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+		InitialA:  100,
+		FutureA:   100,
+		LockTiers: []stableswap.LockTier{{Duration: 30 * 24 * time.Hour, Multiplier: math.LegacyNewDec(2)}},
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = amount
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer:       alice.Address,
+		PoolId:       0,
+		Amount:       amount,
+		LockDuration: 30 * 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	_, err = stableswapServer.SetAutoCompound(ctx, &stableswap.MsgSetAutoCompound{
+		Signer:  alice.Address,
+		PoolId:  0,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+	rewardsAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, 0)).String()
+
+	// ACT: Run several compounding epochs.
+	for epoch := int64(1); epoch <= 5; epoch++ {
+		aliceShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+		depositRewards(t, ctx, k, bank, rewardsAddress, sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(ONE)), sdk.NewCoin("uusdn", math.NewInt(ONE))))
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 20 * epoch, Time: startTime.Add(time.Duration(epoch) * time.Hour)})
+		require.True(t, k.StableSwapCompoundingBeginBlocker(ctx))
+
+		// ASSERT: The rewards have been compounded, creating a single unlocked Position next to the locked one.
+		require.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).GT(aliceShares))
+		require.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 2)
+	}
+
+	// ARRANGE: Accrue rewards below the minimum deposit.
+	aliceShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	depositRewards(t, ctx, k, bank, rewardsAddress, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE/10))))
+
+	// ACT: Run the next compounding epoch.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 120, Time: startTime.Add(6 * time.Hour)})
+	require.True(t, k.StableSwapCompoundingBeginBlocker(ctx))
+
+	// ASSERT: The compounding has been skipped, leaving the rewards pending.
+	require.Equal(t, aliceShares, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address))
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, controller.GetTotalPoolUserRewards(ctx, alice.Address))
+	require.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 2)
+}
+
+// depositRewards funds the rewards address of the first Pool, accounting the amount to its liquidity providers.
+func depositRewards(t *testing.T, ctx sdk.Context, k *keeper.Keeper, bank mocks.BankKeeper, rewardsAddress string, amount sdk.Coins) {
+	bank.Balances[rewardsAddress] = bank.Balances[rewardsAddress].Add(amount...)
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, controller.DepositRewards(ctx, amount))
+}
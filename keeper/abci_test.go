@@ -21,13 +21,23 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	modulev1 "swap.noble.xyz/api/module/v1"
+	"swap.noble.xyz/keeper"
+	"swap.noble.xyz/types"
 	"swap.noble.xyz/types/stableswap"
+	"swap.noble.xyz/utils"
 	"swap.noble.xyz/utils/mocks"
 )
 
@@ -56,3 +66,330 @@ func TestBeginBlocker(t *testing.T) {
 	err = k.BeginBlocker(ctx)
 	assert.NoError(t, err)
 }
+
+func TestIncentivesBeginBlocker(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob, tom := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime})
+	escrowAddress := keeper.IncentivesEscrowAddress().String()
+	incentivesAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/incentives", types.ModuleName, 0)).String()
+
+	// ARRANGE: Create a Pool and an incentive streaming 100 ufoo over 10 hours.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+	bank.Balances[tom.Address] = sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(100*ONE)))
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(100*ONE))),
+		StartTime: startTime.Add(time.Hour),
+		EndTime:   startTime.Add(11 * time.Hour),
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: The incentive is only started once its start time is reached.
+	assert.Empty(t, k.GetStartedIncentiveIDs(ctx, startTime))
+	assert.Equal(t, []uint64{0}, k.GetStartedIncentiveIDs(ctx, startTime.Add(time.Hour)))
+
+	// ACT: Trigger the BeginBlocker without liquidity providers.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(2 * time.Hour)})
+	k.IncentivesBeginBlocker(ctx)
+
+	// ASSERT: Nothing has been distributed.
+	assert.Equal(t, math.NewInt(100*ONE), bank.Balances[escrowAddress].AmountOf("ufoo"))
+
+	// ARRANGE: Add liquidity for Alice and Bob.
+	for _, user := range []utils.Account{alice, bob} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: user.Address,
+			PoolId: 0,
+			Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+		})
+		assert.NoError(t, err)
+	}
+
+	// ARRANGE: Pause the Pool.
+	err = k.Paused.Set(ctx, 0, true)
+	assert.NoError(t, err)
+
+	// ACT: Trigger the BeginBlocker on the paused Pool.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(3 * time.Hour)})
+	k.IncentivesBeginBlocker(ctx)
+
+	// ASSERT: Nothing has been distributed.
+	assert.Equal(t, math.NewInt(100*ONE), bank.Balances[escrowAddress].AmountOf("ufoo"))
+	err = k.Paused.Set(ctx, 0, false)
+	assert.NoError(t, err)
+
+	// ACT: Trigger the BeginBlocker at half of the incentive period.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(6 * time.Hour)})
+	k.IncentivesBeginBlocker(ctx)
+
+	// ASSERT: Half of the incentive has been streamed to the Pool, including the skipped periods.
+	assert.Equal(t, math.NewInt(50*ONE), bank.Balances[escrowAddress].AmountOf("ufoo"))
+	assert.Equal(t, math.NewInt(50*ONE), bank.Balances[incentivesAddress].AmountOf("ufoo"))
+	incentive, err := k.Incentives.Get(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(50*ONE))), incentive.Distributed)
+
+	// ACT: Alice withdraws her rewards.
+	res, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address})
+
+	// ASSERT: Alice received her share of the streamed incentive.
+	assert.NoError(t, err)
+	assert.InDelta(t, 25*ONE, res.Rewards.AmountOf("ufoo").Int64(), 1)
+
+	// ACT: Trigger the BeginBlocker after the end of the incentive.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(12 * time.Hour)})
+	k.IncentivesBeginBlocker(ctx)
+
+	// ASSERT: The incentive has been fully distributed and removed, along with its indexes.
+	assert.True(t, bank.Balances[escrowAddress].IsZero())
+	assert.Empty(t, k.GetIncentives(ctx))
+	assert.Empty(t, k.GetStartedIncentiveIDs(ctx, startTime.Add(12*time.Hour)))
+	assert.Zero(t, k.GetPoolIncentivesCount(ctx, 0))
+
+	// ACT: Both providers withdraw their rewards.
+	aliceRes, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address})
+	assert.NoError(t, err)
+	bobRes, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: bob.Address})
+	assert.NoError(t, err)
+
+	// ASSERT: The incentive has been split pro-rata to the bonded shares.
+	assert.InDelta(t, 25*ONE, aliceRes.Rewards.AmountOf("ufoo").Int64(), 1)
+	assert.InDelta(t, 50*ONE, bobRes.Rewards.AmountOf("ufoo").Int64(), 1)
+}
+
+func TestIncentivesBeginBlockerOrder(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, tom := utils.TestAccount(), utils.TestAccount()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime})
+
+	// ARRANGE: Create a Pool with a liquidity provider, and multiple incentives ending at the same time.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	bank.Balances[tom.Address] = sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(100*ONE)))
+	for i := 0; i < 8; i++ {
+		_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+			Signer:    tom.Address,
+			PoolId:    0,
+			Amount:    sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(10*ONE))),
+			StartTime: startTime.Add(time.Hour),
+			EndTime:   startTime.Add(2 * time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	// ACT: Trigger the BeginBlocker after the end of the incentives.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(3 * time.Hour)}).WithEventManager(sdk.NewEventManager())
+	k.IncentivesBeginBlocker(ctx)
+
+	// ASSERT: The incentives have been completed in order of their ID.
+	var completed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "noble.swap.v1.IncentiveCompleted" {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == "id" {
+				completed = append(completed, attribute.Value)
+			}
+		}
+	}
+	assert.Equal(t, []string{`"0"`, `"1"`, `"2"`, `"3"`, `"4"`, `"5"`, `"6"`, `"7"`}, completed)
+	assert.Empty(t, k.GetIncentives(ctx))
+}
+
+func TestComputeReleasedIncentive(t *testing.T) {
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	incentive := types.Incentive{
+		Amount:    sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(1_000)), sdk.NewCoin("ubar", math.NewInt(3))),
+		StartTime: startTime,
+		EndTime:   startTime.Add(4 * time.Hour),
+	}
+
+	// ASSERT: Nothing is released before the start.
+	assert.True(t, keeper.ComputeReleasedIncentive(incentive, startTime.Add(-time.Hour)).IsZero())
+	assert.True(t, keeper.ComputeReleasedIncentive(incentive, startTime).IsZero())
+
+	// ASSERT: The amount is released linearly, rounding down.
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(250))), keeper.ComputeReleasedIncentive(incentive, startTime.Add(time.Hour)))
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(750)), sdk.NewCoin("ubar", math.NewInt(2))), keeper.ComputeReleasedIncentive(incentive, startTime.Add(3*time.Hour)))
+
+	// ASSERT: The full amount is released after the end.
+	assert.Equal(t, incentive.Amount, keeper.ComputeReleasedIncentive(incentive, startTime.Add(4*time.Hour)))
+	assert.Equal(t, incentive.Amount, keeper.ComputeReleasedIncentive(incentive, startTime.Add(5*time.Hour)))
+}
+
+func TestProtocolFeesConversionBeginBlocker(t *testing.T) {
+	treasury, bob := utils.TestAccount(), utils.TestAccount()
+
+	setup := func(t *testing.T, treasuryAddress string) (*keeper.Keeper, sdk.Context, mocks.BankKeeper) {
+		account := mocks.AccountKeeper{
+			Accounts: make(map[string]sdk.AccountI),
+		}
+		bank := mocks.BankKeeper{
+			Balances:    make(map[string]sdk.Coins),
+			Restriction: mocks.NoOpSendRestrictionFn,
+		}
+		k, ctx := mocks.SwapKeeperWithFeeConversion(t, account, bank, &modulev1.FeeConversion{
+			BlockDelta:               10,
+			MaxPriceImpactPercentage: 1e4,
+			Treasury:                 treasuryAddress,
+		})
+		server := keeper.NewMsgServer(k)
+		stableswapServer := keeper.NewStableSwapMsgServer(k)
+
+		// ARRANGE: Create a Pool with liquidity.
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  "uusdc",
+			ProtocolFeePercentage: 50,
+			RewardsFee:            1_000_000,
+			InitialA:              100,
+			FutureA:               100,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+			),
+		})
+		require.NoError(t, err)
+		bank.Balances[bob.Address] = sdk.NewCoins(
+			sdk.NewCoin("uusdc", math.NewInt(2_000*ONE)),
+			sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		)
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: bob.Address,
+			PoolId: 0,
+			Amount: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+				sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+			),
+		})
+		require.NoError(t, err)
+
+		// ARRANGE: Generate protocol fees in the pair denom.
+		_, err = server.Swap(ctx, &types.MsgSwap{
+			Signer: bob.Address,
+			Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+			Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+			Min:    sdk.NewCoin("uusdn", math.NewInt(90*ONE)),
+		})
+		require.NoError(t, err)
+
+		return k, ctx, bank
+	}
+	protocolFees := authtypes.NewModuleAddress("swap/pool/0/protocol_fees").String()
+
+	// ARRANGE: Setup a Pool with the conversion proceeds sent to the treasury.
+	k, ctx, bank := setup(t, treasury.Address)
+	fees := bank.Balances[protocolFees].AmountOf("uusdc")
+	require.True(t, fees.IsPositive())
+
+	// ACT: Run the BeginBlocker outside the epoch.
+	executed := k.ProtocolFeesConversionBeginBlocker(ctx)
+
+	// ASSERT: The conversion has not been executed.
+	assert.False(t, executed)
+	assert.Equal(t, fees, bank.Balances[protocolFees].AmountOf("uusdc"))
+
+	// ARRANGE: Pause the Pool.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC)})
+	require.NoError(t, k.SetPaused(ctx, 0, true))
+
+	// ACT: Run the BeginBlocker with the Pool paused.
+	executed = k.ProtocolFeesConversionBeginBlocker(ctx)
+
+	// ASSERT: The paused Pool has been skipped.
+	assert.True(t, executed)
+	assert.Equal(t, fees, bank.Balances[protocolFees].AmountOf("uusdc"))
+
+	// ACT: Run the BeginBlocker with the Pool unpaused.
+	require.NoError(t, k.SetPaused(ctx, 0, false))
+	executed = k.ProtocolFeesConversionBeginBlocker(ctx)
+
+	// ASSERT: The protocol fees have been converted and sent to the treasury.
+	assert.True(t, executed)
+	assert.True(t, bank.Balances[protocolFees].AmountOf("uusdc").LT(fees.QuoRaw(100)))
+	assert.True(t, bank.Balances[treasury.Address].AmountOf("uusdn").GT(fees.MulRaw(99).QuoRaw(100)))
+	assert.True(t, bank.Balances[treasury.Address].AmountOf("uusdc").IsZero())
+
+	// ARRANGE: Accrue protocol fees large enough to move the Pool price.
+	bank.Balances[protocolFees] = bank.Balances[protocolFees].Add(sdk.NewCoin("uusdc", math.NewInt(950*ONE)))
+	treasuryBalance := bank.Balances[treasury.Address].AmountOf("uusdn")
+
+	// ACT: Run the BeginBlocker in the next epoch.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20, Time: time.Date(2020, 1, 1, 0, 2, 0, 0, time.UTC)})
+	executed = k.ProtocolFeesConversionBeginBlocker(ctx)
+
+	// ASSERT: The conversion has been skipped due to the price impact.
+	assert.True(t, executed)
+	assert.True(t, bank.Balances[protocolFees].AmountOf("uusdc").GTE(math.NewInt(950*ONE)))
+	assert.Equal(t, treasuryBalance, bank.Balances[treasury.Address].AmountOf("uusdn"))
+
+	// ARRANGE: Setup a Pool with the conversion proceeds burned.
+	k, ctx, bank = setup(t, "")
+	fees = bank.Balances[protocolFees].AmountOf("uusdc")
+	protocolFeesBase := bank.Balances[protocolFees].AmountOf("uusdn")
+
+	// ACT: Run the BeginBlocker in the epoch.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Date(2020, 1, 1, 0, 1, 0, 0, time.UTC)})
+	executed = k.ProtocolFeesConversionBeginBlocker(ctx)
+
+	// ASSERT: The protocol fees have been converted and burned.
+	assert.True(t, executed)
+	assert.True(t, bank.Balances[protocolFees].AmountOf("uusdc").LT(fees.QuoRaw(100)))
+	assert.Equal(t, protocolFeesBase, bank.Balances[protocolFees].AmountOf("uusdn"))
+	assert.True(t, bank.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+}
@@ -55,6 +55,15 @@ type Controller interface {
 	// IsPaused checks if the pool is currently paused.
 	IsPaused() bool
 
+	// IsWithdrawOnly checks if the liquidity providers of the paused pool can still withdraw.
+	IsWithdrawOnly() bool
+
+	// IsActivityPaused checks if a specific activity of the pool is paused.
+	IsActivityPaused(activity types.PauseActivity) bool
+
+	// IsClosed checks if the pool has been permanently closed.
+	IsClosed() bool
+
 	// GetRates computes exchange rates for tokens in the pool based on liquidity.
 	GetRates(ctx context.Context) []types.Rate
 
@@ -67,16 +76,35 @@ type Controller interface {
 	// GetProtocolFeesAddresses retrieves the addresses where protocol fees are collected.
 	GetProtocolFeesAddresses() []sdk.AccAddress
 
+	// GetRewardsFeesAddress retrieves the address where the providers rewards are collected.
+	GetRewardsFeesAddress() sdk.AccAddress
+
+	// GetIncentivesAddress retrieves the address where the streamed incentives are collected.
+	GetIncentivesAddress() sdk.AccAddress
+
+	// GetTotalRewardWeight retrieves the total weight across which rewards and incentives are distributed.
+	GetTotalRewardWeight() math.LegacyDec
+
 	// Swap performs a coin swap within a specified pool and its underlying algorithm.
 	Swap(
 		ctx context.Context,
 		currentTime int64,
 		coin sdk.Coin,
 		denomTo string,
+		feeDiscount math.LegacyDec,
 	) (*types.SwapCommitment, error)
 
-	// ProcessUserRewards distributes rewards to a user.
-	ProcessUserRewards(ctx context.Context, address string, currentTime time.Time) (sdk.Coins, error)
+	// ProcessUserRewards distributes the rewards of a user to the receiver address.
+	ProcessUserRewards(ctx context.Context, address string, receiver string, currentTime time.Time) (sdk.Coins, error)
+
+	// DepositRewards accounts the rewards collected by the pool to its liquidity providers.
+	DepositRewards(ctx context.Context, amount sdk.Coins) error
+
+	// DepositIncentives accounts the incentives streamed to the pool to its liquidity providers.
+	DepositIncentives(ctx context.Context, amount sdk.Coins) error
+
+	// ClosePool settles every liquidity provider of the pool and removes all of its positions.
+	ClosePool(ctx context.Context, currentTime time.Time) ([]types.PositionSettlement, error)
 }
 
 // GetGenericController initializes and returns the Generic Controller for the specified Pool ID.
@@ -43,8 +43,10 @@ func GetStableSwapController(ctx context.Context, keeper *Keeper, poolId uint64)
 		return nil, err
 	}
 
-	// Retrieve if the Pool is paused.
+	// Retrieve if the Pool is paused, if its providers can still withdraw, and its paused activities.
 	paused := keeper.IsPaused(ctx, poolId)
+	withdrawOnly := keeper.IsWithdrawOnly(ctx, poolId)
+	pauseFlags := keeper.GetPoolPauseFlags(ctx, poolId)
 
 	// Retrieve the `StableSwap` Pool.
 	stableswapPool, err := keeper.Stableswap.GetPool(ctx, pool.Id)
@@ -53,7 +55,7 @@ func GetStableSwapController(ctx context.Context, keeper *Keeper, poolId uint64)
 	}
 
 	// Create and return the `StableSwap` StableswapController.
-	stableswapController := stableswap.NewController(&keeper.bankKeeper, &keeper.addressCodec, keeper.baseDenom, &pool, paused, &stableswapPool, keeper.Stableswap)
+	stableswapController := stableswap.NewController(&keeper.bankKeeper, &keeper.addressCodec, keeper.baseDenom, &pool, paused, withdrawOnly, pauseFlags, &stableswapPool, keeper.Stableswap, keeper.GetWithdrawAddress)
 	return &stableswapController, nil
 }
 
@@ -22,6 +22,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -31,8 +32,10 @@ import (
 	"cosmossdk.io/core/store"
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	modulev1 "swap.noble.xyz/api/module/v1"
 	"swap.noble.xyz/keeper/stableswap"
@@ -40,11 +43,12 @@ import (
 )
 
 type Keeper struct {
-	authority                         string
-	baseDenom                         string
-	baseMinimumDeposit                int64
-	maxAddLiquiditySlippagePercentage int64
-	stableswapConfig                  *modulev1.StableSwap
+	authority           string
+	baseDenom           string
+	defaultParams       types.Params
+	maxAffiliateFeeBps  int64
+	stableswapConfig    *modulev1.StableSwap
+	feeConversionConfig *modulev1.FeeConversion
 
 	eventService  event.Service
 	headerService header.Service
@@ -58,15 +62,64 @@ type Keeper struct {
 	// Paused tracks the paused state of pools, mapped by their unique pool ID (uint64).
 	Paused collections.Map[uint64, bool]
 
+	// WithdrawOnly stores the IDs of the paused pools whose liquidity providers can still withdraw.
+	WithdrawOnlyPools collections.KeySet[uint64]
+
+	// PauseFlags stores the bitmask of the activities paused in pools, mapped by their unique pool ID (uint64).
+	PauseFlags collections.Map[uint64, uint32]
+
+	// DenomRegistry stores the denoms allowed as pool pairs, along with their metadata, mapped by denom.
+	DenomRegistry collections.Map[string, types.AllowedDenom]
+
+	// DenomBlocklist stores the denoms blocked from the swaps across all the pools.
+	DenomBlocklist collections.KeySet[string]
+
 	// Pools stores the generic pools, mapped by their unique pool ID (uint64).
 	Pools collections.Map[uint64, types.Pool]
 
+	// NextIncentiveID generates and keeps track of the next available unique incentive ID.
+	NextIncentiveID collections.Sequence
+
+	// Incentives stores the active incentive programs, mapped by their unique incentive ID (uint64).
+	Incentives collections.Map[uint64, types.Incentive]
+	// IncentiveStarts indexes the active incentive programs by their start time (int64) and ID (uint64).
+	IncentiveStarts collections.KeySet[collections.Pair[int64, uint64]]
+	// PoolIncentives indexes the active incentive programs by their pool ID (uint64) and ID (uint64).
+	PoolIncentives collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// WithdrawAddresses stores the addresses receiving the rewards of the providers, mapped by the provider address.
+	WithdrawAddresses collections.Map[string, string]
+
+	// FeeTiers stores the swap fee discount tiers based on the accounts rolling volume.
+	FeeTiers collections.Item[types.FeeTiers]
+
+	// FeeExempt stores the addresses of the accounts exempt from the swap fees.
+	FeeExempt collections.KeySet[string]
+
+	// SwapVolumes stores the daily swap volume in base denom, mapped by the account address and the day.
+	SwapVolumes collections.Map[collections.Pair[string, int64], math.Int]
+
+	// Params stores the module params, which default to the values of the module config when unset.
+	Params collections.Item[types.Params]
+
+	// Roles stores the roles granted by the authority, mapped by the address and the role.
+	Roles collections.KeySet[collections.Pair[string, int32]]
+
+	// CurrentAuthority stores the module authority, which defaults to the value of the module config when unset.
+	CurrentAuthority collections.Item[string]
+
+	// PendingAuthority stores the authority proposed by the current one, until it accepts the handover.
+	PendingAuthority collections.Item[string]
+
 	// Stableswap is the sub-keeper responsible for managing StableSwap-specific functionalities.
 	Stableswap *stableswap.Keeper
 
 	addressCodec  address.Codec
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+
+	// distributionKeeper is optional, and is required only by the community pool fee splits.
+	distributionKeeper types.DistributionKeeper
 }
 
 func NewKeeper(
@@ -79,7 +132,9 @@ func NewKeeper(
 	pairDenom string,
 	baseMinimumDeposit int64,
 	maxAddLiquiditySlippagePercentage int64,
+	maxAffiliateFeeBps int64,
 	stableswapConfig *modulev1.StableSwap,
+	feeConversionConfig *modulev1.FeeConversion,
 	addressCodec address.Codec,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -87,11 +142,16 @@ func NewKeeper(
 	builder := collections.NewSchemaBuilder(storeService)
 
 	keeper := &Keeper{
-		authority:                         authority,
-		baseDenom:                         pairDenom,
-		baseMinimumDeposit:                baseMinimumDeposit,
-		maxAddLiquiditySlippagePercentage: maxAddLiquiditySlippagePercentage,
-		stableswapConfig:                  stableswapConfig,
+		authority: authority,
+		baseDenom: pairDenom,
+		defaultParams: types.Params{
+			BaseMinimumDeposit:                baseMinimumDeposit,
+			MaxAddLiquiditySlippagePercentage: maxAddLiquiditySlippagePercentage,
+			UnbondingBlockDelta:               stableswapConfig.GetUnbondingBlockDelta(),
+		},
+		maxAffiliateFeeBps:  maxAffiliateFeeBps,
+		stableswapConfig:    stableswapConfig,
+		feeConversionConfig: feeConversionConfig,
 
 		eventService:  eventService,
 		headerService: headerService,
@@ -101,6 +161,29 @@ func NewKeeper(
 		Paused:     collections.NewMap(builder, types.PausedPrefix, "paused", collections.Uint64Key, collections.BoolValue),
 		Pools:      collections.NewMap(builder, types.PoolsPrefix, "pools_generic", collections.Uint64Key, codec.CollValue[types.Pool](cdc)),
 
+		WithdrawOnlyPools: collections.NewKeySet(builder, types.WithdrawOnlyPrefix, "withdraw_only", collections.Uint64Key),
+		PauseFlags:        collections.NewMap(builder, types.PauseFlagsPrefix, "pause_flags", collections.Uint64Key, collections.Uint32Value),
+
+		DenomRegistry:  collections.NewMap(builder, types.DenomRegistryPrefix, "denom_registry", collections.StringKey, codec.CollValue[types.AllowedDenom](cdc)),
+		DenomBlocklist: collections.NewKeySet(builder, types.DenomBlocklistPrefix, "denom_blocklist", collections.StringKey),
+
+		NextIncentiveID: collections.NewSequence(builder, types.NextIncentiveIDPrefix, "next_incentive_id"),
+		Incentives:      collections.NewMap(builder, types.IncentivesPrefix, "incentives", collections.Uint64Key, codec.CollValue[types.Incentive](cdc)),
+		IncentiveStarts: collections.NewKeySet(builder, types.IncentiveStartsPrefix, "incentive_starts", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		PoolIncentives:  collections.NewKeySet(builder, types.PoolIncentivesPrefix, "pool_incentives", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+
+		WithdrawAddresses: collections.NewMap(builder, types.WithdrawAddressPrefix, "withdraw_addresses", collections.StringKey, collections.StringValue),
+
+		FeeTiers:    collections.NewItem(builder, types.FeeTiersPrefix, "fee_tiers", codec.CollValue[types.FeeTiers](cdc)),
+		FeeExempt:   collections.NewKeySet(builder, types.FeeExemptPrefix, "fee_exempt", collections.StringKey),
+		SwapVolumes: collections.NewMap(builder, types.SwapVolumesPrefix, "swap_volumes", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), sdk.IntValue),
+
+		Params: collections.NewItem(builder, types.ParamsPrefix, "params", codec.CollValue[types.Params](cdc)),
+		Roles:  collections.NewKeySet(builder, types.RolesPrefix, "roles", collections.PairKeyCodec(collections.StringKey, collections.Int32Key)),
+
+		CurrentAuthority: collections.NewItem(builder, types.AuthorityKey, "authority", collections.StringValue),
+		PendingAuthority: collections.NewItem(builder, types.PendingAuthorityKey, "pending_authority", collections.StringValue),
+
 		Stableswap: stableswap.NewKeeper(cdc, storeService, eventService, headerService, logger),
 
 		addressCodec:  addressCodec,
@@ -122,10 +205,24 @@ func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) {
 	k.bankKeeper = bankKeeper
 }
 
+// SetDistributionKeeper sets the distribution keeper used to fund the community pool.
+func (k *Keeper) SetDistributionKeeper(distributionKeeper types.DistributionKeeper) {
+	k.distributionKeeper = distributionKeeper
+}
+
 func (k *Keeper) Logger() log.Logger {
 	return k.logger.With("module", types.ModuleName)
 }
 
+// checkRole ensures that the signer is either the authority, or an address that has been granted the role.
+func (k *Keeper) checkRole(ctx context.Context, signer string, role types.Role) error {
+	authority := k.GetAuthority(ctx)
+	if signer == authority || k.HasRole(ctx, signer, role) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, signer)
+}
+
 // Swap processes a token swap request, validates the message, and executes the swap routes,
 // ensuring all conditions are met, including balances, slippage limits, and pool states.
 func (k *Keeper) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
@@ -140,6 +237,19 @@ func (k *Keeper) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapRe
 		return nil, err
 	}
 
+	// Validate the optional affiliate fee.
+	var affiliateAddress []byte
+	if msg.Affiliate != "" {
+		if affiliateAddress, err = k.addressCodec.StringToBytes(msg.Affiliate); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAffiliate, "invalid affiliate address: %s", msg.Affiliate)
+		}
+		if msg.AffiliateFeeBps <= 0 || msg.AffiliateFeeBps > k.maxAffiliateFeeBps {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAffiliate, "affiliate fee must be between 1 and %d bps, got %d", k.maxAffiliateFeeBps, msg.AffiliateFeeBps)
+		}
+	} else if msg.AffiliateFeeBps != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAffiliate, "affiliate fee of %d bps requires an affiliate", msg.AffiliateFeeBps)
+	}
+
 	// Check if the user has a balance >= than the requested swap amount.
 	userBalance := k.bankKeeper.GetBalance(ctx, userAddress, msg.Amount.Denom)
 	if userBalance.Amount.LT(msg.Amount.Amount) {
@@ -156,14 +266,20 @@ func (k *Keeper) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapRe
 		return nil, fmt.Errorf("error computing swap routes plan: %s", err.Error())
 	}
 
-	// Verify slippage limits.
+	// Compute the affiliate cut of the output, and verify slippage limits on the remaining amount.
 	out := swapRoutesPlan.Swaps[len(swapRoutesPlan.Swaps)-1].Commitment.Out
+	var affiliateFee sdk.Coin
+	if affiliateAddress != nil {
+		affiliateFee = sdk.NewCoin(out.Denom, out.Amount.MulRaw(msg.AffiliateFeeBps).QuoRaw(1e4))
+		out = out.Sub(affiliateFee)
+	}
 	if out.IsLT(msg.Min) {
 		return nil, fmt.Errorf("%s is less then min amount %s", out.String(), msg.Min.String())
 	}
 
 	// Commit the plan.
 	var executedSwaps []*types.Swap
+	volume, volumeCounted := math.ZeroInt(), false
 	for _, swap := range swapRoutesPlan.Swaps {
 		poolAddr, err := k.addressCodec.StringToBytes(swap.PoolAddress)
 		if err != nil {
@@ -176,31 +292,219 @@ func (k *Keeper) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapRe
 			return nil, sdkerrors.Wrap(err, "unable to transfer from provider to pool")
 		}
 
+		// Retrieve the Pool Controller, in order to account the rewards fees to the liquidity providers.
+		controller, err := GetGenericController(ctx, k, swap.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
 		totalFees := sdk.Coins{}
 		for _, fee := range swap.Commitment.Fees {
 			totalFees = totalFees.Add(fee.Amount)
+			if fee.Name != "" {
+				// Distribute the fee split to its recipient.
+				if err := k.distributeFee(ctx, swap.PoolId, poolAddr, fee); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			if err := k.bankKeeper.SendCoins(ctx, poolAddr, fee.Address.Bytes(), sdk.NewCoins(fee.Amount)); err != nil {
 				return nil, sdkerrors.Wrap(err, "unable to transfer from provider to pool")
 			}
+			if fee.Address.Equals(controller.GetRewardsFeesAddress()) {
+				if err := controller.DepositRewards(ctx, sdk.NewCoins(fee.Amount)); err != nil {
+					return nil, sdkerrors.Wrap(err, "unable to deposit rewards")
+				}
+			}
 		}
 		executedSwaps = append(executedSwaps, &types.Swap{
-			PoolId: swap.PoolId,
-			In:     swap.Commitment.In,
-			Out:    swap.Commitment.Out,
-			Fees:   totalFees,
+			PoolId:     swap.PoolId,
+			In:         swap.Commitment.In,
+			Out:        swap.Commitment.Out,
+			Fees:       totalFees,
+			RewardsFee: swap.Commitment.RewardsFee,
 		})
+
+		// Account the base denom side of the first swap to the signer volume, so that the
+		// multi-hop routes going through the base denom are only counted once.
+		if !volumeCounted {
+			switch k.baseDenom {
+			case swap.Commitment.In.Denom:
+				volume, volumeCounted = swap.Commitment.In.Amount, true
+			case swap.Commitment.Out.Denom:
+				volume, volumeCounted = swap.Commitment.Out.Amount, true
+			}
+		}
+	}
+
+	// Pay the affiliate cut of the output.
+	if affiliateAddress != nil && affiliateFee.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, userAddress, affiliateAddress, sdk.NewCoins(affiliateFee)); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to transfer affiliate fee")
+		}
+	}
+
+	// Update the signer rolling volume, used to compute the fee discount tier.
+	if err := k.updateSwapVolume(ctx, msg.Signer, volume, k.headerService.GetHeaderInfo(ctx).Time.Unix()); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgSwapResponse{
-		Result: swapRoutesPlan.Swaps[len(swapRoutesPlan.Swaps)-1].Commitment.Out,
-		Swaps:  executedSwaps,
+		Result:       out,
+		Swaps:        executedSwaps,
+		AffiliateFee: affiliateFee,
 	}, nil
 }
 
+// secondsPerDay is the duration of the days in which the swap volumes are tracked.
+const secondsPerDay = 24 * 60 * 60
+
+// GetRollingSwapVolume returns the swap volume of an account over the rolling window ending at the given time.
+func (k *Keeper) GetRollingSwapVolume(ctx context.Context, address string, timestamp int64) math.Int {
+	volume := math.ZeroInt()
+	for _, amount := range k.GetSwapVolumesByAccount(ctx, address, timestamp/secondsPerDay-types.VolumeWindowDays+1) {
+		volume = volume.Add(amount)
+	}
+	return volume
+}
+
+// GetFeeDiscount returns the discount applied to the swap fees of an account, which is full for the
+// exempt accounts, and the one of the highest tier reached by the rolling volume otherwise.
+func (k *Keeper) GetFeeDiscount(ctx context.Context, address string, timestamp int64) math.LegacyDec {
+	if k.IsFeeExempt(ctx, address) {
+		return math.LegacyOneDec()
+	}
+
+	discount := math.LegacyZeroDec()
+	tiers := k.GetFeeTiers(ctx)
+	if len(tiers) == 0 {
+		return discount
+	}
+	volume := k.GetRollingSwapVolume(ctx, address, timestamp)
+	for _, tier := range tiers {
+		if volume.GTE(tier.MinVolume) {
+			discount = tier.Discount
+		}
+	}
+	return discount
+}
+
+// updateSwapVolume adds the amount to the account volume of the current day, removing the days
+// that are outside of the rolling window. The volume of the exempt accounts is not tracked.
+func (k *Keeper) updateSwapVolume(ctx context.Context, address string, amount math.Int, timestamp int64) error {
+	if !amount.IsPositive() || k.IsFeeExempt(ctx, address) {
+		return nil
+	}
+	day := timestamp / secondsPerDay
+
+	// Remove the expired days.
+	iter, err := k.SwapVolumes.Iterate(ctx, collections.NewPrefixedPairRange[string, int64](address).EndExclusive(day-types.VolumeWindowDays+1))
+	if err != nil {
+		return err
+	}
+	expired, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err = k.RemoveSwapVolume(ctx, address, key.K2()); err != nil {
+			return err
+		}
+	}
+
+	// Add the amount to the current day.
+	current, err := k.SwapVolumes.Get(ctx, collections.Join(address, day))
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		current = math.ZeroInt()
+	}
+	return k.SetSwapVolume(ctx, address, day, current.Add(amount))
+}
+
+// distributionModuleName is the name of the module account holding the community pool funds.
+const distributionModuleName = "distribution"
+
+// distributeFee delivers a fee split from the pool to its recipient, emitting the related event.
+func (k *Keeper) distributeFee(ctx context.Context, poolId uint64, poolAddr sdk.AccAddress, fee types.Receiver) error {
+	if !fee.Amount.IsPositive() {
+		return nil
+	}
+	amount := sdk.NewCoins(fee.Amount)
+
+	recipient := fee.Address
+	switch fee.Kind {
+	case types.ReceiverBurn:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, poolAddr, types.ModuleName, amount); err != nil {
+			return sdkerrors.Wrapf(err, "unable to transfer fee split %s to module", fee.Name)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+			return sdkerrors.Wrapf(err, "unable to burn fee split %s", fee.Name)
+		}
+	case types.ReceiverCommunityPool:
+		if k.distributionKeeper == nil {
+			return fmt.Errorf("unable to fund community pool with fee split %s: distribution keeper not set", fee.Name)
+		}
+		if err := k.distributionKeeper.FundCommunityPool(ctx, amount, poolAddr); err != nil {
+			return sdkerrors.Wrapf(err, "unable to fund community pool with fee split %s", fee.Name)
+		}
+		recipient = authtypes.NewModuleAddress(distributionModuleName)
+	default:
+		if err := k.bankKeeper.SendCoins(ctx, poolAddr, fee.Address, amount); err != nil {
+			return sdkerrors.Wrapf(err, "unable to transfer fee split %s", fee.Name)
+		}
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.FeesDistributed{
+		PoolId:    poolId,
+		Name:      fee.Name,
+		Recipient: recipient.String(),
+		Amount:    amount,
+	})
+}
+
+// collectCreationFee transfers a Pool or incentive creation fee from the creator, either funding the community pool or burning it.
+func (k *Keeper) collectCreationFee(ctx context.Context, signer string, creationFee sdk.Coins, fundCommunityPool bool) error {
+	if creationFee.IsZero() {
+		return nil
+	}
+	creator, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return fmt.Errorf("unable to decode creator address: %s", signer)
+	}
+
+	if fundCommunityPool {
+		if k.distributionKeeper == nil {
+			return fmt.Errorf("unable to fund community pool with creation fee: distribution keeper not set")
+		}
+		if err := k.distributionKeeper.FundCommunityPool(ctx, creationFee, creator); err != nil {
+			return sdkerrors.Wrap(err, "unable to fund community pool with creation fee")
+		}
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, creationFee); err != nil {
+		return sdkerrors.Wrap(err, "unable to transfer creation fee to module")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, creationFee); err != nil {
+		return sdkerrors.Wrap(err, "unable to burn creation fee")
+	}
+	return nil
+}
+
 // PrepareSwapPlan prepares a swap route plan from the swap message, containing the details for its execution.
 func (k *Keeper) PrepareSwapPlan(ctx context.Context, msg *types.MsgSwap, timestamp int64, s *Keeper) (*types.PlanSwapRoutes, error) {
 	var swaps []types.PlanSwapRoute
 
+	// Retrieve the fee discount of the signer.
+	feeDiscount := s.GetFeeDiscount(ctx, msg.Signer, timestamp)
+
+	// Ensure that the swapped denom is not blocked.
+	if s.IsDenomBlocked(ctx, msg.Amount.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomBlocked, "%s is blocked", msg.Amount.Denom)
+	}
+
 	swapIn := msg.Amount // Initial swap amount.
 	for _, route := range msg.Routes {
 		// Retrieve the Pool StableswapController for the requested Pool.
@@ -209,11 +513,16 @@ func (k *Keeper) PrepareSwapPlan(ctx context.Context, msg *types.MsgSwap, timest
 			return nil, err
 		}
 
-		// Ensure that the Pool is not paused from execution.
-		if controller.IsPaused() {
+		// Ensure that the Pool swaps are not paused from execution.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_SWAPS) {
 			return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", controller.GetId())
 		}
 
+		// Ensure that the output denom is not blocked.
+		if s.IsDenomBlocked(ctx, route.DenomTo) {
+			return nil, sdkerrors.Wrapf(types.ErrDenomBlocked, "%s is blocked", route.DenomTo)
+		}
+
 		// Early check that from/to denoms are different.
 		if swapIn.Denom == route.DenomTo {
 			return nil, sdkerrors.Wrapf(
@@ -236,7 +545,7 @@ func (k *Keeper) PrepareSwapPlan(ctx context.Context, msg *types.MsgSwap, timest
 		}
 
 		// Compute the Swap result.
-		swapRes, err := controller.Swap(ctx, timestamp, swapIn, route.DenomTo)
+		swapRes, err := controller.Swap(ctx, timestamp, swapIn, route.DenomTo, feeDiscount)
 		if err != nil {
 			return nil, err
 		}
@@ -52,7 +52,9 @@ func TestNewKeeper(t *testing.T) {
 			"uusdn",
 			1e6,
 			0.5e4,
+			100,
 			&modulev1.StableSwap{},
+			nil,
 			address.NewBech32Codec("noble"),
 			mocks.AccountKeeper{},
 			mocks.BankKeeper{},
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"github.com/gogo/protobuf/sortkeys"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles the in-place store migrations of the module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator for the given Keeper.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from consensus version 1 to 2. The rewards previously held in the
+// pools rewards fees addresses were distributed by time-weight, they are now deposited into the cumulative
+// reward per share of their pool, so that the liquidity providers can still claim them.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var poolIds []uint64
+	for poolId := range m.keeper.GetPools(ctx) {
+		poolIds = append(poolIds, poolId)
+	}
+	sortkeys.Uint64s(poolIds)
+
+	for _, poolId := range poolIds {
+		controller, err := GetGenericController(ctx, m.keeper, poolId)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "unable to get controller of pool %d", poolId)
+		}
+
+		rewards := m.keeper.bankKeeper.GetAllBalances(ctx, controller.GetRewardsFeesAddress())
+		if err = controller.DepositRewards(ctx, rewards); err != nil {
+			return sdkerrors.Wrapf(err, "unable to deposit rewards of pool %d", poolId)
+		}
+	}
+
+	return nil
+}
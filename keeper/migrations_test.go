@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"swap.noble.xyz/keeper"
+	"swap.noble.xyz/types"
+	"swap.noble.xyz/types/stableswap"
+	"swap.noble.xyz/utils"
+	"swap.noble.xyz/utils/mocks"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, alice := utils.TestAccount(), utils.TestAccount()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	// ARRANGE: Create two Pools, and add liquidity for Bob and Alice only to the first one.
+	for _, pair := range []string{"uusdc", "ueure"} {
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  pair,
+			ProtocolFeePercentage: 1,
+			RewardsFee:            4e3,
+			InitialA:              100,
+			FutureA:               100,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin(pair, math.NewInt(1000000000000000000)),
+			),
+		})
+		require.NoError(t, err)
+	}
+	amount := sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	for _, user := range []utils.Account{bob, alice} {
+		bank.Balances[user.Address] = amount
+		_, err := stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: user.Address,
+			PoolId: 0,
+			Amount: amount,
+		})
+		require.NoError(t, err)
+	}
+
+	// ARRANGE: Fund the Pools rewards fees addresses, as collected before the reward per share accumulator.
+	rewardsAddress := func(poolId uint64) string {
+		return authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, poolId)).String()
+	}
+	bank.Balances[rewardsAddress(0)] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(4*ONE)))
+	bank.Balances[rewardsAddress(1)] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE)))
+
+	// ACT: Migrate the state from version 1 to 2.
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	// ASSERT: The first Pool rewards have been deposited, and can be claimed by Bob and Alice.
+	pool, err := k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdn", math.LegacyMustNewDecFromStr("0.01"))), pool.RewardPerShare)
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	require.NoError(t, err)
+	for _, user := range []utils.Account{bob, alice} {
+		rewards := controller.GetTotalPoolUserRewards(ctx, user.Address)
+		require.Equal(t, math.NewInt(2*ONE), rewards[0].Amount.AmountOf("uusdn"))
+	}
+
+	// ASSERT: The second Pool rewards are pending, as it has no shares.
+	pool, err = k.Stableswap.GetPool(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, pool.RewardPerShare.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE))), pool.PendingRewards)
+
+	// ARRANGE: Add liquidity for Bob to the second Pool.
+	amount = sdk.NewCoins(sdk.NewCoin("ueure", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	bank.Balances[bob.Address] = amount
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 1,
+		Amount: amount,
+	})
+	require.NoError(t, err)
+
+	// ACT: Deposit new rewards in the second Pool.
+	bank.Balances[rewardsAddress(1)] = bank.Balances[rewardsAddress(1)].Add(sdk.NewCoin("uusdn", math.NewInt(ONE)))
+	controller, err = keeper.GetStableSwapController(ctx, k, 1)
+	require.NoError(t, err)
+	err = controller.DepositRewards(ctx, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE))))
+	require.NoError(t, err)
+
+	// ASSERT: The pending rewards have been credited to Bob along with the new ones.
+	pool, err = k.Stableswap.GetPool(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, pool.PendingRewards)
+	rewards := controller.GetTotalPoolUserRewards(ctx, bob.Address)
+	require.Equal(t, math.NewInt(2*ONE), rewards[0].Amount.AmountOf("uusdn"))
+}
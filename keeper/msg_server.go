@@ -27,6 +27,7 @@ import (
 	"github.com/gogo/protobuf/sortkeys"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"swap.noble.xyz/types"
@@ -62,14 +63,17 @@ func (s msgServer) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwap
 		Output: result.Result,
 		Routes: msg.Routes,
 		Fees:   fees,
+
+		Affiliate:    msg.Affiliate,
+		AffiliateFee: result.AffiliateFee,
 	})
 }
 
 // PauseByAlgorithm pauses all pools using a specific algorithm.
 func (s msgServer) PauseByAlgorithm(ctx context.Context, msg *types.MsgPauseByAlgorithm) (*types.MsgPauseByAlgorithmResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
 	}
 
 	// Iterate through the pools and collect the pools to pause.
@@ -102,9 +106,9 @@ func (s msgServer) PauseByAlgorithm(ctx context.Context, msg *types.MsgPauseByAl
 
 // PauseByPoolIds pauses specific pools identified by their pool IDs.
 func (s msgServer) PauseByPoolIds(ctx context.Context, msg *types.MsgPauseByPoolIds) (*types.MsgPauseByPoolIdsResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
 	}
 
 	// Iterate and pause all the wanted pools.
@@ -129,15 +133,15 @@ func (s msgServer) PauseByPoolIds(ctx context.Context, msg *types.MsgPauseByPool
 
 // UnpauseByAlgorithm unpauses all pools using a specific algorithm.
 func (s msgServer) UnpauseByAlgorithm(ctx context.Context, msg *types.MsgUnpauseByAlgorithm) (*types.MsgUnpauseByAlgorithmResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
 	}
 
 	// Iterate through the pools and collect the pools to unpause.
 	var poolsToUnpause []uint64 // store the pools to unpause
 	for poolId, pool := range s.GetPools(ctx) {
-		if msg.Algorithm != types.UNSPECIFIED && pool.Algorithm == msg.Algorithm {
+		if msg.Algorithm != types.UNSPECIFIED && pool.Algorithm == msg.Algorithm && !pool.Closed {
 			poolsToUnpause = append(poolsToUnpause, poolId)
 		}
 	}
@@ -150,6 +154,9 @@ func (s msgServer) UnpauseByAlgorithm(ctx context.Context, msg *types.MsgUnpause
 			if err != nil {
 				return nil, sdkerrors.Wrap(err, "unable to unpause pool")
 			}
+			if err := s.SetPoolWithdrawOnly(ctx, poolId, false); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to disable withdraw-only mode")
+			}
 			unpausedPools = append(unpausedPools, poolId)
 		}
 	}
@@ -164,19 +171,22 @@ func (s msgServer) UnpauseByAlgorithm(ctx context.Context, msg *types.MsgUnpause
 
 // UnpauseByPoolIds unpauses specific pools identified by their pool IDs.
 func (s msgServer) UnpauseByPoolIds(ctx context.Context, msg *types.MsgUnpauseByPoolIds) (*types.MsgUnpauseByPoolIdsResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
 	}
 
 	// Iterate and unpause all the wanted pools.
 	var unpausedPools []uint64
 	for _, poolId := range msg.PoolIds {
-		if s.HasPool(ctx, poolId) {
+		if pool, err := s.GetPool(ctx, poolId); err == nil && !pool.Closed {
 			err := s.SetPaused(ctx, poolId, false) // set false to unpause
 			if err != nil {
 				return nil, sdkerrors.Wrap(err, "unable to unpause pool")
 			}
+			if err := s.SetPoolWithdrawOnly(ctx, poolId, false); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to disable withdraw-only mode")
+			}
 			unpausedPools = append(unpausedPools, poolId)
 		}
 	}
@@ -191,9 +201,9 @@ func (s msgServer) UnpauseByPoolIds(ctx context.Context, msg *types.MsgUnpauseBy
 
 // WithdrawProtocolFees allows the protocol to withdraw accumulated fees and move them to another account.
 func (s msgServer) WithdrawProtocolFees(ctx context.Context, msg *types.MsgWithdrawProtocolFees) (*types.MsgWithdrawProtocolFeesResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_FEE_COLLECTOR); err != nil {
+		return nil, err
 	}
 
 	// Ensure that the receiver is a valid address.
@@ -202,38 +212,125 @@ func (s msgServer) WithdrawProtocolFees(ctx context.Context, msg *types.MsgWithd
 		return nil, fmt.Errorf("unable to decode receiver address: %s", msg.To)
 	}
 
-	// Collect all the Pools protocol fees addresses and amounts.
-	var poolsProtocolFeesAddresses []sdk.AccAddress
-	for poolId := range s.GetPools(ctx) {
+	// Ensure that the denoms and amount filters are valid.
+	if len(msg.Denoms) > 0 && !msg.Amount.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "denoms and amount cannot be both set")
+	}
+	denoms := make(map[string]bool)
+	for _, denom := range msg.Denoms {
+		if err = sdk.ValidateDenom(denom); err != nil || denoms[denom] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "invalid or duplicated denom %s", denom)
+		}
+		denoms[denom] = true
+	}
+	if !msg.Amount.Empty() && (msg.Amount.Validate() != nil || !msg.Amount.IsAllPositive()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "invalid amount %s", msg.Amount.String())
+	}
+	if !msg.Amount.Empty() && len(msg.PoolIds) != 1 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "amount requires exactly one pool id, got %d", len(msg.PoolIds))
+	}
+
+	// Select the Pools to withdraw from, defaulting to all of them.
+	poolIds := msg.PoolIds
+	explicit := len(poolIds) > 0
+	if explicit {
+		seen := make(map[uint64]bool)
+		for _, poolId := range poolIds {
+			if seen[poolId] {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is duplicated", poolId)
+			}
+			seen[poolId] = true
+		}
+	} else {
+		for poolId := range s.GetPools(ctx) {
+			poolIds = append(poolIds, poolId)
+		}
+		sortkeys.Uint64s(poolIds)
+	}
+
+	// Send the selected amounts of each Pool to the provided address.
+	rewards := sdk.Coins{}
+	withdrawn := []types.PoolProtocolFees{}
+	for _, poolId := range poolIds {
 		controller, err := GetGenericController(ctx, s.Keeper, poolId)
 		if err != nil {
+			if explicit {
+				return nil, err
+			}
 			continue
 		}
 
-		// Skip processing if the pool is paused.
-		if controller.IsPaused() {
+		// Skip processing if the pool is paused, unless explicitly included.
+		if controller.IsPaused() && !msg.IncludePaused {
+			if explicit {
+				return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", poolId)
+			}
 			continue
 		}
 
-		poolsProtocolFeesAddresses = append(poolsProtocolFeesAddresses, controller.GetProtocolFeesAddresses()...)
-	}
+		// Ensure that the Pool holds the whole requested amount.
+		senders := controller.GetProtocolFeesAddresses()
+		if !msg.Amount.Empty() {
+			balances := sdk.Coins{}
+			for _, poolSender := range senders {
+				balances = balances.Add(s.bankKeeper.GetAllBalances(ctx, poolSender)...)
+			}
+			if !balances.IsAllGTE(msg.Amount) {
+				return nil, sdkerrors.Wrapf(
+					types.ErrInsufficientBalance,
+					"pool %d protocol fees %s are smaller than %s", poolId, balances.String(), msg.Amount.String(),
+				)
+			}
+		}
 
-	// Send all the collected amounts to the provided address.
-	rewards := sdk.Coins{}
-	for _, poolSender := range poolsProtocolFeesAddresses {
-		balances := s.bankKeeper.GetAllBalances(ctx, poolSender)
-		if err = s.bankKeeper.SendCoins(ctx, poolSender, receiver, balances); err != nil {
-			return nil, err
+		poolFees := sdk.Coins{}
+		remaining := msg.Amount
+		for _, poolSender := range senders {
+			amount := protocolFeesToWithdraw(s.bankKeeper.GetAllBalances(ctx, poolSender), msg, remaining)
+			if amount.IsZero() {
+				continue
+			}
+			if err = s.bankKeeper.SendCoins(ctx, poolSender, receiver, amount); err != nil {
+				return nil, err
+			}
+			poolFees = poolFees.Add(amount...)
+			if !msg.Amount.Empty() {
+				remaining = remaining.Sub(amount...)
+			}
+		}
+
+		if !poolFees.IsZero() {
+			rewards = rewards.Add(poolFees...)
+			withdrawn = append(withdrawn, types.PoolProtocolFees{PoolId: poolId, Amount: poolFees})
 		}
-		rewards = append(rewards, balances...)
 	}
 
-	return &types.MsgWithdrawProtocolFeesResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawnProtocolFees{
+	return &types.MsgWithdrawProtocolFeesResponse{Withdrawn: withdrawn}, s.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawnProtocolFees{
 		To:      msg.To,
 		Rewards: rewards,
+		Pools:   withdrawn,
 	})
 }
 
+// protocolFeesToWithdraw returns the portion of the balances to withdraw, which is capped to the remaining
+// requested amount when set, or restricted to the requested denoms when set.
+func protocolFeesToWithdraw(balances sdk.Coins, msg *types.MsgWithdrawProtocolFees, remaining sdk.Coins) sdk.Coins {
+	amount := sdk.Coins{}
+	switch {
+	case !msg.Amount.Empty():
+		for _, coin := range remaining {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, math.MinInt(coin.Amount, balances.AmountOf(coin.Denom))))
+		}
+	case len(msg.Denoms) > 0:
+		for _, denom := range msg.Denoms {
+			amount = amount.Add(sdk.NewCoin(denom, balances.AmountOf(denom)))
+		}
+	default:
+		amount = balances
+	}
+	return amount
+}
+
 // WithdrawRewards allows a user to claim their accumulated rewards.
 func (s msgServer) WithdrawRewards(ctx context.Context, msg *types.MsgWithdrawRewards) (*types.MsgWithdrawRewardsResponse, error) {
 	// Ensure that the signer is a valid address.
@@ -242,22 +339,54 @@ func (s msgServer) WithdrawRewards(ctx context.Context, msg *types.MsgWithdrawRe
 		return nil, fmt.Errorf("unable to decode user address: %s", msg.Signer)
 	}
 
-	// Iterate and collects the user rewards from all the pools.
+	// Select the receiver of the rewards, defaulting to the signer withdraw address.
+	receiver := msg.Receiver
+	if receiver == "" {
+		receiver = s.GetWithdrawAddress(ctx, msg.Signer)
+	} else if _, err = s.addressCodec.StringToBytes(receiver); err != nil {
+		return nil, fmt.Errorf("unable to decode receiver address: %s", receiver)
+	}
+
+	// Select the Pools to withdraw from, defaulting to all of them.
+	poolIds := msg.PoolIds
+	explicit := len(poolIds) > 0
+	if explicit {
+		seen := make(map[uint64]bool)
+		for _, poolId := range poolIds {
+			if seen[poolId] {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is duplicated", poolId)
+			}
+			seen[poolId] = true
+		}
+	} else {
+		for poolId := range s.GetPools(ctx) {
+			poolIds = append(poolIds, poolId)
+		}
+		sortkeys.Uint64s(poolIds)
+	}
+
+	// Iterate and collects the user rewards from the selected pools.
 	rewards := sdk.Coins{}
 	currentTime := s.headerService.GetHeaderInfo(ctx)
-	for poolId := range s.GetPools(ctx) {
+	for _, poolId := range poolIds {
 		controller, err := GetGenericController(ctx, s.Keeper, poolId)
 		if err != nil {
-			return nil, err
+			if explicit {
+				return nil, err
+			}
+			continue
 		}
 
-		// Skip processing if the pool is paused.
-		if controller.IsPaused() {
+		// Skip processing if the pool reward claims are paused, unless explicitly requested.
+		if controller.IsActivityPaused(types.PAUSE_ACTIVITY_REWARD_CLAIMS) {
+			if explicit {
+				return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", poolId)
+			}
 			continue
 		}
 
 		// Process the user rewards.
-		poolRewards, err := controller.ProcessUserRewards(ctx, msg.Signer, currentTime.Time)
+		poolRewards, err := controller.ProcessUserRewards(ctx, msg.Signer, receiver, currentTime.Time)
 		if err != nil {
 			return nil, err
 		}
@@ -267,7 +396,590 @@ func (s msgServer) WithdrawRewards(ctx context.Context, msg *types.MsgWithdrawRe
 	return &types.MsgWithdrawRewardsResponse{
 			Rewards: rewards,
 		}, s.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawnRewards{
-			Signer:  msg.Signer,
-			Rewards: rewards,
+			Signer:   msg.Signer,
+			Rewards:  rewards,
+			Receiver: receiver,
+		})
+}
+
+// SetWithdrawAddress allows a user to set the address receiving their rewards.
+func (s msgServer) SetWithdrawAddress(ctx context.Context, msg *types.MsgSetWithdrawAddress) (*types.MsgSetWithdrawAddressResponse, error) {
+	// Ensure that the signer is a valid address.
+	if _, err := s.addressCodec.StringToBytes(msg.Signer); err != nil {
+		return nil, fmt.Errorf("unable to decode user address: %s", msg.Signer)
+	}
+
+	// Reset the withdraw address when empty or equal to the signer, so that the rewards are sent to the signer.
+	withdrawAddress := msg.WithdrawAddress
+	if withdrawAddress == "" || withdrawAddress == msg.Signer {
+		withdrawAddress = msg.Signer
+		if err := s.RemoveWithdrawAddress(ctx, msg.Signer); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := s.addressCodec.StringToBytes(withdrawAddress); err != nil {
+			return nil, fmt.Errorf("unable to decode withdraw address: %s", withdrawAddress)
+		}
+		if err := s.Keeper.SetWithdrawAddress(ctx, msg.Signer, withdrawAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetWithdrawAddressResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawAddressSet{
+		Signer:          msg.Signer,
+		WithdrawAddress: withdrawAddress,
+	})
+}
+
+// CreateIncentive allows any account to fund an incentive streamed to the bonded liquidity providers of a pool.
+func (s msgServer) CreateIncentive(ctx context.Context, msg *types.MsgCreateIncentive) (*types.MsgCreateIncentiveResponse, error) {
+	// Ensure that the signer is a valid address.
+	signer, err := s.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode signer address: %s", msg.Signer)
+	}
+
+	// Ensure that the Pool exists and is not paused.
+	controller, err := GetGenericController(ctx, s.Keeper, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if controller.IsPaused() {
+		return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", msg.PoolId)
+	}
+
+	// Validate the incentive amount and period.
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIncentive, "invalid amount: %s", msg.Amount.String())
+	}
+	currentTime := s.headerService.GetHeaderInfo(ctx).Time
+	if msg.StartTime.Before(currentTime) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIncentive, "start time %s is in the past", msg.StartTime)
+	}
+	if !msg.EndTime.After(msg.StartTime) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIncentive, "end time %s must be after start time %s", msg.EndTime, msg.StartTime)
+	}
+	for _, coin := range msg.Amount {
+		if s.IsDenomBlocked(ctx, coin.Denom) {
+			return nil, sdkerrors.Wrapf(types.ErrDenomBlocked, "%s is blocked", coin.Denom)
+		}
+	}
+
+	// Ensure that the incentive is within the limits, and collect its creation fee.
+	limits := s.GetParams(ctx).GetIncentiveLimitsOrDefault()
+	if duration := msg.EndTime.Sub(msg.StartTime); duration > limits.MaxDuration {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIncentive, "duration %s exceeds the maximum of %s", duration, limits.MaxDuration)
+	}
+	if count := s.GetPoolIncentivesCount(ctx, msg.PoolId); count >= limits.MaxActivePerPool {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIncentive, "pool %d already has %d active incentives", msg.PoolId, count)
+	}
+	if err = s.collectCreationFee(ctx, msg.Signer, limits.CreationFee, limits.FundCommunityPool); err != nil {
+		return nil, err
+	}
+
+	// Escrow the incentive amount.
+	if err = s.bankKeeper.SendCoins(ctx, signer, IncentivesEscrowAddress(), msg.Amount); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to escrow incentive")
+	}
+
+	// Store the incentive.
+	incentiveId, err := s.IncreaseNextIncentiveID(ctx)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to increase next incentive id")
+	}
+	if err = s.SetIncentive(ctx, incentiveId, types.Incentive{
+		Id:        incentiveId,
+		Creator:   msg.Signer,
+		PoolId:    msg.PoolId,
+		Amount:    msg.Amount,
+		StartTime: msg.StartTime,
+		EndTime:   msg.EndTime,
+	}); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set incentive")
+	}
+
+	return &types.MsgCreateIncentiveResponse{
+			IncentiveId: incentiveId,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &types.IncentiveCreated{
+			Id:        incentiveId,
+			Creator:   msg.Signer,
+			PoolId:    msg.PoolId,
+			Amount:    msg.Amount,
+			StartTime: msg.StartTime,
+			EndTime:   msg.EndTime,
+		})
+}
+
+// SetFeeTiers sets the swap fee discount tiers based on the accounts rolling volume.
+func (s msgServer) SetFeeTiers(ctx context.Context, msg *types.MsgSetFeeTiers) (*types.MsgSetFeeTiersResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the tiers are valid.
+	if err := types.ValidateFeeTiers(msg.Tiers); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidFeeTiers, err.Error())
+	}
+
+	if err := s.Keeper.SetFeeTiers(ctx, msg.Tiers); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set fee tiers")
+	}
+
+	return &types.MsgSetFeeTiersResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.FeeTiersUpdated{
+		Tiers: msg.Tiers,
+	})
+}
+
+// SetFeeExemptions adds and removes the accounts exempt from the swap fees.
+func (s msgServer) SetFeeExemptions(ctx context.Context, msg *types.MsgSetFeeExemptions) (*types.MsgSetFeeExemptionsResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the addresses are valid and listed only once.
+	seen := make(map[string]bool)
+	for _, address := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if _, err := s.addressCodec.StringToBytes(address); err != nil {
+			return nil, fmt.Errorf("unable to decode address: %s", address)
+		}
+		if seen[address] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidFeeTiers, "address %s is duplicated", address)
+		}
+		seen[address] = true
+	}
+
+	for _, address := range msg.Add {
+		if err := s.SetFeeExempt(ctx, address); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to set fee exemption")
+		}
+	}
+	for _, address := range msg.Remove {
+		if err := s.RemoveFeeExempt(ctx, address); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to remove fee exemption")
+		}
+	}
+
+	return &types.MsgSetFeeExemptionsResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.FeeExemptionsUpdated{
+		Added:   msg.Add,
+		Removed: msg.Remove,
+	})
+}
+
+// UpdateParams updates the module params.
+func (s msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the params are valid.
+	if err := types.ValidateParams(msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := s.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.ParamsUpdated{
+		Params: msg.Params,
+	})
+}
+
+// GrantRole grants a role to an address.
+func (s msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the address and the role are valid.
+	if _, err := s.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRole, "invalid address: %s", msg.Address)
+	}
+	if _, ok := types.Role_name[int32(msg.Role)]; !ok || msg.Role == types.ROLE_UNSPECIFIED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRole, "unknown role: %s", msg.Role)
+	}
+	if s.HasRole(ctx, msg.Address, msg.Role) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRole, "%s already granted to %s", msg.Role, msg.Address)
+	}
+
+	if err := s.SetRole(ctx, msg.Address, msg.Role); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to grant role")
+	}
+
+	return &types.MsgGrantRoleResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.RoleGranted{
+		Address: msg.Address,
+		Role:    msg.Role,
+	})
+}
+
+// RevokeRole revokes a role from an address.
+func (s msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the role has been granted to the address.
+	if !s.HasRole(ctx, msg.Address, msg.Role) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRole, "%s not granted to %s", msg.Role, msg.Address)
+	}
+
+	if err := s.RemoveRole(ctx, msg.Address, msg.Role); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to revoke role")
+	}
+
+	return &types.MsgRevokeRoleResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.RoleRevoked{
+		Address: msg.Address,
+		Role:    msg.Role,
+	})
+}
+
+// ClosePool permanently closes a pool. Its liquidity providers are settled pro-rata from the pool balances,
+// the undistributed incentives are refunded to their creators, and the protocol fees and residual balances
+// are swept to the receiver. The pool remains queryable, but is excluded from the pools and rates.
+func (s msgServer) ClosePool(ctx context.Context, msg *types.MsgClosePool) (*types.MsgClosePoolResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the receiver is a valid address.
+	receiver, err := s.addressCodec.StringToBytes(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode receiver address: %s", msg.To)
+	}
+
+	// Ensure that the Pool exists and is not already closed.
+	controller, err := GetGenericController(ctx, s.Keeper, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if controller.IsClosed() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is already closed", msg.PoolId)
+	}
+	pool, err := s.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle the liquidity providers.
+	settlements, err := controller.ClosePool(ctx, s.headerService.GetHeaderInfo(ctx).Time)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to settle pool positions")
+	}
+	settled := sdk.Coins{}
+	for _, settlement := range settlements {
+		settled = settled.Add(settlement.Amount...)
+		if err = s.eventService.EventManager(ctx).Emit(ctx, &types.PositionSettled{
+			PoolId:   msg.PoolId,
+			Provider: settlement.Address,
+			Amount:   settlement.Amount,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Refund the undistributed amount of the pool incentives.
+	incentives := s.GetIncentives(ctx)
+	var refunded []uint64
+	for id, incentive := range incentives {
+		if incentive.PoolId == msg.PoolId {
+			refunded = append(refunded, id)
+		}
+	}
+	sortkeys.Uint64s(refunded)
+	for _, id := range refunded {
+		incentive := incentives[id]
+		creator, err := s.addressCodec.StringToBytes(incentive.Creator)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode incentive creator address: %s", incentive.Creator)
+		}
+		if remaining := incentive.Amount.Sub(incentive.Distributed...); !remaining.IsZero() {
+			if err = s.bankKeeper.SendCoins(ctx, IncentivesEscrowAddress(), creator, remaining); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to refund incentive")
+			}
+		}
+		if err = s.RemoveIncentive(ctx, id); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to remove incentive")
+		}
+	}
+
+	// Sweep the protocol fees and the residual balances of the pool accounts.
+	poolAddr, err := s.addressCodec.StringToBytes(controller.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	accounts := append(controller.GetProtocolFeesAddresses(), poolAddr, controller.GetRewardsFeesAddress(), controller.GetIncentivesAddress())
+	swept := sdk.Coins{}
+	for _, account := range accounts {
+		balances := s.bankKeeper.GetAllBalances(ctx, account)
+		if balances.IsZero() {
+			continue
+		}
+		if err = s.bankKeeper.SendCoins(ctx, account, receiver, balances); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to sweep pool balances")
+		}
+		swept = swept.Add(balances...)
+	}
+
+	// Mark the Pool as closed, keeping it paused forever.
+	pool.Closed = true
+	if err = s.SetPool(ctx, msg.PoolId, pool); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set pool")
+	}
+	if err = s.SetPaused(ctx, msg.PoolId, true); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to pause pool")
+	}
+	if err = s.SetPoolWithdrawOnly(ctx, msg.PoolId, false); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to disable withdraw-only mode")
+	}
+	if err = s.SetPoolPauseFlags(ctx, msg.PoolId, 0); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to unpause pool activities")
+	}
+
+	return &types.MsgClosePoolResponse{
+			Settled: settled,
+			Swept:   swept,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &types.PoolClosed{
+			PoolId:             msg.PoolId,
+			Signer:             msg.Signer,
+			To:                 msg.To,
+			Settled:            settled,
+			Swept:              swept,
+			RefundedIncentives: refunded,
+		})
+}
+
+// SetWithdrawOnly enables or disables the withdraw-only mode of specific pools. Enabling it also pauses the pools,
+// while still allowing their liquidity providers to remove liquidity and claim rewards.
+func (s msgServer) SetWithdrawOnly(ctx context.Context, msg *types.MsgSetWithdrawOnly) (*types.MsgSetWithdrawOnlyResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Iterate and update all the wanted pools, skipping the closed ones.
+	var updatedPools []uint64
+	for _, poolId := range msg.PoolIds {
+		pool, err := s.GetPool(ctx, poolId)
+		if err != nil || pool.Closed {
+			continue
+		}
+		if msg.Enabled {
+			if err = s.SetPaused(ctx, poolId, true); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to pause pool")
+			}
+		}
+		if err = s.SetPoolWithdrawOnly(ctx, poolId, msg.Enabled); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to set withdraw-only mode")
+		}
+		updatedPools = append(updatedPools, poolId)
+	}
+
+	sortkeys.Uint64s(updatedPools)
+	return &types.MsgSetWithdrawOnlyResponse{
+			PoolIds: updatedPools,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawOnlyUpdated{
+			PoolIds: updatedPools,
+			Enabled: msg.Enabled,
 		})
 }
+
+// PauseActivities pauses specific activities of the pools identified by their pool IDs.
+func (s msgServer) PauseActivities(ctx context.Context, msg *types.MsgPauseActivities) (*types.MsgPauseActivitiesResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
+	}
+
+	// Ensure that the activities are valid.
+	flags, err := types.ActivitiesToFlags(msg.Activities)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPauseActivity, err.Error())
+	}
+
+	// Iterate and pause the activities of all the wanted pools.
+	var updatedPools []uint64
+	for _, poolId := range msg.PoolIds {
+		if s.HasPool(ctx, poolId) {
+			if err = s.SetPoolPauseFlags(ctx, poolId, s.GetPoolPauseFlags(ctx, poolId)|flags); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to pause pool activities")
+			}
+			updatedPools = append(updatedPools, poolId)
+		}
+	}
+
+	sortkeys.Uint64s(updatedPools)
+	return &types.MsgPauseActivitiesResponse{
+			PoolIds: updatedPools,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &types.PoolActivitiesPaused{
+			PoolIds:    updatedPools,
+			Activities: types.FlagsToActivities(flags),
+		})
+}
+
+// UnpauseActivities unpauses specific activities of the pools identified by their pool IDs.
+func (s msgServer) UnpauseActivities(ctx context.Context, msg *types.MsgUnpauseActivities) (*types.MsgUnpauseActivitiesResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_PAUSER); err != nil {
+		return nil, err
+	}
+
+	// Ensure that the activities are valid.
+	flags, err := types.ActivitiesToFlags(msg.Activities)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPauseActivity, err.Error())
+	}
+
+	// Iterate and unpause the activities of all the wanted pools.
+	var updatedPools []uint64
+	for _, poolId := range msg.PoolIds {
+		if s.HasPool(ctx, poolId) {
+			if err = s.SetPoolPauseFlags(ctx, poolId, s.GetPoolPauseFlags(ctx, poolId)&^flags); err != nil {
+				return nil, sdkerrors.Wrap(err, "unable to unpause pool activities")
+			}
+			updatedPools = append(updatedPools, poolId)
+		}
+	}
+
+	sortkeys.Uint64s(updatedPools)
+	return &types.MsgUnpauseActivitiesResponse{
+			PoolIds: updatedPools,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &types.PoolActivitiesUnpaused{
+			PoolIds:    updatedPools,
+			Activities: types.FlagsToActivities(flags),
+		})
+}
+
+// SetAllowedDenoms adds and removes the denoms of the registry allowed as pool pairs.
+func (s msgServer) SetAllowedDenoms(ctx context.Context, msg *types.MsgSetAllowedDenoms) (*types.MsgSetAllowedDenomsResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the denoms are valid and listed only once.
+	seen := make(map[string]bool)
+	denoms := append([]string{}, msg.Remove...)
+	for _, denom := range msg.Add {
+		denoms = append(denoms, denom.Denom)
+	}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "invalid denom %s: %s", denom, err.Error())
+		}
+		if seen[denom] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom %s is duplicated", denom)
+		}
+		seen[denom] = true
+	}
+
+	for _, denom := range msg.Add {
+		if err := s.SetAllowedDenom(ctx, denom); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to set allowed denom")
+		}
+	}
+	for _, denom := range msg.Remove {
+		if err := s.RemoveAllowedDenom(ctx, denom); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to remove allowed denom")
+		}
+	}
+
+	return &types.MsgSetAllowedDenomsResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.AllowedDenomsUpdated{
+		Added:   msg.Add,
+		Removed: msg.Remove,
+	})
+}
+
+// SetBlockedDenoms adds and removes the denoms blocked from the swaps across all the pools.
+func (s msgServer) SetBlockedDenoms(ctx context.Context, msg *types.MsgSetBlockedDenoms) (*types.MsgSetBlockedDenomsResponse, error) {
+	// Ensure that the signer has the required authority.
+	if authority := s.GetAuthority(ctx); msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the denoms are valid and listed only once.
+	seen := make(map[string]bool)
+	for _, denom := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "invalid denom %s: %s", denom, err.Error())
+		}
+		if seen[denom] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom %s is duplicated", denom)
+		}
+		seen[denom] = true
+	}
+
+	for _, denom := range msg.Add {
+		if err := s.SetBlockedDenom(ctx, denom); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to block denom")
+		}
+	}
+	for _, denom := range msg.Remove {
+		if err := s.RemoveBlockedDenom(ctx, denom); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to unblock denom")
+		}
+	}
+
+	return &types.MsgSetBlockedDenomsResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.BlockedDenomsUpdated{
+		Added:   msg.Add,
+		Removed: msg.Remove,
+	})
+}
+
+// ProposeAuthority proposes a new authority, which takes over once it accepts the handover.
+func (s msgServer) ProposeAuthority(ctx context.Context, msg *types.MsgProposeAuthority) (*types.MsgProposeAuthorityResponse, error) {
+	// Ensure that the signer has the required authority.
+	authority := s.GetAuthority(ctx)
+	if msg.Signer != authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", authority, msg.Signer)
+	}
+
+	// Ensure that the proposed authority is a valid address, unless the proposal is cancelled.
+	if msg.NewAuthority != "" {
+		if _, err := s.addressCodec.StringToBytes(msg.NewAuthority); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "invalid new authority %s: %s", msg.NewAuthority, err.Error())
+		}
+		if msg.NewAuthority == authority {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "%s is already the authority", msg.NewAuthority)
+		}
+	}
+
+	if err := s.SetPendingAuthority(ctx, msg.NewAuthority); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set pending authority")
+	}
+
+	return &types.MsgProposeAuthorityResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.AuthorityProposed{
+		Authority:        authority,
+		PendingAuthority: msg.NewAuthority,
+	})
+}
+
+// AcceptAuthority accepts the pending authority handover, replacing the current authority with the signer.
+func (s msgServer) AcceptAuthority(ctx context.Context, msg *types.MsgAcceptAuthority) (*types.MsgAcceptAuthorityResponse, error) {
+	// Ensure that the signer is the pending authority.
+	pendingAuthority := s.GetPendingAuthority(ctx)
+	if pendingAuthority == "" {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAuthority, "no pending authority")
+	}
+	if msg.Signer != pendingAuthority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", pendingAuthority, msg.Signer)
+	}
+
+	previousAuthority := s.GetAuthority(ctx)
+	if err := s.SetAuthority(ctx, pendingAuthority); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set authority")
+	}
+	if err := s.SetPendingAuthority(ctx, ""); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to remove pending authority")
+	}
+
+	return &types.MsgAcceptAuthorityResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &types.AuthorityUpdated{
+		PreviousAuthority: previousAuthority,
+		NewAuthority:      pendingAuthority,
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"swap.noble.xyz/keeper"
 	stableswapkeeper "swap.noble.xyz/keeper/stableswap"
@@ -438,10 +439,11 @@ func TestConformance(t *testing.T) {
 				Result: sdk.NewCoin("uusdc", math.NewInt(99974999)),
 				Swaps: []*types.Swap{
 					{
-						PoolId: 0,
-						In:     sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
-						Out:    sdk.NewCoin("uusdc", math.NewInt(99974999)),
-						Fees:   sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(24998))),
+						PoolId:     0,
+						In:         sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
+						Out:        sdk.NewCoin("uusdc", math.NewInt(99974999)),
+						Fees:       sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(24998))),
+						RewardsFee: 2_500_000,
 					},
 				},
 			},
@@ -467,10 +469,11 @@ func TestConformance(t *testing.T) {
 				Result: sdk.NewCoin("uusdc", math.NewInt(99972764)),
 				Swaps: []*types.Swap{
 					{
-						PoolId: 0,
-						In:     sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
-						Out:    sdk.NewCoin("uusdc", math.NewInt(99972764)),
-						Fees:   sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(24998))),
+						PoolId:     0,
+						In:         sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
+						Out:        sdk.NewCoin("uusdc", math.NewInt(99972764)),
+						Fees:       sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(24998))),
+						RewardsFee: 2_500_000,
 					},
 				},
 			},
@@ -496,10 +499,11 @@ func TestConformance(t *testing.T) {
 				Result: sdk.NewCoin("uusdc", math.NewInt(9997499)),
 				Swaps: []*types.Swap{
 					{
-						PoolId: 0,
-						In:     sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
-						Out:    sdk.NewCoin("uusdc", math.NewInt(9997499)),
-						Fees:   sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(2498))),
+						PoolId:     0,
+						In:         sdk.NewCoin("uusdn", math.NewInt(100*ONE)),
+						Out:        sdk.NewCoin("uusdc", math.NewInt(9997499)),
+						Fees:       sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(2498))),
+						RewardsFee: 2_500_000,
 					},
 				},
 			},
@@ -844,9 +848,10 @@ func TestWithdrawRewards(t *testing.T) {
 		types.PoolsPrefix, "pools_generic", collections.Uint64Key, codec.CollValue[types.Pool](mocks.MakeTestEncodingConfig("noble").Codec),
 	)
 
-	// ACT: Attempt to withdraw the rewards.
+	// ACT: Attempt to withdraw the rewards of the Pool.
 	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{
-		Signer: bob.Address,
+		Signer:  bob.Address,
+		PoolIds: []uint64{0},
 	})
 	assert.Equal(t, mocks.ErrorStoreAccess.Error(), err.Error())
 	k.Pools = tmpPools
@@ -888,21 +893,21 @@ func TestWithdrawRewards(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	// ACT: Withdraw rewards with a too short period.
-	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{
+	// ACT: Withdraw rewards in the same block of the swap.
+	res, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{
 		Signer: bob.Address,
 	})
-	assert.Equal(t, "period is too short", err.Error())
-
-	// ARRANGE: Increase the time.
-	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 2, 1, 1, 1, 1, time.UTC)})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, res.Rewards.Len())
 
-	// ACT: Withdraw rewards.
-	res, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{
+	// ACT: Withdraw rewards again.
+	res, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{
 		Signer: bob.Address,
 	})
+
+	// ASSERT: No rewards have been accrued since the last withdrawal.
 	assert.NoError(t, err)
-	assert.Equal(t, 1, res.Rewards.Len())
+	assert.True(t, res.Rewards.IsZero())
 
 	// ARRANGE: Pause pools.
 	_, err = server.PauseByAlgorithm(ctx, &types.MsgPauseByAlgorithm{
@@ -925,6 +930,471 @@ func TestWithdrawRewards(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestWithdrawRewardsOrderIndependence(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob, tom := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+
+	// ARRANGE: Add identical positions for Alice and Bob.
+	for _, user := range []utils.Account{alice, bob} {
+		bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: user.Address,
+			PoolId: 0,
+			Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+		})
+		assert.NoError(t, err)
+	}
+	bank.Balances[tom.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(20*ONE)))
+	swap := func() {
+		_, err := server.Swap(ctx, &types.MsgSwap{
+			Signer: tom.Address,
+			Amount: sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+			Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+			Min:    sdk.NewCoin("uusdn", math.NewInt(100)),
+		})
+		assert.NoError(t, err)
+	}
+
+	// ACT: Generate fees, claiming with Bob before the second swap and with Alice only at the end.
+	swap()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 2, 1, 1, 1, 1, time.UTC)})
+	bobFirst, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: bob.Address})
+	assert.NoError(t, err)
+	swap()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 3, 1, 1, 1, 1, time.UTC)})
+	aliceRes, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address})
+	assert.NoError(t, err)
+	bobSecond, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: bob.Address})
+	assert.NoError(t, err)
+
+	// ASSERT: Both providers received the same rewards regardless of the claim order, minus the truncation of each claim.
+	bobRewards := bobFirst.Rewards.Add(bobSecond.Rewards...)
+	assert.False(t, aliceRes.Rewards.IsZero())
+	assert.InDelta(t, aliceRes.Rewards.AmountOf("uusdc").Int64(), bobRewards.AmountOf("uusdc").Int64(), 1)
+}
+
+func TestWithdrawRewardsSelective(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, tom, custodian, receiver := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create two Pools, with Alice providing liquidity to both of them.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)})
+	for _, pair := range []string{"uusdc", "ueure"} {
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  pair,
+			ProtocolFeePercentage: 1,
+			RewardsFee:            1_000_000,
+			InitialA:              100,
+			FutureA:               100,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin(pair, math.NewInt(1000000000000000000)),
+			),
+		})
+		require.NoError(t, err)
+	}
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		sdk.NewCoin("ueure", math.NewInt(100*ONE)),
+		sdk.NewCoin("uusdn", math.NewInt(200*ONE)),
+	)
+	bank.Balances[tom.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(20*ONE)), sdk.NewCoin("ueure", math.NewInt(20*ONE)))
+	for poolId, pair := range []string{"uusdc", "ueure"} {
+		_, err := stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: alice.Address,
+			PoolId: uint64(poolId),
+			Amount: sdk.NewCoins(sdk.NewCoin(pair, math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+		})
+		require.NoError(t, err)
+	}
+	swap := func() {
+		for poolId, pair := range []string{"uusdc", "ueure"} {
+			_, err := server.Swap(ctx, &types.MsgSwap{
+				Signer: tom.Address,
+				Amount: sdk.NewCoin(pair, math.NewInt(10*ONE)),
+				Routes: []types.Route{{PoolId: uint64(poolId), DenomTo: "uusdn"}},
+				Min:    sdk.NewCoin("uusdn", math.NewInt(100)),
+			})
+			require.NoError(t, err)
+		}
+	}
+	swap()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 2, 1, 1, 1, 1, time.UTC)})
+	aliceBalance := bank.Balances[alice.Address]
+
+	// ACT: Attempt to withdraw with an invalid receiver.
+	_, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, Receiver: alice.Invalid})
+
+	// ASSERT: The withdrawal failed.
+	require.Error(t, err)
+
+	// ACT: Attempt to withdraw from duplicated Pools.
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{0, 0}})
+
+	// ASSERT: The withdrawal failed.
+	require.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ACT: Attempt to withdraw from a non existing Pool.
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{5}})
+
+	// ASSERT: The withdrawal failed.
+	require.Error(t, err)
+
+	// ACT: Withdraw the rewards of the first Pool to a receiver.
+	res, err := server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{0}, Receiver: receiver.Address})
+
+	// ASSERT: Only the rewards of the first Pool have been sent to the receiver.
+	require.NoError(t, err)
+	require.Equal(t, []string{"uusdc"}, res.Rewards.Denoms())
+	require.Equal(t, res.Rewards, bank.Balances[receiver.Address])
+	require.Equal(t, aliceBalance, bank.Balances[alice.Address])
+
+	// ACT: Attempt to set an invalid withdraw address.
+	_, err = server.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{Signer: alice.Address, WithdrawAddress: alice.Invalid})
+
+	// ASSERT: The withdraw address has not been set.
+	require.Error(t, err)
+	require.Empty(t, k.GetWithdrawAddresses(ctx))
+
+	// ACT: Set the custodian as withdraw address.
+	_, err = server.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{Signer: alice.Address, WithdrawAddress: custodian.Address})
+	require.NoError(t, err)
+
+	// ASSERT: The withdraw address is returned by the query.
+	queryRes, err := queryServer.WithdrawAddress(ctx, &types.QueryWithdrawAddress{Address: alice.Address})
+	require.NoError(t, err)
+	require.Equal(t, custodian.Address, queryRes.WithdrawAddress)
+
+	// ARRANGE: Register a Pool without a supported controller.
+	require.NoError(t, k.SetPool(ctx, 2, types.Pool{Id: 2, Algorithm: types.UNSPECIFIED}))
+
+	// ACT: Attempt to withdraw the rewards of the unsupported Pool explicitly.
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{2}})
+
+	// ASSERT: The withdrawal failed.
+	require.ErrorIs(t, err, types.ErrInvalidAlgorithm)
+
+	// ACT: Withdraw the rewards of all the Pools.
+	res, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address})
+
+	// ASSERT: The unsupported Pool has been skipped, and the remaining rewards of the second Pool have been sent to the custodian.
+	require.NoError(t, err)
+	require.Equal(t, []string{"ueure"}, res.Rewards.Denoms())
+	require.Equal(t, res.Rewards, bank.Balances[custodian.Address])
+	require.Equal(t, aliceBalance, bank.Balances[alice.Address])
+
+	// ACT: Generate new fees and transfer the position, settling the rewards implicitly.
+	swap()
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 3, 1, 1, 1, 1, time.UTC)})
+	custodianBalance := bank.Balances[custodian.Address]
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Receiver:   tom.Address,
+		Percentage: math.LegacyNewDec(100),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The settled rewards have been sent to the custodian.
+	require.True(t, bank.Balances[custodian.Address].AmountOf("uusdc").GT(custodianBalance.AmountOf("uusdc")))
+
+	// ACT: Reset the withdraw address.
+	_, err = server.SetWithdrawAddress(ctx, &types.MsgSetWithdrawAddress{Signer: alice.Address})
+	require.NoError(t, err)
+
+	// ASSERT: The rewards are sent back to Alice.
+	require.Equal(t, alice.Address, k.GetWithdrawAddress(ctx, alice.Address))
+	res, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsAllPositive())
+	require.Equal(t, res.Rewards.AmountOf("ueure"), bank.Balances[alice.Address].AmountOf("ueure"))
+}
+
+func TestCreateIncentive(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	tom := utils.TestAccount()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime})
+	amount := sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(100*ONE)))
+
+	// ACT: Create an incentive with an invalid signer.
+	_, err := server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer: tom.Invalid,
+	})
+	// ASSERT: The action should've failed due to the invalid signer.
+	assert.Error(t, err)
+
+	// ACT: Create an incentive for a non-existing Pool.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the invalid Pool.
+	assert.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ARRANGE: Create a Pool.
+	_, err = stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+
+	// ACT: Create an incentive with an empty amount.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    sdk.Coins{},
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the invalid amount.
+	assert.ErrorIs(t, err, types.ErrInvalidIncentive)
+
+	// ACT: Create an incentive starting in the past.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime.Add(-time.Hour),
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the invalid start time.
+	assert.ErrorIs(t, err, types.ErrInvalidIncentive)
+
+	// ACT: Create an incentive ending before its start.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime.Add(time.Hour),
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the invalid end time.
+	assert.ErrorIs(t, err, types.ErrInvalidIncentive)
+
+	// ACT: Create an incentive without enough balance.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the insufficient balance.
+	assert.Error(t, err)
+
+	// ARRANGE: Pause the Pool.
+	bank.Balances[tom.Address] = amount
+	err = k.Paused.Set(ctx, 0, true)
+	assert.NoError(t, err)
+
+	// ACT: Create an incentive for a paused Pool.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the paused Pool.
+	assert.ErrorIs(t, err, types.ErrPoolActivityPaused)
+	err = k.Paused.Set(ctx, 0, false)
+	assert.NoError(t, err)
+
+	// ACT: Create a valid incentive.
+	res, err := server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+
+	// ASSERT: The amount has been escrowed and the incentive stored.
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), res.IncentiveId)
+	assert.True(t, bank.Balances[tom.Address].IsZero())
+	assert.Equal(t, amount, bank.Balances[keeper.IncentivesEscrowAddress().String()])
+	incentive, err := k.Incentives.Get(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, types.Incentive{
+		Id:        0,
+		Creator:   tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	}, incentive)
+	assert.Equal(t, uint64(1), k.GetNextIncentiveID(ctx))
+}
+
+func TestCreateIncentiveLimits(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	tom := utils.TestAccount()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime})
+	amount := sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(ONE)))
+	fee := sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(10*ONE)))
+
+	// ARRANGE: Create a Pool, and set the incentive limits.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+	params := k.GetParams(ctx)
+	params.IncentiveLimits = &types.IncentiveLimits{
+		CreationFee:      fee,
+		MaxDuration:      24 * time.Hour,
+		MaxActivePerPool: 2,
+	}
+	assert.NoError(t, k.SetParams(ctx, params))
+	bank.Balances[tom.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+
+	// ACT: Create an incentive longer than the maximum duration.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(25 * time.Hour),
+	})
+	// ASSERT: The action should've failed due to the duration.
+	assert.ErrorIs(t, err, types.ErrInvalidIncentive)
+
+	// ARRANGE: Block the incentive denom.
+	assert.NoError(t, k.SetBlockedDenom(ctx, "uusdn"))
+
+	// ACT: Create an incentive with a blocked denom.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the blocked denom.
+	assert.ErrorIs(t, err, types.ErrDenomBlocked)
+	assert.NoError(t, k.RemoveBlockedDenom(ctx, "uusdn"))
+
+	// ACT: Create the maximum number of incentives for the Pool.
+	for i := 0; i < 2; i++ {
+		_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+			Signer:    tom.Address,
+			PoolId:    0,
+			Amount:    amount,
+			StartTime: startTime.Add(time.Duration(i) * time.Hour),
+			EndTime:   startTime.Add(24 * time.Hour),
+		})
+		assert.NoError(t, err)
+	}
+	// ASSERT: The creation fees have been burned and the amounts escrowed.
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(78*ONE))), bank.Balances[tom.Address])
+	assert.Equal(t, amount.MulInt(math.NewInt(2)), bank.Balances[keeper.IncentivesEscrowAddress().String()])
+	assert.True(t, bank.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+	assert.Equal(t, uint64(2), k.GetPoolIncentivesCount(ctx, 0))
+
+	// ACT: Create an incentive above the maximum number of active incentives.
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the per pool limit.
+	assert.ErrorIs(t, err, types.ErrInvalidIncentive)
+
+	// ARRANGE: Complete the first incentive.
+	assert.NoError(t, k.RemoveIncentive(ctx, 0))
+
+	// ACT: Create an incentive without enough balance for the creation fee.
+	bank.Balances[tom.Address] = amount
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    tom.Address,
+		PoolId:    0,
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+	})
+	// ASSERT: The action should've failed due to the insufficient balance.
+	assert.Error(t, err)
+	assert.Equal(t, uint64(1), k.GetPoolIncentivesCount(ctx, 0))
+}
+
 func TestWithdrawProtocolFees(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
@@ -1056,7 +1526,7 @@ func TestWithdrawProtocolFees(t *testing.T) {
 	assert.Equal(t, math.NewInt(4999), bank.Balances[receiver.Address].AmountOf("uusdn"))
 }
 
-func TestPausingAndUnpausingByPoolIds(t *testing.T) {
+func TestWithdrawProtocolFeesSelective(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
 	}
@@ -1067,48 +1537,237 @@ func TestPausingAndUnpausingByPoolIds(t *testing.T) {
 	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
 	server := keeper.NewMsgServer(k)
 	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, receiver := utils.TestAccount(), utils.TestAccount()
+	bank.Balances[bob.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdc", math.NewInt(10_000*ONE)),
+		sdk.NewCoin("uusde", math.NewInt(10_000*ONE)),
+		sdk.NewCoin("uusdn", math.NewInt(10_000*ONE)),
+	)
 
-	user := utils.TestAccount()
+	// ARRANGE: Create two Pools with liquidity.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)})
+	for poolId, pair := range []string{"uusdc", "uusde"} {
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  pair,
+			ProtocolFeePercentage: 50,
+			RewardsFee:            1_000_000,
+			InitialA:              100,
+			FutureA:               100,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin(pair, math.NewInt(1000000000000000000)),
+			),
+		})
+		require.NoError(t, err)
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: bob.Address,
+			PoolId: uint64(poolId),
+			Amount: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+				sdk.NewCoin(pair, math.NewInt(1_000*ONE)),
+			),
+		})
+		require.NoError(t, err)
+	}
 
-	// ARRANGE: Create the initial pools and provide to the user the necessary liquidity.
-	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusdc", math.NewInt(100*ONE)))
-	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusde", math.NewInt(100*ONE)))
-	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
-	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
-		Signer:                "authority",
-		Pair:                  "uusdc",
-		ProtocolFeePercentage: 1,
-		InitialA:              100,
-		FutureA:               100,
-		RateMultipliers: sdk.NewCoins(
-			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
-			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
-		),
+	// ARRANGE: Generate fees in both denoms of the first Pool, and in the second Pool.
+	for _, swap := range []struct {
+		poolId uint64
+		in     string
+		out    string
+	}{{0, "uusdn", "uusdc"}, {0, "uusdc", "uusdn"}, {1, "uusdn", "uusde"}} {
+		_, err := server.Swap(ctx, &types.MsgSwap{
+			Signer: bob.Address,
+			Amount: sdk.NewCoin(swap.in, math.NewInt(100*ONE)),
+			Routes: []types.Route{{PoolId: swap.poolId, DenomTo: swap.out}},
+			Min:    sdk.NewCoin(swap.out, math.NewInt(90*ONE)),
+		})
+		require.NoError(t, err)
+	}
+	pool0Fees := authtypes.NewModuleAddress("swap/pool/0/protocol_fees").String()
+	pool1Fees := authtypes.NewModuleAddress("swap/pool/1/protocol_fees").String()
+	require.True(t, bank.Balances[pool0Fees].AmountOf("uusdn").GT(math.NewInt(1000)))
+	require.True(t, bank.Balances[pool0Fees].AmountOf("uusdc").IsPositive())
+	require.True(t, bank.Balances[pool1Fees].AmountOf("uusdn").IsPositive())
+
+	// ACT: Attempt to withdraw with both denoms and amount.
+	_, err := server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer: "authority",
+		To:     receiver.Address,
+		Denoms: []string{"uusdn"},
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1))),
 	})
-	assert.NoError(t, err)
-	_, err = stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
-		Signer:                "authority",
-		Pair:                  "uusde",
-		ProtocolFeePercentage: 1,
-		RateMultipliers: sdk.NewCoins(
-			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
-			sdk.NewCoin("uusde", math.NewInt(1000000000000000000)),
-		),
-		InitialA: 100,
-		FutureA:  100,
+	// ASSERT: The action should've failed due to the combined filters.
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// ACT: Attempt to withdraw an amount from all the Pools, and from both Pools explicitly.
+	pool0Balance, pool1Balance := bank.Balances[pool0Fees], bank.Balances[pool1Fees]
+	for _, poolIds := range [][]uint64{nil, {0, 1}} {
+		_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+			Signer:  "authority",
+			To:      receiver.Address,
+			PoolIds: poolIds,
+			Amount:  sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1))),
+		})
+		// ASSERT: The action should've failed, as an amount requires a single pool.
+		require.ErrorIs(t, err, types.ErrInvalidAmount)
+	}
+	assert.Equal(t, pool0Balance, bank.Balances[pool0Fees])
+	assert.Equal(t, pool1Balance, bank.Balances[pool1Fees])
+	assert.True(t, bank.Balances[receiver.Address].IsZero())
+
+	// ACT: Attempt to withdraw with duplicated pools.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
+		PoolIds: []uint64{0, 0},
 	})
-	assert.NoError(t, err)
+	// ASSERT: The action should've failed due to the duplicated pools.
+	require.ErrorIs(t, err, types.ErrInvalidPool)
 
-	// ARRANGE: Pause with invalid authority.
-	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{
-		Signer:  "user",
+	// ACT: Attempt to withdraw from a non-existing pool.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
+		PoolIds: []uint64{5},
+	})
+	// ASSERT: The action should've failed due to the non-existing pool.
+	require.Error(t, err)
+
+	// ACT: Attempt to withdraw more than the pool protocol fees.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
 		PoolIds: []uint64{0},
+		Amount:  sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1_000*ONE))),
 	})
-	assert.Equal(t, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected authority, got user").Error(), err.Error())
+	// ASSERT: The action should've failed due to the insufficient balance.
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
 
-	// ARRANGE: Simulate Paused failing collection.
-	tmpPaused := k.Paused
-	k.Paused = collections.NewMap(
+	// ACT: Withdraw a partial amount from the first Pool.
+	res, err := server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
+		PoolIds: []uint64{0},
+		Amount:  sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1000))),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Only the requested amount has been withdrawn.
+	require.Equal(t, []types.PoolProtocolFees{{PoolId: 0, Amount: sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1000)))}}, res.Withdrawn)
+	assert.Equal(t, pool0Balance.AmountOf("uusdn").SubRaw(1000), bank.Balances[pool0Fees].AmountOf("uusdn"))
+	assert.Equal(t, pool0Balance.AmountOf("uusdc"), bank.Balances[pool0Fees].AmountOf("uusdc"))
+	assert.Equal(t, math.NewInt(1000), bank.Balances[receiver.Address].AmountOf("uusdn"))
+
+	// ACT: Withdraw a single denom from the first Pool.
+	res, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
+		PoolIds: []uint64{0},
+		Denoms:  []string{"uusdc"},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Only the requested denom has been withdrawn.
+	require.Equal(t, []types.PoolProtocolFees{{PoolId: 0, Amount: sdk.NewCoins(sdk.NewCoin("uusdc", pool0Balance.AmountOf("uusdc")))}}, res.Withdrawn)
+	assert.True(t, bank.Balances[pool0Fees].AmountOf("uusdc").IsZero())
+	assert.Equal(t, pool0Balance.AmountOf("uusdn").SubRaw(1000), bank.Balances[pool0Fees].AmountOf("uusdn"))
+
+	// ARRANGE: Pause the second Pool.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{Signer: "authority", PoolIds: []uint64{1}})
+	require.NoError(t, err)
+
+	// ACT: Attempt to explicitly withdraw from the paused Pool.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:  "authority",
+		To:      receiver.Address,
+		PoolIds: []uint64{1},
+	})
+	// ASSERT: The action should've failed due to the paused pool.
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ACT: Withdraw from all the Pools, skipping the paused ones.
+	res, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer: "authority",
+		To:     receiver.Address,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Only the first Pool has been withdrawn from.
+	require.Len(t, res.Withdrawn, 1)
+	assert.Equal(t, uint64(0), res.Withdrawn[0].PoolId)
+	assert.True(t, bank.Balances[pool0Fees].IsZero())
+	assert.True(t, bank.Balances[pool1Fees].AmountOf("uusdn").IsPositive())
+
+	// ACT: Withdraw from the paused Pool explicitly including it.
+	pool1Balance = bank.Balances[pool1Fees]
+	res, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{
+		Signer:        "authority",
+		To:            receiver.Address,
+		PoolIds:       []uint64{1},
+		IncludePaused: true,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The paused Pool has been withdrawn from.
+	require.Equal(t, []types.PoolProtocolFees{{PoolId: 1, Amount: pool1Balance}}, res.Withdrawn)
+	assert.True(t, bank.Balances[pool1Fees].IsZero())
+}
+
+func TestPausingAndUnpausingByPoolIds(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+
+	user := utils.TestAccount()
+
+	// ARRANGE: Create the initial pools and provide to the user the necessary liquidity.
+	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusdc", math.NewInt(100*ONE)))
+	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusde", math.NewInt(100*ONE)))
+	bank.Balances[user.Address] = append(bank.Balances[user.Address], sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+	_, err = stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusde",
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusde", math.NewInt(1000000000000000000)),
+		),
+		InitialA: 100,
+		FutureA:  100,
+	})
+	assert.NoError(t, err)
+
+	// ARRANGE: Pause with invalid authority.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{
+		Signer:  "user",
+		PoolIds: []uint64{0},
+	})
+	assert.Equal(t, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected authority, got user").Error(), err.Error())
+
+	// ARRANGE: Simulate Paused failing collection.
+	tmpPaused := k.Paused
+	k.Paused = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
 		types.PausedPrefix, "paused", collections.Uint64Key, codec.BoolValue,
 	)
@@ -1753,20 +2412,10 @@ func TestSwap(t *testing.T) {
 	assert.Equal(t, bank.Balances[bob.Address].AmountOf("uusdn"), response.Result.Amount)
 
 	// ARRANGE: Set a different InitialA value.
-	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
-		Signer:                "authority",
-		PoolId:                0,
-		RewardsFee:            4e3,
-		ProtocolFeePercentage: 1,
-		InitialA:              1,
-		FutureA:               100,
-		FutureATime:           1893452400,
-		RateMultipliers: sdk.NewCoins(
-			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
-			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
-		),
-	})
-	assert.NoError(t, err)
+	stableswapPool, err := k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	stableswapPool.InitialA = 1
+	require.NoError(t, k.Stableswap.SetPool(ctx, 0, stableswapPool))
 
 	// ACT: Perform a new Swap expecting higher fees.
 	_, err = server.Swap(ctx, &types.MsgSwap{
@@ -1873,7 +2522,7 @@ func TestMultiPoolSwap(t *testing.T) {
 	assert.Equal(t, bank.Balances[bob.Address].AmountOf("uusde"), response.Swaps[len(response.Swaps)-1].Out.Amount)
 }
 
-func BenchmarkSwap(b *testing.B) {
+func TestFeeSplits(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
 	}
@@ -1881,61 +2530,140 @@ func BenchmarkSwap(b *testing.B) {
 		Balances:    make(map[string]sdk.Coins),
 		Restriction: mocks.NoOpSendRestrictionFn,
 	}
-	k, ctx := mocks.SwapKeeperWithKeepers(b, account, bank)
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
 	server := keeper.NewMsgServer(k)
 	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
 
-	alice, bob := utils.TestAccount(), utils.TestAccount()
-
-	// ARRANGE: Create a Pool.
-	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+	msg := &stableswap.MsgCreatePool{
 		Signer:                "authority",
 		Pair:                  "uusdc",
 		RewardsFee:            4e3,
-		ProtocolFeePercentage: 1,
+		ProtocolFeePercentage: 50,
 		InitialA:              100,
 		FutureA:               100,
 		FutureATime:           1893452400,
 		RateMultipliers: sdk.NewCoins(
-			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
 			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
 		),
-	})
-	assert.Nil(b, err)
+	}
+
+	// ACT: Attempt to create a Pool with duplicated split names.
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "treasury", RecipientType: stableswap.FEE_RECIPIENT_ADDRESS, Recipient: treasury.Address, Weight: math.LegacyOneDec()},
+		{Name: "treasury", RecipientType: stableswap.FEE_RECIPIENT_BURN, Weight: math.LegacyOneDec()},
+	}
+	_, err := stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to duplicated names.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with a non-positive weight.
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "burn", RecipientType: stableswap.FEE_RECIPIENT_BURN, Weight: math.LegacyZeroDec()},
+	}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid weight.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with an invalid recipient address.
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "treasury", RecipientType: stableswap.FEE_RECIPIENT_ADDRESS, Recipient: "invalid", Weight: math.LegacyOneDec()},
+	}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid address.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with a burn split having a recipient.
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "burn", RecipientType: stableswap.FEE_RECIPIENT_BURN, Recipient: treasury.Address, Weight: math.LegacyOneDec()},
+	}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the unexpected recipient.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with a community pool split without the distribution keeper.
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "community", RecipientType: stableswap.FEE_RECIPIENT_COMMUNITY_POOL, Weight: math.LegacyOneDec()},
+	}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the missing community pool.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ARRANGE: Create a Pool splitting the protocol fees.
+	k.SetDistributionKeeper(mocks.DistributionKeeper{Bank: bank})
+	msg.FeeSplits = []stableswap.FeeSplit{
+		{Name: "treasury", RecipientType: stableswap.FEE_RECIPIENT_ADDRESS, Recipient: treasury.Address, Weight: math.LegacyNewDec(2)},
+		{Name: "community", RecipientType: stableswap.FEE_RECIPIENT_COMMUNITY_POOL, Weight: math.LegacyOneDec()},
+		{Name: "burn", RecipientType: stableswap.FEE_RECIPIENT_BURN, Weight: math.LegacyOneDec()},
+	}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	require.NoError(t, err)
 
 	// ARRANGE: Provide liquidity.
-	nLiquidity := sdk.NewCoin("uusdn", math.NewInt(1_000_000_000*ONE))
-	usdcLiquidity := sdk.NewCoin("uusdc", math.NewInt(1_000_000_000*ONE))
-	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], usdcLiquidity)
-	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], nLiquidity)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000_000*ONE)),
+	)
 	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
 		Signer: alice.Address,
 		PoolId: 0,
-		Amount: sdk.NewCoins(usdcLiquidity, nLiquidity),
+		Amount: bank.Balances[alice.Address],
 	})
-	assert.NoError(b, err)
+	require.NoError(t, err)
 
-	// ACT: Perform swaps.
-	routes := []types.Route{
-		{
-			PoolId:  0,
-			DenomTo: "uusdn",
-		},
-	}
-	bank.Balances[bob.Address] = append(bank.Balances[bob.Address], sdk.NewCoin("uusdc", math.NewInt(1_000_000_000*ONE)))
-	bank.Balances[bob.Address] = append(bank.Balances[bob.Address], sdk.NewCoin("uusdn", math.NewInt(1_000_000_000*ONE)))
-	for i := 0; i < b.N; i++ {
-		_, err = server.Swap(ctx, &types.MsgSwap{
-			Signer: bob.Address,
-			Amount: sdk.NewCoin("uusdc", math.NewInt(1*ONE)),
-			Routes: routes,
-			Min:    sdk.NewCoin("uusdn", math.NewInt(1_000)),
-		})
-		assert.NoError(b, err)
-	}
+	// ACT: Perform a swap.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)))
+	response, err := server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The protocol fees are split across the recipients proportionally to their weights.
+	treasuryFees := bank.Balances[treasury.Address].AmountOf("uusdc")
+	communityFees := bank.Balances[authtypes.NewModuleAddress("distribution").String()].AmountOf("uusdc")
+	protocolFees := bank.Balances[authtypes.NewModuleAddress("swap/pool/0/protocol_fees").String()].AmountOf("uusdc")
+	rewardsFees := bank.Balances[authtypes.NewModuleAddress("swap/pool/0/rewards_fees").String()].AmountOf("uusdc")
+	assert.True(t, treasuryFees.IsPositive())
+	assert.Equal(t, treasuryFees.QuoRaw(2), communityFees)
+	assert.True(t, protocolFees.LT(math.NewInt(int64(len(msg.FeeSplits)))))
+	assert.True(t, bank.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+
+	// ASSERT: The burned amount is the only part of the fees not held by any account.
+	burned := response.Swaps[0].Fees.AmountOf("uusdc").Sub(treasuryFees).Sub(communityFees).Sub(protocolFees).Sub(rewardsFees)
+	assert.Equal(t, communityFees, burned)
+
+	// ARRANGE: Remove the fee splits.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		ProtocolFeePercentage: 50,
+		RewardsFee:            4e3,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       msg.RateMultipliers,
+	})
+	require.NoError(t, err)
+
+	// ACT: Perform another swap.
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The protocol fees are entirely kept in the pool.
+	assert.Equal(t, treasuryFees, bank.Balances[treasury.Address].AmountOf("uusdc"))
+	assert.True(t, bank.Balances[authtypes.NewModuleAddress("swap/pool/0/protocol_fees").String()].AmountOf("uusdc").GT(treasuryFees))
 }
 
-func BenchmarkMultiPoolSwap(b *testing.B) {
+func TestDynamicFee(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
 	}
@@ -1943,17 +2671,16 @@ func BenchmarkMultiPoolSwap(b *testing.B) {
 		Balances:    make(map[string]sdk.Coins),
 		Restriction: mocks.NoOpSendRestrictionFn,
 	}
-	k, ctx := mocks.SwapKeeperWithKeepers(b, account, bank)
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
 	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
 	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
 
-	alice, tom, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
-
-	// ARRANGE: Create 2 Pools.
-	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+	msg := &stableswap.MsgCreatePool{
 		Signer:                "authority",
 		Pair:                  "uusdc",
-		RewardsFee:            4e3,
+		RewardsFee:            1_000_000,
 		ProtocolFeePercentage: 1,
 		InitialA:              100,
 		FutureA:               100,
@@ -1962,64 +2689,1458 @@ func BenchmarkMultiPoolSwap(b *testing.B) {
 			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
 		),
+		DynamicFee: &stableswap.DynamicFee{
+			Multiplier: math.LegacyNewDec(10),
+			MinFee:     2_000_000,
+			MaxFee:     5_000_000,
+		},
+	}
+
+	// ACT: Attempt to create a Pool with bounds not containing the rewards fee.
+	_, err := stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid bounds.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with a negative multiplier.
+	msg.DynamicFee.MinFee = 500_000
+	msg.DynamicFee.Multiplier = math.LegacyNewDec(-1)
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the negative multiplier.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ARRANGE: Create a Pool with the dynamic fee.
+	msg.DynamicFee.Multiplier = math.LegacyNewDec(10)
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
 	})
-	assert.Nil(b, err)
-	_, err = stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+	require.NoError(t, err)
+	bank.Balances[bob.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+
+	// ACT: Simulate a swap pushing the Pool from balance.
+	simulation, err := queryServer.SimulateSwap(ctx, &types.QuerySimulateSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The reported fee is higher than the base fee.
+	assert.Greater(t, simulation.Swaps[0].RewardsFee, msg.RewardsFee)
+	assert.LessOrEqual(t, simulation.Swaps[0].RewardsFee, msg.DynamicFee.MaxFee)
+
+	// ACT: Execute the swap.
+	res, err := server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The applied fee is higher than the base fee.
+	assert.Greater(t, res.Swaps[0].RewardsFee, msg.RewardsFee)
+
+	// ACT: Swap back rebalancing the Pool.
+	res, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdn", math.NewInt(50*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdc"}},
+		Min:    sdk.NewCoin("uusdc", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The applied fee is lower than the base fee.
+	assert.Less(t, res.Swaps[0].RewardsFee, msg.RewardsFee)
+	assert.GreaterOrEqual(t, res.Swaps[0].RewardsFee, msg.DynamicFee.MinFee)
+
+	// ARRANGE: Disable the dynamic fee.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
 		Signer:                "authority",
-		Pair:                  "uusde",
-		RewardsFee:            4e3,
+		PoolId:                0,
 		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
 		InitialA:              100,
 		FutureA:               100,
 		FutureATime:           1893452400,
-		RateMultipliers: sdk.NewCoins(
-			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
-			sdk.NewCoin("uusde", math.NewInt(1000000000000000000)),
-		),
+		RateMultipliers:       msg.RateMultipliers,
 	})
-	assert.Nil(b, err)
+	require.NoError(t, err)
 
-	// ARRANGE: Provide liquidity in both Pools.
-	nLiquidity := sdk.NewCoin("uusdn", math.NewInt(1_000_000*ONE))
-	usdcLiquidity := sdk.NewCoin("uusdc", math.NewInt(1_000_000*ONE))
-	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], usdcLiquidity)
-	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], nLiquidity)
-	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
-		Signer: alice.Address,
-		PoolId: 0,
-		Amount: sdk.NewCoins(usdcLiquidity, nLiquidity),
-	})
-	require.NoError(b, err)
-	nLiquidity2 := sdk.NewCoin("uusdn", math.NewInt(1_000_000*ONE))
-	usdeLiquidity := sdk.NewCoin("uusde", math.NewInt(1_000_000*ONE))
-	bank.Balances[tom.Address] = append(bank.Balances[tom.Address], usdeLiquidity)
-	bank.Balances[tom.Address] = append(bank.Balances[tom.Address], nLiquidity2)
-	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
-		Signer: tom.Address,
-		PoolId: 1,
-		Amount: sdk.NewCoins(nLiquidity, usdeLiquidity),
+	// ACT: Swap pushing the Pool from balance.
+	res, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
 	})
-	require.NoError(b, err)
+	require.NoError(t, err)
 
-	// ACT: Perform multi-pool swaps.
-	routes := []types.Route{
-		{
-			PoolId:  0,
-			DenomTo: "uusdn",
-		},
+	// ASSERT: The base fee has been applied.
+	assert.Equal(t, msg.RewardsFee, res.Swaps[0].RewardsFee)
+}
+
+func TestDirectionalFee(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	msg := &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            1_000_000,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+		DirectionalFee: &stableswap.DirectionalFee{
+			PairToBaseFee: -1,
+			BaseToPairFee: 4_000_000,
+		},
+	}
+
+	// ACT: Attempt to create a Pool with a negative directional fee.
+	_, err := stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the negative fee.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to create a Pool with directional fees outside of the dynamic fee bounds.
+	msg.DirectionalFee.PairToBaseFee = 500_000
+	msg.DynamicFee = &stableswap.DynamicFee{Multiplier: math.LegacyOneDec(), MinFee: 1_000_000, MaxFee: 5_000_000}
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid bounds.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ARRANGE: Create a Pool with the directional fee.
+	msg.DynamicFee = nil
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	bank.Balances[bob.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+
+	// ACT: Swap from the pair to the base denom.
+	res, err := server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The pair to base fee has been applied.
+	assert.Equal(t, msg.DirectionalFee.PairToBaseFee, res.Swaps[0].RewardsFee)
+
+	// ACT: Swap from the base to the pair denom.
+	res, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdn", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdc"}},
+		Min:    sdk.NewCoin("uusdc", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The base to pair fee has been applied.
+	assert.Equal(t, msg.DirectionalFee.BaseToPairFee, res.Swaps[0].RewardsFee)
+
+	// ARRANGE: Disable the directional fee.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       msg.RateMultipliers,
+	})
+	require.NoError(t, err)
+
+	// ACT: Swap from the base to the pair denom.
+	res, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdn", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdc"}},
+		Min:    sdk.NewCoin("uusdc", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The rewards fee has been applied.
+	assert.Equal(t, msg.RewardsFee, res.Swaps[0].RewardsFee)
+}
+
+func TestFeeTiers(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool with liquidity.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            1_000_000,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)))
+	swap := func(amount int64) *types.MsgSwapResponse {
+		res, err := server.Swap(ctx, &types.MsgSwap{
+			Signer: bob.Address,
+			Amount: sdk.NewCoin("uusdc", math.NewInt(amount)),
+			Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+			Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+		})
+		require.NoError(t, err)
+		return res
+	}
+	tiers := []types.FeeTier{
+		{MinVolume: math.NewInt(50 * ONE), Discount: math.LegacyNewDecWithPrec(5, 1)},
+		{MinVolume: math.NewInt(500 * ONE), Discount: math.LegacyNewDecWithPrec(8, 1)},
+	}
+
+	// ACT: Attempt to set the tiers without the authority.
+	_, err = server.SetFeeTiers(ctx, &types.MsgSetFeeTiers{Signer: bob.Address, Tiers: tiers})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to set tiers not sorted by volume.
+	_, err = server.SetFeeTiers(ctx, &types.MsgSetFeeTiers{Signer: "authority", Tiers: []types.FeeTier{tiers[1], tiers[0]}})
+	// ASSERT: The action should've failed due to the invalid tiers.
+	require.ErrorIs(t, err, types.ErrInvalidFeeTiers)
+
+	// ACT: Attempt to set a tier with a discount above 1.
+	_, err = server.SetFeeTiers(ctx, &types.MsgSetFeeTiers{Signer: "authority", Tiers: []types.FeeTier{
+		{MinVolume: math.NewInt(ONE), Discount: math.LegacyNewDec(2)},
+	}})
+	// ASSERT: The action should've failed due to the invalid discount.
+	require.ErrorIs(t, err, types.ErrInvalidFeeTiers)
+
+	// ARRANGE: Set the tiers.
+	_, err = server.SetFeeTiers(ctx, &types.MsgSetFeeTiers{Signer: "authority", Tiers: tiers})
+	require.NoError(t, err)
+
+	// ACT: Swap below the first tier volume.
+	res := swap(10 * ONE)
+	// ASSERT: The full fee has been applied.
+	require.Equal(t, int64(1_000_000), res.Swaps[0].RewardsFee)
+
+	// ACT: Swap reaching the first tier volume, and swap again.
+	swap(50 * ONE)
+	res = swap(10 * ONE)
+
+	// ASSERT: The first tier discount has been applied.
+	require.Equal(t, int64(500_000), res.Swaps[0].RewardsFee)
+	tierRes, err := queryServer.AccountFeeTier(ctx, &types.QueryAccountFeeTier{Address: bob.Address})
+	require.NoError(t, err)
+	require.True(t, tierRes.Volume.GT(math.NewInt(60*ONE)))
+	require.Equal(t, tiers[0].Discount, tierRes.Discount)
+
+	// ACT: Swap after the rolling window has passed.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 2, 1, 1, 1, 1, 1, time.UTC)})
+	res = swap(10 * ONE)
+
+	// ASSERT: The full fee has been applied, and the expired volume has been removed.
+	require.Equal(t, int64(1_000_000), res.Swaps[0].RewardsFee)
+	require.Len(t, k.GetSwapVolumes(ctx), 1)
+
+	// ACT: Attempt to exempt an invalid address.
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: "authority", Add: []string{bob.Invalid}})
+	// ASSERT: The action should've failed due to the invalid address.
+	require.Error(t, err)
+
+	// ACT: Attempt to both add and remove the same address.
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: "authority", Add: []string{bob.Address}, Remove: []string{bob.Address}})
+	// ASSERT: The action should've failed due to the duplicated address.
+	require.ErrorIs(t, err, types.ErrInvalidFeeTiers)
+
+	// ARRANGE: Exempt Bob from the swap fees.
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: "authority", Add: []string{bob.Address}})
+	require.NoError(t, err)
+
+	// ACT: Swap with an exempt account.
+	res = swap(10 * ONE)
+
+	// ASSERT: No fees have been charged.
+	require.Equal(t, int64(0), res.Swaps[0].RewardsFee)
+	require.True(t, res.Swaps[0].Fees.IsZero())
+	tiersRes, err := queryServer.FeeTiers(ctx, &types.QueryFeeTiers{})
+	require.NoError(t, err)
+	require.Equal(t, tiers, tiersRes.Tiers)
+	require.Equal(t, []string{bob.Address}, tiersRes.Exempt)
+
+	// ACT: Remove the exemption of Bob and swap.
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: "authority", Remove: []string{bob.Address}})
+	require.NoError(t, err)
+	res = swap(10 * ONE)
+
+	// ASSERT: The full fee has been applied.
+	require.Equal(t, int64(1_000_000), res.Swaps[0].RewardsFee)
+}
+
+func TestFeeTiersMultiHopVolume(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	now := time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	// ARRANGE: Create two Pools with liquidity.
+	for poolId, pair := range []string{"uusdc", "uusde"} {
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  pair,
+			RewardsFee:            1_000_000,
+			ProtocolFeePercentage: 1,
+			InitialA:              100,
+			FutureA:               100,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin(pair, math.NewInt(1000000000000000000)),
+			),
+		})
+		require.NoError(t, err)
+		bank.Balances[alice.Address] = sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+			sdk.NewCoin(pair, math.NewInt(1_000*ONE)),
+		)
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: alice.Address,
+			PoolId: uint64(poolId),
+			Amount: bank.Balances[alice.Address],
+		})
+		require.NoError(t, err)
+	}
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)))
+
+	// ACT: Swap through both Pools, going through the base denom.
+	res, err := server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}, {PoolId: 1, DenomTo: "uusde"}},
+		Min:    sdk.NewCoin("uusde", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Swaps, 2)
+
+	// ASSERT: The base denom amount has been accounted once to the volume.
+	require.Equal(t, res.Swaps[0].Out.Amount, k.GetRollingSwapVolume(ctx, bob.Address, now.Unix()))
+}
+
+func BenchmarkSwap(b *testing.B) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(b, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.Nil(b, err)
+
+	// ARRANGE: Provide liquidity.
+	nLiquidity := sdk.NewCoin("uusdn", math.NewInt(1_000_000_000*ONE))
+	usdcLiquidity := sdk.NewCoin("uusdc", math.NewInt(1_000_000_000*ONE))
+	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], usdcLiquidity)
+	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], nLiquidity)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(usdcLiquidity, nLiquidity),
+	})
+	assert.NoError(b, err)
+
+	// ACT: Perform swaps.
+	routes := []types.Route{
 		{
-			PoolId:  1,
-			DenomTo: "uusde",
+			PoolId:  0,
+			DenomTo: "uusdn",
 		},
 	}
 	bank.Balances[bob.Address] = append(bank.Balances[bob.Address], sdk.NewCoin("uusdc", math.NewInt(1_000_000_000*ONE)))
+	bank.Balances[bob.Address] = append(bank.Balances[bob.Address], sdk.NewCoin("uusdn", math.NewInt(1_000_000_000*ONE)))
 	for i := 0; i < b.N; i++ {
 		_, err = server.Swap(ctx, &types.MsgSwap{
 			Signer: bob.Address,
 			Amount: sdk.NewCoin("uusdc", math.NewInt(1*ONE)),
 			Routes: routes,
-			Min:    sdk.NewCoin("uusde", math.NewInt(1_000)),
+			Min:    sdk.NewCoin("uusdn", math.NewInt(1_000)),
 		})
 		assert.NoError(b, err)
 	}
 }
+
+func BenchmarkMultiPoolSwap(b *testing.B) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(b, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+
+	alice, tom, bob := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create 2 Pools.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.Nil(b, err)
+	_, err = stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusde",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusde", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.Nil(b, err)
+
+	// ARRANGE: Provide liquidity in both Pools.
+	nLiquidity := sdk.NewCoin("uusdn", math.NewInt(1_000_000*ONE))
+	usdcLiquidity := sdk.NewCoin("uusdc", math.NewInt(1_000_000*ONE))
+	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], usdcLiquidity)
+	bank.Balances[alice.Address] = append(bank.Balances[alice.Address], nLiquidity)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(usdcLiquidity, nLiquidity),
+	})
+	require.NoError(b, err)
+	nLiquidity2 := sdk.NewCoin("uusdn", math.NewInt(1_000_000*ONE))
+	usdeLiquidity := sdk.NewCoin("uusde", math.NewInt(1_000_000*ONE))
+	bank.Balances[tom.Address] = append(bank.Balances[tom.Address], usdeLiquidity)
+	bank.Balances[tom.Address] = append(bank.Balances[tom.Address], nLiquidity2)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: tom.Address,
+		PoolId: 1,
+		Amount: sdk.NewCoins(nLiquidity, usdeLiquidity),
+	})
+	require.NoError(b, err)
+
+	// ACT: Perform multi-pool swaps.
+	routes := []types.Route{
+		{
+			PoolId:  0,
+			DenomTo: "uusdn",
+		},
+		{
+			PoolId:  1,
+			DenomTo: "uusde",
+		},
+	}
+	bank.Balances[bob.Address] = append(bank.Balances[bob.Address], sdk.NewCoin("uusdc", math.NewInt(1_000_000_000*ONE)))
+	for i := 0; i < b.N; i++ {
+		_, err = server.Swap(ctx, &types.MsgSwap{
+			Signer: bob.Address,
+			Amount: sdk.NewCoin("uusdc", math.NewInt(1*ONE)),
+			Routes: routes,
+			Min:    sdk.NewCoin("uusde", math.NewInt(1_000)),
+		})
+		assert.NoError(b, err)
+	}
+}
+
+func TestAffiliateFee(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice, bob, affiliate := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool with liquidity.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)))
+
+	msg := &types.MsgSwap{
+		Signer:          bob.Address,
+		Amount:          sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+		Routes:          []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:             sdk.NewCoin("uusdn", math.ZeroInt()),
+		AffiliateFeeBps: 50,
+	}
+
+	// ACT: Attempt to swap with an affiliate fee but without an affiliate.
+	_, err = server.Swap(ctx, msg)
+	// ASSERT: The action should've failed due to the missing affiliate.
+	require.ErrorIs(t, err, types.ErrInvalidAffiliate)
+
+	// ACT: Attempt to swap with an invalid affiliate.
+	msg.Affiliate = affiliate.Invalid
+	_, err = server.Swap(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid affiliate.
+	require.ErrorIs(t, err, types.ErrInvalidAffiliate)
+
+	// ACT: Attempt to swap with an affiliate fee above the maximum.
+	msg.Affiliate = affiliate.Address
+	msg.AffiliateFeeBps = 101
+	_, err = server.Swap(ctx, msg)
+	// ASSERT: The action should've failed due to the fee above the maximum.
+	require.ErrorIs(t, err, types.ErrInvalidAffiliate)
+
+	// ACT: Attempt to swap with a zero affiliate fee.
+	msg.AffiliateFeeBps = 0
+	_, err = server.Swap(ctx, msg)
+	// ASSERT: The action should've failed due to the zero fee.
+	require.ErrorIs(t, err, types.ErrInvalidAffiliate)
+
+	// ACT: Swap with a 0.5% affiliate fee.
+	msg.AffiliateFeeBps = 50
+	res, err := server.Swap(ctx, msg)
+	require.NoError(t, err)
+
+	// ASSERT: The affiliate received its cut of the output, deducted from the result.
+	out := res.Swaps[0].Out.Amount
+	expectedFee := out.MulRaw(50).QuoRaw(1e4)
+	assert.True(t, expectedFee.IsPositive())
+	assert.Equal(t, sdk.NewCoin("uusdn", expectedFee), res.AffiliateFee)
+	assert.Equal(t, out.Sub(expectedFee), res.Result.Amount)
+	assert.Equal(t, expectedFee, bank.Balances[affiliate.Address].AmountOf("uusdn"))
+	assert.Equal(t, res.Result.Amount, bank.Balances[bob.Address].AmountOf("uusdn"))
+
+	// ACT: Attempt to swap with a min amount only reached before the affiliate fee.
+	msg.Min = sdk.NewCoin("uusdn", out.Sub(expectedFee).AddRaw(1))
+	_, err = server.Swap(ctx, msg)
+	// ASSERT: The action should've failed due to the slippage.
+	require.Error(t, err)
+}
+
+func TestUpdateParams(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice := utils.TestAccount()
+
+	// ARRANGE: Create a Pool.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(ONE)),
+	)
+
+	// ACT: Query the params before being set.
+	res, err := queryServer.Params(ctx, &types.QueryParams{})
+	require.NoError(t, err)
+	// ASSERT: The params default to the module config.
+	assert.Equal(t, types.Params{
+		BaseMinimumDeposit:                1e6,
+		MaxAddLiquiditySlippagePercentage: 0.5e4,
+		UnbondingBlockDelta:               10,
+	}, res.Params)
+
+	// ACT: Attempt to add liquidity below the minimum deposit.
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(ONE/2)),
+			sdk.NewCoin("uusdc", math.NewInt(ONE/2)),
+		),
+	})
+	// ASSERT: The action should've failed due to the minimum deposit.
+	require.ErrorContains(t, err, "minimum amount of 1000000uusdn")
+
+	params := types.Params{
+		BaseMinimumDeposit:                ONE / 10,
+		MaxAddLiquiditySlippagePercentage: 1e4,
+		UnbondingBlockDelta:               5,
+	}
+
+	// ACT: Attempt to update the params with an invalid authority.
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: alice.Address, Params: params})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to update the params with an invalid unbonding block delta.
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: types.Params{
+		BaseMinimumDeposit:                ONE,
+		MaxAddLiquiditySlippagePercentage: 1e4,
+	}})
+	// ASSERT: The action should've failed due to the invalid params.
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// ACT: Attempt to update the params with a slippage above 100%.
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: types.Params{
+		BaseMinimumDeposit:                ONE,
+		MaxAddLiquiditySlippagePercentage: 1e6 + 1,
+		UnbondingBlockDelta:               5,
+	}})
+	// ASSERT: The action should've failed due to the invalid params.
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// ACT: Update the params.
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	require.NoError(t, err)
+
+	// ASSERT: The params have been updated.
+	res, err = queryServer.Params(ctx, &types.QueryParams{})
+	require.NoError(t, err)
+	assert.Equal(t, params, res.Params)
+
+	// ACT: Add liquidity below the previous minimum deposit.
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(ONE/2)),
+			sdk.NewCoin("uusdc", math.NewInt(ONE/2)),
+		),
+	})
+	// ASSERT: The action should've succeeded with the lowered minimum deposit.
+	require.NoError(t, err)
+}
+
+func TestRoles(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	pauser, manager, collector := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	createPool := &stableswap.MsgCreatePool{
+		Signer:                manager.Address,
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	}
+
+	// ACT: Attempt to grant a role with an invalid authority.
+	_, err := server.GrantRole(ctx, &types.MsgGrantRole{Signer: pauser.Address, Address: pauser.Address, Role: types.ROLE_PAUSER})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to grant an unspecified role.
+	_, err = server.GrantRole(ctx, &types.MsgGrantRole{Signer: "authority", Address: pauser.Address, Role: types.ROLE_UNSPECIFIED})
+	// ASSERT: The action should've failed due to the invalid role.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	// ACT: Attempt to grant a role to an invalid address.
+	_, err = server.GrantRole(ctx, &types.MsgGrantRole{Signer: "authority", Address: pauser.Invalid, Role: types.ROLE_PAUSER})
+	// ASSERT: The action should've failed due to the invalid address.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	// ACT: Attempt to create a Pool without the pool manager role.
+	_, err = stableswapServer.CreatePool(ctx, createPool)
+	// ASSERT: The action should've failed due to the missing role.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ARRANGE: Grant the roles.
+	for address, role := range map[string]types.Role{
+		pauser.Address:    types.ROLE_PAUSER,
+		manager.Address:   types.ROLE_POOL_MANAGER,
+		collector.Address: types.ROLE_FEE_COLLECTOR,
+	} {
+		_, err = server.GrantRole(ctx, &types.MsgGrantRole{Signer: "authority", Address: address, Role: role})
+		require.NoError(t, err)
+	}
+
+	// ACT: Attempt to grant an already granted role.
+	_, err = server.GrantRole(ctx, &types.MsgGrantRole{Signer: "authority", Address: pauser.Address, Role: types.ROLE_PAUSER})
+	// ASSERT: The action should've failed due to the existing grant.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+
+	// ASSERT: The grants are returned by the queries.
+	roles, err := queryServer.Roles(ctx, &types.QueryRoles{})
+	require.NoError(t, err)
+	assert.Len(t, roles.Grants, 3)
+	rolesByAddress, err := queryServer.RolesByAddress(ctx, &types.QueryRolesByAddress{Address: pauser.Address})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Role{types.ROLE_PAUSER}, rolesByAddress.Roles)
+
+	// ACT: Create a Pool with the pool manager role.
+	_, err = stableswapServer.CreatePool(ctx, createPool)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to create a Pool with the pauser role.
+	createPool.Signer = pauser.Address
+	_, err = stableswapServer.CreatePool(ctx, createPool)
+	// ASSERT: The action should've failed due to the missing role.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to withdraw the protocol fees with the pauser role.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{Signer: pauser.Address, To: pauser.Address})
+	// ASSERT: The action should've failed due to the missing role.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to pause the Pool with the fee collector role.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{Signer: collector.Address, PoolIds: []uint64{0}})
+	// ASSERT: The action should've failed due to the missing role.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Pause and unpause the Pool with the pauser role.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{Signer: pauser.Address, PoolIds: []uint64{0}})
+	require.NoError(t, err)
+	// ASSERT: The Pool has been paused.
+	assert.True(t, k.GetPaused(ctx)[0])
+	_, err = server.UnpauseByPoolIds(ctx, &types.MsgUnpauseByPoolIds{Signer: pauser.Address, PoolIds: []uint64{0}})
+	require.NoError(t, err)
+	assert.False(t, k.GetPaused(ctx)[0])
+
+	// ACT: Withdraw the protocol fees with the fee collector role.
+	_, err = server.WithdrawProtocolFees(ctx, &types.MsgWithdrawProtocolFees{Signer: collector.Address, To: collector.Address})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Revoke the pauser role.
+	_, err = server.RevokeRole(ctx, &types.MsgRevokeRole{Signer: "authority", Address: pauser.Address, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+
+	// ASSERT: The pauser can no longer pause the Pool.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{Signer: pauser.Address, PoolIds: []uint64{0}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to revoke a role that is not granted.
+	_, err = server.RevokeRole(ctx, &types.MsgRevokeRole{Signer: "authority", Address: pauser.Address, Role: types.ROLE_PAUSER})
+	// ASSERT: The action should've failed due to the missing grant.
+	require.ErrorIs(t, err, types.ErrInvalidRole)
+}
+
+func TestClosePool(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000, 0)})
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	alice, bob, carol, dave, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool with two liquidity providers, one of them unbonding part of its shares.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 50,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	for _, provider := range []struct {
+		address string
+		amount  int64
+	}{{alice.Address, 1_000 * ONE}, {carol.Address, 500 * ONE}} {
+		bank.Balances[provider.address] = sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(provider.amount)),
+			sdk.NewCoin("uusdc", math.NewInt(provider.amount)),
+		)
+		_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+			Signer: provider.address,
+			PoolId: 0,
+			Amount: bank.Balances[provider.address],
+		})
+		require.NoError(t, err)
+	}
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     carol.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(50),
+	})
+	require.NoError(t, err)
+
+	// ARRANGE: Swap to accrue rewards and protocol fees, and fund an incentive.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)))
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(100*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	require.NoError(t, err)
+	bank.Balances[dave.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	_, err = server.CreateIncentive(ctx, &types.MsgCreateIncentive{
+		Signer:    dave.Address,
+		PoolId:    0,
+		Amount:    bank.Balances[dave.Address],
+		StartTime: time.Unix(2_000, 0),
+		EndTime:   time.Unix(3_000, 0),
+	})
+	require.NoError(t, err)
+
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	require.NoError(t, err)
+	liquidity := controller.GetLiquidity(ctx)
+	protocolFees := bank.Balances[controller.GetProtocolFeesAddresses()[0].String()]
+	require.True(t, protocolFees.IsAllPositive())
+
+	// ACT: Attempt to close the Pool without the authority.
+	_, err = server.ClosePool(ctx, &types.MsgClosePool{Signer: "user", PoolId: 0, To: treasury.Address})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to close a non existing Pool.
+	_, err = server.ClosePool(ctx, &types.MsgClosePool{Signer: "authority", PoolId: 1, To: treasury.Address})
+	// ASSERT: The action should've failed due to the invalid pool.
+	require.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ACT: Close the Pool.
+	res, err := server.ClosePool(ctx, &types.MsgClosePool{Signer: "authority", PoolId: 0, To: treasury.Address})
+	require.NoError(t, err)
+
+	// ASSERT: The providers have been settled pro-rata, including the unbonding shares.
+	for _, denom := range []string{"uusdn", "uusdc"} {
+		assert.True(t, res.Settled.AmountOf(denom).LTE(liquidity.AmountOf(denom)))
+		assert.True(t, res.Settled.AmountOf(denom).GTE(liquidity.AmountOf(denom).SubRaw(2)))
+		assert.True(t, bank.Balances[alice.Address].AmountOf(denom).Sub(bank.Balances[carol.Address].AmountOf(denom).MulRaw(2)).Abs().LTE(math.NewInt(2)))
+	}
+	assert.Empty(t, k.Stableswap.GetBondedPositions(ctx))
+	assert.Empty(t, k.Stableswap.GetUnbondingPositions(ctx))
+	assert.Empty(t, k.Stableswap.GetUsersTotalBondedShares(ctx))
+	assert.Empty(t, k.Stableswap.GetUsersTotalUnbondingShares(ctx))
+
+	// ASSERT: The incentive has been refunded, and the pool accounts swept.
+	assert.Empty(t, k.GetIncentives(ctx))
+	assert.Equal(t, math.NewInt(100*ONE), bank.Balances[dave.Address].AmountOf("uusdn"))
+	assert.True(t, bank.Balances[controller.GetAddress()].IsZero())
+	assert.True(t, bank.Balances[controller.GetProtocolFeesAddresses()[0].String()].IsZero())
+	assert.True(t, res.Swept.IsAllGTE(protocolFees))
+	assert.Equal(t, res.Swept, bank.Balances[treasury.Address])
+
+	// ASSERT: The Pool is closed, hidden from the pools and rates, but still queryable.
+	pools, err := queryServer.Pools(ctx, &types.QueryPools{})
+	require.NoError(t, err)
+	assert.Empty(t, pools.Pools)
+	rates, err := queryServer.Rates(ctx, &types.QueryRates{})
+	require.NoError(t, err)
+	assert.Empty(t, rates.Rates)
+	pool, err := queryServer.Pool(ctx, &types.QueryPool{PoolId: 0})
+	require.NoError(t, err)
+	assert.True(t, pool.Pool.Closed)
+
+	// ACT: Attempt to unpause the Pool.
+	_, err = server.UnpauseByPoolIds(ctx, &types.MsgUnpauseByPoolIds{Signer: "authority", PoolIds: []uint64{0}})
+	require.NoError(t, err)
+	// ASSERT: The Pool is still paused.
+	assert.True(t, k.IsPaused(ctx, 0))
+
+	// ACT: Attempt to swap, deposit, update or close the Pool again.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)))
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	// ASSERT: The actions should've failed due to the closed pool.
+	require.ErrorContains(t, err, "pool 0 is paused")
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: bank.Balances[bob.Address],
+	})
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{Signer: "authority", PoolId: 0, InitialA: 100})
+	require.ErrorIs(t, err, types.ErrInvalidPool)
+	_, err = server.ClosePool(ctx, &types.MsgClosePool{Signer: "authority", PoolId: 0, To: treasury.Address})
+	require.ErrorIs(t, err, types.ErrInvalidPool)
+}
+
+func TestWithdrawOnly(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000, 0)})
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool with a liquidity provider.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 50,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to enable the withdraw-only mode without the authority.
+	_, err = server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "user", PoolIds: []uint64{0}, Enabled: true})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Enable the withdraw-only mode, including a non existing Pool.
+	res, err := server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "authority", PoolIds: []uint64{0, 1}, Enabled: true})
+	require.NoError(t, err)
+	// ASSERT: Only the existing Pool has been updated, and it is now paused.
+	assert.Equal(t, []uint64{0}, res.PoolIds)
+	assert.True(t, k.IsPaused(ctx, 0))
+	withdrawOnly, err := queryServer.WithdrawOnly(ctx, &types.QueryWithdrawOnly{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0}, withdrawOnly.PoolIds)
+
+	// ACT: Attempt to swap and deposit into the Pool.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)))
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	})
+	// ASSERT: The actions should've failed due to the paused pool.
+	require.ErrorContains(t, err, "pool 0 is paused")
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: bank.Balances[bob.Address],
+	})
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ACT: Claim the rewards of the Pool.
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{0}})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Remove half of the liquidity, without requesting an instant removal.
+	removed, err := stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(50),
+	})
+	require.NoError(t, err)
+	// ASSERT: The liquidity has been returned immediately, without any exit fee.
+	assert.True(t, removed.ExitFee.IsZero())
+	assert.Empty(t, k.Stableswap.GetUnbondingPositions(ctx))
+	for _, denom := range []string{"uusdn", "uusdc"} {
+		assert.True(t, bank.Balances[alice.Address].AmountOf(denom).GTE(math.NewInt(500*ONE-1)))
+	}
+
+	// ACT: Unpause the Pool.
+	_, err = server.UnpauseByPoolIds(ctx, &types.MsgUnpauseByPoolIds{Signer: "authority", PoolIds: []uint64{0}})
+	require.NoError(t, err)
+	// ASSERT: The withdraw-only mode has been disabled as well.
+	assert.False(t, k.IsPaused(ctx, 0))
+	assert.False(t, k.IsWithdrawOnly(ctx, 0))
+
+	// ACT: Enable and then disable the withdraw-only mode.
+	_, err = server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "authority", PoolIds: []uint64{0}, Enabled: true})
+	require.NoError(t, err)
+	_, err = server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "authority", PoolIds: []uint64{0}, Enabled: false})
+	require.NoError(t, err)
+	// ASSERT: The Pool is still paused, and withdrawals are blocked again.
+	assert.True(t, k.IsPaused(ctx, 0))
+	assert.False(t, k.IsWithdrawOnly(ctx, 0))
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(50),
+	})
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+}
+
+func TestPauseActivities(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000, 0)})
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool with a liquidity provider.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 50,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(2_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(2_000*ONE)),
+	)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)), sdk.NewCoin("uusdc", math.NewInt(1_000*ONE))),
+	})
+	require.NoError(t, err)
+	swap := &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.ZeroInt()),
+	}
+
+	// ACT: Attempt to pause the swaps without the authority or the pauser role.
+	_, err = server.PauseActivities(ctx, &types.MsgPauseActivities{
+		Signer: "user", PoolIds: []uint64{0}, Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_SWAPS},
+	})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to pause without activities, or with an unknown one.
+	_, err = server.PauseActivities(ctx, &types.MsgPauseActivities{Signer: "authority", PoolIds: []uint64{0}})
+	// ASSERT: The actions should've failed due to the invalid activities.
+	require.ErrorIs(t, err, types.ErrInvalidPauseActivity)
+	_, err = server.PauseActivities(ctx, &types.MsgPauseActivities{
+		Signer: "authority", PoolIds: []uint64{0}, Activities: []types.PauseActivity{3},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPauseActivity)
+
+	// ACT: Pause the swaps, including a non existing Pool.
+	res, err := server.PauseActivities(ctx, &types.MsgPauseActivities{
+		Signer: "authority", PoolIds: []uint64{0, 1}, Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_SWAPS},
+	})
+	require.NoError(t, err)
+	// ASSERT: Only the existing Pool has been updated, without being fully paused.
+	assert.Equal(t, []uint64{0}, res.PoolIds)
+	assert.False(t, k.IsPaused(ctx, 0))
+	paused, err := queryServer.Paused(ctx, &types.QueryPaused{})
+	require.NoError(t, err)
+	assert.Empty(t, paused.PausedPools)
+	assert.Equal(t, []types.PausedActivities{{PoolId: 0, Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_SWAPS}}}, paused.PausedActivities)
+
+	// ACT: Attempt to swap.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)))
+	_, err = server.Swap(ctx, swap)
+	// ASSERT: The action should've failed due to the paused swaps.
+	require.ErrorContains(t, err, "pool 0 is paused")
+
+	// ACT: Add and remove liquidity, and claim the rewards.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(2_000, 0)})
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(10),
+	})
+	require.NoError(t, err)
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{0}})
+	// ASSERT: The actions should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Pause the reward claims and the liquidity removals.
+	_, err = server.PauseActivities(ctx, &types.MsgPauseActivities{
+		Signer:     "authority",
+		PoolIds:    []uint64{0},
+		Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_REWARD_CLAIMS, types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY},
+	})
+	require.NoError(t, err)
+	// ASSERT: The flags have been combined with the existing ones.
+	paused, err = queryServer.Paused(ctx, &types.QueryPaused{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.PauseActivity{
+		types.PAUSE_ACTIVITY_SWAPS, types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY, types.PAUSE_ACTIVITY_REWARD_CLAIMS,
+	}, paused.PausedActivities[0].Activities)
+	_, err = server.WithdrawRewards(ctx, &types.MsgWithdrawRewards{Signer: alice.Address, PoolIds: []uint64{0}})
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(10),
+	})
+	require.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ACT: Unpause all the activities.
+	_, err = server.UnpauseActivities(ctx, &types.MsgUnpauseActivities{
+		Signer:     "authority",
+		PoolIds:    []uint64{0},
+		Activities: types.PauseActivities,
+	})
+	require.NoError(t, err)
+	// ASSERT: The Pool has no paused activities anymore, and the swaps are processed again.
+	paused, err = queryServer.Paused(ctx, &types.QueryPaused{})
+	require.NoError(t, err)
+	assert.Empty(t, paused.PausedActivities)
+	_, err = server.Swap(ctx, swap)
+	require.NoError(t, err)
+}
+
+func TestDenomRegistry(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000, 0)})
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+	createPool := func(pair string) error {
+		_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+			Signer:                "authority",
+			Pair:                  pair,
+			RewardsFee:            4e3,
+			ProtocolFeePercentage: 50,
+			InitialA:              100,
+			FutureA:               100,
+			FutureATime:           1893452400,
+			RateMultipliers: sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin(pair, math.NewInt(1000000000000000000)),
+			),
+		})
+		return err
+	}
+
+	// ARRANGE: Create a Pool while the registry is empty, with a liquidity provider.
+	require.NoError(t, createPool("uusdc"))
+	bank.Balances[alice.Address] = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1_000*ONE)),
+		sdk.NewCoin("uusdc", math.NewInt(1_000*ONE)),
+	)
+	_, err := stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: bank.Balances[alice.Address],
+	})
+	require.NoError(t, err)
+	swap := &types.MsgSwap{
+		Signer: bob.Address,
+		Amount: sdk.NewCoin("uusdn", math.NewInt(10*ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdc"}},
+		Min:    sdk.NewCoin("uusdc", math.ZeroInt()),
+	}
+
+	// ACT: Attempt to update the registry without the authority.
+	_, err = server.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: "user", Add: []types.AllowedDenom{{Denom: "ueure"}},
+	})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to update the registry with an invalid or duplicated denom.
+	_, err = server.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: "authority", Add: []types.AllowedDenom{{Denom: "1"}},
+	})
+	// ASSERT: The actions should've failed due to the invalid denoms.
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+	_, err = server.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: "authority", Add: []types.AllowedDenom{{Denom: "ueure"}}, Remove: []string{"ueure"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	// ACT: Register a denom.
+	allowed := types.AllowedDenom{Denom: "ueure", Issuer: "Monerium", Decimals: 6, RiskTier: "low"}
+	_, err = server.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: "authority", Add: []types.AllowedDenom{allowed},
+	})
+	require.NoError(t, err)
+	// ASSERT: The registry contains the denom, and only registered denoms can be used as pairs.
+	allowedDenoms, err := queryServer.AllowedDenoms(ctx, &types.QueryAllowedDenoms{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.AllowedDenom{allowed}, allowedDenoms.Denoms)
+	require.ErrorIs(t, createPool("uusde"), types.ErrInvalidDenom)
+	require.NoError(t, createPool("ueure"))
+
+	// ACT: Attempt to block a denom without the authority, including with the pauser role.
+	require.NoError(t, k.SetRole(ctx, alice.Address, types.ROLE_PAUSER))
+	for _, signer := range []string{"user", alice.Address} {
+		_, err = server.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{Signer: signer, Add: []string{"uusdc"}})
+		// ASSERT: The action should've failed due to the invalid authority.
+		require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	}
+
+	// ACT: Block a denom.
+	_, err = server.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{Signer: "authority", Add: []string{"uusdc"}})
+	require.NoError(t, err)
+	// ASSERT: The swaps into the blocked denom are rejected.
+	blockedDenoms, err := queryServer.BlockedDenoms(ctx, &types.QueryBlockedDenoms{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uusdc"}, blockedDenoms.Denoms)
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(10*ONE)))
+	_, err = server.Swap(ctx, swap)
+	require.ErrorContains(t, err, "uusdc is blocked")
+
+	// ACT: Unblock the denom, and clear the registry.
+	_, err = server.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{Signer: "authority", Remove: []string{"uusdc"}})
+	require.NoError(t, err)
+	_, err = server.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{Signer: "authority", Remove: []string{"ueure"}})
+	require.NoError(t, err)
+	// ASSERT: The swaps are processed again, and any denom can be used as pair.
+	blockedDenoms, err = queryServer.BlockedDenoms(ctx, &types.QueryBlockedDenoms{})
+	require.NoError(t, err)
+	assert.Empty(t, blockedDenoms.Denoms)
+	_, err = server.Swap(ctx, swap)
+	require.NoError(t, err)
+	require.NoError(t, createPool("uusde"))
+}
+
+func TestAuthorityHandover(t *testing.T) {
+	k, ctx := mocks.SwapKeeper(t)
+	server := keeper.NewMsgServer(k)
+	queryServer := keeper.NewQueryServer(k)
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: The authority defaults to the value of the module config.
+	res, err := queryServer.Authority(ctx, &types.QueryAuthority{})
+	require.NoError(t, err)
+	assert.Equal(t, "authority", res.Authority)
+	assert.Empty(t, res.PendingAuthority)
+
+	// ACT: Attempt to propose a new authority without the authority.
+	_, err = server.ProposeAuthority(ctx, &types.MsgProposeAuthority{Signer: alice.Address, NewAuthority: alice.Address})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to propose an invalid address.
+	_, err = server.ProposeAuthority(ctx, &types.MsgProposeAuthority{Signer: "authority", NewAuthority: "noble1typo"})
+	// ASSERT: The action should've failed due to the invalid address.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to accept without a pending proposal.
+	_, err = server.AcceptAuthority(ctx, &types.MsgAcceptAuthority{Signer: alice.Address})
+	// ASSERT: The action should've failed due to the missing proposal.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Propose a new authority.
+	_, err = server.ProposeAuthority(ctx, &types.MsgProposeAuthority{Signer: "authority", NewAuthority: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: The proposal is pending, and the current authority is unchanged.
+	res, err = queryServer.Authority(ctx, &types.QueryAuthority{})
+	require.NoError(t, err)
+	assert.Equal(t, "authority", res.Authority)
+	assert.Equal(t, alice.Address, res.PendingAuthority)
+
+	// ACT: Attempt to accept the handover from another account.
+	_, err = server.AcceptAuthority(ctx, &types.MsgAcceptAuthority{Signer: bob.Address})
+	// ASSERT: The action should've failed due to the invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Accept the handover.
+	_, err = server.AcceptAuthority(ctx, &types.MsgAcceptAuthority{Signer: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: The new authority took over, and the previous one lost its permissions.
+	res, err = queryServer.Authority(ctx, &types.QueryAuthority{})
+	require.NoError(t, err)
+	assert.Equal(t, alice.Address, res.Authority)
+	assert.Empty(t, res.PendingAuthority)
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: "authority", Add: []string{bob.Address}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = server.SetFeeExemptions(ctx, &types.MsgSetFeeExemptions{Signer: alice.Address, Add: []string{bob.Address}})
+	require.NoError(t, err)
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{Signer: "authority", PoolIds: []uint64{0}})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Propose a handover, and cancel it.
+	_, err = server.ProposeAuthority(ctx, &types.MsgProposeAuthority{Signer: alice.Address, NewAuthority: bob.Address})
+	require.NoError(t, err)
+	_, err = server.ProposeAuthority(ctx, &types.MsgProposeAuthority{Signer: alice.Address})
+	require.NoError(t, err)
+	// ASSERT: The cancelled proposal cannot be accepted.
+	_, err = server.AcceptAuthority(ctx, &types.MsgAcceptAuthority{Signer: bob.Address})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+}
@@ -26,6 +26,7 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	swapv1 "swap.noble.xyz/api/v1"
@@ -45,9 +46,14 @@ func NewStableSwapMsgServer(keeper *Keeper) stableswap.MsgServer {
 
 // CreatePool creates a new `StableSwap` Pool with the provided params.
 func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.MsgCreatePool) (*stableswap.MsgCreatePoolResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role, unless the permissionless creation is enabled.
+	creation := s.GetParams(ctx).PermissionlessPoolCreation
+	permissionless := false
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		if creation == nil {
+			return nil, err
+		}
+		permissionless = true
 	}
 
 	// Check if the Pair denom is valid and exists on chain.
@@ -65,6 +71,14 @@ func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "%s does not exists on chain", msg.Pair)
 	}
 
+	// Check if the Pair denom is allowed by the registry, and not blocked.
+	if !s.IsDenomAllowed(ctx, msg.Pair) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not an allowed denom", msg.Pair)
+	}
+	if s.IsDenomBlocked(ctx, msg.Pair) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomBlocked, "%s is blocked", msg.Pair)
+	}
+
 	// Check if the Initial A value is valid.
 	if msg.InitialA <= 0 {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid InitialA value")
@@ -98,6 +112,34 @@ func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid ProtocolFeePercentage value")
 	}
 
+	// Ensure that the unbonding tiers are valid.
+	if err := stableswap.ValidateUnbondingTiers(msg.UnbondingTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid UnbondingTiers: %s", err.Error())
+	}
+
+	// Ensure that the exit fee tiers are valid.
+	if err := stableswap.ValidateExitFeeTiers(msg.ExitFeeTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid ExitFeeTiers: %s", err.Error())
+	}
+	if err := stableswap.ValidateLockTiers(msg.LockTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid LockTiers: %s", err.Error())
+	}
+
+	// Ensure that the fee splits are valid.
+	if err := s.validateFeeSplits(msg.FeeSplits); err != nil {
+		return nil, err
+	}
+
+	// Ensure that the dynamic fee is valid.
+	if err := stableswap.ValidateDynamicFee(msg.DynamicFee, msg.RewardsFee); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid DynamicFee: %s", err.Error())
+	}
+
+	// Ensure that the directional fee is valid.
+	if err := stableswap.ValidateDirectionalFee(msg.DirectionalFee, msg.DynamicFee); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid DirectionalFee: %s", err.Error())
+	}
+
 	// Check if a Pool with the same Algorithm and Pair already exists.
 	algorithm := types.Algorithm(swapv1.Algorithm_STABLESWAP)
 	for _, pool := range s.GetPools(ctx) {
@@ -106,6 +148,18 @@ func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.Msg
 		}
 	}
 
+	// Ensure that the permissionless Pools are within the bounds, and collect their creation fee.
+	var creationFee sdk.Coins
+	if permissionless {
+		if err := s.validatePermissionlessPool(msg, rateMultipliers, *creation); err != nil {
+			return nil, err
+		}
+		if err := s.collectCreationFee(ctx, msg.Signer, creation.CreationFee, creation.FundCommunityPool); err != nil {
+			return nil, err
+		}
+		creationFee = creation.CreationFee
+	}
+
 	// Increase and get the next Pool ID.
 	poolId, err := s.IncreaseNextPoolID(ctx)
 	if err != nil {
@@ -153,6 +207,13 @@ func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.Msg
 		FutureATime:           msg.FutureATime,
 		RateMultipliers:       msg.RateMultipliers,
 		TotalShares:           math.LegacyZeroDec(),
+		TotalBoostedShares:    math.LegacyZeroDec(),
+		UnbondingTiers:        msg.UnbondingTiers,
+		ExitFeeTiers:          msg.ExitFeeTiers,
+		LockTiers:             msg.LockTiers,
+		FeeSplits:             msg.FeeSplits,
+		DynamicFee:            msg.DynamicFee,
+		DirectionalFee:        msg.DirectionalFee,
 	}); err != nil {
 		return nil, sdkerrors.Wrapf(err, "unable to set stableswap pool")
 	}
@@ -166,16 +227,75 @@ func (s stableswapMsgServer) CreatePool(ctx context.Context, msg *stableswap.Msg
 		InitialATime:          s.headerService.GetHeaderInfo(ctx).Time.Unix(),
 		FutureATime:           msg.FutureATime,
 		RateMultipliers:       msg.RateMultipliers,
+		UnbondingTiers:        msg.UnbondingTiers,
+		ExitFeeTiers:          msg.ExitFeeTiers,
+		LockTiers:             msg.LockTiers,
+		FeeSplits:             msg.FeeSplits,
+		DynamicFee:            msg.DynamicFee,
+		DirectionalFee:        msg.DirectionalFee,
+		Creator:               msg.Signer,
+		CreationFee:           creationFee,
 	})
 }
 
+// validatePermissionlessPool ensures that a Pool created without the pool manager role is within the
+// bounds set by the authority, leaving the fee recipients and the fee models to the authority.
+func (s stableswapMsgServer) validatePermissionlessPool(msg *stableswap.MsgCreatePool, rateMultipliers sdk.Coins, creation types.PermissionlessPoolCreation) error {
+	for _, a := range []int64{msg.InitialA, msg.FutureA} {
+		if a < creation.MinA || a > creation.MaxA {
+			return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "amplification coefficient must be between %d and %d, got %d", creation.MinA, creation.MaxA, a)
+		}
+	}
+	if msg.RewardsFee > creation.MaxRewardsFee {
+		return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "RewardsFee must be at most %d, got %d", creation.MaxRewardsFee, msg.RewardsFee)
+	}
+	if msg.ProtocolFeePercentage > creation.MaxProtocolFeePercentage {
+		return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "ProtocolFeePercentage must be at most %d, got %d", creation.MaxProtocolFeePercentage, msg.ProtocolFeePercentage)
+	}
+
+	// Compute the relative deviation of the pair rate multiplier from the base one.
+	baseRate := rateMultipliers.AmountOf(s.baseDenom).ToLegacyDec()
+	deviation := rateMultipliers.AmountOf(msg.Pair).ToLegacyDec().Sub(baseRate).Abs().Quo(baseRate)
+	if deviation.GT(creation.MaxRateMultiplierDeviation) {
+		return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "rate multipliers deviation must be at most %s, got %s", creation.MaxRateMultiplierDeviation, deviation)
+	}
+
+	// Ensure that the exit fee and unbonding tiers are within the bounds, they cannot be set when unbounded.
+	for i, tier := range msg.ExitFeeTiers {
+		if creation.MaxExitFeePercentage.IsNil() || !creation.MaxExitFeePercentage.IsPositive() {
+			return sdkerrors.Wrap(types.ErrInvalidPoolParams, "exit fee tiers can only be set by the authority")
+		}
+		if tier.FeePercentage.GT(creation.MaxExitFeePercentage) {
+			return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "exit fee tier %d fee percentage must be at most %s, got %s", i, creation.MaxExitFeePercentage, tier.FeePercentage)
+		}
+	}
+	for i, tier := range msg.UnbondingTiers {
+		if creation.MaxUnbondingDuration <= 0 {
+			return sdkerrors.Wrap(types.ErrInvalidPoolParams, "unbonding tiers can only be set by the authority")
+		}
+		if tier.Duration > creation.MaxUnbondingDuration {
+			return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "unbonding tier %d duration must be at most %s, got %s", i, creation.MaxUnbondingDuration, tier.Duration)
+		}
+	}
+
+	if len(msg.FeeSplits) != 0 || msg.DynamicFee != nil || msg.DirectionalFee != nil || len(msg.LockTiers) != 0 {
+		return sdkerrors.Wrap(types.ErrInvalidPoolParams, "fee splits, dynamic and directional fees and lock tiers can only be set by the authority")
+	}
+	return nil
+}
+
 // UpdatePool updates the params of the `StableSwap` Pool.
 func (s stableswapMsgServer) UpdatePool(ctx context.Context, msg *stableswap.MsgUpdatePool) (*stableswap.MsgUpdatePoolResponse, error) {
-	// Ensure that the signer has the required authority.
-	if msg.Signer != s.authority {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", s.authority, msg.Signer)
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		return nil, err
 	}
 
+	return s.updatePool(ctx, msg)
+}
+
+// updatePool updates the params of the `StableSwap` Pool, once the signer has been authorized.
+func (s stableswapMsgServer) updatePool(ctx context.Context, msg *stableswap.MsgUpdatePool) (*stableswap.MsgUpdatePoolResponse, error) {
 	// Get the Pool Controller.
 	controller, err := GetStableSwapController(ctx, s.Keeper, msg.PoolId)
 	if err != nil {
@@ -187,9 +307,19 @@ func (s stableswapMsgServer) UpdatePool(ctx context.Context, msg *stableswap.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "invalid pool algorithm")
 	}
 
-	// Check if the A values are valid.
-	if msg.InitialA <= 0 {
-		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid InitialA value")
+	// Ensure that the Pool has not been closed.
+	if controller.IsClosed() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is closed", msg.PoolId)
+	}
+
+	// Ensure that the amplification coefficient ramp is either unset or unchanged, as it can only be updated through MsgRampA.
+	pool, err := s.Stableswap.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if (msg.InitialA != 0 || msg.FutureA != 0 || msg.FutureATime != 0) &&
+		(msg.InitialA != pool.InitialA || msg.FutureA != pool.FutureA || msg.FutureATime != pool.FutureATime) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "amplification coefficient can only be updated through MsgRampA")
 	}
 
 	// Manually sort and validate the rate multipliers.
@@ -220,15 +350,45 @@ func (s stableswapMsgServer) UpdatePool(ctx context.Context, msg *stableswap.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid ProtocolFeePercentage value")
 	}
 
+	// Ensure that the unbonding tiers are valid.
+	if err = stableswap.ValidateUnbondingTiers(msg.UnbondingTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid UnbondingTiers: %s", err.Error())
+	}
+
+	// Ensure that the exit fee tiers are valid.
+	if err = stableswap.ValidateExitFeeTiers(msg.ExitFeeTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid ExitFeeTiers: %s", err.Error())
+	}
+	if err = stableswap.ValidateLockTiers(msg.LockTiers); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid LockTiers: %s", err.Error())
+	}
+
+	// Ensure that the fee splits are valid.
+	if err = s.validateFeeSplits(msg.FeeSplits); err != nil {
+		return nil, err
+	}
+
+	// Ensure that the dynamic fee is valid.
+	if err = stableswap.ValidateDynamicFee(msg.DynamicFee, msg.RewardsFee); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid DynamicFee: %s", err.Error())
+	}
+
+	// Ensure that the directional fee is valid.
+	if err = stableswap.ValidateDirectionalFee(msg.DirectionalFee, msg.DynamicFee); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid DirectionalFee: %s", err.Error())
+	}
+
 	if err = controller.UpdatePool(
 		ctx,
 		msg.ProtocolFeePercentage,
 		msg.RewardsFee,
-		msg.InitialA,
-		s.headerService.GetHeaderInfo(ctx).Time.Unix(),
-		msg.FutureA,
-		msg.FutureATime,
 		rateMultipliers,
+		msg.UnbondingTiers,
+		msg.ExitFeeTiers,
+		msg.LockTiers,
+		msg.FeeSplits,
+		msg.DynamicFee,
+		msg.DirectionalFee,
 	); err != nil {
 		return nil, err
 	}
@@ -236,9 +396,158 @@ func (s stableswapMsgServer) UpdatePool(ctx context.Context, msg *stableswap.Msg
 	return &stableswap.MsgUpdatePoolResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.PoolUpdated{
 		ProtocolFeePercentage: msg.ProtocolFeePercentage,
 		RewardsFee:            msg.RewardsFee,
-		FutureA:               msg.FutureA,
-		FutureATime:           msg.FutureATime,
+		FutureA:               pool.FutureA,
+		FutureATime:           pool.FutureATime,
 		RateMultipliers:       msg.RateMultipliers,
+		UnbondingTiers:        msg.UnbondingTiers,
+		ExitFeeTiers:          msg.ExitFeeTiers,
+		LockTiers:             msg.LockTiers,
+		FeeSplits:             msg.FeeSplits,
+		DynamicFee:            msg.DynamicFee,
+		DirectionalFee:        msg.DirectionalFee,
+	})
+}
+
+// ScheduleUpdatePool schedules an update of the params of a `StableSwap` Pool, executed at a future time.
+func (s stableswapMsgServer) ScheduleUpdatePool(ctx context.Context, msg *stableswap.MsgScheduleUpdatePool) (*stableswap.MsgScheduleUpdatePoolResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		return nil, err
+	}
+
+	// Ensure that the execution time respects the minimum delay.
+	now := s.headerService.GetHeaderInfo(ctx).Time.Unix()
+	minExecutionTime := now + s.GetParams(ctx).MinPoolUpdateDelay
+	if msg.ExecutionTime <= now || msg.ExecutionTime < minExecutionTime {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidScheduledUpdate, "execution time must be in the future and at least %d, got %d", minExecutionTime, msg.ExecutionTime)
+	}
+
+	// Validate the update against the current state, without persisting its effects.
+	update := msg.Update
+	update.Signer = msg.Signer
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if _, err := s.updatePool(cacheCtx, &update); err != nil {
+		return nil, err
+	}
+
+	id, err := s.Stableswap.IncreaseNextScheduledUpdateID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.Stableswap.SetScheduledUpdate(ctx, stableswap.ScheduledPoolUpdate{
+		Id:            id,
+		ExecutionTime: msg.ExecutionTime,
+		Update:        update,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgScheduleUpdatePoolResponse{Id: id}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.PoolUpdateScheduled{
+		Id:            id,
+		PoolId:        update.PoolId,
+		ExecutionTime: msg.ExecutionTime,
+		Signer:        msg.Signer,
+	})
+}
+
+// CancelPoolUpdate cancels a pending scheduled update of a `StableSwap` Pool.
+func (s stableswapMsgServer) CancelPoolUpdate(ctx context.Context, msg *stableswap.MsgCancelPoolUpdate) (*stableswap.MsgCancelPoolUpdateResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		return nil, err
+	}
+
+	scheduled, err := s.Stableswap.GetScheduledUpdate(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidScheduledUpdate, "scheduled update %d does not exist", msg.Id)
+	}
+	if err = s.Stableswap.RemoveScheduledUpdate(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgCancelPoolUpdateResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.PoolUpdateCancelled{
+		Id:     msg.Id,
+		PoolId: scheduled.Update.PoolId,
+		Signer: msg.Signer,
+	})
+}
+
+// RampA starts a bounded ramp of the amplification coefficient of a `StableSwap` Pool from its current value.
+func (s stableswapMsgServer) RampA(ctx context.Context, msg *stableswap.MsgRampA) (*stableswap.MsgRampAResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		return nil, err
+	}
+
+	// Get the Pool Controller, ensuring that the Pool has not been closed.
+	controller, err := GetStableSwapController(ctx, s.Keeper, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if controller.IsClosed() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is closed", msg.PoolId)
+	}
+	pool, err := s.Stableswap.GetPool(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that the ramp respects the minimum duration, both since the previous one and until its end.
+	limits := s.GetParams(ctx).GetRampLimitsOrDefault()
+	now := s.headerService.GetHeaderInfo(ctx).Time.Unix()
+	if now < pool.InitialATime+limits.MinRampDuration {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "a new ramp can only start from %d", pool.InitialATime+limits.MinRampDuration)
+	}
+	if msg.FutureATime <= now || msg.FutureATime < now+limits.MinRampDuration {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "ramp must end in the future and at least at %d, got %d", now+limits.MinRampDuration, msg.FutureATime)
+	}
+
+	// Ensure that the future A is within the maximum change factor from the current A.
+	initialA := controller.GetAmplificationCoefficient(now).TruncateInt64()
+	if msg.FutureA <= 0 || msg.FutureA > initialA*limits.MaxAChange || msg.FutureA*limits.MaxAChange < initialA {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolParams, "future A must be within a factor of %d from the current A %d, got %d", limits.MaxAChange, initialA, msg.FutureA)
+	}
+
+	if err = controller.RampA(ctx, initialA, now, msg.FutureA, msg.FutureATime); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgRampAResponse{InitialA: initialA}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.RampAStarted{
+		PoolId:       msg.PoolId,
+		InitialA:     initialA,
+		FutureA:      msg.FutureA,
+		InitialATime: now,
+		FutureATime:  msg.FutureATime,
+	})
+}
+
+// StopRampA stops the ongoing amplification coefficient ramp of a `StableSwap` Pool, freezing its current value.
+func (s stableswapMsgServer) StopRampA(ctx context.Context, msg *stableswap.MsgStopRampA) (*stableswap.MsgStopRampAResponse, error) {
+	// Ensure that the signer has the required authority or role.
+	if err := s.checkRole(ctx, msg.Signer, types.ROLE_POOL_MANAGER); err != nil {
+		return nil, err
+	}
+
+	// Get the Pool Controller, ensuring that the Pool has not been closed.
+	controller, err := GetStableSwapController(ctx, s.Keeper, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if controller.IsClosed() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPool, "pool %d is closed", msg.PoolId)
+	}
+
+	// Freeze the A at its current interpolated value.
+	now := s.headerService.GetHeaderInfo(ctx).Time.Unix()
+	currentA := controller.GetAmplificationCoefficient(now).TruncateInt64()
+	if err = controller.RampA(ctx, currentA, now, currentA, now); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStopRampAResponse{CurrentA: currentA}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.RampAStopped{
+		PoolId:   msg.PoolId,
+		CurrentA: currentA,
+		Time:     now,
 	})
 }
 
@@ -261,8 +570,8 @@ func (s stableswapMsgServer) RemoveLiquidity(ctx context.Context, msg *stableswa
 		return nil, err
 	}
 
-	// Allow interactions only if the Pool is not paused.
-	if stableswapController.IsPaused() {
+	// Allow interactions only if the Pool liquidity removals are not paused.
+	if stableswapController.IsActivityPaused(types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY) {
 		return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", msg.PoolId)
 	}
 
@@ -274,15 +583,91 @@ func (s stableswapMsgServer) RemoveLiquidity(ctx context.Context, msg *stableswa
 
 	return &stableswap.MsgRemoveLiquidityResponse{
 			UnbondingShares: unbondingCommitment.UnbondingPosition.Shares,
+			ExitFee:         unbondingCommitment.ExitFee,
 		}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.LiquidityRemoved{
 			Provider:   msg.Signer,
 			PoolId:     msg.PoolId,
 			Amount:     unbondingCommitment.UnbondingPosition.Amount,
 			Shares:     unbondingCommitment.UnbondingPosition.Shares,
 			UnlockTime: unbondingCommitment.UnbondingPosition.EndTime,
+			ExitFee:    unbondingCommitment.ExitFee,
 		})
 }
 
+// TransferPosition allows a user to transfer bonded positions of a `StableSwap` liquidity pool to another address.
+func (s stableswapMsgServer) TransferPosition(ctx context.Context, msg *stableswap.MsgTransferPosition) (*stableswap.MsgTransferPositionResponse, error) {
+	// Check if the provider address is valid.
+	if _, err := s.addressCodec.StringToBytes(msg.Signer); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode provider address %s", msg.Signer)
+	}
+
+	// Check if the receiver address is valid.
+	if _, err := s.addressCodec.StringToBytes(msg.Receiver); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode receiver address %s", msg.Receiver)
+	}
+	if msg.Receiver == msg.Signer {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTransfer, "receiver must be different from the provider")
+	}
+
+	// Check if the transfer percentage is a valid number.
+	if !msg.Percentage.IsPositive() || msg.Percentage.GT(math.LegacyNewDec(100)) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTransfer, "percentage must be > 0 and <= 100, got %s", msg.Percentage.String())
+	}
+
+	// Get the StableswapController associated to the Pool.
+	stableswapController, err := GetStableSwapController(ctx, s.Keeper, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allow interactions only if the Pool liquidity deposits, removals and reward claims are not paused, as the
+	// transfer moves shares between the users and settles their rewards.
+	for _, activity := range []types.PauseActivity{types.PAUSE_ACTIVITY_ADD_LIQUIDITY, types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY, types.PAUSE_ACTIVITY_REWARD_CLAIMS} {
+		if stableswapController.IsActivityPaused(activity) {
+			return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", msg.PoolId)
+		}
+	}
+
+	// Move the bonded positions to the receiver.
+	transferredShares, err := stableswapController.TransferPosition(ctx, s.headerService.GetHeaderInfo(ctx).Time, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgTransferPositionResponse{
+			TransferredShares: transferredShares,
+		}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.PositionTransferred{
+			PoolId:   msg.PoolId,
+			Sender:   msg.Signer,
+			Receiver: msg.Receiver,
+			Shares:   transferredShares,
+		})
+}
+
+// SetAutoCompound allows a user to opt in or out of the periodic compounding of their rewards in a `StableSwap` liquidity pool.
+func (s stableswapMsgServer) SetAutoCompound(ctx context.Context, msg *stableswap.MsgSetAutoCompound) (*stableswap.MsgSetAutoCompoundResponse, error) {
+	// Check if the provider address is valid.
+	if _, err := s.addressCodec.StringToBytes(msg.Signer); err != nil {
+		return nil, sdkerrors.Wrapf(err, "unable to decode provider address %s", msg.Signer)
+	}
+
+	// Ensure that the Pool exists.
+	if _, err := GetStableSwapController(ctx, s.Keeper, msg.PoolId); err != nil {
+		return nil, err
+	}
+
+	// Update the user auto-compounding preference.
+	if err := s.Stableswap.SetAutoCompound(ctx, msg.PoolId, msg.Signer, msg.Enabled); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to set auto-compound preference")
+	}
+
+	return &stableswap.MsgSetAutoCompoundResponse{}, s.eventService.EventManager(ctx).Emit(ctx, &stableswap.AutoCompoundUpdated{
+		PoolId:   msg.PoolId,
+		Provider: msg.Signer,
+		Enabled:  msg.Enabled,
+	})
+}
+
 // AddLiquidity allows a user to add liquidity to a `StableSwap` liquidity pool.
 func (s stableswapMsgServer) AddLiquidity(ctx context.Context, msg *stableswap.MsgAddLiquidity) (*stableswap.MsgAddLiquidityResponse, error) {
 	// Check if the provider address is valid.
@@ -297,8 +682,8 @@ func (s stableswapMsgServer) AddLiquidity(ctx context.Context, msg *stableswap.M
 		return nil, err
 	}
 
-	// Allow interactions only if the Pool is not paused.
-	if stableswapController.IsPaused() {
+	// Allow interactions only if the Pool liquidity deposits are not paused.
+	if stableswapController.IsActivityPaused(types.PAUSE_ACTIVITY_ADD_LIQUIDITY) {
 		return nil, sdkerrors.Wrapf(types.ErrPoolActivityPaused, "pool %d is paused", msg.PoolId)
 	}
 
@@ -318,10 +703,11 @@ func (s stableswapMsgServer) AddLiquidity(ctx context.Context, msg *stableswap.M
 	}
 
 	// Validate the provided slippage percentage.
-	if msg.SlippagePercentage < 0 || msg.SlippagePercentage > s.maxAddLiquiditySlippagePercentage {
+	params := s.GetParams(ctx)
+	if msg.SlippagePercentage < 0 || msg.SlippagePercentage > params.MaxAddLiquiditySlippagePercentage {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidSlippage, "percentage must be > 0 and <= %s%% (%d) , got %s%% (%d)",
-			math.LegacyNewDec(s.maxAddLiquiditySlippagePercentage).QuoInt64(1e4),
-			s.maxAddLiquiditySlippagePercentage,
+			math.LegacyNewDec(params.MaxAddLiquiditySlippagePercentage).QuoInt64(1e4),
+			params.MaxAddLiquiditySlippagePercentage,
 			math.LegacyNewDec(msg.SlippagePercentage).QuoInt64(1e4),
 			msg.SlippagePercentage,
 		)
@@ -369,10 +755,11 @@ func (s stableswapMsgServer) AddLiquidity(ctx context.Context, msg *stableswap.M
 	}
 
 	// Ensure that deposit amount of the base token is not less than the `base_minimum_deposit`.
-	if baseAmount.LT(math.LegacyNewDec(s.baseMinimumDeposit)) {
+	if baseAmount.LT(math.LegacyNewDec(params.BaseMinimumDeposit)) {
 		return nil, sdkerrors.Wrapf(
 			types.ErrInvalidAmount,
-			"must provide a minimum amount of 1000000%s but got: %s%s",
+			"must provide a minimum amount of %d%s but got: %s%s",
+			params.BaseMinimumDeposit,
 			s.baseDenom,
 			baseAmount.TruncateInt().String(),
 			s.baseDenom,
@@ -399,3 +786,23 @@ func (s stableswapMsgServer) AddLiquidity(ctx context.Context, msg *stableswap.M
 			Shares:   newPosition.BondedPosition.Balance,
 		})
 }
+
+// validateFeeSplits ensures that the fee splits are valid and that their recipients can be resolved.
+func (s stableswapMsgServer) validateFeeSplits(splits []stableswap.FeeSplit) error {
+	if err := stableswap.ValidateFeeSplits(splits); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid FeeSplits: %s", err.Error())
+	}
+	for _, split := range splits {
+		switch split.RecipientType {
+		case stableswap.FEE_RECIPIENT_ADDRESS:
+			if _, err := s.addressCodec.StringToBytes(split.Recipient); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid FeeSplits: unable to decode recipient %s", split.Recipient)
+			}
+		case stableswap.FEE_RECIPIENT_COMMUNITY_POOL:
+			if s.distributionKeeper == nil {
+				return sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid FeeSplits: community pool is not available")
+			}
+		}
+	}
+	return nil
+}
@@ -229,6 +229,79 @@ func TestCreateStableSwapPool(t *testing.T) {
 			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "RewardsFee cannot be negative"),
 			nil,
 		},
+		{
+			"Invalid UnbondingTiers, non-zero first threshold",
+			&stableswap.MsgCreatePool{
+				Signer:   "authority",
+				Pair:     "uusdc",
+				InitialA: 100,
+				FutureA:  100,
+				RateMultipliers: sdk.NewCoins(
+					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+				),
+				UnbondingTiers: []stableswap.UnbondingTier{
+					{Threshold: math.LegacyOneDec(), Duration: time.Hour},
+				},
+			},
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid UnbondingTiers: first tier threshold must be zero, got 1.000000000000000000"),
+			nil,
+		},
+		{
+			"Invalid UnbondingTiers, non-increasing thresholds",
+			&stableswap.MsgCreatePool{
+				Signer:   "authority",
+				Pair:     "uusdc",
+				InitialA: 100,
+				FutureA:  100,
+				RateMultipliers: sdk.NewCoins(
+					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+				),
+				UnbondingTiers: []stableswap.UnbondingTier{
+					{Threshold: math.LegacyZeroDec(), Duration: time.Hour},
+					{Threshold: math.LegacyZeroDec(), Duration: time.Hour},
+				},
+			},
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid UnbondingTiers: tier 1 threshold must be greater than 0.000000000000000000, got 0.000000000000000000"),
+			nil,
+		},
+		{
+			"Invalid ExitFeeTiers, fee percentage too high",
+			&stableswap.MsgCreatePool{
+				Signer:   "authority",
+				Pair:     "uusdc",
+				InitialA: 100,
+				FutureA:  100,
+				RateMultipliers: sdk.NewCoins(
+					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+				),
+				ExitFeeTiers: []stableswap.ExitFeeTier{
+					{Threshold: math.LegacyZeroDec(), FeePercentage: math.LegacyNewDec(100)},
+				},
+			},
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid ExitFeeTiers: tier 0 fee percentage must be >= 0 and < 100, got 100.000000000000000000"),
+			nil,
+		},
+		{
+			"Invalid LockTiers, multiplier lower than 1",
+			&stableswap.MsgCreatePool{
+				Signer:   "authority",
+				Pair:     "uusdc",
+				InitialA: 100,
+				FutureA:  100,
+				RateMultipliers: sdk.NewCoins(
+					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+				),
+				LockTiers: []stableswap.LockTier{
+					{Duration: 7 * 24 * time.Hour, Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+				},
+			},
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid LockTiers: tier 0 multiplier must be >= 1, got 0.500000000000000000"),
+			nil,
+		},
 		{
 			"[collections] Failing collection on Set NextPoolId",
 			&stableswap.MsgCreatePool{
@@ -391,7 +464,8 @@ func TestCreateStableSwapPool(t *testing.T) {
 			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
 		),
-		TotalShares: math.LegacyZeroDec(),
+		TotalShares:        math.LegacyZeroDec(),
+		TotalBoostedShares: math.LegacyZeroDec(),
 	}, ssPool)
 
 	// ASSERT: Expect empty liquidity amount
@@ -450,6 +524,7 @@ func TestCreateStableSwapPool(t *testing.T) {
 			RewardsFee:            10,
 			ProtocolFeePercentage: 1,
 			TotalShares:           math.LegacyZeroDec(),
+			TotalBoostedShares:    math.LegacyZeroDec(),
 		},
 		1: {
 			RateMultipliers: sdk.NewCoins(
@@ -463,6 +538,7 @@ func TestCreateStableSwapPool(t *testing.T) {
 			FutureA:               100,
 			FutureATime:           10000000,
 			TotalShares:           math.LegacyZeroDec(),
+			TotalBoostedShares:    math.LegacyZeroDec(),
 		},
 	}, ssPools)
 
@@ -509,21 +585,22 @@ func TestUpdateStableSwapPool(t *testing.T) {
 			nil,
 		},
 		{
-			"Invalid initial A param",
+			"Changed amplification coefficient",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: -1,
+				Signer:      "authority",
+				PoolId:      0,
+				InitialA:    100,
+				FutureA:     1000,
+				FutureATime: 10000000,
 			},
-			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid InitialA value"),
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "amplification coefficient can only be updated through MsgRampA"),
 			nil,
 		},
 		{
 			"Invalid RateMultipliers, missing pair value",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 				),
@@ -534,10 +611,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid Rate Multipliers, invalid base pair denom",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdx", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -549,10 +624,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid Rate Multipliers, invalid pair value",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(0)),
@@ -564,9 +637,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid Rate Multipliers, too many values",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -579,10 +651,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid Rate Multipliers, invalid pair denom",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdx", math.NewInt(1000000000000000000)),
@@ -594,10 +664,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid ProtocolFee value (<0)",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -610,10 +678,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid ProtocolFee value (>100)",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -626,10 +692,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"Invalid RewardsFee value",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -639,13 +703,27 @@ func TestUpdateStableSwapPool(t *testing.T) {
 			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "RewardsFee cannot be negative"),
 			nil,
 		},
+		{
+			"Invalid UnbondingTiers, negative duration",
+			&stableswap.MsgUpdatePool{
+				Signer: "authority",
+				PoolId: 0,
+				RateMultipliers: sdk.NewCoins(
+					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+				),
+				UnbondingTiers: []stableswap.UnbondingTier{
+					{Threshold: math.LegacyZeroDec(), Duration: -time.Hour},
+				},
+			},
+			sdkerrors.Wrapf(types.ErrInvalidPoolParams, "invalid UnbondingTiers: tier 0 duration cannot be negative, got -1h0m0s"),
+			nil,
+		},
 		{
 			"Invalid Pool",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -661,10 +739,8 @@ func TestUpdateStableSwapPool(t *testing.T) {
 		{
 			"[collections] Invalid Set StableSwap Pool",
 			&stableswap.MsgUpdatePool{
-				Signer:   "authority",
-				PoolId:   0,
-				InitialA: 100,
-				FutureA:  100,
+				Signer: "authority",
+				PoolId: 0,
 				RateMultipliers: sdk.NewCoins(
 					sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
 					sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
@@ -745,9 +821,6 @@ func TestUpdateStableSwapPool(t *testing.T) {
 	_, err := server.UpdatePool(ctx, &stableswap.MsgUpdatePool{
 		Signer:                "authority",
 		PoolId:                1,
-		InitialA:              101,
-		FutureA:               101,
-		FutureATime:           10000002,
 		RewardsFee:            11,
 		ProtocolFeePercentage: 2,
 		RateMultipliers: sdk.NewCoins(
@@ -772,6 +845,7 @@ func TestUpdateStableSwapPool(t *testing.T) {
 			RewardsFee:            10,
 			ProtocolFeePercentage: 1,
 			TotalShares:           math.LegacyZeroDec(),
+			TotalBoostedShares:    math.LegacyZeroDec(),
 		},
 		1: {
 			RateMultipliers: sdk.NewCoins(
@@ -779,12 +853,13 @@ func TestUpdateStableSwapPool(t *testing.T) {
 				sdk.NewCoin("ueure", math.NewInt(1000000000000000000)),
 			),
 			InitialATime:          time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
-			InitialA:              101,
-			FutureA:               101,
-			FutureATime:           10000002,
+			InitialA:              300,
+			FutureA:               400,
+			FutureATime:           10000001,
 			RewardsFee:            11,
 			ProtocolFeePercentage: 2,
 			TotalShares:           math.LegacyZeroDec(),
+			TotalBoostedShares:    math.LegacyZeroDec(),
 		},
 	}, ssPools)
 }
@@ -1979,3 +2054,945 @@ func TestUnbondingPositionsFailingCollections(t *testing.T) {
 	err := k.BeginBlocker(ctx)
 	assert.NoError(t, err)
 }
+
+func TestTransferPosition(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, alice := utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Create a Pool.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC)})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            1_000_000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+
+	// ACT: Attempt to transfer with an invalid provider address.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Invalid,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.Error(t, err)
+
+	// ACT: Attempt to transfer with an invalid receiver address.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Invalid,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.Error(t, err)
+
+	// ACT: Attempt to transfer to the same address.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   bob.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidTransfer)
+
+	// ACT: Attempt to transfer an invalid percentage.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(101),
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidTransfer)
+
+	// ACT: Attempt to transfer from a non-existing pool.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     1,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ACT: Attempt to transfer without any position.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidTransfer)
+
+	// ARRANGE: Add two liquidity positions for Bob and one for Alice, and generate rewards with a swap.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(40*ONE)), sdk.NewCoin("uusdn", math.NewInt(40*ONE))),
+	})
+	assert.NoError(t, err)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)), sdk.NewCoin("uusdn", math.NewInt(10*ONE))),
+	})
+	assert.NoError(t, err)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 2, 1, 1, 1, time.UTC)})
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(20*ONE)), sdk.NewCoin("uusdn", math.NewInt(20*ONE))),
+	})
+	assert.NoError(t, err)
+	_, err = server.Swap(ctx, &types.MsgSwap{
+		Signer: alice.Address,
+		Amount: sdk.NewCoin("uusdc", math.NewInt(ONE)),
+		Routes: []types.Route{{PoolId: 0, DenomTo: "uusdn"}},
+		Min:    sdk.NewCoin("uusdn", math.NewInt(100)),
+	})
+	assert.NoError(t, err)
+
+	// ARRANGE: Unbond 10% of Bob's shares.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 2, 1, 1, 1, 1, time.UTC)})
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(10),
+	})
+	assert.NoError(t, err)
+	bobTotalShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address)
+	bobUnbondingShares := k.Stableswap.GetUserTotalUnbondingShares(ctx, 0, bob.Address)
+	aliceTotalShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	poolBefore, _ := k.Stableswap.Pools.Get(ctx, 0)
+
+	// ARRANGE: Pause the Pool.
+	_, err = server.PauseByPoolIds(ctx, &types.MsgPauseByPoolIds{
+		Signer:  "authority",
+		PoolIds: []uint64{0},
+	})
+	assert.NoError(t, err)
+
+	// ACT: Attempt to transfer with a paused pool.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ARRANGE: Unpause the Pool and set it in withdraw-only mode.
+	_, err = server.UnpauseByPoolIds(ctx, &types.MsgUnpauseByPoolIds{
+		Signer:  "authority",
+		PoolIds: []uint64{0},
+	})
+	assert.NoError(t, err)
+	_, err = server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "authority", PoolIds: []uint64{0}, Enabled: true})
+	assert.NoError(t, err)
+
+	// ACT: Attempt to transfer with a withdraw-only pool.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ARRANGE: Disable the withdraw-only mode, unpause the Pool and pause the liquidity removals.
+	_, err = server.SetWithdrawOnly(ctx, &types.MsgSetWithdrawOnly{Signer: "authority", PoolIds: []uint64{0}, Enabled: false})
+	assert.NoError(t, err)
+	_, err = server.UnpauseByPoolIds(ctx, &types.MsgUnpauseByPoolIds{
+		Signer:  "authority",
+		PoolIds: []uint64{0},
+	})
+	assert.NoError(t, err)
+	_, err = server.PauseActivities(ctx, &types.MsgPauseActivities{
+		Signer: "authority", PoolIds: []uint64{0}, Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY},
+	})
+	assert.NoError(t, err)
+
+	// ACT: Attempt to transfer with paused liquidity removals.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.ErrorIs(t, err, types.ErrPoolActivityPaused)
+
+	// ARRANGE: Unpause the liquidity removals.
+	_, err = server.UnpauseActivities(ctx, &types.MsgUnpauseActivities{
+		Signer: "authority", PoolIds: []uint64{0}, Activities: []types.PauseActivity{types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY},
+	})
+	assert.NoError(t, err)
+
+	// ACT: Transfer 50% of Bob's available shares to Alice.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 3, 1, 1, 1, 1, time.UTC)})
+	bobBalance := bank.Balances[bob.Address]
+	res, err := stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: Half of the available shares have been moved.
+	expectedShares := bobTotalShares.Sub(bobUnbondingShares).QuoInt64(2)
+	assert.Equal(t, expectedShares, res.TransferredShares)
+	assert.Equal(t, bobTotalShares.Sub(expectedShares), k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address))
+	assert.Equal(t, aliceTotalShares.Add(expectedShares), k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address))
+	assert.Equal(t, bobUnbondingShares, k.Stableswap.GetUserTotalUnbondingShares(ctx, 0, bob.Address))
+	poolAfter, _ := k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, poolBefore.TotalShares, poolAfter.TotalShares)
+
+	// ASSERT: The positions match the users totals.
+	for _, user := range []utils.Account{bob, alice} {
+		positionsShares := math.LegacyZeroDec()
+		for _, entry := range k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, user.Address) {
+			positionsShares = positionsShares.Add(entry.BondedPosition.Balance)
+			assert.Equal(t, time.Date(2020, 1, 3, 1, 1, 1, 1, time.UTC), entry.BondedPosition.RewardsPeriodStart)
+		}
+		assert.Equal(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, user.Address), positionsShares)
+	}
+	assert.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 2)
+
+	// ASSERT: Bob's pending rewards have been settled.
+	assert.True(t, bank.Balances[bob.Address].AmountOf("uusdc").GT(bobBalance.AmountOf("uusdc")))
+
+	// ACT: Execute the BeginBlocker after the unbonding time.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 5, 1, 1, 1, 1, time.UTC), Height: 10})
+	err = k.BeginBlocker(ctx)
+	assert.NoError(t, err)
+
+	// ASSERT: Bob's unbonding shares have been consumed from his remaining positions.
+	assert.Equal(t, bobTotalShares.Sub(expectedShares).Sub(bobUnbondingShares), k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address))
+	assert.True(t, k.Stableswap.GetUserTotalUnbondingShares(ctx, 0, bob.Address).IsZero())
+
+	// ACT: Transfer all of Alice's shares back to Bob.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 6, 1, 1, 1, 1, time.UTC)})
+	res, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Receiver:   bob.Address,
+		Percentage: math.LegacyNewDec(100),
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: Alice does not have any share left.
+	assert.Equal(t, aliceTotalShares.Add(expectedShares), res.TransferredShares)
+	assert.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).IsZero())
+	assert.Empty(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address))
+}
+
+func TestRemoveLiquidityInstant(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, alice := utils.TestAccount(), utils.TestAccount()
+	rateMultipliers := sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+	)
+
+	// ARRANGE: Create a Pool without exit fee tiers.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RateMultipliers:       rateMultipliers,
+		InitialA:              100,
+		FutureA:               100,
+	})
+	assert.NoError(t, err)
+	pool, _ := k.Pools.Get(ctx, 0)
+	rewardsAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, 0))
+
+	// ARRANGE: Add liquidity for Bob and Alice.
+	bank.Balances[bob.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(90*ONE)), sdk.NewCoin("uusdn", math.NewInt(90*ONE)))
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)), sdk.NewCoin("uusdn", math.NewInt(10*ONE)))
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(90*ONE)), sdk.NewCoin("uusdn", math.NewInt(90*ONE))),
+	})
+	assert.NoError(t, err)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: alice.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)), sdk.NewCoin("uusdn", math.NewInt(10*ONE))),
+	})
+	assert.NoError(t, err)
+
+	// ACT: Attempt to remove liquidity instantly without exit fee tiers.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(100),
+		Instant:    true,
+	})
+	assert.ErrorIs(t, err, types.ErrInstantRemovalDisabled)
+
+	// ARRANGE: Configure the exit fee tiers of the Pool.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		ProtocolFeePercentage: 1,
+		RateMultipliers:       rateMultipliers,
+		InitialA:              100,
+		FutureA:               100,
+		ExitFeeTiers: []stableswap.ExitFeeTier{
+			{Threshold: math.LegacyZeroDec(), FeePercentage: math.LegacyMustNewDecFromStr("0.5")},
+			{Threshold: math.LegacyNewDec(10), FeePercentage: math.LegacyNewDec(2)},
+		},
+	})
+	assert.NoError(t, err)
+
+	// ACT: Remove all of Alice's liquidity instantly (10% of the pool).
+	res, err := stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(100),
+		Instant:    true,
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: Alice received her liquidity minus the 0.5% exit fee, sent to the remaining providers.
+	expectedFee := sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(50_000)), sdk.NewCoin("uusdn", math.NewInt(50_000)))
+	assert.Equal(t, expectedFee, res.ExitFee)
+	assert.Equal(t, math.NewInt(10*ONE-50_000), bank.Balances[alice.Address].AmountOf("uusdc"))
+	assert.Equal(t, math.NewInt(10*ONE-50_000), bank.Balances[alice.Address].AmountOf("uusdn"))
+	assert.Equal(t, expectedFee, bank.Balances[rewardsAddress.String()])
+	assert.Equal(t, math.NewInt(90*ONE), bank.Balances[pool.Address].AmountOf("uusdc"))
+
+	// ASSERT: Alice's shares have been burned without any unbonding position.
+	assert.True(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address).IsZero())
+	assert.Empty(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address))
+	assert.Empty(t, k.Stableswap.GetUnbondingPositionsByProvider(ctx, alice.Address))
+	stableswapPool, _ := k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address), stableswapPool.TotalShares)
+
+	// ACT: Remove half of Bob's liquidity instantly (50% of the pool).
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)})
+	res, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(50),
+		Instant:    true,
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: Bob paid the 2% exit fee, and received his pending rewards minus the truncation dust.
+	dust := sdk.NewCoins(sdk.NewCoin("uusdc", math.OneInt()), sdk.NewCoin("uusdn", math.OneInt()))
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(900_000)), sdk.NewCoin("uusdn", math.NewInt(900_000))), res.ExitFee)
+	assert.Equal(t, math.NewInt(45*ONE-900_000), bank.Balances[bob.Address].AmountOf("uusdc").Sub(expectedFee.Sub(dust...).AmountOf("uusdc")))
+	assert.Equal(t, res.ExitFee.Add(dust...), bank.Balances[rewardsAddress.String()])
+	stableswapPool, _ = k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, k.Stableswap.GetUserTotalBondedShares(ctx, 0, bob.Address), stableswapPool.TotalShares)
+	assert.Equal(t, math.NewInt(45*ONE), bank.Balances[pool.Address].AmountOf("uusdc"))
+}
+
+func TestAddLiquidityLocked(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	bob, alice := utils.TestAccount(), utils.TestAccount()
+	amount := sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ARRANGE: Create a Pool with lock tiers.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime})
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+		InitialA: 100,
+		FutureA:  100,
+		LockTiers: []stableswap.LockTier{
+			{Duration: 7 * 24 * time.Hour, Multiplier: math.LegacyMustNewDecFromStr("1.5")},
+			{Duration: 30 * 24 * time.Hour, Multiplier: math.LegacyNewDec(2)},
+		},
+	})
+	assert.NoError(t, err)
+	bank.Balances[bob.Address] = amount
+	bank.Balances[alice.Address] = amount
+
+	// ACT: Attempt to add liquidity with an unsupported lock duration.
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer:       alice.Address,
+		PoolId:       0,
+		Amount:       amount,
+		LockDuration: 3 * 24 * time.Hour,
+	})
+	// ASSERT: The action should've failed due to the invalid lock.
+	assert.ErrorIs(t, err, types.ErrInvalidLock)
+
+	// ACT: Add liquidity for Bob without lock, and for Alice with a 30 days lock.
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: bob.Address,
+		PoolId: 0,
+		Amount: amount,
+	})
+	assert.NoError(t, err)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer:       alice.Address,
+		PoolId:       0,
+		Amount:       amount,
+		LockDuration: 30 * 24 * time.Hour,
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: Alice's position is locked and boosted.
+	aliceShares := k.Stableswap.GetUserTotalBondedShares(ctx, 0, alice.Address)
+	position := k.Stableswap.GetBondedPosition(ctx, 0, alice.Address, startTime.Unix())
+	assert.Equal(t, startTime.Add(30*24*time.Hour), position.LockEndTime)
+	assert.Equal(t, math.LegacyNewDec(2), *position.Multiplier)
+	assert.Nil(t, k.Stableswap.GetBondedPosition(ctx, 0, bob.Address, startTime.Unix()).Multiplier)
+	stableswapPool, _ := k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, aliceShares, stableswapPool.TotalBoostedShares)
+	assert.Len(t, k.Stableswap.GetLockedPositions(ctx), 1)
+
+	// ACT: Attempt to remove and transfer Alice's locked liquidity.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: startTime.Add(24 * time.Hour)})
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(100),
+	})
+	// ASSERT: The action should've failed due to the locked shares.
+	assert.ErrorIs(t, err, types.ErrInvalidUnbondAmount)
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Receiver:   bob.Address,
+		Percentage: math.LegacyNewDec(100),
+	})
+	// ASSERT: The action should've failed due to the locked shares.
+	assert.ErrorIs(t, err, types.ErrInvalidTransfer)
+
+	// ACT: Attempt to transfer Bob's unlocked shares into Alice's locked position created at the same time.
+	_, err = stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	// ASSERT: The action should've failed due to the different lock and boost.
+	assert.ErrorIs(t, err, types.ErrInvalidTransfer)
+	assert.ErrorContains(t, err, "has a different lock or boost")
+
+	// ARRANGE: Accrue rewards in the Pool.
+	rewardsAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, 0)).String()
+	bank.Balances[rewardsAddress] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(3*ONE)))
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	assert.NoError(t, err)
+	err = controller.DepositRewards(ctx, bank.Balances[rewardsAddress])
+	assert.NoError(t, err)
+
+	// ACT: Compute the pending rewards of Bob and Alice.
+	bobRewards := controller.GetTotalPoolUserRewards(ctx, bob.Address)
+	aliceRewards := controller.GetTotalPoolUserRewards(ctx, alice.Address)
+
+	// ASSERT: Alice's rewards have been doubled by the lock multiplier.
+	assert.Equal(t, math.NewInt(ONE), bobRewards[0].Amount.AmountOf("uusdn"))
+	assert.Equal(t, math.NewInt(2*ONE), aliceRewards[0].Amount.AmountOf("uusdn"))
+
+	// ACT: Trigger the BeginBlocker after the lock expiry.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: startTime.Add(31 * 24 * time.Hour)})
+	executed := k.StableSwapBeginBlocker(ctx)
+
+	// ASSERT: Alice's lock has been released and the boost removed.
+	assert.True(t, executed)
+	position = k.Stableswap.GetBondedPosition(ctx, 0, alice.Address, startTime.Unix())
+	assert.Nil(t, position.Multiplier)
+	stableswapPool, _ = k.Stableswap.Pools.Get(ctx, 0)
+	assert.True(t, stableswapPool.TotalBoostedShares.IsZero())
+	assert.Empty(t, k.Stableswap.GetLockedPositions(ctx))
+	assert.True(t, bank.Balances[alice.Address].AmountOf("uusdn").IsPositive())
+
+	// ACT: Transfer half of Bob's shares into Alice's now unlocked position created at the same time.
+	transfer, err := stableswapServer.TransferPosition(ctx, &stableswap.MsgTransferPosition{
+		Signer:     bob.Address,
+		PoolId:     0,
+		Receiver:   alice.Address,
+		Percentage: math.LegacyNewDec(50),
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: The shares have been merged into Alice's position.
+	assert.Len(t, k.Stableswap.GetBondedPositionsByPoolAndProvider(ctx, 0, alice.Address), 1)
+	position = k.Stableswap.GetBondedPosition(ctx, 0, alice.Address, startTime.Unix())
+	assert.Equal(t, aliceShares.Add(transfer.TransferredShares), position.Balance)
+	assert.Nil(t, position.Multiplier)
+
+	// ACT: Remove Alice's unlocked liquidity.
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: startTime.Add(32 * 24 * time.Hour)})
+	res, err := stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     alice.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(100),
+	})
+
+	// ASSERT: All the shares are now unbonding.
+	assert.NoError(t, err)
+	assert.Equal(t, aliceShares.Add(transfer.TransferredShares), res.UnbondingShares)
+}
+
+func TestPermissionlessPoolCreation(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	k.SetDistributionKeeper(mocks.DistributionKeeper{Bank: bank})
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	alice := utils.TestAccount()
+
+	msg := &stableswap.MsgCreatePool{
+		Signer:                alice.Address,
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	}
+
+	// ACT: Attempt to create a Pool while the permissionless creation is disabled.
+	_, err := stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ARRANGE: Enable the permissionless creation.
+	params := k.GetParams(ctx)
+	params.PermissionlessPoolCreation = &types.PermissionlessPoolCreation{
+		CreationFee:                sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+		MinA:                       10,
+		MaxA:                       1_000,
+		MaxRewardsFee:              1e7,
+		MaxProtocolFeePercentage:   50,
+		MaxRateMultiplierDeviation: math.LegacyMustNewDecFromStr("0.01"),
+		MaxExitFeePercentage:       math.LegacyOneDec(),
+		MaxUnbondingDuration:       7 * 24 * time.Hour,
+	}
+	for _, mutate := range []func(creation *types.PermissionlessPoolCreation){
+		func(creation *types.PermissionlessPoolCreation) { creation.MaxA = 5 },
+		func(creation *types.PermissionlessPoolCreation) { creation.MaxExitFeePercentage = math.LegacyNewDec(100) },
+		func(creation *types.PermissionlessPoolCreation) { creation.MaxUnbondingDuration = -time.Hour },
+	} {
+		invalid := *params.PermissionlessPoolCreation
+		mutate(&invalid)
+		_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: types.Params{
+			BaseMinimumDeposit:                params.BaseMinimumDeposit,
+			MaxAddLiquiditySlippagePercentage: params.MaxAddLiquiditySlippagePercentage,
+			UnbondingBlockDelta:               params.UnbondingBlockDelta,
+			PermissionlessPoolCreation:        &invalid,
+		}})
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	}
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	require.NoError(t, err)
+
+	// ACT: Attempt to create Pools outside of the bounds.
+	for _, mutate := range []func(msg *stableswap.MsgCreatePool){
+		func(msg *stableswap.MsgCreatePool) { msg.InitialA = 5 },
+		func(msg *stableswap.MsgCreatePool) { msg.FutureA = 2_000 },
+		func(msg *stableswap.MsgCreatePool) { msg.RewardsFee = 1e7 + 1 },
+		func(msg *stableswap.MsgCreatePool) { msg.ProtocolFeePercentage = 51 },
+		func(msg *stableswap.MsgCreatePool) {
+			msg.RateMultipliers = sdk.NewCoins(
+				sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+				sdk.NewCoin("uusdc", math.NewInt(1020000000000000000)),
+			)
+		},
+		func(msg *stableswap.MsgCreatePool) {
+			msg.DynamicFee = &stableswap.DynamicFee{Multiplier: math.LegacyOneDec(), MinFee: 1e3, MaxFee: 1e4}
+		},
+		func(msg *stableswap.MsgCreatePool) {
+			msg.ExitFeeTiers = []stableswap.ExitFeeTier{{Threshold: math.LegacyZeroDec(), FeePercentage: math.LegacyNewDec(2)}}
+		},
+		func(msg *stableswap.MsgCreatePool) {
+			msg.UnbondingTiers = []stableswap.UnbondingTier{{Threshold: math.LegacyZeroDec(), Duration: 30 * 24 * time.Hour}}
+		},
+		func(msg *stableswap.MsgCreatePool) {
+			msg.LockTiers = []stableswap.LockTier{{Duration: 7 * 24 * time.Hour, Multiplier: math.LegacyNewDec(2)}}
+		},
+	} {
+		invalidMsg := *msg
+		mutate(&invalidMsg)
+		_, err = stableswapServer.CreatePool(ctx, &invalidMsg)
+		// ASSERT: The action should've failed due to the invalid params.
+		require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+	}
+
+	// ACT: Attempt to create a Pool without the creation fee balance.
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	// ASSERT: The action should've failed due to the insufficient balance.
+	require.Error(t, err)
+	assert.Empty(t, k.GetPools(ctx))
+
+	// ACT: Create a Pool paying the creation fee.
+	bank.Balances[alice.Address] = sdk.NewCoins(sdk.NewCoin("uusdn", math.NewInt(300*ONE)))
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	require.NoError(t, err)
+
+	// ASSERT: The Pool has been created, and the creation fee burned.
+	assert.Len(t, k.GetPools(ctx), 1)
+	assert.Equal(t, math.NewInt(200*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	assert.True(t, bank.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+
+	// ACT: Attempt to update the Pool without the pool manager role.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                alice.Address,
+		PoolId:                0,
+		ProtocolFeePercentage: 1,
+		RewardsFee:            4e3,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       msg.RateMultipliers,
+	})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Create a Pool outside of the bounds with the authority.
+	authorityMsg := *msg
+	authorityMsg.Signer = "authority"
+	authorityMsg.Pair = "ueure"
+	authorityMsg.InitialA = 5_000
+	authorityMsg.RateMultipliers = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		sdk.NewCoin("ueure", math.NewInt(1000000000000000000)),
+	)
+	_, err = stableswapServer.CreatePool(ctx, &authorityMsg)
+	// ASSERT: The action should've succeeded without paying the creation fee.
+	require.NoError(t, err)
+	assert.Equal(t, math.NewInt(200*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+
+	// ARRANGE: Fund the community pool with the creation fee, and remove the exit fee and unbonding bounds.
+	params.PermissionlessPoolCreation.FundCommunityPool = true
+	params.PermissionlessPoolCreation.MaxExitFeePercentage = math.LegacyZeroDec()
+	params.PermissionlessPoolCreation.MaxUnbondingDuration = 0
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	require.NoError(t, err)
+
+	// ACT: Attempt to create Pools with exit fee or unbonding tiers.
+	msg.Pair = "uusde"
+	msg.RateMultipliers = sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		sdk.NewCoin("uusde", math.NewInt(1005000000000000000)),
+	)
+	exitFeeMsg, unbondingMsg := *msg, *msg
+	exitFeeMsg.ExitFeeTiers = []stableswap.ExitFeeTier{{Threshold: math.LegacyZeroDec(), FeePercentage: math.LegacyMustNewDecFromStr("0.5")}}
+	unbondingMsg.UnbondingTiers = []stableswap.UnbondingTier{{Threshold: math.LegacyZeroDec(), Duration: 24 * time.Hour}}
+	for _, invalidMsg := range []stableswap.MsgCreatePool{exitFeeMsg, unbondingMsg} {
+		_, err = stableswapServer.CreatePool(ctx, &invalidMsg)
+		// ASSERT: The action should've failed as the tiers can only be set by the authority.
+		require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+		require.ErrorContains(t, err, "can only be set by the authority")
+	}
+
+	// ARRANGE: Restore the exit fee and unbonding bounds.
+	params.PermissionlessPoolCreation.MaxExitFeePercentage = math.LegacyOneDec()
+	params.PermissionlessPoolCreation.MaxUnbondingDuration = 7 * 24 * time.Hour
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	require.NoError(t, err)
+
+	// ACT: Create a Pool with tiers within the bounds, paying the creation fee.
+	msg.ExitFeeTiers = exitFeeMsg.ExitFeeTiers
+	msg.UnbondingTiers = unbondingMsg.UnbondingTiers
+	_, err = stableswapServer.CreatePool(ctx, msg)
+	require.NoError(t, err)
+
+	// ASSERT: The creation fee has been sent to the community pool, and the tiers set.
+	assert.Equal(t, math.NewInt(100*ONE), bank.Balances[alice.Address].AmountOf("uusdn"))
+	assert.Equal(t, math.NewInt(100*ONE), bank.Balances[authtypes.NewModuleAddress("distribution").String()].AmountOf("uusdn"))
+	stableswapPool, err := k.Stableswap.GetPool(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, msg.ExitFeeTiers, stableswapPool.ExitFeeTiers)
+	assert.Equal(t, msg.UnbondingTiers, stableswapPool.UnbondingTiers)
+}
+
+func TestScheduleUpdatePool(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_000, 0)})
+
+	// ARRANGE: Create a Pool and require a minimum delay of 100 seconds.
+	rateMultipliers := sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+	)
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       rateMultipliers,
+	})
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	params.MinPoolUpdateDelay = 100
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	require.NoError(t, err)
+
+	update := stableswap.MsgUpdatePool{
+		PoolId:                0,
+		ProtocolFeePercentage: 2,
+		RewardsFee:            5e3,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       rateMultipliers,
+	}
+
+	// ACT: Attempt to schedule an update without the pool manager role.
+	_, err = stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: "user", ExecutionTime: 1_100, Update: update})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to schedule an update before the minimum delay.
+	_, err = stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: "authority", ExecutionTime: 1_099, Update: update})
+	// ASSERT: The action should've failed due to the invalid execution time.
+	require.ErrorIs(t, err, types.ErrInvalidScheduledUpdate)
+
+	// ACT: Attempt to schedule an invalid update.
+	invalidUpdate := update
+	invalidUpdate.ProtocolFeePercentage = 101
+	_, err = stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: "authority", ExecutionTime: 1_100, Update: invalidUpdate})
+	// ASSERT: The action should've failed due to the invalid pool params.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+	assert.Empty(t, k.Stableswap.GetScheduledUpdates(ctx))
+
+	// ACT: Schedule two valid updates and cancel the second one.
+	res, err := stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: "authority", ExecutionTime: 1_100, Update: update})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Id)
+	res, err = stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: "authority", ExecutionTime: 1_200, Update: update})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.Id)
+	_, err = stableswapServer.CancelPoolUpdate(ctx, &stableswap.MsgCancelPoolUpdate{Signer: "user", Id: 1})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = stableswapServer.CancelPoolUpdate(ctx, &stableswap.MsgCancelPoolUpdate{Signer: "authority", Id: 1})
+	require.NoError(t, err)
+	_, err = stableswapServer.CancelPoolUpdate(ctx, &stableswap.MsgCancelPoolUpdate{Signer: "authority", Id: 1})
+	require.ErrorIs(t, err, types.ErrInvalidScheduledUpdate)
+
+	// ASSERT: Only the first update is pending, and the Pool is unchanged.
+	scheduled := k.Stableswap.GetScheduledUpdates(ctx)
+	require.Len(t, scheduled, 1)
+	assert.Equal(t, int64(1_100), scheduled[0].ExecutionTime)
+	assert.Equal(t, "authority", scheduled[0].Update.Signer)
+	pool, err := k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), pool.ProtocolFeePercentage)
+
+	// ACT: Run the BeginBlocker before the execution time.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_099, 0)})
+	require.NoError(t, k.BeginBlocker(ctx))
+	// ASSERT: The update is still pending.
+	assert.Len(t, k.Stableswap.GetScheduledUpdates(ctx), 1)
+
+	// ACT: Run the BeginBlocker at the execution time.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_100, 0)})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// ASSERT: The update has been applied and removed.
+	assert.Empty(t, k.Stableswap.GetScheduledUpdates(ctx))
+	pool, err = k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pool.ProtocolFeePercentage)
+	assert.Equal(t, int64(5e3), pool.RewardsFee)
+
+	// ARRANGE: Schedule an update with a pool manager, and revoke their role before the execution.
+	manager := utils.TestAccount()
+	require.NoError(t, k.SetRole(ctx, manager.Address, types.ROLE_POOL_MANAGER))
+	update.ProtocolFeePercentage = 3
+	_, err = stableswapServer.ScheduleUpdatePool(ctx, &stableswap.MsgScheduleUpdatePool{Signer: manager.Address, ExecutionTime: 1_200, Update: update})
+	require.NoError(t, err)
+	require.NoError(t, k.RemoveRole(ctx, manager.Address, types.ROLE_POOL_MANAGER))
+
+	// ACT: Run the BeginBlocker at the execution time.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(1_200, 0)})
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// ASSERT: The update has been applied, as it was authorized when scheduled.
+	assert.Empty(t, k.Stableswap.GetScheduledUpdates(ctx))
+	pool, err = k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pool.ProtocolFeePercentage)
+}
+
+func TestRampA(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	server := keeper.NewMsgServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	queryServer := keeper.NewStableSwapQueryServer(k)
+	start := int64(1_000)
+	day := types.DefaultRampLimits.MinRampDuration
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(start, 0)})
+	rateMultipliers := sdk.NewCoins(
+		sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+		sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+	)
+
+	// ARRANGE: Create a Pool with a constant A.
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		FutureATime:           1893452400,
+		RateMultipliers:       rateMultipliers,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to move the A beyond the ramp limits through a Pool update.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              5_000,
+		FutureA:               5_000,
+		FutureATime:           start,
+		RateMultipliers:       rateMultipliers,
+	})
+	// ASSERT: The action should've failed, leaving the A unchanged.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+	amplification, err := queryServer.Amplification(ctx, &stableswap.QueryAmplification{PoolId: 0})
+	require.NoError(t, err)
+	assert.Equal(t, math.LegacyNewDec(100), amplification.CurrentA)
+
+	// ACT: Attempt to set invalid ramp limits.
+	params := k.GetParams(ctx)
+	params.RampLimits = &types.RampLimits{MaxAChange: 0, MinRampDuration: day}
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Signer: "authority", Params: params})
+	// ASSERT: The action should've failed due to the invalid params.
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// ACT: Attempt to ramp without the authority or the pool manager role.
+	_, err = stableswapServer.RampA(ctx, &stableswap.MsgRampA{Signer: "user", PoolId: 0, FutureA: 200, FutureATime: start + 3*day})
+	// ASSERT: The action should've failed due to the invalid authority.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to ramp right after the Pool creation.
+	_, err = stableswapServer.RampA(ctx, &stableswap.MsgRampA{Signer: "authority", PoolId: 0, FutureA: 200, FutureATime: start + 3*day})
+	// ASSERT: The action should've failed due to the minimum duration since the previous ramp.
+	require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+
+	// ACT: Attempt to ramp too fast, or beyond the maximum change factor.
+	now := start + day
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(now, 0)})
+	for _, msg := range []*stableswap.MsgRampA{
+		{Signer: "authority", PoolId: 0, FutureA: 200, FutureATime: now + day - 1},
+		{Signer: "authority", PoolId: 0, FutureA: 1_001, FutureATime: now + day},
+		{Signer: "authority", PoolId: 0, FutureA: 9, FutureATime: now + day},
+	} {
+		_, err = stableswapServer.RampA(ctx, msg)
+		// ASSERT: The action should've failed due to the ramp limits.
+		require.ErrorIs(t, err, types.ErrInvalidPoolParams)
+	}
+
+	// ACT: Ramp the A to 200 over two days.
+	res, err := stableswapServer.RampA(ctx, &stableswap.MsgRampA{Signer: "authority", PoolId: 0, FutureA: 200, FutureATime: now + 2*day})
+	require.NoError(t, err)
+	// ASSERT: The ramp starts from the current A.
+	assert.Equal(t, int64(100), res.InitialA)
+
+	// ACT: Update the Pool without the amplification coefficient, and query the A halfway through the ramp.
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(now+day, 0)})
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		RateMultipliers:       rateMultipliers,
+	})
+	require.NoError(t, err)
+	amplification, err = queryServer.Amplification(ctx, &stableswap.QueryAmplification{PoolId: 0})
+	require.NoError(t, err)
+	// ASSERT: The A has been interpolated along the unchanged ramp.
+	assert.Equal(t, math.LegacyNewDec(150), amplification.CurrentA)
+	assert.True(t, amplification.Ramping)
+	assert.Equal(t, int64(200), amplification.FutureA)
+	assert.Equal(t, now+2*day, amplification.FutureATime)
+
+	// ACT: Stop the ramp.
+	stopped, err := stableswapServer.StopRampA(ctx, &stableswap.MsgStopRampA{Signer: "authority", PoolId: 0})
+	require.NoError(t, err)
+	// ASSERT: The A has been frozen at its current value.
+	assert.Equal(t, int64(150), stopped.CurrentA)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Unix(now+3*day, 0)})
+	amplification, err = queryServer.Amplification(ctx, &stableswap.QueryAmplification{PoolId: 0})
+	require.NoError(t, err)
+	assert.Equal(t, math.LegacyNewDec(150), amplification.CurrentA)
+	assert.False(t, amplification.Ramping)
+
+	// ACT: Attempt to query a non existing Pool.
+	_, err = queryServer.Amplification(ctx, &stableswap.QueryAmplification{PoolId: 1})
+	// ASSERT: The query should've failed.
+	require.Error(t, err)
+}
@@ -63,6 +63,7 @@ func (s queryServer) Pool(ctx context.Context, req *types.QueryPool) (*types.Que
 		Address:      controller.GetAddress(),
 		Algorithm:    controller.GetAlgorithm(),
 		Pair:         controller.GetPair(),
+		Closed:       controller.IsClosed(),
 		Details:      controller.PoolDetails(),
 		Liquidity:    s.bankKeeper.GetAllBalances(ctx, liquidityPoolAddr),
 		ProtocolFees: s.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/protocol_fees", types.ModuleName, controller.GetId()))),
@@ -80,6 +81,11 @@ func (s queryServer) Pools(ctx context.Context, req *types.QueryPools) (*types.Q
 	// Iterate through the Pools.
 	var pools []*types.PoolDetails
 	for _, pool := range s.GetPools(ctx) {
+		// Skip the closed pools, which are only visible through the single pool query.
+		if pool.Closed {
+			continue
+		}
+
 		controller, err := GetGenericController(ctx, s.Keeper, pool.Id)
 		if err != nil {
 			continue
@@ -122,6 +128,9 @@ func (s queryServer) SimulateSwap(ctx context.Context, req *types.QuerySimulateS
 		Amount: req.Amount,
 		Routes: req.Routes,
 		Min:    req.Min,
+
+		Affiliate:       req.Affiliate,
+		AffiliateFeeBps: req.AffiliateFeeBps,
 	})
 }
 
@@ -141,7 +150,53 @@ func (s queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types
 	}
 	sortkeys.Uint64s(pausedPools)
 
-	return &types.QueryPausedResponse{PausedPools: pausedPools}, nil
+	return &types.QueryPausedResponse{
+		PausedPools:      pausedPools,
+		PausedActivities: s.GetPausedActivities(ctx),
+	}, nil
+}
+
+// WithdrawOnly retrieves the IDs of all the pools in withdraw-only mode.
+func (s queryServer) WithdrawOnly(ctx context.Context, req *types.QueryWithdrawOnly) (*types.QueryWithdrawOnlyResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryWithdrawOnlyResponse{PoolIds: s.GetWithdrawOnly(ctx)}, nil
+}
+
+// AllowedDenoms retrieves the registry of the denoms allowed as pool pairs.
+func (s queryServer) AllowedDenoms(ctx context.Context, req *types.QueryAllowedDenoms) (*types.QueryAllowedDenomsResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryAllowedDenomsResponse{Denoms: s.GetAllowedDenoms(ctx)}, nil
+}
+
+// BlockedDenoms retrieves the denoms blocked from the swaps.
+func (s queryServer) BlockedDenoms(ctx context.Context, req *types.QueryBlockedDenoms) (*types.QueryBlockedDenomsResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryBlockedDenomsResponse{Denoms: s.GetBlockedDenoms(ctx)}, nil
+}
+
+// Authority retrieves the current authority, and the pending one if any.
+func (s queryServer) Authority(ctx context.Context, req *types.QueryAuthority) (*types.QueryAuthorityResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryAuthorityResponse{
+		Authority:        s.GetAuthority(ctx),
+		PendingAuthority: s.GetPendingAuthority(ctx),
+	}, nil
 }
 
 // Rates retrieves exchange rates for all tokens, with the optionality of filtering by algorithm.
@@ -154,8 +209,8 @@ func (s queryServer) Rates(ctx context.Context, req *types.QueryRates) (*types.Q
 	// Iterate through all the Pools.
 	var rates []types.Rate
 	for _, pool := range s.GetPools(ctx) {
-		// If set ignore non-requested algorithms.
-		if req.Algorithm != types.UNSPECIFIED && req.Algorithm != pool.Algorithm {
+		// If set ignore non-requested algorithms, and always ignore closed pools.
+		if (req.Algorithm != types.UNSPECIFIED && req.Algorithm != pool.Algorithm) || pool.Closed {
 			continue
 		}
 
@@ -188,8 +243,8 @@ func (s queryServer) Rate(ctx context.Context, req *types.QueryRate) (*types.Que
 	// Iterate through all the Pools.
 	var rates []types.Rate
 	for _, pool := range s.GetPools(ctx) {
-		// If set ignore non-requested algorithms.
-		if req.Algorithm != types.UNSPECIFIED && req.Algorithm != pool.Algorithm {
+		// If set ignore non-requested algorithms, and always ignore closed pools.
+		if (req.Algorithm != types.UNSPECIFIED && req.Algorithm != pool.Algorithm) || pool.Closed {
 			continue
 		}
 
@@ -209,3 +264,126 @@ func (s queryServer) Rate(ctx context.Context, req *types.QueryRate) (*types.Que
 
 	return &types.QueryRateResponse{Rates: rates}, nil
 }
+
+// Incentives retrieves all the active incentive programs.
+func (s queryServer) Incentives(ctx context.Context, req *types.QueryIncentives) (*types.QueryIncentivesResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	var incentives []types.Incentive
+	for _, incentive := range s.GetIncentives(ctx) {
+		incentives = append(incentives, incentive)
+	}
+
+	// Sort Incentives by id.
+	sort.Slice(incentives, func(i, j int) bool {
+		return incentives[i].Id < incentives[j].Id
+	})
+	return &types.QueryIncentivesResponse{Incentives: incentives}, nil
+}
+
+// IncentivesByPool retrieves the active incentive programs of a specific Pool.
+func (s queryServer) IncentivesByPool(ctx context.Context, req *types.QueryIncentivesByPool) (*types.QueryIncentivesResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	var incentives []types.Incentive
+	for _, incentive := range s.GetIncentives(ctx) {
+		if incentive.PoolId == req.PoolId {
+			incentives = append(incentives, incentive)
+		}
+	}
+
+	// Sort Incentives by id.
+	sort.Slice(incentives, func(i, j int) bool {
+		return incentives[i].Id < incentives[j].Id
+	})
+	return &types.QueryIncentivesResponse{Incentives: incentives}, nil
+}
+
+// WithdrawAddress retrieves the address receiving the rewards of a specific user.
+func (s queryServer) WithdrawAddress(ctx context.Context, req *types.QueryWithdrawAddress) (*types.QueryWithdrawAddressResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Ensure that the address is valid.
+	if _, err := s.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	return &types.QueryWithdrawAddressResponse{WithdrawAddress: s.GetWithdrawAddress(ctx, req.Address)}, nil
+}
+
+// FeeTiers retrieves the swap fee discount tiers and the fee exempt accounts.
+func (s queryServer) FeeTiers(ctx context.Context, req *types.QueryFeeTiers) (*types.QueryFeeTiersResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryFeeTiersResponse{
+		Tiers:  s.GetFeeTiers(ctx),
+		Exempt: s.GetFeeExempt(ctx),
+	}, nil
+}
+
+// AccountFeeTier retrieves the rolling swap volume and the fee discount of a specific account.
+func (s queryServer) AccountFeeTier(ctx context.Context, req *types.QueryAccountFeeTier) (*types.QueryAccountFeeTierResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Ensure that the address is valid.
+	if _, err := s.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	currentTime := s.headerService.GetHeaderInfo(ctx).Time.Unix()
+	return &types.QueryAccountFeeTierResponse{
+		Volume:   s.GetRollingSwapVolume(ctx, req.Address, currentTime),
+		Discount: s.GetFeeDiscount(ctx, req.Address, currentTime),
+		Exempt:   s.IsFeeExempt(ctx, req.Address),
+	}, nil
+}
+
+// Params retrieves the module params.
+func (s queryServer) Params(ctx context.Context, req *types.QueryParams) (*types.QueryParamsResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryParamsResponse{Params: s.GetParams(ctx)}, nil
+}
+
+// Roles retrieves all the role grants.
+func (s queryServer) Roles(ctx context.Context, req *types.QueryRoles) (*types.QueryRolesResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryRolesResponse{Grants: s.GetRoleGrants(ctx)}, nil
+}
+
+// RolesByAddress retrieves the roles granted to a specific address.
+func (s queryServer) RolesByAddress(ctx context.Context, req *types.QueryRolesByAddress) (*types.QueryRolesByAddressResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Ensure that the address is valid.
+	if _, err := s.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, errors.ErrInvalidAddress
+	}
+
+	return &types.QueryRolesByAddressResponse{Roles: s.GetRolesByAddress(ctx, req.Address)}, nil
+}
@@ -22,6 +22,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -337,6 +338,61 @@ func TestPool(t *testing.T) {
 	assert.Equal(t, uint64(0), res.Pool.Id)
 }
 
+func TestIncentives(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	queryServer := keeper.NewQueryServer(k)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ARRANGE: Store incentives for two different Pools.
+	for id, poolId := range []uint64{1, 0, 1} {
+		err := k.SetIncentive(ctx, uint64(id), types.Incentive{
+			Id:        uint64(id),
+			PoolId:    poolId,
+			Amount:    sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(100))),
+			StartTime: startTime,
+			EndTime:   startTime.Add(time.Hour),
+		})
+		assert.NoError(t, err)
+	}
+
+	// ACT: Attempt to query the incentives with invalid messages.
+	_, err := queryServer.Incentives(ctx, nil)
+	assert.Error(t, err)
+	_, err = queryServer.IncentivesByPool(ctx, nil)
+	assert.Error(t, err)
+
+	// ACT: Query all the incentives.
+	res, err := queryServer.Incentives(ctx, &types.QueryIncentives{})
+
+	// ASSERT: All the incentives are returned sorted by id.
+	assert.NoError(t, err)
+	assert.Len(t, res.Incentives, 3)
+	for i, incentive := range res.Incentives {
+		assert.Equal(t, uint64(i), incentive.Id)
+	}
+
+	// ACT: Query the incentives of a specific Pool.
+	res, err = queryServer.IncentivesByPool(ctx, &types.QueryIncentivesByPool{PoolId: 1})
+
+	// ASSERT: Only the incentives of the Pool are returned.
+	assert.NoError(t, err)
+	assert.Len(t, res.Incentives, 2)
+	assert.Equal(t, uint64(0), res.Incentives[0].Id)
+	assert.Equal(t, uint64(2), res.Incentives[1].Id)
+
+	// ACT: Query the incentives of a Pool without incentives.
+	res, err = queryServer.IncentivesByPool(ctx, &types.QueryIncentivesByPool{PoolId: 2})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Incentives)
+}
+
 func TestPools(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
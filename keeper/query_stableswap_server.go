@@ -25,6 +25,7 @@ import (
 	"sort"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -84,12 +85,8 @@ func (s queryStableSwapServer) RewardsByProvider(ctx context.Context, req *stabl
 	var rewards []stableswap.QueryRewardsResponseEntry
 	for _, controller := range GetStableSwapControllers(ctx, s.keeper) {
 		// Get and collect the rewards for the given Pool.
-		poolRewards, err := controller.GetTotalPoolUserRewards(ctx, req.Provider, s.keeper.headerService.GetHeaderInfo(ctx).Time)
-		if err != nil {
-			continue
-		}
 		amount := sdk.Coins{}
-		for _, reward := range poolRewards {
+		for _, reward := range controller.GetTotalPoolUserRewards(ctx, req.Provider) {
 			amount = amount.Add(reward.Amount...)
 		}
 		rewards = append(rewards, stableswap.QueryRewardsResponseEntry{
@@ -107,6 +104,36 @@ func (s queryStableSwapServer) RewardsByProvider(ctx context.Context, req *stabl
 	}, nil
 }
 
+// IncentivesByProvider retrieves all the incentives accrued by a specific provider.
+func (s queryStableSwapServer) IncentivesByProvider(ctx context.Context, req *stableswap.QueryIncentivesByProvider) (*stableswap.QueryIncentivesByProviderResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil || req.Provider == "" {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Iterate through the StableSwap Controllers.
+	var incentives []stableswap.QueryRewardsResponseEntry
+	for _, controller := range GetStableSwapControllers(ctx, s.keeper) {
+		// Get and collect the incentives for the given Pool.
+		amount := sdk.Coins{}
+		for _, incentive := range controller.GetTotalPoolUserIncentives(ctx, req.Provider) {
+			amount = amount.Add(incentive.Amount...)
+		}
+		incentives = append(incentives, stableswap.QueryRewardsResponseEntry{
+			PoolId: controller.GetId(),
+			Amount: amount,
+		})
+	}
+
+	// Sort Incentives by Pool id.
+	sort.Slice(incentives, func(i, j int) bool {
+		return incentives[i].PoolId < incentives[j].PoolId
+	})
+	return &stableswap.QueryIncentivesByProviderResponse{
+		Incentives: incentives,
+	}, nil
+}
+
 // BondedPositionsByProvider retrieves all the bonded positions by a specific provider.
 func (s queryStableSwapServer) BondedPositionsByProvider(ctx context.Context, req *stableswap.QueryBondedPositionsByProvider) (*stableswap.QueryBondedPositionsByProviderResponse, error) {
 	// Ensure that the payload is valid.
@@ -154,3 +181,66 @@ func (s queryStableSwapServer) UnbondingPositionsByProvider(ctx context.Context,
 	})
 	return &stableswap.QueryUnbondingPositionsByProviderResponse{UnbondingPositions: positions}, nil
 }
+
+// UnbondingPeriod retrieves the expected unbonding period for removing a given amount of shares from a pool.
+func (s queryStableSwapServer) UnbondingPeriod(ctx context.Context, req *stableswap.QueryUnbondingPeriod) (*stableswap.QueryUnbondingPeriodResponse, error) {
+	// Ensure that the payload is valid.
+	if req == nil || req.Shares.IsNil() || !req.Shares.IsPositive() {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Get the StableswapController associated to the Pool.
+	controller, err := GetStableSwapController(ctx, s.keeper, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the unbonding period weighted to the requested shares.
+	unbondingPeriod, err := controller.GetUnbondingPeriod(req.Shares)
+	if err != nil {
+		return nil, sdkerrors.Wrap(errors.ErrInvalidRequest, err.Error())
+	}
+
+	return &stableswap.QueryUnbondingPeriodResponse{
+		UnbondingPeriod: unbondingPeriod,
+		EndTime:         s.keeper.headerService.GetHeaderInfo(ctx).Time.Add(unbondingPeriod),
+	}, nil
+}
+
+// ScheduledPoolUpdates retrieves the pending scheduled pool updates.
+func (s queryStableSwapServer) ScheduledPoolUpdates(ctx context.Context, req *stableswap.QueryScheduledPoolUpdates) (*stableswap.QueryScheduledPoolUpdatesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &stableswap.QueryScheduledPoolUpdatesResponse{
+		Updates: s.keeper.Stableswap.GetScheduledUpdates(ctx),
+	}, nil
+}
+
+// Amplification retrieves the current effective amplification coefficient of a pool, along with its ramp schedule.
+func (s queryStableSwapServer) Amplification(ctx context.Context, req *stableswap.QueryAmplification) (*stableswap.QueryAmplificationResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	// Get the StableswapController associated to the Pool.
+	controller, err := GetStableSwapController(ctx, s.keeper, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.keeper.Stableswap.GetPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.keeper.headerService.GetHeaderInfo(ctx).Time.Unix()
+	return &stableswap.QueryAmplificationResponse{
+		CurrentA:     controller.GetAmplificationCoefficient(now),
+		InitialA:     pool.InitialA,
+		FutureA:      pool.FutureA,
+		InitialATime: pool.InitialATime,
+		FutureATime:  pool.FutureATime,
+		Ramping:      pool.FutureATime > now && pool.FutureA != pool.InitialA,
+	}, nil
+}
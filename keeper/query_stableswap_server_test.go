@@ -369,6 +369,75 @@ func TestRewardsByProvider(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestIncentivesByProvider(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapQueryServer := keeper.NewStableSwapQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+
+	user := utils.TestAccount()
+
+	// ARRANGE: Create a Pool and add liquidity.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RewardsFee:            100000,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+	_, err = stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: user.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE))),
+	})
+	assert.NoError(t, err)
+
+	// ACT: Query the incentives with an invalid request.
+	_, err = stableswapQueryServer.IncentivesByProvider(ctx, nil)
+	assert.Error(t, err)
+
+	// ACT: Query the incentives before any distribution.
+	res, err := stableswapQueryServer.IncentivesByProvider(ctx, &stableswap.QueryIncentivesByProvider{
+		Provider: user.Address,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Incentives, 1)
+	assert.True(t, res.Incentives[0].Amount.IsZero())
+
+	// ARRANGE: Stream incentives to the Pool.
+	controller, err := keeper.GetStableSwapController(ctx, k, 0)
+	assert.NoError(t, err)
+	err = controller.DepositIncentives(ctx, sdk.NewCoins(sdk.NewCoin("ufoo", math.NewInt(ONE))))
+	assert.NoError(t, err)
+
+	// ACT: Query the incentives after the distribution.
+	res, err = stableswapQueryServer.IncentivesByProvider(ctx, &stableswap.QueryIncentivesByProvider{
+		Provider: user.Address,
+	})
+
+	// ASSERT: The incentives are accounted separately from the rewards.
+	assert.NoError(t, err)
+	assert.InDelta(t, ONE, res.Incentives[0].Amount.AmountOf("ufoo").Int64(), 1)
+	rewards, err := stableswapQueryServer.RewardsByProvider(ctx, &stableswap.QueryRewardsByProvider{
+		Provider: user.Address,
+	})
+	assert.NoError(t, err)
+	assert.True(t, rewards.Rewards[0].Amount.IsZero())
+}
+
 func TestPositions(t *testing.T) {
 	account := mocks.AccountKeeper{
 		Accounts: make(map[string]sdk.AccountI),
@@ -451,3 +520,106 @@ func TestPositions(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestUnbondingPeriod(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapQueryServer := keeper.NewStableSwapQueryServer(k)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	user := utils.TestAccount()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now})
+
+	// ARRANGE: Create a Pool with custom unbonding tiers.
+	tiers := []stableswap.UnbondingTier{
+		{Threshold: math.LegacyZeroDec(), Duration: time.Hour},
+		{Threshold: math.LegacyNewDec(50), Duration: 7 * 24 * time.Hour},
+	}
+	_, err := stableswapServer.CreatePool(ctx, &stableswap.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+		InitialA:       100,
+		FutureA:        100,
+		UnbondingTiers: tiers,
+	})
+	assert.NoError(t, err)
+	pool, _ := k.Stableswap.Pools.Get(ctx, 0)
+	assert.Equal(t, tiers, pool.UnbondingTiers)
+
+	// ACT: Attempt to query with an invalid request.
+	_, err = stableswapQueryServer.UnbondingPeriod(ctx, nil)
+	assert.Error(t, err)
+	_, err = stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 0, Shares: math.LegacyZeroDec()})
+	assert.Error(t, err)
+
+	// ACT: Attempt to query a non-existing pool.
+	_, err = stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 1, Shares: math.LegacyOneDec()})
+	assert.ErrorIs(t, err, types.ErrInvalidPool)
+
+	// ACT: Attempt to query a pool without liquidity.
+	_, err = stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 0, Shares: math.LegacyOneDec()})
+	assert.Error(t, err)
+
+	// ARRANGE: Add liquidity to the pool.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100*ONE)), sdk.NewCoin("uusdn", math.NewInt(100*ONE)))
+	resAdd, err := stableswapServer.AddLiquidity(ctx, &stableswap.MsgAddLiquidity{
+		Signer: user.Address,
+		PoolId: 0,
+		Amount: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(10*ONE)), sdk.NewCoin("uusdn", math.NewInt(10*ONE))),
+	})
+	assert.NoError(t, err)
+	totalShares := math.LegacyNewDec(resAdd.MintedShares)
+
+	// ACT: Query the unbonding period of a small amount of shares.
+	res, err := stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 0, Shares: totalShares.QuoInt64(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, res.UnbondingPeriod)
+	assert.Equal(t, now.Add(time.Hour), res.EndTime)
+
+	// ACT: Query the unbonding period of a large amount of shares.
+	res, err = stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 0, Shares: totalShares})
+	assert.NoError(t, err)
+	assert.Equal(t, 7*24*time.Hour, res.UnbondingPeriod)
+	assert.Equal(t, now.Add(7*24*time.Hour), res.EndTime)
+
+	// ACT: Remove all the liquidity.
+	_, err = stableswapServer.RemoveLiquidity(ctx, &stableswap.MsgRemoveLiquidity{
+		Signer:     user.Address,
+		PoolId:     0,
+		Percentage: math.LegacyNewDec(100),
+	})
+	assert.NoError(t, err)
+
+	// ASSERT: The unbonding position matches the queried end time.
+	positions := k.Stableswap.GetUnbondingPositionsByProvider(ctx, user.Address)
+	assert.Len(t, positions, 1)
+	assert.Equal(t, now.Add(7*24*time.Hour), positions[0].UnbondingPosition.EndTime)
+
+	// ARRANGE: Reset the pool to the default unbonding tiers.
+	_, err = stableswapServer.UpdatePool(ctx, &stableswap.MsgUpdatePool{
+		Signer:                "authority",
+		PoolId:                0,
+		ProtocolFeePercentage: 1,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	assert.NoError(t, err)
+
+	// ACT: Query the unbonding period with the default tiers.
+	res, err = stableswapQueryServer.UnbondingPeriod(ctx, &stableswap.QueryUnbondingPeriod{PoolId: 0, Shares: totalShares})
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, res.UnbondingPeriod)
+}
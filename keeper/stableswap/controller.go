@@ -46,8 +46,13 @@ type Controller struct {
 	baseDenom        string
 	pool             *types.Pool
 	paused           bool
+	withdrawOnly     bool
+	pauseFlags       uint32
 	stableswapPool   *stableswap.Pool
 	stableswapKeeper *Keeper
+
+	// withdrawAddress resolves the address receiving the rewards settled on behalf of a provider.
+	withdrawAddress func(ctx context.Context, address string) string
 }
 
 // NewController initializes a Controller for managing a StableSwap pool.
@@ -57,8 +62,11 @@ func NewController(
 	baseDenom string,
 	pool *types.Pool,
 	paused bool,
+	withdrawOnly bool,
+	pauseFlags uint32,
 	stableswapPool *stableswap.Pool,
 	stableswapKeeper *Keeper,
+	withdrawAddress func(ctx context.Context, address string) string,
 ) Controller {
 	return Controller{
 		bankKeeper:       bankKeeper,
@@ -66,8 +74,11 @@ func NewController(
 		baseDenom:        baseDenom,
 		pool:             pool,
 		paused:           paused,
+		withdrawOnly:     withdrawOnly,
+		pauseFlags:       pauseFlags,
 		stableswapPool:   stableswapPool,
 		stableswapKeeper: stableswapKeeper,
+		withdrawAddress:  withdrawAddress,
 	}
 }
 
@@ -97,17 +108,46 @@ func (c *Controller) PoolDetails() *anyproto.Any {
 	return details
 }
 
-// IsPaused checks if the pool is currently paused.
+// IsPaused checks if the pool is currently paused, which is always the case once closed.
 func (c *Controller) IsPaused() bool {
-	return c.paused
+	return c.paused || c.pool.Closed
+}
+
+// IsWithdrawOnly checks if the liquidity providers of the paused pool can still withdraw.
+func (c *Controller) IsWithdrawOnly() bool {
+	return c.paused && c.withdrawOnly && !c.pool.Closed
+}
+
+// IsActivityPaused checks if a specific activity of the pool is paused, either through its pause flags or by
+// the pool being paused. Withdraw-only pools keep the removals, reward claims and unbondings active.
+func (c *Controller) IsActivityPaused(activity types.PauseActivity) bool {
+	if c.pauseFlags&uint32(activity) != 0 {
+		return true
+	}
+	if !c.IsPaused() {
+		return false
+	}
+	switch activity {
+	case types.PAUSE_ACTIVITY_REMOVE_LIQUIDITY, types.PAUSE_ACTIVITY_REWARD_CLAIMS, types.PAUSE_ACTIVITY_UNBONDING:
+		return !c.IsWithdrawOnly()
+	default:
+		return true
+	}
+}
+
+// IsClosed checks if the pool has been permanently closed.
+func (c *Controller) IsClosed() bool {
+	return c.pool.Closed
 }
 
-// Swap performs a token swap using the StableSwap algorithm and returns the result with fees.
+// Swap performs a token swap using the StableSwap algorithm and returns the result with fees,
+// reduced by the given fee discount.
 func (c *Controller) Swap(
 	ctx context.Context,
 	currentTime int64,
 	coin sdk.Coin,
 	denomTo string,
+	feeDiscount math.LegacyDec,
 ) (*types.SwapCommitment, error) {
 	// Ensure that the Pool has liquidity.
 	poolLiquidity := c.GetLiquidity(ctx)
@@ -144,7 +184,9 @@ func (c *Controller) Swap(
 		adjustedLiquidity,
 		amp,
 		denomTo,
-		c.stableswapPool.RewardsFee,
+		c.getRewardsFee(denomTo),
+		c.stableswapPool.DynamicFee,
+		feeDiscount,
 		c.stableswapPool.ProtocolFeePercentage,
 		c.stableswapPool.RateMultipliers,
 	)
@@ -157,82 +199,117 @@ func (c *Controller) Swap(
 		return nil, errors.New("swap result amount is not positive")
 	}
 
+	// Split the protocol fees across the configured recipients, keeping the remainder in the pool.
+	protocolFee, splitFees, err := c.splitProtocolFee(sdk.NewCoin(coin.Denom, swapResult.ProtocolFee.TruncateInt()))
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.SwapCommitment{
 		In:  sdk.NewCoin(coin.Denom, coin.Amount),
 		Out: sdk.NewCoin(denomTo, swapResult.Dy.TruncateInt()),
-		Fees: []types.Receiver{
+		Fees: append([]types.Receiver{
 			{ // protocol fees
-				Amount:  sdk.NewCoin(coin.Denom, swapResult.ProtocolFee.TruncateInt()),
+				Amount:  protocolFee,
 				Address: authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/protocol_fees", types.ModuleName, c.GetId())),
 			},
 			{ // rewards fees
 				Amount:  sdk.NewCoin(coin.Denom, swapResult.RewardsFee.TruncateInt()),
-				Address: authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, c.GetId())),
+				Address: c.GetRewardsFeesAddress(),
 			},
-		},
+		}, splitFees...),
+		RewardsFee: swapResult.RewardsFeeRate,
 	}, nil
 }
 
+// getRewardsFee returns the rewards fee applied to the swaps towards the given denom, which
+// depends on the swap direction when the directional fee is set.
+func (c *Controller) getRewardsFee(denomTo string) int64 {
+	directionalFee := c.stableswapPool.DirectionalFee
+	switch {
+	case directionalFee == nil:
+		return c.stableswapPool.RewardsFee
+	case denomTo == c.baseDenom:
+		return directionalFee.PairToBaseFee
+	default:
+		return directionalFee.BaseToPairFee
+	}
+}
+
+// splitProtocolFee divides the protocol fee across the fee splits of the pool proportionally to
+// their weights, returning the truncation remainder kept as protocol fees and the split receivers.
+func (c *Controller) splitProtocolFee(fee sdk.Coin) (sdk.Coin, []types.Receiver, error) {
+	splits := c.stableswapPool.FeeSplits
+	if len(splits) == 0 || !fee.IsPositive() {
+		return fee, nil, nil
+	}
+
+	totalWeight := stableswap.TotalFeeSplitsWeight(splits)
+	remainder := fee.Amount
+	var receivers []types.Receiver
+	for _, split := range splits {
+		amount := fee.Amount.ToLegacyDec().Mul(split.Weight).Quo(totalWeight).TruncateInt()
+		remainder = remainder.Sub(amount)
+
+		receiver := types.Receiver{
+			Amount: sdk.NewCoin(fee.Denom, amount),
+			Name:   split.Name,
+		}
+		switch split.RecipientType {
+		case stableswap.FEE_RECIPIENT_ADDRESS:
+			address, err := (*c.addressCodec).StringToBytes(split.Recipient)
+			if err != nil {
+				return sdk.Coin{}, nil, sdkerrors.Wrapf(err, "unable to decode recipient of split %s", split.Name)
+			}
+			receiver.Address = address
+		case stableswap.FEE_RECIPIENT_MODULE:
+			receiver.Address = authtypes.NewModuleAddress(split.Recipient)
+		case stableswap.FEE_RECIPIENT_COMMUNITY_POOL:
+			receiver.Kind = types.ReceiverCommunityPool
+		case stableswap.FEE_RECIPIENT_BURN:
+			receiver.Address = authtypes.NewModuleAddress(types.ModuleName)
+			receiver.Kind = types.ReceiverBurn
+		default:
+			return sdk.Coin{}, nil, fmt.Errorf("invalid recipient type of split %s", split.Name)
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	return sdk.NewCoin(fee.Denom, remainder), receivers, nil
+}
+
 // AddLiquidity adds liquidity to the StableSwap pool and creates a bonded position for the user.
 func (c *Controller) AddLiquidity(
 	ctx context.Context,
 	currentTime time.Time,
 	msg *stableswap.MsgAddLiquidity,
 ) (*types.AddLiquidityCommitment, error) {
-	// Get current amplification coefficient.
-	amp := getAmplificationCoefficient(
-		currentTime.Unix(),
-		math.LegacyNewDec(c.stableswapPool.InitialA),
-		math.LegacyNewDec(c.stableswapPool.FutureA),
-		c.stableswapPool.InitialATime,
-		c.stableswapPool.FutureATime,
-	)
-
-	// Retrieve the Pool liquidity.
-	liquidity := c.GetLiquidity(ctx)
-
-	// Calculate pre-deposit invariant.
-	xp, err := calculateAdjustedBalancesInRates(c.stableswapPool.RateMultipliers, liquidity)
-	if err != nil {
-		return nil, err
-	}
-	D0, err := calculateInvariant(xp, amp)
+	shares, err := c.calculateDepositShares(ctx, currentTime, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate new liquidity balances after adding the user provided amounts.
-	for _, coin := range msg.Amount {
-		liquidity = liquidity.Add(sdk.NewCoin(coin.Denom, math.NewInt(liquidity.AmountOf(coin.Denom).Int64()+msg.Amount.AmountOf(coin.Denom).Int64())))
-	}
+	bondedPosition := stableswaptypes.BondedPosition{
+		Balance:                  shares,
+		Timestamp:                currentTime,
+		RewardsPeriodStart:       currentTime,
+		RewardPerShareCheckpoint: c.stableswapPool.RewardPerShare,
 
-	// Calculate post-deposit invariant.
-	xp, err = calculateAdjustedBalancesInRates(c.stableswapPool.RateMultipliers, liquidity)
-	if err != nil {
-		return nil, err
-	}
-	D1, err := calculateInvariant(xp, amp)
-	if err != nil {
-		return nil, err
-	}
-	if D1.LTE(D0) {
-		return nil, fmt.Errorf("d1 must be greater than d0")
+		IncentivePerShareCheckpoint: c.stableswapPool.IncentivePerShare,
 	}
 
-	// Calculate how many LP tokens to mint.
-	newTotalMint := math.LegacyZeroDec()
-	if c.stableswapPool.TotalShares.GT(math.LegacyZeroDec()) {
-		newTotalMint = c.stableswapPool.TotalShares.Mul(D1.Sub(D0))
-		newTotalMint = newTotalMint.Quo(D0)
-	} else {
-		// First liquidity provider mints tokens equal to D1.
-		newTotalMint.Set(D1)
-	}
+	// Lock the position if requested, boosting its reward weight.
+	if msg.LockDuration != 0 {
+		multiplier, err := c.GetLockMultiplier(msg.LockDuration)
+		if err != nil {
+			return nil, err
+		}
+		bondedPosition.LockEndTime = currentTime.Add(msg.LockDuration)
+		bondedPosition.Multiplier = &multiplier
 
-	bondedPosition := stableswaptypes.BondedPosition{
-		Balance:            newTotalMint.Sub(c.stableswapPool.TotalShares),
-		Timestamp:          currentTime,
-		RewardsPeriodStart: currentTime,
+		if err = c.stableswapKeeper.SetLockedPosition(ctx, bondedPosition.LockEndTime.Unix(), msg.Signer, c.GetId()); err != nil {
+			return nil, sdkerrors.Wrap(err, "unable to set locked position")
+		}
 	}
 
 	// Add the new BondedPosition on the StableSwap State.
@@ -256,6 +333,7 @@ func (c *Controller) AddLiquidity(
 
 	// Update the Pool TotalShares on the State.
 	c.stableswapPool.TotalShares = c.stableswapPool.TotalShares.Add(bondedPosition.Balance)
+	c.stableswapPool.TotalBoostedShares = c.getTotalBoostedShares().Add(bondedPosition.BoostedShares())
 	if c.GetLiquidity(ctx).IsZero() {
 		c.stableswapPool.InitialRewardsTime = currentTime
 	}
@@ -268,6 +346,50 @@ func (c *Controller) AddLiquidity(
 	}, nil
 }
 
+// calculateDepositShares computes the shares minted for depositing the given amount into the pool.
+func (c *Controller) calculateDepositShares(ctx context.Context, currentTime time.Time, amount sdk.Coins) (math.LegacyDec, error) {
+	// Get current amplification coefficient.
+	amp := getAmplificationCoefficient(
+		currentTime.Unix(),
+		math.LegacyNewDec(c.stableswapPool.InitialA),
+		math.LegacyNewDec(c.stableswapPool.FutureA),
+		c.stableswapPool.InitialATime,
+		c.stableswapPool.FutureATime,
+	)
+
+	// Retrieve the Pool liquidity.
+	liquidity := c.GetLiquidity(ctx)
+
+	// Calculate pre-deposit invariant.
+	xp, err := calculateAdjustedBalancesInRates(c.stableswapPool.RateMultipliers, liquidity)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	D0, err := calculateInvariant(xp, amp)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// Calculate new liquidity balances after adding the user provided amounts once, so that single
+	// sided deposits (e.g. compounded rewards) are valued like any other deposit.
+	liquidity = liquidity.Add(amount...)
+
+	// Calculate post-deposit invariant.
+	xp, err = calculateAdjustedBalancesInRates(c.stableswapPool.RateMultipliers, liquidity)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	D1, err := calculateInvariant(xp, amp)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if D1.LTE(D0) {
+		return math.LegacyDec{}, fmt.Errorf("d1 must be greater than d0")
+	}
+
+	return calculateMintedShares(c.stableswapPool.TotalShares, D0, D1), nil
+}
+
 // RemoveLiquidity begins the process for a user to remove liquidity positions from a given `StableSwap` Pool and
 // a given percentage amount in the Pool initiating the unbonding period.
 func (c *Controller) RemoveLiquidity(
@@ -288,7 +410,7 @@ func (c *Controller) RemoveLiquidity(
 	}
 
 	// Compute the user remaining bonded shares in the Pool, available to be unbonded.
-	availableShares := userTotalShares.Sub(prevUserTotalUnbondingShares)
+	availableShares := userTotalShares.Sub(prevUserTotalUnbondingShares).Sub(c.getUserLockedShares(ctx, msg.Signer, currentTime))
 
 	// Compute the unbonding shares by the percentage.
 	sharesToUnbond := availableShares.Mul(msg.Percentage).QuoInt64(100)
@@ -312,8 +434,22 @@ func (c *Controller) RemoveLiquidity(
 		coinsToReturn = coinsToReturn.Add(sdk.NewCoin(asset.Denom, amountToReturn))
 	}
 
+	// Skip the unbonding period and the exit fee if the pool is withdraw-only.
+	if c.IsWithdrawOnly() {
+		return c.removeLiquidityInstant(ctx, currentTime, msg.Signer, sharesToUnbond, coinsToReturn, math.LegacyZeroDec())
+	}
+
+	// Skip the unbonding period if requested, charging the exit fee.
+	if msg.Instant {
+		feePercentage, err := c.GetExitFeePercentage(sharesToUnbond)
+		if err != nil {
+			return nil, err
+		}
+		return c.removeLiquidityInstant(ctx, currentTime, msg.Signer, sharesToUnbond, coinsToReturn, feePercentage)
+	}
+
 	// Compute the unbonding period weighted to the amount of tokens to unbond and the total pool liquidity.
-	unbondingPeriod, err := ComputeWeightedPoolUnbondingPeriod(c.stableswapPool.TotalShares, sharesToUnbond)
+	unbondingPeriod, err := c.GetUnbondingPeriod(sharesToUnbond)
 	if err != nil {
 		return nil, err
 	}
@@ -359,6 +495,240 @@ func (c *Controller) RemoveLiquidity(
 	}, nil
 }
 
+// removeLiquidityInstant burns the user shares and returns the liquidity immediately, charging the given exit fee
+// percentage which is sent to the rewards of the remaining providers.
+func (c *Controller) removeLiquidityInstant(
+	ctx context.Context,
+	currentTime time.Time,
+	address string,
+	sharesToUnbond math.LegacyDec,
+	coinsToReturn sdk.Coins,
+	feePercentage math.LegacyDec,
+) (*types.RemoveLiquidityCommitment, error) {
+	poolAddr, err := (*c.addressCodec).StringToBytes(c.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	addr, err := (*c.addressCodec).StringToBytes(address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compute the exit fee, rounding up in favour of the remaining providers.
+	exitFee := sdk.NewCoins()
+	for _, coin := range coinsToReturn {
+		fee := coin.Amount.ToLegacyDec().Mul(feePercentage).QuoInt64(100).Ceil().TruncateInt()
+		exitFee = exitFee.Add(sdk.NewCoin(coin.Denom, fee))
+	}
+	amount := coinsToReturn.Sub(exitFee...)
+
+	// Process all the rewards associated to the given pool, before burning the shares.
+	if err = c.settleUserRewards(ctx, address, currentTime); err != nil {
+		return nil, err
+	}
+
+	// Burn the user shares.
+	if err = c.unbondShares(ctx, address, sharesToUnbond, currentTime); err != nil {
+		return nil, err
+	}
+
+	// Send the tokens back to the user, and the exit fee to the pool rewards.
+	if err = (*c.bankKeeper).SendCoins(ctx, poolAddr, addr, amount); err != nil {
+		return nil, err
+	}
+	if !exitFee.IsZero() {
+		if err = (*c.bankKeeper).SendCoins(ctx, poolAddr, c.GetRewardsFeesAddress(), exitFee); err != nil {
+			return nil, err
+		}
+		if err = c.DepositRewards(ctx, exitFee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.RemoveLiquidityCommitment{
+		UnbondingPosition: stableswaptypes.UnbondingPosition{
+			Amount:  amount,
+			EndTime: currentTime,
+			Shares:  sharesToUnbond,
+		},
+		ExitFee: exitFee,
+	}, nil
+}
+
+// unbondShares removes the given shares from the user unlocked bonded positions of the pool, starting from the
+// oldest ones, and updates the user and pool totals accordingly.
+func (c *Controller) unbondShares(ctx context.Context, address string, shares math.LegacyDec, currentTime time.Time) error {
+	// Release the expired locks, so that their boost is removed before burning the shares.
+	if err := c.releaseExpiredLocks(ctx, address, currentTime); err != nil {
+		return err
+	}
+
+	cumulativeUnbonded := math.LegacyZeroDec()
+	// Iterate through user's positions to unbond the specified amount.
+	for _, bondedEntry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
+		if cumulativeUnbonded.GTE(shares) {
+			break
+		}
+		if bondedEntry.BondedPosition.IsLocked(currentTime) {
+			continue
+		}
+		if cumulativeUnbonded.Add(bondedEntry.BondedPosition.Balance).GT(shares) {
+			remainingShares := shares.Sub(cumulativeUnbonded)
+			bondedEntry.BondedPosition.Balance = bondedEntry.BondedPosition.Balance.Sub(remainingShares)
+			cumulativeUnbonded = shares
+
+			if err := c.stableswapKeeper.SetBondedPosition(ctx, bondedEntry.PoolId, bondedEntry.Address, bondedEntry.Timestamp, bondedEntry.BondedPosition); err != nil {
+				return err
+			}
+		} else {
+			cumulativeUnbonded = cumulativeUnbonded.Add(bondedEntry.BondedPosition.Balance)
+			if err := c.stableswapKeeper.RemoveBondedPosition(ctx, bondedEntry.PoolId, bondedEntry.Address, bondedEntry.Timestamp); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Final check to ensure the unbonded amount matches the target.
+	if cumulativeUnbonded.LT(shares) {
+		return fmt.Errorf("%s is smaller then requested: %s", cumulativeUnbonded.String(), shares.String())
+	}
+
+	// Update the pool total shares.
+	c.stableswapPool.TotalShares = c.stableswapPool.TotalShares.Sub(shares)
+	if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+		return err
+	}
+
+	// Remove the unbonded shares from the user total.
+	userTotalBondedShares := math.LegacyZeroDec()
+	if c.stableswapKeeper.HasUserTotalBondedShares(ctx, c.GetId(), address) {
+		userTotalBondedShares = c.stableswapKeeper.GetUserTotalBondedShares(ctx, c.GetId(), address)
+	}
+	return c.stableswapKeeper.SetUserTotalBondedShares(ctx, c.GetId(), address, userTotalBondedShares.Sub(shares))
+}
+
+// TransferPosition moves a percentage of the user available bonded shares in the Pool to the receiver,
+// settling the pending rewards of both the user and the receiver beforehand.
+func (c *Controller) TransferPosition(
+	ctx context.Context,
+	currentTime time.Time,
+	msg *stableswaptypes.MsgTransferPosition,
+) (math.LegacyDec, error) {
+	// Get the user total shares in the Pool.
+	if !c.stableswapKeeper.HasUserTotalBondedShares(ctx, c.GetId(), msg.Signer) {
+		return math.LegacyDec{}, sdkerrors.Wrapf(types.ErrInvalidTransfer, "user %s does not have a UsersTotalBondedShares position", msg.Signer)
+	}
+	userTotalShares := c.stableswapKeeper.GetUserTotalBondedShares(ctx, c.GetId(), msg.Signer)
+
+	// Compute the user remaining bonded shares in the Pool, as the unbonding and locked ones cannot be transferred.
+	availableShares := userTotalShares.Sub(c.getUserLockedShares(ctx, msg.Signer, currentTime))
+	if c.stableswapKeeper.HasUserTotalUnbondingShares(ctx, c.GetId(), msg.Signer) {
+		availableShares = availableShares.Sub(c.stableswapKeeper.GetUserTotalUnbondingShares(ctx, c.GetId(), msg.Signer))
+	}
+
+	// Compute the shares to transfer by the percentage.
+	sharesToTransfer := availableShares.Mul(msg.Percentage).QuoInt64(100)
+	if !sharesToTransfer.IsPositive() {
+		return math.LegacyDec{}, sdkerrors.Wrapf(types.ErrInvalidTransfer, "no available shares to transfer")
+	}
+
+	// Settle the pending rewards of both parties, so that they are not lost during the transfer.
+	if err := c.settleUserRewards(ctx, msg.Signer, currentTime); err != nil {
+		return math.LegacyDec{}, err
+	}
+	if c.stableswapKeeper.HasUserTotalBondedShares(ctx, c.GetId(), msg.Receiver) {
+		if err := c.settleUserRewards(ctx, msg.Receiver, currentTime); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+
+	// Release the expired locks of both parties, so that only unlocked positions without boost are
+	// transferred and merged.
+	if err := c.releaseExpiredLocks(ctx, msg.Signer, currentTime); err != nil {
+		return math.LegacyDec{}, err
+	}
+	if err := c.releaseExpiredLocks(ctx, msg.Receiver, currentTime); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// Iterate through the user's positions starting from the most recent ones, as the oldest positions
+	// are the first to be consumed by the pending unbondings.
+	positions := c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), msg.Signer)
+
+	// Ensure that the receiver positions created at the same time as the transferred ones have the same
+	// lock and boost before moving any share, as they are merged together.
+	cumulativeTransferred := math.LegacyZeroDec()
+	for i := len(positions) - 1; i >= 0 && cumulativeTransferred.LT(sharesToTransfer); i-- {
+		entry := positions[i]
+		if entry.BondedPosition.IsLocked(currentTime) {
+			continue
+		}
+		if c.stableswapKeeper.HasBondedPosition(ctx, entry.PoolId, msg.Receiver, entry.Timestamp) &&
+			!c.stableswapKeeper.GetBondedPosition(ctx, entry.PoolId, msg.Receiver, entry.Timestamp).HasSameLockAndBoost(entry.BondedPosition, currentTime) {
+			return math.LegacyDec{}, sdkerrors.Wrapf(types.ErrInvalidTransfer, "receiver position at %d has a different lock or boost", entry.Timestamp)
+		}
+		cumulativeTransferred = cumulativeTransferred.Add(entry.BondedPosition.Balance)
+	}
+
+	cumulativeTransferred = math.LegacyZeroDec()
+	for i := len(positions) - 1; i >= 0 && cumulativeTransferred.LT(sharesToTransfer); i-- {
+		entry := positions[i]
+		if entry.BondedPosition.IsLocked(currentTime) {
+			continue
+		}
+
+		transferred := entry.BondedPosition
+		if cumulativeTransferred.Add(entry.BondedPosition.Balance).GT(sharesToTransfer) {
+			// Split the position, keeping the remaining shares on the user.
+			transferred.Balance = sharesToTransfer.Sub(cumulativeTransferred)
+			entry.BondedPosition.Balance = entry.BondedPosition.Balance.Sub(transferred.Balance)
+			if err := c.stableswapKeeper.SetBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp, entry.BondedPosition); err != nil {
+				return math.LegacyDec{}, err
+			}
+		} else {
+			if err := c.stableswapKeeper.RemoveBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp); err != nil {
+				return math.LegacyDec{}, err
+			}
+		}
+		cumulativeTransferred = cumulativeTransferred.Add(transferred.Balance)
+
+		// Merge the transferred shares into an eventual receiver position created at the same time,
+		// otherwise store them as a new receiver position keeping the user lock and boost.
+		if c.stableswapKeeper.HasBondedPosition(ctx, entry.PoolId, msg.Receiver, entry.Timestamp) {
+			receiverPosition := c.stableswapKeeper.GetBondedPosition(ctx, entry.PoolId, msg.Receiver, entry.Timestamp)
+			boostedShares := receiverPosition.BoostedShares()
+			receiverPosition.Balance = receiverPosition.Balance.Add(transferred.Balance)
+			c.stableswapPool.TotalBoostedShares = c.getTotalBoostedShares().Add(receiverPosition.BoostedShares().Sub(boostedShares))
+			if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+				return math.LegacyDec{}, err
+			}
+			transferred = receiverPosition
+		}
+		if err := c.stableswapKeeper.SetBondedPosition(ctx, entry.PoolId, msg.Receiver, entry.Timestamp, transferred); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+
+	// Final check to ensure the transferred amount matches the target.
+	if cumulativeTransferred.LT(sharesToTransfer) {
+		return math.LegacyDec{}, fmt.Errorf("%s is smaller then requested: %s", cumulativeTransferred.String(), sharesToTransfer.String())
+	}
+
+	// Move the shares between the users totals.
+	if err := c.stableswapKeeper.SetUserTotalBondedShares(ctx, c.GetId(), msg.Signer, userTotalShares.Sub(sharesToTransfer)); err != nil {
+		return math.LegacyDec{}, err
+	}
+	receiverTotalShares := math.LegacyZeroDec()
+	if c.stableswapKeeper.HasUserTotalBondedShares(ctx, c.GetId(), msg.Receiver) {
+		receiverTotalShares = c.stableswapKeeper.GetUserTotalBondedShares(ctx, c.GetId(), msg.Receiver)
+	}
+	if err := c.stableswapKeeper.SetUserTotalBondedShares(ctx, c.GetId(), msg.Receiver, receiverTotalShares.Add(sharesToTransfer)); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return sharesToTransfer, nil
+}
+
 // GetLiquidity retrieves the total liquidity in the StableSwap pool.
 func (c *Controller) GetLiquidity(ctx context.Context) sdk.Coins {
 	poolAddress, err := (*c.addressCodec).StringToBytes(c.GetAddress())
@@ -411,7 +781,7 @@ func (c *Controller) GetRate(ctx context.Context) math.LegacyDec {
 
 	// Perform a swap simulation and get the real rate using the cached context.
 	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
-	res, err := c.Swap(cacheCtx, time.Now().Unix(), sdk.NewCoin(c.baseDenom, math.NewInt(1_000_000)), c.GetPair())
+	res, err := c.Swap(cacheCtx, time.Now().Unix(), sdk.NewCoin(c.baseDenom, math.NewInt(1_000_000)), c.GetPair(), math.LegacyZeroDec())
 	if err != nil {
 		return math.LegacyZeroDec()
 	}
@@ -428,24 +798,55 @@ func (c *Controller) GetRate(ctx context.Context) math.LegacyDec {
 	return math.LegacyNewDecFromInt(rate).QuoInt64(1e6)
 }
 
-// UpdatePool updates parameters of the StableSwap pool in the state.
+// UpdatePool updates parameters of the StableSwap pool in the state, leaving the amplification
+// coefficient ramp unchanged as it can only be updated through RampA.
 func (c *Controller) UpdatePool(
 	ctx context.Context,
 	protocolFeePercentage int64,
 	rewardsFee int64,
-	initialA int64,
-	initialATime int64,
-	futureA int64,
-	futureATime int64,
 	rateMultipliers sdk.Coins,
+	unbondingTiers []stableswaptypes.UnbondingTier,
+	exitFeeTiers []stableswaptypes.ExitFeeTier,
+	lockTiers []stableswaptypes.LockTier,
+	feeSplits []stableswaptypes.FeeSplit,
+	dynamicFee *stableswaptypes.DynamicFee,
+	directionalFee *stableswaptypes.DirectionalFee,
 ) error {
 	c.stableswapPool.ProtocolFeePercentage = protocolFeePercentage
 	c.stableswapPool.RewardsFee = rewardsFee
+	c.stableswapPool.RateMultipliers = rateMultipliers
+	c.stableswapPool.UnbondingTiers = unbondingTiers
+	c.stableswapPool.ExitFeeTiers = exitFeeTiers
+	c.stableswapPool.LockTiers = lockTiers
+	c.stableswapPool.FeeSplits = feeSplits
+	c.stableswapPool.DynamicFee = dynamicFee
+	c.stableswapPool.DirectionalFee = directionalFee
+
+	// Update the `StableSwap` pool on state.
+	if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+		return sdkerrors.Wrapf(err, "unable to set stableswap pool")
+	}
+
+	return nil
+}
+
+// GetAmplificationCoefficient computes the current effective amplification coefficient, interpolated along the ramp.
+func (c *Controller) GetAmplificationCoefficient(currentTime int64) math.LegacyDec {
+	return getAmplificationCoefficient(
+		currentTime,
+		math.LegacyNewDec(c.stableswapPool.InitialA),
+		math.LegacyNewDec(c.stableswapPool.FutureA),
+		c.stableswapPool.InitialATime,
+		c.stableswapPool.FutureATime,
+	).QuoInt64(AmplificationCoefficientPrecision)
+}
+
+// RampA updates the amplification coefficient ramp of the StableSwap pool in the state.
+func (c *Controller) RampA(ctx context.Context, initialA int64, initialATime int64, futureA int64, futureATime int64) error {
 	c.stableswapPool.InitialA = initialA
 	c.stableswapPool.InitialATime = initialATime
 	c.stableswapPool.FutureA = futureA
 	c.stableswapPool.FutureATime = futureATime
-	c.stableswapPool.RateMultipliers = rateMultipliers
 
 	// Update the `StableSwap` pool on state.
 	if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
@@ -465,6 +866,11 @@ func (c *Controller) ProcessUnbondings(ctx context.Context, currentTime time.Tim
 
 	// Iterate over unbonding entries and process those whose unbonding period has ended.
 	for _, entry := range c.stableswapKeeper.GetUnbondingPositionsUntil(ctx, currentTime.Unix()) {
+		// Skip the entries of the other pools.
+		if entry.PoolId != c.GetId() {
+			continue
+		}
+
 		addr, err := (*c.addressCodec).StringToBytes(entry.Address)
 		if err != nil {
 			c.stableswapKeeper.logger.Error("unable to parse unbonding position address  : %s")
@@ -484,41 +890,13 @@ func (c *Controller) ProcessUnbondings(ctx context.Context, currentTime time.Tim
 			}
 
 			// Process all the rewards associated to the given pool.
-			rewards, err := c.ProcessUserRewards(ctx, entry.Address, currentTime)
-			if err != nil {
+			if err = c.settleUserRewards(ctx, entry.Address, currentTime); err != nil {
 				return err
 			}
-			if rewards.Len() > 0 {
-				if err = c.stableswapKeeper.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawnRewards{
-					Signer:  entry.Address,
-					Rewards: rewards,
-				}); err != nil {
-					return err
-				}
-			}
 
-			cumulativeUnbonded := math.LegacyZeroDec()
-			// Iterate through user's positions to unbond the specified amount.
-			for _, bondedEntry := range c.stableswapKeeper.GetBondedPositionsByProvider(ctx, entry.Address) {
-				if cumulativeUnbonded.Add(bondedEntry.BondedPosition.Balance).GT(entry.UnbondingPosition.Shares) {
-					remainingShares := entry.UnbondingPosition.Shares.Sub(cumulativeUnbonded)
-					bondedEntry.BondedPosition.Balance = bondedEntry.BondedPosition.Balance.Sub(remainingShares)
-					cumulativeUnbonded = entry.UnbondingPosition.Shares
-
-					if err = c.stableswapKeeper.SetBondedPosition(ctx, bondedEntry.PoolId, bondedEntry.Address, bondedEntry.Timestamp, bondedEntry.BondedPosition); err != nil {
-						return err
-					}
-				} else {
-					cumulativeUnbonded = cumulativeUnbonded.Add(bondedEntry.BondedPosition.Balance)
-					if err = c.stableswapKeeper.RemoveBondedPosition(ctx, bondedEntry.PoolId, bondedEntry.Address, bondedEntry.Timestamp); err != nil {
-						return err
-					}
-				}
-			}
-
-			// Final check to ensure the unbonded amount matches the target.
-			if cumulativeUnbonded.LT(entry.UnbondingPosition.Shares) {
-				return fmt.Errorf("%s is smaller then requested: %s", cumulativeUnbonded.String(), entry.UnbondingPosition.Shares.String())
+			// Burn the unbonded shares from the user positions.
+			if err = c.unbondShares(ctx, entry.Address, entry.UnbondingPosition.Shares, currentTime); err != nil {
+				return err
 			}
 
 			// Remove entry from the unbonding queue after processing it.
@@ -526,21 +904,6 @@ func (c *Controller) ProcessUnbondings(ctx context.Context, currentTime time.Tim
 				return err
 			}
 
-			// Update the pool total shares.
-			c.stableswapPool.TotalShares = c.stableswapPool.TotalShares.Sub(entry.UnbondingPosition.Shares)
-			if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
-				return err
-			}
-
-			// Remove the unbonded shares from the user total.
-			userTotalBondedShares := math.LegacyZeroDec()
-			if c.stableswapKeeper.HasUserTotalBondedShares(ctx, c.GetId(), entry.Address) {
-				userTotalBondedShares = c.stableswapKeeper.GetUserTotalBondedShares(ctx, c.GetId(), entry.Address)
-			}
-			if err := c.stableswapKeeper.SetUserTotalBondedShares(ctx, c.GetId(), entry.Address, userTotalBondedShares.Sub(entry.UnbondingPosition.Shares)); err != nil {
-				return err
-			}
-
 			// Remove the shares from the pool total unbonding shares.
 			totalPoolUnbondingShares := math.LegacyZeroDec()
 			if c.stableswapKeeper.HasPoolTotalUnbondingShares(ctx, c.GetId()) {
@@ -564,68 +927,76 @@ func (c *Controller) ProcessUnbondings(ctx context.Context, currentTime time.Tim
 }
 
 // GetTotalPoolUserRewards calculates the total rewards for a user across their positions in the pool.
-func (c *Controller) GetTotalPoolUserRewards(ctx context.Context, address string, currentTime time.Time) ([]types.ReceiverMulti, error) {
-	// Get the total Pool rewards.
-	poolRewardsAddress := authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, c.GetId()))
-	poolRewards := (*c.bankKeeper).GetAllBalances(ctx, poolRewardsAddress)
-
+func (c *Controller) GetTotalPoolUserRewards(ctx context.Context, address string) []types.ReceiverMulti {
 	// Iterate over the user pool bonded positions.
 	var userRewards []types.ReceiverMulti
 	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
-		// Calculate the adjusted rewards for the given position.
-		rewards, err := CalculatePositionRewards(currentTime, poolRewards, entry.BondedPosition, c.stableswapPool.TotalShares, c.stableswapPool.InitialRewardsTime)
-		if err != nil {
-			return nil, err
-		}
+		// Calculate the rewards accrued by the given position.
+		rewards := CalculatePositionRewards(entry.BondedPosition, c.stableswapPool.RewardPerShare)
 		if rewards.IsZero() {
 			continue
 		}
 
-		cumulativeRewards := sdk.Coins{}
-		cumulativeRewards = cumulativeRewards.Add(rewards...)
-
 		userRewards = append(userRewards, types.ReceiverMulti{
-			Amount:  cumulativeRewards,
-			Address: poolRewardsAddress,
+			Amount:  rewards,
+			Address: c.GetRewardsFeesAddress(),
 			PoolId:  entry.PoolId,
 		})
 	}
-	return userRewards, nil
+	return userRewards
 }
 
-// ProcessUserRewards distributes rewards to a user and updates their reward periods.
-func (c *Controller) ProcessUserRewards(ctx context.Context, address string, currentTime time.Time) (sdk.Coins, error) {
-	addr, err := (*c.addressCodec).StringToBytes(address)
-	if err != nil {
+// GetTotalPoolUserIncentives calculates the total incentives for a user across their positions in the pool.
+func (c *Controller) GetTotalPoolUserIncentives(ctx context.Context, address string) []types.ReceiverMulti {
+	// Iterate over the user pool bonded positions.
+	var userIncentives []types.ReceiverMulti
+	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
+		// Calculate the incentives accrued by the given position.
+		incentives := CalculatePositionIncentives(entry.BondedPosition, c.stableswapPool.IncentivePerShare)
+		if incentives.IsZero() {
+			continue
+		}
+
+		userIncentives = append(userIncentives, types.ReceiverMulti{
+			Amount:  incentives,
+			Address: c.GetIncentivesAddress(),
+			PoolId:  entry.PoolId,
+		})
+	}
+	return userIncentives
+}
+
+// ProcessUserRewards distributes the rewards and incentives of a user to the receiver address and updates
+// the user reward checkpoints.
+func (c *Controller) ProcessUserRewards(ctx context.Context, address string, receiver string, currentTime time.Time) (sdk.Coins, error) {
+	if _, err := (*c.addressCodec).StringToBytes(address); err != nil {
 		return nil, err
 	}
-	// Get the expected amount of user rewards for the user bonded positions.
-	userRewards, err := c.GetTotalPoolUserRewards(ctx, address, currentTime)
+	receiverAddr, err := (*c.addressCodec).StringToBytes(receiver)
 	if err != nil {
-		return sdk.Coins{}, err
-	}
-
-	// If the user does not have any reward, exit.
-	if len(userRewards) <= 0 {
-		return sdk.Coins{}, nil
+		return nil, err
 	}
+	// Get the expected amount of user rewards and incentives for the user bonded positions.
+	userRewards := c.GetTotalPoolUserRewards(ctx, address)
+	userRewards = append(userRewards, c.GetTotalPoolUserIncentives(ctx, address)...)
 
-	// Update the user RewardsPeriodStart periods with the current time.
+	// Update the user checkpoints with the current pool reward and incentive per share, so that the
+	// positions are always settled before any change of their reward weight.
 	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
 		entry.BondedPosition.RewardsPeriodStart = currentTime
+		entry.BondedPosition.RewardPerShareCheckpoint = c.stableswapPool.RewardPerShare
+		entry.BondedPosition.IncentivePerShareCheckpoint = c.stableswapPool.IncentivePerShare
 		err = c.stableswapKeeper.SetBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp, entry.BondedPosition)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Send the rewards to the user.
+	// Send the rewards to the receiver.
 	finalRewards := sdk.Coins{}
 	for _, poolRewards := range userRewards {
-		for _, coin := range poolRewards.Amount {
-			finalRewards = finalRewards.Add(coin)
-		}
-		err = (*c.bankKeeper).SendCoins(ctx, poolRewards.Address, addr, poolRewards.Amount)
+		finalRewards = finalRewards.Add(poolRewards.Amount...)
+		err = (*c.bankKeeper).SendCoins(ctx, poolRewards.Address, receiverAddr, poolRewards.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -634,6 +1005,261 @@ func (c *Controller) ProcessUserRewards(ctx context.Context, address string, cur
 	return finalRewards, nil
 }
 
+// DepositRewards accounts the rewards sent to the pool rewards fees address to the liquidity providers,
+// by increasing the pool cumulative reward per share. Rewards deposited while the pool has no bonded
+// shares are kept pending, and credited to the providers with the next deposit.
+func (c *Controller) DepositRewards(ctx context.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	// Carry the rewards forward while the pool has no reward weight, so that they are not stranded.
+	totalRewardWeight := c.GetTotalRewardWeight()
+	if !totalRewardWeight.IsPositive() {
+		c.stableswapPool.PendingRewards = c.stableswapPool.PendingRewards.Add(amount...)
+		return c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool)
+	}
+	amount = amount.Add(c.stableswapPool.PendingRewards...)
+	c.stableswapPool.PendingRewards = nil
+
+	rewardPerShare := sdk.NewDecCoinsFromCoins(amount...).QuoDecTruncate(totalRewardWeight)
+	c.stableswapPool.RewardPerShare = c.stableswapPool.RewardPerShare.Add(rewardPerShare...)
+	return c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool)
+}
+
+// DepositIncentives accounts the incentives sent to the pool incentives address to the liquidity providers,
+// by increasing the pool cumulative incentive per share. The caller must ensure that the pool has a positive
+// reward weight, otherwise the incentives are not attributed.
+func (c *Controller) DepositIncentives(ctx context.Context, amount sdk.Coins) error {
+	totalRewardWeight := c.GetTotalRewardWeight()
+	if amount.IsZero() || !totalRewardWeight.IsPositive() {
+		return nil
+	}
+
+	incentivePerShare := sdk.NewDecCoinsFromCoins(amount...).QuoDecTruncate(totalRewardWeight)
+	c.stableswapPool.IncentivePerShare = c.stableswapPool.IncentivePerShare.Add(incentivePerShare...)
+	return c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool)
+}
+
+// settleUserRewards processes the user rewards of the pool, sending them to the user withdraw address and
+// emitting an event if any reward has been sent.
+func (c *Controller) settleUserRewards(ctx context.Context, address string, currentTime time.Time) error {
+	receiver := c.withdrawAddress(ctx, address)
+
+	rewards, err := c.ProcessUserRewards(ctx, address, receiver, currentTime)
+	if err != nil {
+		return err
+	}
+	if rewards.Len() > 0 {
+		return c.stableswapKeeper.eventService.EventManager(ctx).Emit(ctx, &types.WithdrawnRewards{
+			Signer:   address,
+			Rewards:  rewards,
+			Receiver: receiver,
+		})
+	}
+	return nil
+}
+
+// CompoundUserRewards processes the user rewards of the pool and deposits the ones denominated in the
+// pool assets back into the pool, minting new bonded shares for the user. Depending on the accrued
+// rewards, the deposit can be either balanced or one-sided. Rewards in other denoms are left to the user.
+//
+// The compounding is skipped, leaving the rewards pending, while the rewards in the pool assets are below
+// the minimum deposit. The minted shares are added to the most recent unlocked position of the user without
+// boost, a new position is only created when there is none, so that the user positions do not grow unbounded.
+func (c *Controller) CompoundUserRewards(ctx context.Context, address string, currentTime time.Time, minimumDeposit int64) (sdk.Coins, math.LegacyDec, error) {
+	// Skip the compounding while the pending rewards in the pool assets are below the minimum deposit.
+	pending := sdk.Coins{}
+	for _, entry := range append(c.GetTotalPoolUserRewards(ctx, address), c.GetTotalPoolUserIncentives(ctx, address)...) {
+		pending = pending.Add(entry.Amount...)
+	}
+	if pending.AmountOf(c.baseDenom).Add(pending.AmountOf(c.GetPair())).LT(math.NewInt(max(minimumDeposit, 1))) {
+		return sdk.Coins{}, math.LegacyZeroDec(), nil
+	}
+
+	addr, err := (*c.addressCodec).StringToBytes(address)
+	if err != nil {
+		return nil, math.LegacyZeroDec(), err
+	}
+	poolAddress, err := (*c.addressCodec).StringToBytes(c.GetAddress())
+	if err != nil {
+		return nil, math.LegacyZeroDec(), err
+	}
+
+	// Send the pending rewards to the user, as they are deposited from the user account.
+	rewards, err := c.ProcessUserRewards(ctx, address, address, currentTime)
+	if err != nil {
+		return nil, math.LegacyZeroDec(), err
+	}
+
+	// Select the rewards that can be deposited into the pool.
+	var amount sdk.Coins
+	for _, denom := range []string{c.baseDenom, c.GetPair()} {
+		if rewards.AmountOf(denom).IsPositive() {
+			amount = amount.Add(sdk.NewCoin(denom, rewards.AmountOf(denom)))
+		}
+	}
+	if amount.IsZero() {
+		return sdk.Coins{}, math.LegacyZeroDec(), nil
+	}
+
+	// Add the minted shares to the most recent unlocked user position without boost, or create a new one.
+	var shares math.LegacyDec
+	positions := c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address)
+	target := -1
+	for i := len(positions) - 1; i >= 0; i-- {
+		if !positions[i].BondedPosition.IsLocked(currentTime) && !positions[i].BondedPosition.IsBoosted() {
+			target = i
+			break
+		}
+	}
+	if target >= 0 {
+		if shares, err = c.compoundIntoPosition(ctx, currentTime, positions[target], amount); err != nil {
+			return nil, math.LegacyZeroDec(), err
+		}
+	} else {
+		commitment, err := c.AddLiquidity(ctx, currentTime, &stableswaptypes.MsgAddLiquidity{
+			Signer: address,
+			PoolId: c.GetId(),
+			Amount: amount,
+		})
+		if err != nil {
+			return nil, math.LegacyZeroDec(), err
+		}
+		shares = commitment.BondedPosition.Balance
+	}
+
+	// Transfer the rewards to the Pool.
+	if err = (*c.bankKeeper).SendCoins(ctx, addr, poolAddress, amount); err != nil {
+		return nil, math.LegacyZeroDec(), sdkerrors.Wrap(err, "unable to transfer from provider to pool")
+	}
+
+	return amount, shares, nil
+}
+
+// compoundIntoPosition mints the shares for depositing the given amount into the pool, and adds them to the
+// given unlocked user position without boost. The caller must settle the position rewards beforehand.
+func (c *Controller) compoundIntoPosition(ctx context.Context, currentTime time.Time, entry stableswaptypes.BondedPositionEntry, amount sdk.Coins) (math.LegacyDec, error) {
+	shares, err := c.calculateDepositShares(ctx, currentTime, amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	entry.BondedPosition.Balance = entry.BondedPosition.Balance.Add(shares)
+	if err = c.stableswapKeeper.SetBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp, entry.BondedPosition); err != nil {
+		return math.LegacyDec{}, sdkerrors.Wrap(err, "unable to set updated user pool position")
+	}
+
+	userTotalShares := c.stableswapKeeper.GetUserTotalBondedShares(ctx, c.GetId(), entry.Address)
+	if err = c.stableswapKeeper.SetUserTotalBondedShares(ctx, c.GetId(), entry.Address, userTotalShares.Add(shares)); err != nil {
+		return math.LegacyDec{}, sdkerrors.Wrap(err, "unable to set stableswap user pool total bonded shares")
+	}
+
+	c.stableswapPool.TotalShares = c.stableswapPool.TotalShares.Add(shares)
+	if err = c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+		return math.LegacyDec{}, sdkerrors.Wrapf(err, "unable to set stableswap pool total bonded shares")
+	}
+	return shares, nil
+}
+
+// ClosePool settles every liquidity provider of the pool, paying out their pending rewards and returning their
+// pro-rata share of the pool liquidity, then removes all the pool positions. The shares of the unbonding positions
+// are still part of the provider bonded shares, so they are settled from the pool balances as well.
+func (c *Controller) ClosePool(ctx context.Context, currentTime time.Time) ([]types.PositionSettlement, error) {
+	poolAddr, err := (*c.addressCodec).StringToBytes(c.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	liquidity := c.GetLiquidity(ctx)
+	totalShares := c.stableswapPool.TotalShares
+
+	var settlements []types.PositionSettlement
+	for _, entry := range c.stableswapKeeper.GetUsersTotalBondedSharesByPool(ctx, c.GetId()) {
+		if entry.Shares.IsPositive() && totalShares.IsPositive() {
+			addr, err := (*c.addressCodec).StringToBytes(entry.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			// Pay out the pending rewards and incentives of the provider.
+			if err = c.settleUserRewards(ctx, entry.Address, currentTime); err != nil {
+				return nil, err
+			}
+
+			// Return the provider share of each asset in the pool.
+			amount := sdk.NewCoins()
+			for _, asset := range liquidity {
+				amountToReturn := math.LegacyNewDecFromInt(asset.Amount).Mul(entry.Shares).Quo(totalShares).TruncateInt()
+				if amountToReturn.IsPositive() {
+					amount = amount.Add(sdk.NewCoin(asset.Denom, amountToReturn))
+				}
+			}
+			if !amount.IsZero() {
+				if err = (*c.bankKeeper).SendCoins(ctx, poolAddr, addr, amount); err != nil {
+					return nil, err
+				}
+			}
+
+			settlements = append(settlements, types.PositionSettlement{
+				Address: entry.Address,
+				Shares:  entry.Shares,
+				Amount:  amount,
+			})
+		}
+
+		if err = c.stableswapKeeper.RemoveUserTotalBondedShares(ctx, c.GetId(), entry.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	// Remove all the positions of the pool.
+	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPool(ctx, c.GetId()) {
+		if err = c.stableswapKeeper.RemoveBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range c.stableswapKeeper.GetUnbondingPositions(ctx) {
+		if entry.PoolId != c.GetId() {
+			continue
+		}
+		if err = c.stableswapKeeper.RemoveUnbondingPosition(ctx, entry.Timestamp, entry.Address, entry.PoolId); err != nil {
+			return nil, err
+		}
+		if err = c.stableswapKeeper.RemoveUserTotalUnbondingShares(ctx, entry.PoolId, entry.Address); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.stableswapKeeper.RemovePoolTotalUnbondingShares(ctx, c.GetId()); err != nil {
+		return nil, err
+	}
+	for _, entry := range c.stableswapKeeper.GetLockedPositions(ctx) {
+		if entry.PoolId != c.GetId() {
+			continue
+		}
+		if err = c.stableswapKeeper.RemoveLockedPosition(ctx, entry.LockEndTime, entry.Address, entry.PoolId); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range c.stableswapKeeper.GetAutoCompoundEntries(ctx) {
+		if entry.PoolId != c.GetId() {
+			continue
+		}
+		if err = c.stableswapKeeper.SetAutoCompound(ctx, entry.PoolId, entry.Address, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reset the pool shares.
+	c.stableswapPool.TotalShares = math.LegacyZeroDec()
+	c.stableswapPool.TotalBoostedShares = math.LegacyZeroDec()
+	if err = c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
+
 // GetProtocolFeesAddresses retrieves the addresses where protocol fees are collected.
 func (c *Controller) GetProtocolFeesAddresses() []sdk.AccAddress {
 	return []sdk.AccAddress{
@@ -641,70 +1267,167 @@ func (c *Controller) GetProtocolFeesAddresses() []sdk.AccAddress {
 	}
 }
 
-// ComputeWeightedPoolUnbondingPeriod calculates the unbonding period based on the proportion of shares to total pool shares.
-func ComputeWeightedPoolUnbondingPeriod(totalShares math.LegacyDec, sharesToUnbond math.LegacyDec) (time.Duration, error) {
-	const (
-		// Default duration for unbonding requests with negligible impact on the pool.
-		baseDuration = 1 * time.Minute
-
-		// Duration for unbonding requests with small impact on the pool.
-		thresholdShort = 0.1
-		shortDuration  = 30 * time.Minute
+// GetRewardsFeesAddress retrieves the address where the providers rewards are collected.
+func (c *Controller) GetRewardsFeesAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/rewards_fees", types.ModuleName, c.GetId()))
+}
 
-		// Duration for unbonding requests with medium impact on the pool.
-		thresholdMedium = 1.
-		mediumDuration  = 12 * time.Hour
+// GetIncentivesAddress retrieves the address where the streamed incentives are collected.
+func (c *Controller) GetIncentivesAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/pool/%d/incentives", types.ModuleName, c.GetId()))
+}
 
-		// Duration for unbonding requests with high impact on the pool.
-		thresholdLong = 10.
-		longDuration  = 24 * time.Hour
-	)
+// ComputeWeightedPoolUnbondingPeriod calculates the unbonding period based on the proportion of shares to total pool shares,
+// using the duration of the highest tier whose threshold is exceeded. The default tiers are used if none are provided.
+func ComputeWeightedPoolUnbondingPeriod(tiers []stableswaptypes.UnbondingTier, totalShares math.LegacyDec, sharesToUnbond math.LegacyDec) (time.Duration, error) {
+	if len(tiers) == 0 {
+		tiers = stableswaptypes.DefaultUnbondingTiers()
+	}
 
 	// Ensure that the unbonding amount is valid.
 	if !sharesToUnbond.IsPositive() || !totalShares.IsPositive() {
-		return baseDuration, fmt.Errorf("invalid zero values")
+		return tiers[0].Duration, fmt.Errorf("invalid zero values")
 	}
 
 	// Calculate the percentage of total shares that the amount represents.
-	percentage := sharesToUnbond.Quo(totalShares).MulInt64(100).MustFloat64()
+	percentage := sharesToUnbond.Quo(totalShares).MulInt64(100)
 
 	// Determine the unbonding duration based on the percentage thresholds.
-	switch {
-	case percentage > thresholdLong:
-		return longDuration, nil
-	case percentage > thresholdMedium:
-		return mediumDuration, nil
-	case percentage > thresholdShort:
-		return shortDuration, nil
-	default:
-		return baseDuration, nil
+	duration := tiers[0].Duration
+	for _, tier := range tiers[1:] {
+		if !percentage.GT(tier.Threshold) {
+			break
+		}
+		duration = tier.Duration
 	}
+	return duration, nil
 }
 
-// CalculatePositionRewards computes rewards for a user's bonded position based on their share of the pool and duration.
-func CalculatePositionRewards(
-	currentTime time.Time,
-	poolRewards sdk.Coins,
-	position stableswaptypes.BondedPosition,
-	totalShares math.LegacyDec,
-	initialPoolRewardsTime time.Time,
-) (sdk.Coins, error) {
-	// Get the relative duration.
-	duration := currentTime.Sub(position.RewardsPeriodStart)
-	poolDuration := currentTime.Sub(initialPoolRewardsTime)
-
-	// Ensure that the period is valid.
-	if poolDuration.Seconds() == 0 || duration.Seconds() == 0 {
-		return nil, fmt.Errorf("period is too short")
-	}
-
-	// Compute the rewards amount.
-	var positionRewards sdk.Coins
-	for _, coinRewards := range poolRewards {
-		numerator := position.Balance.MulInt64(int64(duration.Seconds()))  // User's shares * position period
-		denominator := totalShares.MulInt64(int64(poolDuration.Seconds())) // Total shares * pool period
-		userReward := numerator.Quo(denominator).Mul(coinRewards.Amount.ToLegacyDec())
-		positionRewards = append(positionRewards, sdk.NewCoin(coinRewards.Denom, userReward.TruncateInt()))
-	}
-	return positionRewards, nil
+// GetExitFeePercentage computes the exit fee percentage charged for instantly removing the given amount of shares
+// of the pool, using the fee of the highest tier whose threshold is exceeded.
+func (c *Controller) GetExitFeePercentage(sharesToUnbond math.LegacyDec) (math.LegacyDec, error) {
+	tiers := c.stableswapPool.ExitFeeTiers
+	if len(tiers) == 0 {
+		return math.LegacyZeroDec(), sdkerrors.Wrapf(types.ErrInstantRemovalDisabled, "pool %d", c.GetId())
+	}
+
+	// Ensure that the unbonding amount is valid.
+	if !sharesToUnbond.IsPositive() || !c.stableswapPool.TotalShares.IsPositive() {
+		return math.LegacyZeroDec(), fmt.Errorf("invalid zero values")
+	}
+
+	// Calculate the percentage of total shares that the amount represents.
+	percentage := sharesToUnbond.Quo(c.stableswapPool.TotalShares).MulInt64(100)
+
+	// Determine the exit fee based on the percentage thresholds.
+	fee := tiers[0].FeePercentage
+	for _, tier := range tiers[1:] {
+		if !percentage.GT(tier.Threshold) {
+			break
+		}
+		fee = tier.FeePercentage
+	}
+	return fee, nil
+}
+
+// GetLockMultiplier returns the reward multiplier of the pool lock tier matching the given duration.
+func (c *Controller) GetLockMultiplier(lockDuration time.Duration) (math.LegacyDec, error) {
+	for _, tier := range c.stableswapPool.LockTiers {
+		if tier.Duration == lockDuration {
+			return tier.Multiplier, nil
+		}
+	}
+	return math.LegacyDec{}, sdkerrors.Wrapf(types.ErrInvalidLock, "pool %d does not support a lock duration of %s", c.GetId(), lockDuration)
+}
+
+// GetTotalRewardWeight returns the total reward weight of the pool, including the boost of the locked positions.
+func (c *Controller) GetTotalRewardWeight() math.LegacyDec {
+	return c.stableswapPool.TotalShares.Add(c.getTotalBoostedShares())
+}
+
+// getTotalBoostedShares returns the additional reward weight of the locked positions of the pool.
+func (c *Controller) getTotalBoostedShares() math.LegacyDec {
+	if c.stableswapPool.TotalBoostedShares.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return c.stableswapPool.TotalBoostedShares
+}
+
+// getUserLockedShares returns the user shares of the pool that are still locked at the given time.
+func (c *Controller) getUserLockedShares(ctx context.Context, address string, currentTime time.Time) math.LegacyDec {
+	lockedShares := math.LegacyZeroDec()
+	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
+		if entry.BondedPosition.IsLocked(currentTime) {
+			lockedShares = lockedShares.Add(entry.BondedPosition.Balance)
+		}
+	}
+	return lockedShares
+}
+
+// ReleaseExpiredLocks settles the user rewards of the pool, and removes the boost of the user positions whose lock expired.
+func (c *Controller) ReleaseExpiredLocks(ctx context.Context, address string, currentTime time.Time) error {
+	if err := c.settleUserRewards(ctx, address, currentTime); err != nil {
+		return err
+	}
+	return c.releaseExpiredLocks(ctx, address, currentTime)
+}
+
+// releaseExpiredLocks removes the boost of the user positions whose lock expired, updating the pool total boost.
+// The rewards are expected to be settled beforehand.
+func (c *Controller) releaseExpiredLocks(ctx context.Context, address string, currentTime time.Time) error {
+	for _, entry := range c.stableswapKeeper.GetBondedPositionsByPoolAndProvider(ctx, c.GetId(), address) {
+		if !entry.BondedPosition.IsBoosted() || entry.BondedPosition.IsLocked(currentTime) {
+			continue
+		}
+
+		// Remove the position boost from the pool.
+		c.stableswapPool.TotalBoostedShares = c.getTotalBoostedShares().Sub(entry.BondedPosition.BoostedShares())
+		if err := c.stableswapKeeper.SetPool(ctx, c.GetId(), *c.stableswapPool); err != nil {
+			return err
+		}
+
+		entry.BondedPosition.Multiplier = nil
+		if err := c.stableswapKeeper.SetBondedPosition(ctx, entry.PoolId, entry.Address, entry.Timestamp, entry.BondedPosition); err != nil {
+			return err
+		}
+		if err := c.stableswapKeeper.RemoveLockedPosition(ctx, entry.BondedPosition.LockEndTime.Unix(), entry.Address, entry.PoolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetUnbondingPeriod computes the unbonding period for the given amount of shares of the pool.
+func (c *Controller) GetUnbondingPeriod(sharesToUnbond math.LegacyDec) (time.Duration, error) {
+	return ComputeWeightedPoolUnbondingPeriod(c.stableswapPool.UnbondingTiers, c.stableswapPool.TotalShares, sharesToUnbond)
+}
+
+// CalculatePositionRewards computes the rewards accrued by a user's bonded position since its last checkpoint,
+// given the current pool cumulative reward per share. The multiplier of boosted positions is applied to their
+// reward weight until the lock is released.
+func CalculatePositionRewards(position stableswaptypes.BondedPosition, rewardPerShare sdk.DecCoins) sdk.Coins {
+	return calculateAccruedAmount(position, rewardPerShare, position.RewardPerShareCheckpoint)
+}
+
+// CalculatePositionIncentives computes the incentives accrued by a user's bonded position since its last checkpoint,
+// given the current pool cumulative incentive per share.
+func CalculatePositionIncentives(position stableswaptypes.BondedPosition, incentivePerShare sdk.DecCoins) sdk.Coins {
+	return calculateAccruedAmount(position, incentivePerShare, position.IncentivePerShareCheckpoint)
+}
+
+// calculateAccruedAmount computes the amount accrued by a position, given a cumulative per share index and its checkpoint.
+func calculateAccruedAmount(position stableswaptypes.BondedPosition, index sdk.DecCoins, checkpoint sdk.DecCoins) sdk.Coins {
+	// Compute the index growth since the last checkpoint.
+	accrued, hasNeg := index.SafeSub(checkpoint)
+	if hasNeg || accrued.IsZero() {
+		return sdk.Coins{}
+	}
+
+	// Compute the rewards amount, based on the position reward weight.
+	weight := position.Balance.Add(position.BoostedShares())
+	rewards, _ := accrued.MulDecTruncate(weight).TruncateDecimal()
+	if rewards.IsZero() {
+		return sdk.Coins{}
+	}
+	return rewards
 }
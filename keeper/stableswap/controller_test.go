@@ -96,13 +96,43 @@ func TestController(t *testing.T) {
 
 func TestComputeWeightedPoolUnbondingPeriod(t *testing.T) {
 	// Test cases.
+	customTiers := []stableswaptypes.UnbondingTier{
+		{Threshold: math.LegacyZeroDec(), Duration: 0},
+		{Threshold: math.LegacyNewDec(5), Duration: 7 * 24 * time.Hour},
+	}
+
 	tests := []struct {
 		name           string
+		tiers          []stableswaptypes.UnbondingTier
 		totalShares    math.LegacyDec
 		sharesToUnbond math.LegacyDec
 		expectedTime   time.Duration
 		expectError    bool
 	}{
+		{
+			name:           "Custom tiers, instant unbonding below 5%",
+			tiers:          customTiers,
+			totalShares:    math.LegacyNewDec(10000),
+			sharesToUnbond: math.LegacyNewDec(500), // 5%
+			expectedTime:   0,
+			expectError:    false,
+		},
+		{
+			name:           "Custom tiers, long duration above 5%",
+			tiers:          customTiers,
+			totalShares:    math.LegacyNewDec(10000),
+			sharesToUnbond: math.LegacyNewDec(501), // 5.01%
+			expectedTime:   7 * 24 * time.Hour,
+			expectError:    false,
+		},
+		{
+			name:           "Custom tiers, error on zero shares to unbond",
+			tiers:          customTiers,
+			totalShares:    math.LegacyNewDec(10000),
+			sharesToUnbond: math.LegacyNewDec(0),
+			expectedTime:   0,
+			expectError:    true,
+		},
 		{
 			name:           "Base duration when percentage is below 0.1%",
 			totalShares:    math.LegacyNewDec(10000),
@@ -172,7 +202,7 @@ func TestComputeWeightedPoolUnbondingPeriod(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// ACT: Compute the unbonding period.
-			result, err := stableswap.ComputeWeightedPoolUnbondingPeriod(tt.totalShares, tt.sharesToUnbond)
+			result, err := stableswap.ComputeWeightedPoolUnbondingPeriod(tt.tiers, tt.totalShares, tt.sharesToUnbond)
 			if tt.expectError {
 				require.Error(t, err)
 			} else {
@@ -185,61 +215,55 @@ func TestComputeWeightedPoolUnbondingPeriod(t *testing.T) {
 
 func TestCalculatePositionRewards(t *testing.T) {
 	// Define common values for all test cases
-	currentTime := time.Now()
-	poolCreation := currentTime.Add(-time.Hour * 24 * 30) // Pool created 30 days ago
-	totalShares := math.LegacyNewDec(1000)                // Total shares in the pool
+	rewardPerShare := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("token", math.LegacyMustNewDecFromStr("1.5")),
+		sdk.NewDecCoinFromDec("other", math.LegacyMustNewDecFromStr("0.25")),
+	) // Cumulative rewards per share of the pool
+	multiplier := math.LegacyNewDec(2) // Reward multiplier of the locked positions
 
 	// Test cases
 	tests := []struct {
 		name           string
 		position       stableswaptypes.BondedPosition
-		poolRewards    sdk.Coins
 		expectedReward sdk.Coins
 	}{
 		{
-			name: "User with 10% share for 15 days",
+			name: "User without checkpoint",
 			position: stableswaptypes.BondedPosition{
-				Balance:            math.LegacyNewDec(100),                // 10% of total shares
-				RewardsPeriodStart: currentTime.Add(-time.Hour * 24 * 15), // BondedPosition held for 15 days
+				Balance: math.LegacyNewDec(100),
 			},
-			poolRewards:    sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))), // 1000 tokens in pool rewards
-			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(50))),   // Expected reward: 50 tokens
+			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(150)), sdk.NewCoin("other", math.NewInt(25))),
 		},
 		{
-			name: "User with 5% share for 10 days",
+			name: "User with a partial checkpoint",
 			position: stableswaptypes.BondedPosition{
-				Balance:            math.LegacyNewDec(50),                 // 5% of total shares
-				RewardsPeriodStart: currentTime.Add(-time.Hour * 24 * 10), // BondedPosition held for 10 days
+				Balance:                  math.LegacyNewDec(100),
+				RewardPerShareCheckpoint: sdk.NewDecCoins(sdk.NewDecCoinFromDec("token", math.LegacyOneDec())),
 			},
-			poolRewards:    sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))), // 1000 tokens in pool rewards
-			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(16))),   // Expected reward: 5 tokens
+			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(50)), sdk.NewCoin("other", math.NewInt(25))),
 		},
 		{
-			name: "User with 20% share for entire pool duration (30 days)",
+			name: "User with an up to date checkpoint",
 			position: stableswaptypes.BondedPosition{
-				Balance:            math.LegacyNewDec(200), // 20% of total shares
-				RewardsPeriodStart: poolCreation,           // BondedPosition held from pool creation
+				Balance:                  math.LegacyNewDec(100),
+				RewardPerShareCheckpoint: rewardPerShare,
 			},
-			poolRewards:    sdk.NewCoins(sdk.NewCoin("token", math.NewInt(1000))), // 1000 tokens in pool rewards
-			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(200))),  // Expected reward: 200 tokens
+			expectedReward: sdk.Coins{},
 		},
 		{
-			name: "User with full share for partial period",
+			name: "User with rewards lower than one unit",
 			position: stableswaptypes.BondedPosition{
-				Balance:            totalShares,                          // 100% of total shares
-				RewardsPeriodStart: currentTime.Add(-time.Hour * 24 * 5), // BondedPosition held for 5 days
+				Balance: math.LegacyMustNewDecFromStr("0.5"),
 			},
-			poolRewards:    sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500))), // 500 tokens in pool rewards
-			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(83))),  // Expected reward: 83 tokens
+			expectedReward: sdk.Coins{},
 		},
 		{
-			name: "User with full share for full period",
+			name: "User locked with a 2x multiplier",
 			position: stableswaptypes.BondedPosition{
-				Balance:            totalShares,  // 100% of total shares
-				RewardsPeriodStart: poolCreation, // BondedPosition held for 5 days
+				Balance:    math.LegacyNewDec(100),
+				Multiplier: &multiplier,
 			},
-			poolRewards:    sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500))), // 500 tokens in pool rewards
-			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(500))), // Expected reward: 500 tokens
+			expectedReward: sdk.NewCoins(sdk.NewCoin("token", math.NewInt(300)), sdk.NewCoin("other", math.NewInt(50))),
 		},
 	}
 
@@ -247,9 +271,155 @@ func TestCalculatePositionRewards(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// ACT: Compute the position rewards.
-			reward, err := stableswap.CalculatePositionRewards(currentTime, tt.poolRewards, tt.position, totalShares, poolCreation)
-			require.NoError(t, err)
+			reward := stableswap.CalculatePositionRewards(tt.position, rewardPerShare)
 			require.Equal(t, tt.expectedReward, reward)
 		})
 	}
 }
+
+func TestComputeDynamicFee(t *testing.T) {
+	// Define common values for all test cases
+	rewardsFee := int64(1_000_000) // Base rewards fee
+	dynamicFee := stableswaptypes.DynamicFee{
+		Multiplier: math.LegacyNewDec(2),
+		MinFee:     500_000,
+		MaxFee:     1_500_000,
+	}
+
+	// Test cases
+	tests := []struct {
+		name        string
+		dynamicFee  stableswaptypes.DynamicFee
+		x0, y0      int64
+		x1, y1      int64
+		expectedFee int64
+	}{
+		{
+			name:        "Swap pushing the pool from balance",
+			dynamicFee:  dynamicFee,
+			x0:          100,
+			y0:          100,
+			x1:          110,
+			y1:          90,
+			expectedFee: 1_363_636, // 1 + 2 * (1 - 90/110)
+		},
+		{
+			name:        "Swap rebalancing the pool",
+			dynamicFee:  dynamicFee,
+			x0:          90,
+			y0:          110,
+			x1:          100,
+			y1:          100,
+			expectedFee: 636_363, // 1 - 2 * (1 - 90/110)
+		},
+		{
+			name:        "Swap pushing the pool above the max fee",
+			dynamicFee:  dynamicFee,
+			x0:          100,
+			y0:          100,
+			x1:          150,
+			y1:          50,
+			expectedFee: 1_500_000,
+		},
+		{
+			name:        "Swap rebalancing the pool below the min fee",
+			dynamicFee:  dynamicFee,
+			x0:          50,
+			y0:          150,
+			x1:          100,
+			y1:          100,
+			expectedFee: 500_000,
+		},
+		{
+			name:        "Zero multiplier",
+			dynamicFee:  stableswaptypes.DynamicFee{Multiplier: math.LegacyZeroDec(), MinFee: 0, MaxFee: 2_000_000},
+			x0:          100,
+			y0:          100,
+			x1:          150,
+			y1:          50,
+			expectedFee: rewardsFee,
+		},
+	}
+
+	// Execute each test case.
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT: Compute the dynamic fee.
+			fee := stableswap.ComputeDynamicFee(
+				rewardsFee,
+				tt.dynamicFee,
+				math.LegacyNewDec(tt.x0), math.LegacyNewDec(tt.y0),
+				math.LegacyNewDec(tt.x1), math.LegacyNewDec(tt.y1),
+			)
+			require.Equal(t, tt.expectedFee, fee)
+		})
+	}
+}
+
+func TestAddLiquidityMintedShares(t *testing.T) {
+	account := mocks.AccountKeeper{
+		Accounts: make(map[string]sdk.AccountI),
+	}
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.SwapKeeperWithKeepers(t, account, bank)
+	stableswapServer := keeper.NewStableSwapMsgServer(k)
+	user := utils.TestAccount()
+
+	// ARRANGE: Create a Pool.
+	_, err := stableswapServer.CreatePool(ctx, &stableswaptypes.MsgCreatePool{
+		Signer:                "authority",
+		Pair:                  "uusdc",
+		RewardsFee:            4e3,
+		ProtocolFeePercentage: 1,
+		InitialA:              100,
+		FutureA:               100,
+		RateMultipliers: sdk.NewCoins(
+			sdk.NewCoin("uusdn", math.NewInt(1000000000000000000)),
+			sdk.NewCoin("uusdc", math.NewInt(1000000000000000000)),
+		),
+	})
+	require.NoError(t, err)
+
+	// deposit adds the given amounts through the controller and funds the Pool as the handler would.
+	deposit := func(currentTime time.Time, amount sdk.Coins) math.LegacyDec {
+		controller, err := keeper.GetStableSwapController(ctx, k, 0)
+		require.NoError(t, err)
+		commitment, err := controller.AddLiquidity(ctx, currentTime, &stableswaptypes.MsgAddLiquidity{
+			Signer: user.Address,
+			PoolId: 0,
+			Amount: amount,
+		})
+		require.NoError(t, err)
+		bank.Balances[controller.GetAddress()] = bank.Balances[controller.GetAddress()].Add(amount...)
+		return commitment.BondedPosition.Balance
+	}
+
+	// ACT: First deposit of 100usdc/100usdn.
+	shares := deposit(time.Unix(1, 0), sdk.NewCoins(
+		sdk.NewCoin("uusdc", math.NewInt(100e6)),
+		sdk.NewCoin("uusdn", math.NewInt(100e6)),
+	))
+	// ASSERT: The first provider mints shares equal to D1.
+	require.Equal(t, math.LegacyNewDec(200e6), shares)
+
+	// ACT: Balanced deposit of 50usdc/50usdn.
+	shares = deposit(time.Unix(2, 0), sdk.NewCoins(
+		sdk.NewCoin("uusdc", math.NewInt(50e6)),
+		sdk.NewCoin("uusdn", math.NewInt(50e6)),
+	))
+	// ASSERT: A balanced deposit mints shares proportionally to the existing ones.
+	require.Equal(t, math.LegacyNewDec(100e6), shares)
+
+	// ACT: Single sided deposit of 30usdc.
+	shares = deposit(time.Unix(3, 0), sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(30e6))))
+	// ASSERT: A single sided deposit mints positive shares, slightly below its value due to the imbalance.
+	require.Equal(t, math.LegacyMustNewDecFromStr("29999999.986250000139218749"), shares)
+
+	// ASSERT: The Pool total shares match the sum of the minted shares.
+	pool, err := k.Stableswap.GetPool(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("329999999.986250000139218749"), pool.TotalShares)
+}
@@ -63,6 +63,20 @@ type Keeper struct {
 	// UnbondingPositions stores the unbonding positions of users in a pool,
 	// keyed by a triple of unbonding_end_time (int64), user address (string), and pool ID (uint64).
 	UnbondingPositions *collections.IndexedMap[collections.Triple[int64, string, uint64], stableswaptypes.UnbondingPosition, UnbondingPositionIndexes]
+
+	// AutoCompound stores the users that opted in to the auto-compounding of their rewards in a pool,
+	// keyed by a pair of pool ID (uint64) and user address (string).
+	AutoCompound collections.KeySet[collections.Pair[uint64, string]]
+
+	// LockedPositions keeps track of the users with locked bonded positions in a pool,
+	// keyed by a triple of lock_end_time (int64), user address (string), and pool ID (uint64).
+	LockedPositions collections.KeySet[collections.Triple[int64, string, uint64]]
+
+	// ScheduledUpdates stores the timelocked pool parameter updates, mapped by their unique uint64 ID.
+	ScheduledUpdates collections.Map[uint64, stableswaptypes.ScheduledPoolUpdate]
+
+	// NextScheduledUpdateID generates and keeps track of the next available unique scheduled update ID.
+	NextScheduledUpdateID collections.Sequence
 }
 
 func NewKeeper(
@@ -97,6 +111,11 @@ func NewKeeper(
 			codec.CollValue[stableswaptypes.UnbondingPosition](cdc),
 			NewUnbondingPositionIndexes(builder),
 		),
+		AutoCompound:    collections.NewKeySet(builder, types.StableSwapAutoCompoundPrefix, "stableswap_auto_compound", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		LockedPositions: collections.NewKeySet(builder, types.StableSwapLockedPositionsPrefix, "stableswap_locked_positions", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key)),
+
+		ScheduledUpdates:      collections.NewMap(builder, types.StableSwapScheduledUpdatesPrefix, "stableswap_scheduled_updates", collections.Uint64Key, codec.CollValue[stableswaptypes.ScheduledPoolUpdate](cdc)),
+		NextScheduledUpdateID: collections.NewSequence(builder, types.StableSwapNextScheduledUpdateIDPrefix, "stableswap_next_scheduled_update_id"),
 	}
 }
 
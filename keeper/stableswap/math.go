@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"swap.noble.xyz/types"
+	"swap.noble.xyz/types/stableswap"
 )
 
 const (
@@ -122,6 +123,17 @@ func calculateAdjustedBalancesInRates(rates sdk.Coins, balances sdk.Coins) (sdk.
 	return balance, nil
 }
 
+// calculateMintedShares computes the shares minted for a deposit that grows the invariant from D0 to D1.
+// The first provider mints shares equal to D1, later ones mint proportionally to the invariant growth.
+func calculateMintedShares(totalShares, D0, D1 math.LegacyDec) math.LegacyDec {
+	if !totalShares.IsPositive() {
+		return D1
+	}
+
+	// shares: totalShares * (D1 - D0) / D0
+	return totalShares.Mul(D1.Sub(D0)).Quo(D0)
+}
+
 // getY calculates the y value for the exchange. It represents the final balance of the output coin
 // in the pool after the swap.
 func getY(x sdk.Coin, amp, D math.LegacyDec) (math.LegacyDec, error) {
@@ -170,7 +182,7 @@ func getY(x sdk.Coin, amp, D math.LegacyDec) (math.LegacyDec, error) {
 
 // performSwap executes the internal token swap and computes resulting balances and fees.
 func performSwap(x sdk.Coin, xp sdk.DecCoins, amp math.LegacyDec, denomTo string,
-	rewardsFee int64, protocolFeePercentage int64, rateMultipliers sdk.Coins,
+	rewardsFee int64, dynamicFee *stableswap.DynamicFee, feeDiscount math.LegacyDec, protocolFeePercentage int64, rateMultipliers sdk.Coins,
 ) (types.SwapResult, error) {
 	// Calculate invariant D.
 	D, err := calculateInvariant(xp, amp)
@@ -192,6 +204,21 @@ func performSwap(x sdk.Coin, xp sdk.DecCoins, amp math.LegacyDec, denomTo string
 		return types.SwapResult{}, errors.New("not enough liquidity to complete the swap")
 	}
 
+	// Scale the rewards fee by the pool imbalance, if the dynamic fee model is enabled.
+	if dynamicFee != nil {
+		rewardsFee = ComputeDynamicFee(
+			rewardsFee,
+			*dynamicFee,
+			xp.AmountOf(x.Denom), xp.AmountOf(denomTo),
+			x.Amount.ToLegacyDec(), y,
+		)
+	}
+
+	// Apply the account fee discount, which reduces both the rewards and the protocol fees.
+	if feeDiscount.IsPositive() {
+		rewardsFee = math.LegacyNewDec(rewardsFee).Mul(math.LegacyOneDec().Sub(feeDiscount)).TruncateInt64()
+	}
+
 	// Calculate the rewards from the swap (fee amount for the user).
 	rewards := dy.MulInt64(rewardsFee).Quo(math.LegacyNewDec(FeeDenominator))
 
@@ -213,12 +240,43 @@ func performSwap(x sdk.Coin, xp sdk.DecCoins, amp math.LegacyDec, denomTo string
 	dy = dy.Mul(math.LegacyNewDec(DecimalPrecision)).Quo(rateMultipliers.AmountOf(denomTo).ToLegacyDec())
 
 	return types.SwapResult{
-		Dy:          dy,
-		ProtocolFee: protocolFeeAmount,
-		RewardsFee:  rewardsFeeAmount,
+		Dy:             dy,
+		ProtocolFee:    protocolFeeAmount,
+		RewardsFee:     rewardsFeeAmount,
+		RewardsFeeRate: rewardsFee,
 	}, nil
 }
 
+// ComputeDynamicFee scales the rewards fee by the imbalance of the rate-adjusted balances, defined
+// as 1 - min/max, considering the most imbalanced state between before (x0, y0) and after (x1, y1)
+// the swap. The fee increases when the swap pushes the pool further from balance, and decreases
+// when it rebalances it, within the configured bounds.
+func ComputeDynamicFee(rewardsFee int64, dynamicFee stableswap.DynamicFee, x0, y0, x1, y1 math.LegacyDec) int64 {
+	imbalance := func(x, y math.LegacyDec) math.LegacyDec {
+		if !x.IsPositive() || !y.IsPositive() {
+			return math.LegacyOneDec()
+		}
+		return math.LegacyOneDec().Sub(math.LegacyMinDec(x, y).Quo(math.LegacyMaxDec(x, y)))
+	}
+	before, after := imbalance(x0, y0), imbalance(x1, y1)
+
+	scale := dynamicFee.Multiplier.Mul(math.LegacyMaxDec(before, after))
+	if after.GT(before) {
+		scale = math.LegacyOneDec().Add(scale)
+	} else {
+		scale = math.LegacyOneDec().Sub(scale)
+	}
+
+	fee := math.LegacyNewDec(rewardsFee).Mul(scale)
+	if fee.LT(math.LegacyNewDec(dynamicFee.MinFee)) {
+		return dynamicFee.MinFee
+	}
+	if fee.GT(math.LegacyNewDec(dynamicFee.MaxFee)) {
+		return dynamicFee.MaxFee
+	}
+	return fee.TruncateInt64()
+}
+
 // computeNewAdjustedBalance calculates the new balance after adding the delta, adjusted by the rate multiplier and precision.
 func computeNewAdjustedBalance(xp math.LegacyDec, dx math.LegacyDec, rateMultiplier math.LegacyDec, PRECISION int64) math.LegacyDec {
 	return xp.Add(dx.Mul(rateMultiplier).QuoInt64(PRECISION))
@@ -83,6 +83,11 @@ func (k *Keeper) SetPoolTotalUnbondingShares(ctx context.Context, poolId uint64,
 	return k.PoolsTotalUnbondingShares.Set(ctx, poolId, value)
 }
 
+// RemovePoolTotalUnbondingShares deletes the total unbonding shares of a specific pool.
+func (k *Keeper) RemovePoolTotalUnbondingShares(ctx context.Context, poolId uint64) error {
+	return k.PoolsTotalUnbondingShares.Remove(ctx, poolId)
+}
+
 //
 
 // GetUsersTotalBondedShares retrieves total bonded shares for all users.
@@ -99,6 +104,20 @@ func (k *Keeper) GetUsersTotalBondedShares(ctx context.Context) []stableswap.Use
 	return entries
 }
 
+// GetUsersTotalBondedSharesByPool retrieves the total bonded shares of all the users in a specific pool.
+func (k *Keeper) GetUsersTotalBondedSharesByPool(ctx context.Context, poolId uint64) []stableswap.UsersTotalBondedSharesEntry {
+	var entries []stableswap.UsersTotalBondedSharesEntry
+	_ = k.UsersTotalBondedShares.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](poolId), func(key collections.Pair[uint64, string], value math.LegacyDec) (stop bool, err error) {
+		entries = append(entries, stableswap.UsersTotalBondedSharesEntry{
+			PoolId:  key.K1(),
+			Address: key.K2(),
+			Shares:  value,
+		})
+		return false, nil
+	})
+	return entries
+}
+
 // HasUserTotalBondedShares checks if bonded shares exist for a user in a specific pool.
 func (k *Keeper) HasUserTotalBondedShares(ctx context.Context, poolId uint64, address string) bool {
 	has, _ := k.UsersTotalBondedShares.Has(ctx, collections.Join(poolId, address))
@@ -116,6 +135,11 @@ func (k *Keeper) SetUserTotalBondedShares(ctx context.Context, poolId uint64, ad
 	return k.UsersTotalBondedShares.Set(ctx, collections.Join(poolId, address), value)
 }
 
+// RemoveUserTotalBondedShares deletes the bonded shares of a user in a specific pool.
+func (k *Keeper) RemoveUserTotalBondedShares(ctx context.Context, poolId uint64, address string) error {
+	return k.UsersTotalBondedShares.Remove(ctx, collections.Join(poolId, address))
+}
+
 //
 
 // GetUsersTotalUnbondingShares retrieves total unbonding shares for all users.
@@ -149,6 +173,11 @@ func (k *Keeper) SetUserTotalUnbondingShares(ctx context.Context, poolId uint64,
 	return k.UsersTotalUnbondingShares.Set(ctx, collections.Join(poolId, address), value)
 }
 
+// RemoveUserTotalUnbondingShares deletes the unbonding shares of a user in a specific pool.
+func (k *Keeper) RemoveUserTotalUnbondingShares(ctx context.Context, poolId uint64, address string) error {
+	return k.UsersTotalUnbondingShares.Remove(ctx, collections.Join(poolId, address))
+}
+
 //
 
 // GetBondedPositions retrieves all bonded positions from the state.
@@ -166,6 +195,21 @@ func (k *Keeper) GetBondedPositions(ctx context.Context) []stableswap.BondedPosi
 	return entries
 }
 
+// GetBondedPositionsByPool retrieves all bonded positions within a specific pool.
+func (k *Keeper) GetBondedPositionsByPool(ctx context.Context, poolId uint64) []stableswap.BondedPositionEntry {
+	var entries []stableswap.BondedPositionEntry
+	_ = k.BondedPositions.Walk(ctx, collections.NewPrefixedTripleRange[uint64, string, int64](poolId), func(key collections.Triple[uint64, string, int64], value stableswap.BondedPosition) (stop bool, err error) {
+		entries = append(entries, stableswap.BondedPositionEntry{
+			PoolId:         key.K1(),
+			Address:        key.K2(),
+			Timestamp:      key.K3(),
+			BondedPosition: value,
+		})
+		return false, nil
+	})
+	return entries
+}
+
 // HasBondedPosition checks if a bonded position exists for a user in a specific pool.
 func (k *Keeper) HasBondedPosition(ctx context.Context, poolId uint64, address string, timestamp int64) bool {
 	has, _ := k.BondedPositions.Has(ctx, collections.Join3(poolId, address, timestamp))
@@ -173,6 +217,11 @@ func (k *Keeper) HasBondedPosition(ctx context.Context, poolId uint64, address s
 }
 
 // GetBondedPositionsByProvider retrieves all bonded positions by a specific provider.
+func (k *Keeper) GetBondedPosition(ctx context.Context, poolId uint64, address string, timestamp int64) stableswap.BondedPosition {
+	position, _ := k.BondedPositions.Get(ctx, collections.Join3(poolId, address, timestamp))
+	return position
+}
+
 func (k *Keeper) GetBondedPositionsByProvider(ctx context.Context, provider string) []stableswap.BondedPositionEntry {
 	var entries []stableswap.BondedPositionEntry
 	itr, err := k.BondedPositions.Indexes.ByProvider.MatchExact(ctx, provider)
@@ -296,3 +345,110 @@ func (k *Keeper) SetUnbondingPosition(ctx context.Context, timestamp int64, addr
 func (k *Keeper) RemoveUnbondingPosition(ctx context.Context, timestamp int64, address string, poolId uint64) error {
 	return k.UnbondingPositions.Remove(ctx, collections.Join3(timestamp, address, poolId))
 }
+
+//
+
+// GetAutoCompoundEntries retrieves all the users that opted in to the auto-compounding of their rewards.
+func (k *Keeper) GetAutoCompoundEntries(ctx context.Context) []stableswap.AutoCompoundEntry {
+	var entries []stableswap.AutoCompoundEntry
+	_ = k.AutoCompound.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (stop bool, err error) {
+		entries = append(entries, stableswap.AutoCompoundEntry{
+			PoolId:  key.K1(),
+			Address: key.K2(),
+		})
+		return false, nil
+	})
+	return entries
+}
+
+// IsAutoCompoundEnabled checks if a user opted in to the auto-compounding of their rewards in a specific pool.
+func (k *Keeper) IsAutoCompoundEnabled(ctx context.Context, poolId uint64, address string) bool {
+	has, _ := k.AutoCompound.Has(ctx, collections.Join(poolId, address))
+	return has
+}
+
+// SetAutoCompound enables or disables the auto-compounding of the user rewards in a specific pool.
+func (k *Keeper) SetAutoCompound(ctx context.Context, poolId uint64, address string, enabled bool) error {
+	if enabled {
+		return k.AutoCompound.Set(ctx, collections.Join(poolId, address))
+	}
+	return k.AutoCompound.Remove(ctx, collections.Join(poolId, address))
+}
+
+//
+
+// GetLockedPositions retrieves all the users with locked bonded positions.
+func (k *Keeper) GetLockedPositions(ctx context.Context) []stableswap.LockedPositionEntry {
+	return k.getLockedPositions(ctx, nil)
+}
+
+// GetLockedPositionsUntil retrieves the users with locked bonded positions expiring up to a given timestamp.
+func (k *Keeper) GetLockedPositionsUntil(ctx context.Context, to int64) []stableswap.LockedPositionEntry {
+	return k.getLockedPositions(ctx, collections.NewPrefixUntilTripleRange[int64, string, uint64](to))
+}
+
+func (k *Keeper) getLockedPositions(ctx context.Context, ranger collections.Ranger[collections.Triple[int64, string, uint64]]) []stableswap.LockedPositionEntry {
+	var entries []stableswap.LockedPositionEntry
+	_ = k.LockedPositions.Walk(ctx, ranger, func(key collections.Triple[int64, string, uint64]) (stop bool, err error) {
+		entries = append(entries, stableswap.LockedPositionEntry{
+			LockEndTime: key.K1(),
+			Address:     key.K2(),
+			PoolId:      key.K3(),
+		})
+		return false, nil
+	})
+	return entries
+}
+
+// SetLockedPosition tracks a user locked bonded position in a specific pool.
+func (k *Keeper) SetLockedPosition(ctx context.Context, lockEndTime int64, address string, poolId uint64) error {
+	return k.LockedPositions.Set(ctx, collections.Join3(lockEndTime, address, poolId))
+}
+
+// RemoveLockedPosition removes a tracked user locked bonded position in a specific pool.
+func (k *Keeper) RemoveLockedPosition(ctx context.Context, lockEndTime int64, address string, poolId uint64) error {
+	return k.LockedPositions.Remove(ctx, collections.Join3(lockEndTime, address, poolId))
+}
+
+//
+
+// GetScheduledUpdates retrieves all the scheduled pool updates, ordered by their ID.
+func (k *Keeper) GetScheduledUpdates(ctx context.Context) []stableswap.ScheduledPoolUpdate {
+	var updates []stableswap.ScheduledPoolUpdate
+	_ = k.ScheduledUpdates.Walk(ctx, nil, func(_ uint64, value stableswap.ScheduledPoolUpdate) (stop bool, err error) {
+		updates = append(updates, value)
+		return false, nil
+	})
+	return updates
+}
+
+// GetScheduledUpdate retrieves a specific scheduled pool update by its ID.
+func (k *Keeper) GetScheduledUpdate(ctx context.Context, id uint64) (stableswap.ScheduledPoolUpdate, error) {
+	return k.ScheduledUpdates.Get(ctx, id)
+}
+
+// SetScheduledUpdate stores a scheduled pool update in the state.
+func (k *Keeper) SetScheduledUpdate(ctx context.Context, update stableswap.ScheduledPoolUpdate) error {
+	return k.ScheduledUpdates.Set(ctx, update.Id, update)
+}
+
+// RemoveScheduledUpdate removes a scheduled pool update from the state.
+func (k *Keeper) RemoveScheduledUpdate(ctx context.Context, id uint64) error {
+	return k.ScheduledUpdates.Remove(ctx, id)
+}
+
+// GetNextScheduledUpdateID retrieves the next scheduled update ID from the state.
+func (k *Keeper) GetNextScheduledUpdateID(ctx context.Context) uint64 {
+	nextID, _ := k.NextScheduledUpdateID.Peek(ctx)
+	return nextID
+}
+
+// IncreaseNextScheduledUpdateID increments the next scheduled update ID in the state.
+func (k *Keeper) IncreaseNextScheduledUpdateID(ctx context.Context) (uint64, error) {
+	return k.NextScheduledUpdateID.Next(ctx)
+}
+
+// SetNextScheduledUpdateID sets the next scheduled update ID to a specified value.
+func (k *Keeper) SetNextScheduledUpdateID(ctx context.Context, id uint64) error {
+	return k.NextScheduledUpdateID.Set(ctx, id)
+}
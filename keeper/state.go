@@ -22,6 +22,11 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/gogo/protobuf/sortkeys"
 
 	"swap.noble.xyz/types"
 )
@@ -51,6 +56,154 @@ func (k *Keeper) SetPaused(ctx context.Context, poolId uint64, value bool) error
 
 //
 
+// GetWithdrawOnly retrieves the IDs of all the pools in withdraw-only mode.
+func (k *Keeper) GetWithdrawOnly(ctx context.Context) []uint64 {
+	var poolIds []uint64
+	_ = k.WithdrawOnlyPools.Walk(ctx, nil, func(key uint64) (stop bool, err error) {
+		poolIds = append(poolIds, key)
+		return false, nil
+	})
+	return poolIds
+}
+
+// IsWithdrawOnly checks if a specific pool is in withdraw-only mode.
+func (k *Keeper) IsWithdrawOnly(ctx context.Context, poolId uint64) bool {
+	has, _ := k.WithdrawOnlyPools.Has(ctx, poolId)
+	return has
+}
+
+// SetPoolWithdrawOnly enables or disables the withdraw-only mode of a specific pool.
+func (k *Keeper) SetPoolWithdrawOnly(ctx context.Context, poolId uint64, enabled bool) error {
+	if enabled {
+		return k.WithdrawOnlyPools.Set(ctx, poolId)
+	}
+	return k.WithdrawOnlyPools.Remove(ctx, poolId)
+}
+
+//
+
+// GetPausedActivities retrieves the paused activities of all the pools, ordered by pool ID.
+func (k *Keeper) GetPausedActivities(ctx context.Context) []types.PausedActivities {
+	pausedActivities := []types.PausedActivities{}
+	_ = k.PauseFlags.Walk(ctx, nil, func(key uint64, value uint32) (stop bool, err error) {
+		pausedActivities = append(pausedActivities, types.PausedActivities{
+			PoolId:     key,
+			Activities: types.FlagsToActivities(value),
+		})
+		return false, nil
+	})
+	return pausedActivities
+}
+
+// GetPoolPauseFlags retrieves the pause flags of a specific pool, defaulting to no paused activities.
+func (k *Keeper) GetPoolPauseFlags(ctx context.Context, poolId uint64) uint32 {
+	flags, _ := k.PauseFlags.Get(ctx, poolId)
+	return flags
+}
+
+// SetPoolPauseFlags updates the pause flags of a specific pool, removing them when no activity is paused.
+func (k *Keeper) SetPoolPauseFlags(ctx context.Context, poolId uint64, flags uint32) error {
+	if flags == 0 {
+		return k.PauseFlags.Remove(ctx, poolId)
+	}
+	return k.PauseFlags.Set(ctx, poolId, flags)
+}
+
+//
+
+// GetAllowedDenoms retrieves the registry of the denoms allowed as pool pairs, ordered by denom.
+func (k *Keeper) GetAllowedDenoms(ctx context.Context) []types.AllowedDenom {
+	denoms := []types.AllowedDenom{}
+	_ = k.DenomRegistry.Walk(ctx, nil, func(_ string, value types.AllowedDenom) (stop bool, err error) {
+		denoms = append(denoms, value)
+		return false, nil
+	})
+	return denoms
+}
+
+// IsDenomAllowed checks if a denom can be used as a pool pair, which is always the case while the registry is empty.
+func (k *Keeper) IsDenomAllowed(ctx context.Context, denom string) bool {
+	if has, _ := k.DenomRegistry.Has(ctx, denom); has {
+		return true
+	}
+	empty := true
+	_ = k.DenomRegistry.Walk(ctx, nil, func(_ string, _ types.AllowedDenom) (stop bool, err error) {
+		empty = false
+		return true, nil
+	})
+	return empty
+}
+
+// SetAllowedDenom adds a denom to the registry, replacing its metadata if already allowed.
+func (k *Keeper) SetAllowedDenom(ctx context.Context, denom types.AllowedDenom) error {
+	return k.DenomRegistry.Set(ctx, denom.Denom, denom)
+}
+
+// RemoveAllowedDenom removes a denom from the registry.
+func (k *Keeper) RemoveAllowedDenom(ctx context.Context, denom string) error {
+	return k.DenomRegistry.Remove(ctx, denom)
+}
+
+//
+
+// GetBlockedDenoms retrieves the denoms blocked from the swaps, ordered by denom.
+func (k *Keeper) GetBlockedDenoms(ctx context.Context) []string {
+	denoms := []string{}
+	_ = k.DenomBlocklist.Walk(ctx, nil, func(key string) (stop bool, err error) {
+		denoms = append(denoms, key)
+		return false, nil
+	})
+	return denoms
+}
+
+// IsDenomBlocked checks if a denom is blocked from the swaps.
+func (k *Keeper) IsDenomBlocked(ctx context.Context, denom string) bool {
+	has, _ := k.DenomBlocklist.Has(ctx, denom)
+	return has
+}
+
+// SetBlockedDenom blocks a denom from the swaps.
+func (k *Keeper) SetBlockedDenom(ctx context.Context, denom string) error {
+	return k.DenomBlocklist.Set(ctx, denom)
+}
+
+// RemoveBlockedDenom unblocks a denom from the swaps.
+func (k *Keeper) RemoveBlockedDenom(ctx context.Context, denom string) error {
+	return k.DenomBlocklist.Remove(ctx, denom)
+}
+
+//
+
+// GetAuthority retrieves the module authority, defaulting to the value of the module config when unset.
+func (k *Keeper) GetAuthority(ctx context.Context) string {
+	authority, err := k.CurrentAuthority.Get(ctx)
+	if err != nil {
+		return k.authority
+	}
+	return authority
+}
+
+// SetAuthority sets the module authority in the state.
+func (k *Keeper) SetAuthority(ctx context.Context, authority string) error {
+	return k.CurrentAuthority.Set(ctx, authority)
+}
+
+// GetPendingAuthority retrieves the authority pending the handover acceptance, if any.
+func (k *Keeper) GetPendingAuthority(ctx context.Context) string {
+	pendingAuthority, _ := k.PendingAuthority.Get(ctx)
+	return pendingAuthority
+}
+
+// SetPendingAuthority sets the authority pending the handover acceptance, removing it when empty.
+func (k *Keeper) SetPendingAuthority(ctx context.Context, pendingAuthority string) error {
+	if pendingAuthority == "" {
+		return k.PendingAuthority.Remove(ctx)
+	}
+	return k.PendingAuthority.Set(ctx, pendingAuthority)
+}
+
+//
+
 // GetNextPoolID retrieves the next pool ID from the state.
 func (k *Keeper) GetNextPoolID(ctx context.Context) uint64 {
 	nextPoolID, _ := k.NextPoolID.Peek(ctx)
@@ -94,3 +247,239 @@ func (k *Keeper) GetPool(ctx context.Context, poolId uint64) (types.Pool, error)
 func (k *Keeper) SetPool(ctx context.Context, poolId uint64, pool types.Pool) error {
 	return k.Pools.Set(ctx, poolId, pool)
 }
+
+//
+
+// GetIncentives retrieves all the active incentives from the state.
+func (k *Keeper) GetIncentives(ctx context.Context) map[uint64]types.Incentive {
+	incentives := map[uint64]types.Incentive{}
+	_ = k.Incentives.Walk(ctx, nil, func(key uint64, value types.Incentive) (stop bool, err error) {
+		incentives[key] = value
+		return false, nil
+	})
+	return incentives
+}
+
+// GetStartedIncentiveIDs retrieves the IDs of the active incentives started at the given time, ordered by ID.
+func (k *Keeper) GetStartedIncentiveIDs(ctx context.Context, currentTime time.Time) []uint64 {
+	var incentiveIds []uint64
+	rng := collections.NewPrefixUntilPairRange[int64, uint64](currentTime.Unix())
+	_ = k.IncentiveStarts.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (stop bool, err error) {
+		incentiveIds = append(incentiveIds, key.K2())
+		return false, nil
+	})
+	sortkeys.Uint64s(incentiveIds)
+	return incentiveIds
+}
+
+// GetPoolIncentivesCount retrieves the number of active incentives of a specific pool.
+func (k *Keeper) GetPoolIncentivesCount(ctx context.Context, poolId uint64) uint64 {
+	var count uint64
+	_ = k.PoolIncentives.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](poolId), func(_ collections.Pair[uint64, uint64]) (stop bool, err error) {
+		count++
+		return false, nil
+	})
+	return count
+}
+
+// SetIncentive updates or creates an incentive in the state with a given ID, along with its indexes.
+func (k *Keeper) SetIncentive(ctx context.Context, incentiveId uint64, incentive types.Incentive) error {
+	if err := k.IncentiveStarts.Set(ctx, collections.Join(incentive.StartTime.Unix(), incentiveId)); err != nil {
+		return err
+	}
+	if err := k.PoolIncentives.Set(ctx, collections.Join(incentive.PoolId, incentiveId)); err != nil {
+		return err
+	}
+	return k.Incentives.Set(ctx, incentiveId, incentive)
+}
+
+// RemoveIncentive removes a specific incentive from the state, along with its indexes.
+func (k *Keeper) RemoveIncentive(ctx context.Context, incentiveId uint64) error {
+	incentive, err := k.Incentives.Get(ctx, incentiveId)
+	if err != nil {
+		return err
+	}
+	if err = k.IncentiveStarts.Remove(ctx, collections.Join(incentive.StartTime.Unix(), incentiveId)); err != nil {
+		return err
+	}
+	if err = k.PoolIncentives.Remove(ctx, collections.Join(incentive.PoolId, incentiveId)); err != nil {
+		return err
+	}
+	return k.Incentives.Remove(ctx, incentiveId)
+}
+
+// GetNextIncentiveID retrieves the next incentive ID from the state.
+func (k *Keeper) GetNextIncentiveID(ctx context.Context) uint64 {
+	nextIncentiveID, _ := k.NextIncentiveID.Peek(ctx)
+	return nextIncentiveID
+}
+
+// IncreaseNextIncentiveID increments the next incentive ID in the state.
+func (k *Keeper) IncreaseNextIncentiveID(ctx context.Context) (uint64, error) {
+	return k.NextIncentiveID.Next(ctx)
+}
+
+// SetNextIncentiveID sets the next incentive ID to a specified value.
+func (k *Keeper) SetNextIncentiveID(ctx context.Context, incentiveId uint64) error {
+	return k.NextIncentiveID.Set(ctx, incentiveId)
+}
+
+//
+
+// GetWithdrawAddresses retrieves all the withdraw addresses from the state.
+func (k *Keeper) GetWithdrawAddresses(ctx context.Context) map[string]string {
+	withdrawAddresses := map[string]string{}
+	_ = k.WithdrawAddresses.Walk(ctx, nil, func(key string, value string) (stop bool, err error) {
+		withdrawAddresses[key] = value
+		return false, nil
+	})
+	return withdrawAddresses
+}
+
+// GetWithdrawAddress retrieves the address receiving the rewards of a provider, defaulting to the provider itself.
+func (k *Keeper) GetWithdrawAddress(ctx context.Context, address string) string {
+	withdrawAddress, err := k.WithdrawAddresses.Get(ctx, address)
+	if err != nil {
+		return address
+	}
+	return withdrawAddress
+}
+
+// SetWithdrawAddress updates or creates the address receiving the rewards of a provider.
+func (k *Keeper) SetWithdrawAddress(ctx context.Context, address string, withdrawAddress string) error {
+	return k.WithdrawAddresses.Set(ctx, address, withdrawAddress)
+}
+
+// RemoveWithdrawAddress removes the withdraw address of a provider from the state.
+func (k *Keeper) RemoveWithdrawAddress(ctx context.Context, address string) error {
+	return k.WithdrawAddresses.Remove(ctx, address)
+}
+
+//
+
+// GetFeeTiers retrieves the swap fee discount tiers from the state.
+func (k *Keeper) GetFeeTiers(ctx context.Context) []types.FeeTier {
+	feeTiers, _ := k.FeeTiers.Get(ctx)
+	return feeTiers.Tiers
+}
+
+// SetFeeTiers sets the swap fee discount tiers in the state.
+func (k *Keeper) SetFeeTiers(ctx context.Context, tiers []types.FeeTier) error {
+	return k.FeeTiers.Set(ctx, types.FeeTiers{Tiers: tiers})
+}
+
+//
+
+// GetFeeExempt retrieves the addresses of all the fee exempt accounts from the state.
+func (k *Keeper) GetFeeExempt(ctx context.Context) []string {
+	var exempt []string
+	_ = k.FeeExempt.Walk(ctx, nil, func(key string) (stop bool, err error) {
+		exempt = append(exempt, key)
+		return false, nil
+	})
+	return exempt
+}
+
+// IsFeeExempt checks if a specific account is exempt from the swap fees.
+func (k *Keeper) IsFeeExempt(ctx context.Context, address string) bool {
+	has, _ := k.FeeExempt.Has(ctx, address)
+	return has
+}
+
+// SetFeeExempt exempts a specific account from the swap fees.
+func (k *Keeper) SetFeeExempt(ctx context.Context, address string) error {
+	return k.FeeExempt.Set(ctx, address)
+}
+
+// RemoveFeeExempt removes the fee exemption of a specific account.
+func (k *Keeper) RemoveFeeExempt(ctx context.Context, address string) error {
+	return k.FeeExempt.Remove(ctx, address)
+}
+
+//
+
+// GetSwapVolumes retrieves all the daily swap volumes from the state.
+func (k *Keeper) GetSwapVolumes(ctx context.Context) []types.SwapVolume {
+	var volumes []types.SwapVolume
+	_ = k.SwapVolumes.Walk(ctx, nil, func(key collections.Pair[string, int64], value math.Int) (stop bool, err error) {
+		volumes = append(volumes, types.SwapVolume{Address: key.K1(), Day: key.K2(), Amount: value})
+		return false, nil
+	})
+	return volumes
+}
+
+// GetSwapVolumesByAccount retrieves the daily swap volumes of a specific account, starting from the given day.
+func (k *Keeper) GetSwapVolumesByAccount(ctx context.Context, address string, fromDay int64) map[int64]math.Int {
+	volumes := map[int64]math.Int{}
+	rng := collections.NewPrefixedPairRange[string, int64](address).StartInclusive(fromDay)
+	_ = k.SwapVolumes.Walk(ctx, rng, func(key collections.Pair[string, int64], value math.Int) (stop bool, err error) {
+		volumes[key.K2()] = value
+		return false, nil
+	})
+	return volumes
+}
+
+// SetSwapVolume updates or creates the swap volume of a specific account in a given day.
+func (k *Keeper) SetSwapVolume(ctx context.Context, address string, day int64, amount math.Int) error {
+	return k.SwapVolumes.Set(ctx, collections.Join(address, day), amount)
+}
+
+// RemoveSwapVolume removes the swap volume of a specific account in a given day.
+func (k *Keeper) RemoveSwapVolume(ctx context.Context, address string, day int64) error {
+	return k.SwapVolumes.Remove(ctx, collections.Join(address, day))
+}
+
+//
+
+// GetParams retrieves the module params from the state, defaulting to the values of the module config.
+func (k *Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return k.defaultParams
+	}
+	return params
+}
+
+// SetParams sets the module params in the state.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
+}
+
+//
+
+// GetRoleGrants retrieves all the role grants from the state.
+func (k *Keeper) GetRoleGrants(ctx context.Context) []types.RoleGrant {
+	var grants []types.RoleGrant
+	_ = k.Roles.Walk(ctx, nil, func(key collections.Pair[string, int32]) (stop bool, err error) {
+		grants = append(grants, types.RoleGrant{Address: key.K1(), Role: types.Role(key.K2())})
+		return false, nil
+	})
+	return grants
+}
+
+// GetRolesByAddress retrieves the roles granted to a specific address from the state.
+func (k *Keeper) GetRolesByAddress(ctx context.Context, address string) []types.Role {
+	var roles []types.Role
+	rng := collections.NewPrefixedPairRange[string, int32](address)
+	_ = k.Roles.Walk(ctx, rng, func(key collections.Pair[string, int32]) (stop bool, err error) {
+		roles = append(roles, types.Role(key.K2()))
+		return false, nil
+	})
+	return roles
+}
+
+// HasRole checks if a role has been granted to a specific address.
+func (k *Keeper) HasRole(ctx context.Context, address string, role types.Role) bool {
+	has, _ := k.Roles.Has(ctx, collections.Join(address, int32(role)))
+	return has
+}
+
+// SetRole grants a role to a specific address.
+func (k *Keeper) SetRole(ctx context.Context, address string, role types.Role) error {
+	return k.Roles.Set(ctx, collections.Join(address, int32(role)))
+}
+
+// RemoveRole revokes a role from a specific address.
+func (k *Keeper) RemoveRole(ctx context.Context, address string, role types.Role) error {
+	return k.Roles.Remove(ctx, collections.Join(address, int32(role)))
+}
@@ -50,7 +50,10 @@ import (
 )
 
 // ConsensusVersion defines the current Noble Swap module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
+
+// DefaultCompoundingBlockDelta is the number of blocks between auto-compounding executions used when unset in the config.
+const DefaultCompoundingBlockDelta = 100
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -141,6 +144,11 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 
 	stableswap.RegisterMsgServer(cfg.MsgServer(), keeper.NewStableSwapMsgServer(m.keeper))
 	stableswap.RegisterQueryServer(cfg.QueryServer(), keeper.NewStableSwapQueryServer(m.keeper))
+
+	migrator := keeper.NewMigrator(m.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register x/%s migration from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 func (m AppModule) BeginBlock(ctx context.Context) error {
@@ -158,7 +166,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Swap",
 					Use:       "swap [amount] [routes] [min]",
 					Short:     "Execute a amount swap across specified routes",
-					Long:      "Swaps a specified `amount` along the provided `routes`, with a `min` value that sets the minimum acceptable output to protect against slippage.",
+					Long:      "Swaps a specified `amount` along the provided `routes`, with a `min` value that sets the minimum acceptable output to protect against slippage. An optional `--affiliate` and `--affiliate-fee-bps` pay a cut of the output to an affiliate.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "amount"},
 						{ProtoField: "routes"},
@@ -169,14 +177,21 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "WithdrawRewards",
 					Use:            "withdraw-rewards",
 					Short:          "Collect rewards generated from liquidity provided to pools",
-					Long:           "Collects the rewards accrued from swap fees in the pools where liquidity has been provided.",
+					Long:           "Collects the rewards accrued from swap fees in the pools where liquidity has been provided. The withdrawal can be restricted to specific pools, and the rewards are sent to the receiver when provided, or to the signer withdraw address otherwise.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod:      "SetWithdrawAddress",
+					Use:            "set-withdraw-address [withdraw_address]",
+					Short:          "Set the address receiving the liquidity rewards",
+					Long:           "Sets the address receiving the rewards withdrawn or settled on behalf of the signer. Setting the signer address resets the withdraw address.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "withdraw_address"}},
+				},
 				{
 					RpcMethod: "WithdrawProtocolFees",
 					Use:       "withdraw-protocol-fees [to]",
 					Short:     "Collects protocol fees and transfers them to the specified address",
-					Long:      "This command collects accumulated protocol fees and transfers them to the specified address. Only the authority can execute this command.",
+					Long:      "This command collects accumulated protocol fees and transfers them to the specified address. The withdrawal can be restricted to specific pools, denoms or amounts, and paused pools can be included with the related flag. Only the authority can execute this command.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "to"},
 					},
@@ -217,6 +232,123 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "pool_ids"},
 					},
 				},
+				{
+					RpcMethod: "CreateIncentive",
+					Use:       "create-incentive [pool_id] [amount] [start_time] [end_time]",
+					Short:     "Fund an incentive streamed to the liquidity providers of a pool",
+					Long:      "Escrows the provided `amount`, streaming it linearly between `start_time` and `end_time` to the bonded liquidity providers of the pool identified by `pool_id`.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+						{ProtoField: "amount"},
+						{ProtoField: "start_time"},
+						{ProtoField: "end_time"},
+					},
+				},
+				{
+					RpcMethod: "SetFeeTiers",
+					Use:       "set-fee-tiers",
+					Short:     "Set the swap fee discount tiers",
+					Long:      "Sets the discount `tiers` applied to the swap fees based on the accounts rolling 30-day volume, replacing the existing ones. Only the authority can execute this command.",
+				},
+				{
+					RpcMethod: "SetFeeExemptions",
+					Use:       "set-fee-exemptions",
+					Short:     "Add and remove the accounts exempt from the swap fees",
+					Long:      "Exempts the `add` addresses from the swap fees, and removes the exemption of the `remove` addresses. Only the authority can execute this command.",
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Use:       "update-params",
+					Short:     "Update the module params",
+					Long:      "Replaces the module `params` with the provided ones. Only the authority can execute this command.",
+				},
+				{
+					RpcMethod: "GrantRole",
+					Use:       "grant-role [address] [role]",
+					Short:     "Grant a role to an address",
+					Long:      "Grants the `role` to the `address`: `ROLE_PAUSER` can pause and unpause the pools, `ROLE_POOL_MANAGER` can create and update the pools, and `ROLE_FEE_COLLECTOR` can withdraw the protocol fees. Only the authority can execute this command.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "RevokeRole",
+					Use:       "revoke-role [address] [role]",
+					Short:     "Revoke a role from an address",
+					Long:      "Revokes the `role` previously granted to the `address`. Only the authority can execute this command.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "role"},
+					},
+				},
+				{
+					RpcMethod: "ClosePool",
+					Use:       "close-pool [pool_id] [to]",
+					Short:     "Permanently close a pool",
+					Long:      "Closes the pool identified by `pool_id`, settling its liquidity providers pro-rata and sweeping its protocol fees and residual balances to the `to` address. Only the authority can execute this command.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_id"},
+						{ProtoField: "to"},
+					},
+				},
+				{
+					RpcMethod: "SetWithdrawOnly",
+					Use:       "set-withdraw-only [enabled] [pool_ids]",
+					Short:     "Enable or disable the withdraw-only mode of pools",
+					Long:      "Enables or disables the withdraw-only mode of the pools identified by the provided pool IDs. Enabling it pauses the pools, while still allowing their liquidity providers to remove liquidity and claim rewards. Only the authority can execute this command.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+						{ProtoField: "pool_ids"},
+					},
+				},
+				{
+					RpcMethod: "PauseActivities",
+					Use:       "pause-activities [pool_ids] [activities]",
+					Short:     "Pause specific activities of the pools identified by the provided pool IDs",
+					Long:      "Pauses the `activities` (swaps, add liquidity, remove liquidity, reward claims, unbonding) of the pools identified by the provided pool IDs, independently of the other ones.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_ids"},
+						{ProtoField: "activities"},
+					},
+				},
+				{
+					RpcMethod: "SetAllowedDenoms",
+					Use:       "set-allowed-denoms",
+					Short:     "Add and remove the denoms allowed as pool pairs",
+					Long:      "Adds the `add` denoms, along with their optional issuer, decimals and risk tier, to the registry of the denoms allowed as pool pairs, and removes the `remove` denoms from it. While the registry is empty, any denom can be used as a pool pair. Only the authority can execute this command.",
+				},
+				{
+					RpcMethod: "SetBlockedDenoms",
+					Use:       "set-blocked-denoms",
+					Short:     "Add and remove the denoms blocked from the swaps",
+					Long:      "Blocks the swaps into or out of the `add` denoms across all the pools, and unblocks the `remove` denoms.",
+				},
+				{
+					RpcMethod: "ProposeAuthority",
+					Use:       "propose-authority [new_authority]",
+					Short:     "Propose a new authority",
+					Long:      "Proposes a new authority, which takes over once it accepts the handover. An empty address cancels the pending proposal. Only the authority can execute this command.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "new_authority"},
+					},
+				},
+				{
+					RpcMethod: "AcceptAuthority",
+					Use:       "accept-authority",
+					Short:     "Accept the pending authority handover",
+					Long:      "Replaces the current authority with the signer. Only the pending authority can execute this command.",
+				},
+				{
+					RpcMethod: "UnpauseActivities",
+					Use:       "unpause-activities [pool_ids] [activities]",
+					Short:     "Unpause specific activities of the pools identified by the provided pool IDs",
+					Long:      "Unpauses the `activities` previously paused in the pools identified by the provided pool IDs. Fully paused pools are left paused.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "pool_ids"},
+						{ProtoField: "activities"},
+					},
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"stableswap": {
@@ -239,16 +371,13 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 						{
 							RpcMethod: "UpdatePool",
-							Use:       "update-pool [pool_id] [protocol_fee_percentage] [rewards_fee] [initial_a] [future_a] [future_a_time] [rate_multipliers]",
+							Use:       "update-pool [pool_id] [protocol_fee_percentage] [rewards_fee] [rate_multipliers]",
 							Short:     "Updates a stable swap pool",
-							Long:      "Update a stable swap pool with specified parameters, including the `fees`, amplification factors (`future_a_time` and `future_a`), and `rate_multipliers` for dynamic rates.",
+							Long:      "Update a stable swap pool with specified parameters, including the `fees` and `rate_multipliers` for dynamic rates. The amplification factors can only be updated through `ramp-a` and `stop-ramp-a`.",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 								{ProtoField: "pool_id"},
 								{ProtoField: "protocol_fee_percentage"},
 								{ProtoField: "rewards_fee"},
-								{ProtoField: "initial_a"},
-								{ProtoField: "future_a"},
-								{ProtoField: "future_a_time"},
 								{ProtoField: "rate_multipliers", Varargs: true},
 							},
 						},
@@ -256,7 +385,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							RpcMethod: "AddLiquidity",
 							Use:       "add-liquidity [pool_id] [slippage_percentage] [amount]",
 							Short:     "Add liquidity to a specified `StableSwap` pool",
-							Long:      "Adds a specified amount of liquidity to the pool identified by `pool_id`.",
+							Long:      "Adds a specified amount of liquidity to the pool identified by `pool_id`. Use the `--lock-duration` flag to lock the liquidity for one of the pool lock tiers, boosting its rewards.",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 								{ProtoField: "pool_id"},
 								{ProtoField: "slippage_percentage"},
@@ -267,12 +396,72 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							RpcMethod: "RemoveLiquidity",
 							Use:       "remove-liquidity [pool_id] [percentage]",
 							Short:     "Remove a percentage of liquidity from a specified pool",
-							Long:      "Removes a specified percentage of liquidity from the pool identified by `pool_id`.",
+							Long:      "Removes a specified percentage of liquidity from the pool identified by `pool_id`. Use the `--instant` flag to skip the unbonding period by paying the pool exit fee.",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 								{ProtoField: "pool_id"},
 								{ProtoField: "percentage"},
 							},
 						},
+						{
+							RpcMethod: "TransferPosition",
+							Use:       "transfer-position [pool_id] [receiver] [percentage]",
+							Short:     "Transfer a percentage of bonded liquidity to another address",
+							Long:      "Transfers a specified percentage of the available bonded positions in the pool identified by `pool_id` to the `receiver` address, settling the pending rewards first.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "pool_id"},
+								{ProtoField: "receiver"},
+								{ProtoField: "percentage"},
+							},
+						},
+						{
+							RpcMethod: "SetAutoCompound",
+							Use:       "set-auto-compound [pool_id] [enabled]",
+							Short:     "Enable or disable the auto-compounding of rewards",
+							Long:      "Enables or disables the periodic reinvestment of the pending rewards in the pool identified by `pool_id` into new bonded shares.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "pool_id"},
+								{ProtoField: "enabled"},
+							},
+						},
+						{
+							RpcMethod: "ScheduleUpdatePool",
+							Use:       "schedule-update-pool [execution_time] [update]",
+							Short:     "Schedule a stable swap pool update",
+							Long:      "Schedules the pool `update`, provided as JSON, for execution at the `execution_time` unix timestamp. The execution time must respect the minimum pool update delay of the module params.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "execution_time"},
+								{ProtoField: "update"},
+							},
+						},
+						{
+							RpcMethod: "CancelPoolUpdate",
+							Use:       "cancel-pool-update [id]",
+							Short:     "Cancel a scheduled stable swap pool update",
+							Long:      "Cancels the pending scheduled pool update identified by `id`.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "id"},
+							},
+						},
+						{
+							RpcMethod: "RampA",
+							Use:       "ramp-a [pool_id] [future_a] [future_a_time]",
+							Short:     "Ramp the amplification coefficient of a stable swap pool",
+							Long:      "Ramps linearly the amplification coefficient of the pool identified by `pool_id` from its current value to `future_a`, reached at `future_a_time`. The change is bounded by the maximum change factor and the minimum ramp duration of the module params.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "pool_id"},
+								{ProtoField: "future_a"},
+								{ProtoField: "future_a_time"},
+							},
+						},
+						{
+							RpcMethod: "StopRampA",
+							Use:       "stop-ramp-a [pool_id]",
+							Short:     "Stop the amplification coefficient ramp of a stable swap pool",
+							Long:      "Stops the ongoing amplification coefficient ramp of the pool identified by `pool_id`, freezing the coefficient at its current interpolated value.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "pool_id"},
+							},
+						},
 					},
 				},
 			},
@@ -297,7 +486,31 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Paused",
 					Use:       "paused",
 					Short:     "Check if the module is paused",
-					Long:      "Queries the module to determine if operations are currently paused, indicating a temporary or emergency halt of activity.",
+					Long:      "Queries the module to determine if operations are currently paused, indicating a temporary or emergency halt of activity, along with the activities paused independently in each pool.",
+				},
+				{
+					RpcMethod: "Authority",
+					Use:       "authority",
+					Short:     "Query the module authority",
+					Long:      "Retrieves the current module authority, and the pending one if a handover has been proposed.",
+				},
+				{
+					RpcMethod: "AllowedDenoms",
+					Use:       "allowed-denoms",
+					Short:     "Query the denoms allowed as pool pairs",
+					Long:      "Retrieves the registry of the denoms allowed as pool pairs, along with their metadata.",
+				},
+				{
+					RpcMethod: "BlockedDenoms",
+					Use:       "blocked-denoms",
+					Short:     "Query the denoms blocked from the swaps",
+					Long:      "Retrieves the denoms whose swaps are blocked across all the pools.",
+				},
+				{
+					RpcMethod: "WithdrawOnly",
+					Use:       "withdraw-only",
+					Short:     "Query the pools in withdraw-only mode",
+					Long:      "Retrieves the IDs of the paused pools whose liquidity providers can still remove liquidity and claim rewards.",
 				},
 				{
 					RpcMethod:      "Rates",
@@ -329,6 +542,58 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:           "Fetches detailed information about a specific liquidity pool, including current liquidity, participants, and fees.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}},
 				},
+				{
+					RpcMethod: "Incentives",
+					Use:       "incentives",
+					Short:     "List all active incentive programs",
+					Long:      "Provides a list of all the incentive programs that have not been fully distributed yet.",
+				},
+				{
+					RpcMethod:      "IncentivesByPool",
+					Use:            "incentives-by-pool [pool_id]",
+					Short:          "List the active incentive programs of a specific pool",
+					Long:           "Provides a list of the incentive programs of the pool identified by `pool_id` that have not been fully distributed yet.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}},
+				},
+				{
+					RpcMethod:      "WithdrawAddress",
+					Use:            "withdraw-address [address]",
+					Short:          "Get the address receiving the rewards of a user",
+					Long:           "Retrieves the address receiving the liquidity rewards of the provided `address`, which defaults to the address itself.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "FeeTiers",
+					Use:       "fee-tiers",
+					Short:     "List the swap fee discount tiers and the exempt accounts",
+					Long:      "Provides the discount tiers applied to the swap fees based on the accounts rolling volume, and the accounts exempt from the swap fees.",
+				},
+				{
+					RpcMethod:      "AccountFeeTier",
+					Use:            "account-fee-tier [address]",
+					Short:          "Get the rolling swap volume and the fee discount of an account",
+					Long:           "Retrieves the rolling 30-day swap volume of the provided `address`, together with the discount applied to its swap fees.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Get the module params",
+					Long:      "Retrieves the module params, including the minimum base deposit, the maximum add liquidity slippage and the unbonding block delta.",
+				},
+				{
+					RpcMethod: "Roles",
+					Use:       "roles",
+					Short:     "List all the role grants",
+					Long:      "Provides the addresses and the roles granted to them by the authority.",
+				},
+				{
+					RpcMethod:      "RolesByAddress",
+					Use:            "roles-by-address [address]",
+					Short:          "Get the roles granted to an address",
+					Long:           "Retrieves the roles granted by the authority to the provided `address`.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 			},
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"stableswap": {
@@ -348,6 +613,36 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Long:           "Retrieves the unbonding positions attributed to a specified `provider` within the module.",
 							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
 						},
+						{
+							RpcMethod:      "IncentivesByProvider",
+							Use:            "incentives-by-provider [provider]",
+							Short:          "List the incentives accrued by a specific provider",
+							Long:           "Retrieves the pending incentives accrued by a specified `provider` in each pool.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "provider"}},
+						},
+						{
+							RpcMethod: "UnbondingPeriod",
+							Use:       "unbonding-period [pool_id] [shares]",
+							Short:     "Query the expected unbonding period of a shares amount",
+							Long:      "Retrieves the expected unbonding period and end time for removing the given amount of `shares` from the pool identified by `pool_id`.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "pool_id"},
+								{ProtoField: "shares"},
+							},
+						},
+						{
+							RpcMethod: "ScheduledPoolUpdates",
+							Use:       "scheduled-pool-updates",
+							Short:     "Query the pending scheduled pool updates",
+							Long:      "Retrieves all the pending scheduled pool updates, along with their execution time.",
+						},
+						{
+							RpcMethod:      "Amplification",
+							Use:            "amplification [pool_id]",
+							Short:          "Query the amplification coefficient of a stable swap pool",
+							Long:           "Retrieves the current effective amplification coefficient of the pool identified by `pool_id`, along with its ramp schedule.",
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pool_id"}},
+						},
 					},
 				},
 			},
@@ -378,6 +673,8 @@ type ModuleInputs struct {
 	AddressCodec  address.Codec
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -400,6 +697,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic("max_add_liquidity_slippage_percentage for x/swap module must be set")
 	}
 
+	if in.Config.MaxAffiliateFeeBps < 0 || in.Config.MaxAffiliateFeeBps > 1e4 {
+		panic("max_affiliate_fee_bps for x/swap module must be between 0 and 1e4")
+	}
+
 	if in.Config.Stableswap == nil {
 		panic("stableswap config for x/swap/stableswap module must be set")
 	}
@@ -408,6 +709,29 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		panic("unbonding_block_delta for x/swap/stableswap module must be set")
 	}
 
+	if in.Config.Stableswap.CompoundingBlockDelta < 0 {
+		panic("compounding_block_delta for x/swap/stableswap module must be non-negative")
+	}
+	if in.Config.Stableswap.CompoundingBlockDelta == 0 {
+		in.Config.Stableswap.CompoundingBlockDelta = DefaultCompoundingBlockDelta
+	}
+
+	if in.Config.FeeConversion != nil {
+		if in.Config.FeeConversion.BlockDelta <= 0 {
+			panic("block_delta for x/swap fee_conversion must be set")
+		}
+
+		if in.Config.FeeConversion.MaxPriceImpactPercentage <= 0 || in.Config.FeeConversion.MaxPriceImpactPercentage > 1e6 {
+			panic("max_price_impact_percentage for x/swap fee_conversion must be between 0 and 1e6")
+		}
+
+		if in.Config.FeeConversion.Treasury != "" {
+			if _, err := in.AddressCodec.StringToBytes(in.Config.FeeConversion.Treasury); err != nil {
+				panic("treasury for x/swap fee_conversion must be a valid address")
+			}
+		}
+	}
+
 	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	k := keeper.NewKeeper(
 		in.Cdc,
@@ -419,10 +743,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Config.BaseDenom,
 		in.Config.BaseMinimumDeposit,
 		in.Config.MaxAddLiquiditySlippagePercentage,
+		in.Config.MaxAffiliateFeeBps,
 		in.Config.Stableswap,
+		in.Config.FeeConversion,
 		in.AddressCodec,
 		in.AccountKeeper,
 		in.BankKeeper)
+	if in.DistributionKeeper != nil {
+		k.SetDistributionKeeper(in.DistributionKeeper)
+	}
 	m := NewAppModule(k)
 
 	return ModuleOutputs{Keeper: k, Module: m}
@@ -39,6 +39,21 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnpauseByPoolIds{}, "swap/UnpauseByPoolIds", nil)
 	cdc.RegisterConcrete(&MsgWithdrawProtocolFees{}, "swap/WithdrawProtocolFees", nil)
 	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "swap/WithdrawRewards", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "swap/SetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgCreateIncentive{}, "swap/CreateIncentive", nil)
+	cdc.RegisterConcrete(&MsgSetFeeTiers{}, "swap/SetFeeTiers", nil)
+	cdc.RegisterConcrete(&MsgSetFeeExemptions{}, "swap/SetFeeExemptions", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "swap/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "swap/GrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "swap/RevokeRole", nil)
+	cdc.RegisterConcrete(&MsgClosePool{}, "swap/ClosePool", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawOnly{}, "swap/SetWithdrawOnly", nil)
+	cdc.RegisterConcrete(&MsgPauseActivities{}, "swap/PauseActivities", nil)
+	cdc.RegisterConcrete(&MsgUnpauseActivities{}, "swap/UnpauseActivities", nil)
+	cdc.RegisterConcrete(&MsgSetAllowedDenoms{}, "swap/SetAllowedDenoms", nil)
+	cdc.RegisterConcrete(&MsgSetBlockedDenoms{}, "swap/SetBlockedDenoms", nil)
+	cdc.RegisterConcrete(&MsgProposeAuthority{}, "swap/ProposeAuthority", nil)
+	cdc.RegisterConcrete(&MsgAcceptAuthority{}, "swap/AcceptAuthority", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -51,6 +66,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpauseByPoolIds{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawProtocolFees{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWithdrawRewards{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetWithdrawAddress{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCreateIncentive{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetFeeTiers{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetFeeExemptions{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgGrantRole{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRevokeRole{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgClosePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetWithdrawOnly{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPauseActivities{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpauseActivities{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAllowedDenoms{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetBlockedDenoms{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgProposeAuthority{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcceptAuthority{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
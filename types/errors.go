@@ -35,4 +35,16 @@ var (
 	ErrInvalidUnbondAmount     = errors.Register(ModuleName, 10, "invalid unbond amount")
 	ErrInvalidUnbondPercentage = errors.Register(ModuleName, 11, "invalid unbond percentage")
 	ErrInvalidUnbondPosition   = errors.Register(ModuleName, 12, "invalid unbond position")
+	ErrInvalidTransfer         = errors.Register(ModuleName, 13, "invalid position transfer")
+	ErrInstantRemovalDisabled  = errors.Register(ModuleName, 14, "instant liquidity removal is disabled")
+	ErrInvalidLock             = errors.Register(ModuleName, 15, "invalid liquidity lock")
+	ErrInvalidIncentive        = errors.Register(ModuleName, 16, "invalid incentive")
+	ErrInvalidFeeTiers         = errors.Register(ModuleName, 17, "invalid fee tiers")
+	ErrInvalidAffiliate        = errors.Register(ModuleName, 18, "invalid affiliate")
+	ErrInvalidParams           = errors.Register(ModuleName, 19, "invalid params")
+	ErrInvalidRole             = errors.Register(ModuleName, 20, "invalid role")
+	ErrInvalidScheduledUpdate  = errors.Register(ModuleName, 21, "invalid scheduled update")
+	ErrInvalidPauseActivity    = errors.Register(ModuleName, 22, "invalid pause activity")
+	ErrInvalidDenom            = errors.Register(ModuleName, 23, "invalid denom")
+	ErrDenomBlocked            = errors.Register(ModuleName, 24, "denom is blocked")
 )
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// MaxFeeTiers is the maximum number of swap fee discount tiers.
+	MaxFeeTiers = 10
+
+	// VolumeWindowDays is the number of days over which the rolling swap volume of an account is tracked.
+	VolumeWindowDays = 30
+)
+
+// ValidateFeeTiers ensures that the tiers are sorted by strictly increasing minimum volume, with
+// non-negative volumes and discounts between 0 and 1. An empty list is valid.
+func ValidateFeeTiers(tiers []FeeTier) error {
+	if len(tiers) > MaxFeeTiers {
+		return fmt.Errorf("at most %d tiers are allowed, got %d", MaxFeeTiers, len(tiers))
+	}
+
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("tier %d min volume must be non-negative, got %s", i, tier.MinVolume)
+		}
+		if i > 0 && !tier.MinVolume.GT(tiers[i-1].MinVolume) {
+			return fmt.Errorf("tier %d min volume must be greater than the previous one", i)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("tier %d discount must be between 0 and 1, got %s", i, tier.Discount)
+		}
+	}
+	return nil
+}
@@ -26,10 +26,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the Swap genesis state.
 type GenesisState struct {
-	NextPoolId      uint64                  `protobuf:"varint,1,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
-	Pools           map[uint64]Pool         `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Paused          map[uint64]bool         `protobuf:"bytes,3,rep,name=paused,proto3" json:"paused" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	StableswapState stableswap.GenesisState `protobuf:"bytes,4,opt,name=stableswap_state,json=stableswapState,proto3" json:"stableswap_state"`
+	NextPoolId        uint64                  `protobuf:"varint,1,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
+	Pools             map[uint64]Pool         `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Paused            map[uint64]bool         `protobuf:"bytes,3,rep,name=paused,proto3" json:"paused" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	StableswapState   stableswap.GenesisState `protobuf:"bytes,4,opt,name=stableswap_state,json=stableswapState,proto3" json:"stableswap_state"`
+	NextIncentiveId   uint64                  `protobuf:"varint,5,opt,name=next_incentive_id,json=nextIncentiveId,proto3" json:"next_incentive_id,omitempty"`
+	Incentives        map[uint64]Incentive    `protobuf:"bytes,6,rep,name=incentives,proto3" json:"incentives" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WithdrawAddresses map[string]string       `protobuf:"bytes,7,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FeeTiers          []FeeTier               `protobuf:"bytes,8,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	FeeExempt         []string                `protobuf:"bytes,9,rep,name=fee_exempt,json=feeExempt,proto3" json:"fee_exempt,omitempty"`
+	SwapVolumes       []SwapVolume            `protobuf:"bytes,10,rep,name=swap_volumes,json=swapVolumes,proto3" json:"swap_volumes"`
+	// Module params, which default to the values of the module config when unset.
+	Params           *Params            `protobuf:"bytes,11,opt,name=params,proto3" json:"params,omitempty"`
+	RoleGrants       []RoleGrant        `protobuf:"bytes,12,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	WithdrawOnly     []uint64           `protobuf:"varint,13,rep,packed,name=withdraw_only,json=withdrawOnly,proto3" json:"withdraw_only,omitempty"`
+	PausedActivities []PausedActivities `protobuf:"bytes,14,rep,name=paused_activities,json=pausedActivities,proto3" json:"paused_activities"`
+	AllowedDenoms    []AllowedDenom     `protobuf:"bytes,15,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms"`
+	BlockedDenoms    []string           `protobuf:"bytes,16,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+	// Module authority, which defaults to the value of the module config when unset.
+	Authority        string `protobuf:"bytes,17,opt,name=authority,proto3" json:"authority,omitempty"`
+	PendingAuthority string `protobuf:"bytes,18,opt,name=pending_authority,json=pendingAuthority,proto3" json:"pending_authority,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,38 +109,163 @@ func (m *GenesisState) GetStableswapState() stableswap.GenesisState {
 	return stableswap.GenesisState{}
 }
 
+func (m *GenesisState) GetNextIncentiveId() uint64 {
+	if m != nil {
+		return m.NextIncentiveId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIncentives() map[uint64]Incentive {
+	if m != nil {
+		return m.Incentives
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawAddresses() map[string]string {
+	if m != nil {
+		return m.WithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExempt() []string {
+	if m != nil {
+		return m.FeeExempt
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapVolumes() []SwapVolume {
+	if m != nil {
+		return m.SwapVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawOnly() []uint64 {
+	if m != nil {
+		return m.WithdrawOnly
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedActivities() []PausedActivities {
+	if m != nil {
+		return m.PausedActivities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedDenoms() []AllowedDenom {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPendingAuthority() string {
+	if m != nil {
+		return m.PendingAuthority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.swap.v1.GenesisState")
+	proto.RegisterMapType((map[uint64]Incentive)(nil), "noble.swap.v1.GenesisState.IncentivesEntry")
 	proto.RegisterMapType((map[uint64]bool)(nil), "noble.swap.v1.GenesisState.PausedEntry")
 	proto.RegisterMapType((map[uint64]Pool)(nil), "noble.swap.v1.GenesisState.PoolsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "noble.swap.v1.GenesisState.WithdrawAddressesEntry")
 }
 
 func init() { proto.RegisterFile("noble/swap/v1/genesis.proto", fileDescriptor_4e2e0a8c02cafb5b) }
 
 var fileDescriptor_4e2e0a8c02cafb5b = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0x32, 0x41,
-	0x18, 0xc7, 0x77, 0x5c, 0x95, 0x97, 0x47, 0x5f, 0x92, 0xc9, 0xc3, 0xb0, 0xc1, 0xb4, 0x74, 0x30,
-	0xbb, 0xcc, 0x62, 0x5d, 0xaa, 0xa3, 0x20, 0xd1, 0x21, 0x08, 0x3b, 0x04, 0x5d, 0x64, 0xc5, 0x41,
-	0xa4, 0x65, 0x67, 0x71, 0x46, 0x73, 0xfb, 0x14, 0x7d, 0x82, 0x3e, 0x8f, 0x47, 0x8f, 0x9d, 0x22,
-	0xf4, 0x8b, 0xc4, 0xcc, 0x08, 0x3b, 0x8a, 0x74, 0x1b, 0xe6, 0xff, 0xfb, 0xff, 0x9e, 0x67, 0x76,
-	0xe1, 0x24, 0x15, 0xc3, 0x84, 0x47, 0xf2, 0x2d, 0xce, 0xa2, 0x79, 0x27, 0x1a, 0xf3, 0x94, 0xcb,
-	0x89, 0x64, 0xd9, 0x54, 0x28, 0x81, 0xff, 0x9b, 0x90, 0xe9, 0x90, 0xcd, 0x3b, 0x41, 0x73, 0x2c,
-	0xc6, 0xc2, 0x24, 0x91, 0x3e, 0x59, 0x28, 0x68, 0x39, 0x06, 0xa9, 0xe2, 0x61, 0xc2, 0x0f, 0xca,
-	0x02, 0xb2, 0x3b, 0x29, 0x13, 0x22, 0xb1, 0xc9, 0xd9, 0xa7, 0x0f, 0xf5, 0x3b, 0xcb, 0x3e, 0xa9,
-	0x58, 0x71, 0x1c, 0x42, 0x3d, 0xe5, 0x0b, 0x35, 0xd0, 0xcc, 0x60, 0x32, 0x22, 0x28, 0x44, 0xed,
-	0x72, 0x1f, 0xf4, 0xdd, 0xa3, 0x10, 0xc9, 0xfd, 0x08, 0x77, 0xa1, 0xa2, 0x43, 0x49, 0x4a, 0xa1,
-	0xdf, 0xae, 0x5d, 0xb6, 0xd8, 0xce, 0xa6, 0xcc, 0xb5, 0x31, 0x5d, 0x91, 0xbd, 0x54, 0x4d, 0xf3,
-	0x6e, 0x79, 0xf9, 0x7d, 0xea, 0xf5, 0x6d, 0x15, 0xf7, 0xa0, 0x9a, 0xc5, 0x33, 0xc9, 0x47, 0xc4,
-	0x37, 0x92, 0xf3, 0x3f, 0x25, 0x86, 0x74, 0x2d, 0xdb, 0x32, 0x7e, 0x86, 0x46, 0xf1, 0xec, 0x81,
-	0xd4, 0x34, 0x29, 0x87, 0x68, 0x7f, 0xab, 0x82, 0xd9, 0x77, 0x6f, 0x7d, 0x47, 0x05, 0x61, 0xae,
-	0x83, 0x07, 0x80, 0x62, 0x75, 0xdc, 0x00, 0xff, 0x95, 0xe7, 0xdb, 0x4f, 0xa1, 0x8f, 0xf8, 0x02,
-	0x2a, 0xf3, 0x38, 0x99, 0x71, 0x52, 0x32, 0xd3, 0x8e, 0xf7, 0xd6, 0xd7, 0xdd, 0xbe, 0x25, 0x6e,
-	0x4b, 0xd7, 0x28, 0xb8, 0x81, 0x9a, 0xf3, 0x88, 0x03, 0xbe, 0xa6, 0xeb, 0xfb, 0xe7, 0x54, 0xbb,
-	0x6c, 0xb9, 0xa6, 0x68, 0xb5, 0xa6, 0xe8, 0x67, 0x4d, 0xd1, 0xc7, 0x86, 0x7a, 0xab, 0x0d, 0xf5,
-	0xbe, 0x36, 0xd4, 0x7b, 0x69, 0x9a, 0x69, 0x76, 0xf0, 0x22, 0x7f, 0x8f, 0x54, 0x9e, 0x71, 0x39,
-	0xac, 0x9a, 0xff, 0x7a, 0xf5, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xb7, 0x3b, 0x68, 0x5d, 0x02,
-	0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x63, 0x12, 0x28, 0x79, 0x49, 0x48, 0x32, 0xa5, 0x68, 0x08, 0x10, 0xa2, 0x56, 0xa5,
+	0x69, 0x51, 0x1d, 0x41, 0x2f, 0xa5, 0x97, 0x2a, 0x11, 0x94, 0x72, 0x58, 0x2d, 0x6b, 0x56, 0x8b,
+	0xb4, 0x17, 0x6b, 0x82, 0x87, 0x60, 0xe1, 0x78, 0x2c, 0xcf, 0x24, 0x21, 0xfb, 0x29, 0xf6, 0x63,
+	0x71, 0xe4, 0xb8, 0xa7, 0xd5, 0x2a, 0x7c, 0x91, 0xd5, 0x8c, 0xc7, 0x8e, 0xe3, 0xcd, 0x72, 0x4b,
+	0xde, 0xff, 0xff, 0xff, 0xcd, 0xf3, 0x7b, 0x63, 0xc3, 0x8e, 0xcf, 0xfa, 0x1e, 0xed, 0xf0, 0x09,
+	0x09, 0x3a, 0xe3, 0xa3, 0xce, 0x80, 0xfa, 0x94, 0xbb, 0xdc, 0x0c, 0x42, 0x26, 0x18, 0xaa, 0x28,
+	0xd1, 0x94, 0xa2, 0x39, 0x3e, 0x6a, 0x6c, 0x0e, 0xd8, 0x80, 0x29, 0xa5, 0x23, 0x7f, 0x45, 0xa6,
+	0xc6, 0x41, 0x8a, 0xc0, 0x05, 0xe9, 0x7b, 0x74, 0x29, 0xac, 0xb1, 0xbd, 0x78, 0x92, 0x43, 0x7d,
+	0x36, 0xd4, 0xd2, 0xee, 0xa2, 0x74, 0x4b, 0xa9, 0x2d, 0x5c, 0x1a, 0x6a, 0x75, 0x6f, 0x51, 0x75,
+	0xfd, 0x1b, 0xea, 0x0b, 0x77, 0x4c, 0xb5, 0xdc, 0x58, 0x94, 0x03, 0x12, 0x92, 0xe1, 0x77, 0xce,
+	0x0c, 0xc8, 0x88, 0xc7, 0x31, 0x9c, 0x91, 0x18, 0xf3, 0x96, 0x2b, 0x21, 0xf3, 0x74, 0xe6, 0xe7,
+	0x19, 0x40, 0xf9, 0x3c, 0x7a, 0xa8, 0x2b, 0x41, 0x04, 0x45, 0x2d, 0x28, 0xfb, 0xf4, 0x41, 0xd8,
+	0x32, 0x6d, 0xbb, 0x0e, 0x36, 0x5a, 0x46, 0xbb, 0x60, 0x81, 0xac, 0x5d, 0x32, 0xe6, 0x5d, 0x38,
+	0xa8, 0x07, 0xab, 0x52, 0xe4, 0x78, 0xa5, 0x95, 0x6f, 0x97, 0x8e, 0x0f, 0xcc, 0x85, 0x91, 0x9a,
+	0x69, 0x9a, 0x29, 0x23, 0xfc, 0xcc, 0x17, 0xe1, 0xb4, 0x57, 0x78, 0xfc, 0xbc, 0x9f, 0xb3, 0xa2,
+	0x28, 0x3a, 0x83, 0x35, 0xd5, 0xb9, 0x83, 0xf3, 0x0a, 0xf2, 0xdb, 0x8b, 0x10, 0xe5, 0x4c, 0x53,
+	0x74, 0x18, 0x5d, 0x43, 0x6d, 0xbe, 0x1f, 0x9b, 0x4b, 0x37, 0x2e, 0xb4, 0x8c, 0x6c, 0x57, 0x73,
+	0x4f, 0x96, 0xad, 0x79, 0xd5, 0xb9, 0x23, 0x9a, 0xc2, 0x1f, 0x50, 0x57, 0x53, 0x48, 0x36, 0x23,
+	0x47, 0xb1, 0xaa, 0x46, 0x51, 0x95, 0xc2, 0x45, 0x5c, 0xbf, 0x70, 0xd0, 0x1b, 0x80, 0xc4, 0xc6,
+	0xf1, 0x9a, 0x7a, 0x9e, 0xc3, 0x97, 0x9e, 0x27, 0x09, 0x2f, 0x4c, 0x26, 0x05, 0x41, 0x04, 0xd0,
+	0xc4, 0x15, 0x77, 0x4e, 0x48, 0x26, 0x36, 0x71, 0x9c, 0x90, 0x72, 0x4e, 0x39, 0xfe, 0x41, 0xa1,
+	0x8f, 0x5f, 0x42, 0x5f, 0xeb, 0x54, 0x37, 0x0e, 0xa9, 0x13, 0xac, 0xfa, 0x24, 0x5b, 0x47, 0x27,
+	0x50, 0x8c, 0x2f, 0x25, 0xc7, 0xeb, 0x8a, 0xbc, 0x95, 0x21, 0xff, 0x47, 0xe9, 0x5b, 0x97, 0x86,
+	0xba, 0xbf, 0xf5, 0xdb, 0xe8, 0x2f, 0x47, 0x7b, 0x00, 0x32, 0x4a, 0x1f, 0xe8, 0x30, 0x10, 0xb8,
+	0xd8, 0xca, 0xb7, 0x8b, 0x96, 0x84, 0x9d, 0xa9, 0x02, 0xea, 0x41, 0x59, 0xad, 0x63, 0xcc, 0xbc,
+	0xd1, 0x90, 0x72, 0x0c, 0x0a, 0xbe, 0x9d, 0x81, 0x5f, 0x4d, 0x48, 0xf0, 0x4e, 0x39, 0x34, 0xbf,
+	0xc4, 0x93, 0x0a, 0x47, 0x7f, 0xca, 0xfb, 0x21, 0x6f, 0x3d, 0x2e, 0xa9, 0x75, 0xfe, 0x94, 0x49,
+	0x5f, 0x2a, 0xd1, 0xd2, 0x26, 0xf4, 0x2f, 0x94, 0xe4, 0x9d, 0xb6, 0x07, 0x21, 0xf1, 0x05, 0xc7,
+	0x65, 0x75, 0x22, 0xce, 0x64, 0x2c, 0xe6, 0xd1, 0x73, 0x69, 0x88, 0x07, 0x1e, 0xc6, 0x05, 0x8e,
+	0x7e, 0x81, 0x4a, 0x32, 0x70, 0xe6, 0x7b, 0x53, 0x5c, 0x69, 0xe5, 0xdb, 0x05, 0xab, 0x1c, 0x17,
+	0x5f, 0xfb, 0xde, 0x14, 0x59, 0x50, 0x8f, 0xee, 0x9d, 0x4d, 0x6e, 0x84, 0x3b, 0x76, 0x85, 0x4b,
+	0x39, 0xde, 0x50, 0x67, 0xed, 0x7f, 0xd3, 0x9f, 0xf4, 0x75, 0x13, 0x9b, 0x3e, 0xb2, 0x16, 0x64,
+	0xea, 0xe8, 0x7f, 0xd8, 0x20, 0x9e, 0xc7, 0x26, 0xd4, 0xb1, 0xd5, 0xe7, 0x83, 0xe3, 0xaa, 0x02,
+	0xee, 0x64, 0x80, 0xdd, 0xc8, 0x74, 0x2a, 0x3d, 0x1a, 0x56, 0x21, 0xa9, 0x1a, 0x47, 0xbf, 0xc2,
+	0x46, 0xdf, 0x63, 0x37, 0xf7, 0x73, 0x52, 0x4d, 0x6d, 0xa6, 0xa2, 0xab, 0xda, 0xb6, 0x0b, 0x45,
+	0x32, 0x12, 0x77, 0x2c, 0x74, 0xc5, 0x14, 0xd7, 0x5b, 0x86, 0xdc, 0x5d, 0x52, 0x40, 0x87, 0x50,
+	0x0f, 0xa8, 0xef, 0xb8, 0xfe, 0xc0, 0x9e, 0xbb, 0x90, 0x72, 0xd5, 0xb4, 0xd0, 0x8d, 0xeb, 0x8d,
+	0x57, 0x00, 0xf3, 0xf7, 0x1b, 0xd5, 0x20, 0x7f, 0x4f, 0xa7, 0xfa, 0x7b, 0x21, 0x7f, 0xa2, 0xdf,
+	0x61, 0x75, 0x4c, 0xbc, 0x11, 0xc5, 0x2b, 0x6a, 0x87, 0x3f, 0x66, 0x67, 0xc4, 0x98, 0x67, 0x45,
+	0x8e, 0x7f, 0x56, 0xfe, 0x36, 0x1a, 0x27, 0x50, 0x4a, 0xbd, 0xe9, 0x4b, 0x78, 0x9b, 0x69, 0xde,
+	0x7a, 0x3a, 0x7a, 0x0d, 0xd5, 0xcc, 0x4b, 0xb5, 0x24, 0x6e, 0x2e, 0xb6, 0x93, 0xbd, 0x1e, 0x09,
+	0x20, 0x0d, 0x3e, 0x85, 0xad, 0xe5, 0xaf, 0x54, 0x9a, 0x5f, 0x5c, 0xd2, 0x5e, 0x31, 0x45, 0xe9,
+	0x99, 0x8f, 0xb3, 0xa6, 0xf1, 0x34, 0x6b, 0x1a, 0x5f, 0x66, 0x4d, 0xe3, 0xe3, 0x73, 0x33, 0xf7,
+	0xf4, 0xdc, 0xcc, 0x7d, 0x7a, 0x6e, 0xe6, 0xde, 0x6f, 0xaa, 0xd3, 0xa3, 0x46, 0x1e, 0xa6, 0x1f,
+	0x3a, 0x62, 0x1a, 0x50, 0xde, 0x5f, 0x53, 0xdf, 0xe6, 0xbf, 0xbe, 0x06, 0x00, 0x00, 0xff, 0xff,
+	0x66, 0xeb, 0xd4, 0xda, 0xca, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +288,190 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingAuthority) > 0 {
+		i -= len(m.PendingAuthority)
+		copy(dAtA[i:], m.PendingAuthority)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PausedActivities) > 0 {
+		for iNdEx := len(m.PausedActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.WithdrawOnly) > 0 {
+		dAtA2 := make([]byte, len(m.WithdrawOnly)*10)
+		var j1 int
+		for _, num := range m.WithdrawOnly {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SwapVolumes) > 0 {
+		for iNdEx := len(m.SwapVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FeeExempt) > 0 {
+		for iNdEx := len(m.FeeExempt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeExempt[iNdEx])
+			copy(dAtA[i:], m.FeeExempt[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeExempt[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for k := range m.WithdrawAddresses {
+			v := m.WithdrawAddresses[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Incentives) > 0 {
+		for k := range m.Incentives {
+			v := m.Incentives[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintGenesis(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextIncentiveId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.StableswapState.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -246,6 +571,87 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.StableswapState.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextIncentiveId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextIncentiveId))
+	}
+	if len(m.Incentives) > 0 {
+		for k, v := range m.Incentives {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for k, v := range m.WithdrawAddresses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeExempt) > 0 {
+		for _, s := range m.FeeExempt {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapVolumes) > 0 {
+		for _, e := range m.SwapVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawOnly) > 0 {
+		l = 0
+		for _, e := range m.WithdrawOnly {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PausedActivities) > 0 {
+		for _, e := range m.PausedActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, e := range m.AllowedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PendingAuthority)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -552,6 +958,677 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIncentiveId", wireType)
+			}
+			m.NextIncentiveId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIncentiveId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Incentives == nil {
+				m.Incentives = make(map[uint64]Incentive)
+			}
+			var mapkey uint64
+			mapvalue := &Incentive{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Incentive{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Incentives[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawAddresses == nil {
+				m.WithdrawAddresses = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WithdrawAddresses[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExempt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExempt = append(m.FeeExempt, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumes = append(m.SwapVolumes, SwapVolume{})
+			if err := m.SwapVolumes[len(m.SwapVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.WithdrawOnly = append(m.WithdrawOnly, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.WithdrawOnly) == 0 {
+					m.WithdrawOnly = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.WithdrawOnly = append(m.WithdrawOnly, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawOnly", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedActivities = append(m.PausedActivities, PausedActivities{})
+			if err := m.PausedActivities[len(m.PausedActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, AllowedDenom{})
+			if err := m.AllowedDenoms[len(m.AllowedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,644 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/swap/v1/incentive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Incentive struct {
+	// ID of the Incentive.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address of the account that funded the Incentive.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the Pool whose bonded liquidity providers receive the Incentive.
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Total amount of coins streamed by the Incentive.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Amount of coins already distributed to the Pool.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+	// Time at which the distribution starts.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Time at which the distribution ends.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *Incentive) Reset()         { *m = Incentive{} }
+func (m *Incentive) String() string { return proto.CompactTextString(m) }
+func (*Incentive) ProtoMessage()    {}
+func (*Incentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_006f0930354f7964, []int{0}
+}
+func (m *Incentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Incentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Incentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Incentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Incentive.Merge(m, src)
+}
+func (m *Incentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *Incentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_Incentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Incentive proto.InternalMessageInfo
+
+func (m *Incentive) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Incentive) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Incentive) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Incentive) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Incentive) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+func (m *Incentive) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Incentive) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Incentive)(nil), "noble.swap.v1.Incentive")
+}
+
+func init() { proto.RegisterFile("noble/swap/v1/incentive.proto", fileDescriptor_006f0930354f7964) }
+
+var fileDescriptor_006f0930354f7964 = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xbb, 0x8e, 0xd3, 0x40,
+	0x14, 0xcd, 0x24, 0x4b, 0xb2, 0x99, 0x00, 0x12, 0x56, 0x24, 0xbc, 0x91, 0x70, 0x22, 0x2a, 0x6b,
+	0xa5, 0x9d, 0x51, 0xc2, 0x07, 0x20, 0xb2, 0x12, 0xd2, 0xb6, 0x81, 0x8a, 0x26, 0x1a, 0x7b, 0x06,
+	0x33, 0x22, 0x9e, 0x6b, 0x79, 0x26, 0x81, 0xd0, 0xf2, 0x03, 0x5b, 0xf3, 0x05, 0x88, 0x6a, 0x0b,
+	0x3e, 0x62, 0xcb, 0x15, 0x15, 0x15, 0x8b, 0x92, 0x22, 0x25, 0xbf, 0x80, 0xe6, 0x61, 0x89, 0x96,
+	0x66, 0x1b, 0xdb, 0xe7, 0x9e, 0x3b, 0xe7, 0xde, 0xe3, 0x33, 0xf8, 0x89, 0x82, 0x6c, 0x25, 0xa8,
+	0xfe, 0xc0, 0x2a, 0xba, 0x99, 0x52, 0xa9, 0x72, 0xa1, 0x8c, 0xdc, 0x08, 0x52, 0xd5, 0x60, 0x20,
+	0x7a, 0xe0, 0x68, 0x62, 0x69, 0xb2, 0x99, 0x8e, 0x1e, 0xb1, 0x52, 0x2a, 0xa0, 0xee, 0xe9, 0x3b,
+	0x46, 0x49, 0x0e, 0xba, 0x04, 0x4d, 0x33, 0xa6, 0x05, 0xdd, 0x4c, 0x33, 0x61, 0xd8, 0x94, 0xe6,
+	0x20, 0x55, 0xe0, 0x4f, 0x3c, 0xbf, 0x74, 0x88, 0x7a, 0x10, 0xa8, 0x61, 0x01, 0x05, 0xf8, 0xba,
+	0xfd, 0x0a, 0xd5, 0x71, 0x01, 0x50, 0xac, 0x04, 0x75, 0x28, 0x5b, 0xbf, 0xa5, 0x46, 0x96, 0x42,
+	0x1b, 0x56, 0x56, 0xbe, 0xe1, 0xe9, 0x9f, 0x0e, 0xee, 0x5f, 0x34, 0x7b, 0x46, 0x0f, 0x71, 0x5b,
+	0xf2, 0x18, 0x4d, 0x50, 0x7a, 0xb4, 0x68, 0x4b, 0x1e, 0xcd, 0x70, 0x2f, 0xaf, 0x05, 0x33, 0x50,
+	0xc7, 0xed, 0x09, 0x4a, 0xfb, 0xf3, 0xf8, 0xc7, 0xf7, 0xb3, 0x61, 0x98, 0xfb, 0x82, 0xf3, 0x5a,
+	0x68, 0xfd, 0xca, 0xd4, 0x52, 0x15, 0x8b, 0xa6, 0x31, 0x7a, 0x8c, 0x7b, 0x15, 0xc0, 0x6a, 0x29,
+	0x79, 0xdc, 0x71, 0x42, 0x5d, 0x0b, 0x2f, 0x78, 0xb4, 0xc5, 0x5d, 0x56, 0xc2, 0x5a, 0x99, 0xf8,
+	0x68, 0xd2, 0x49, 0x07, 0xb3, 0x13, 0x12, 0x84, 0xac, 0x5b, 0x12, 0xdc, 0x92, 0x73, 0x90, 0x6a,
+	0xfe, 0xf2, 0xfa, 0xd7, 0xb8, 0xf5, 0xed, 0x76, 0x9c, 0x16, 0xd2, 0xbc, 0x5b, 0x67, 0x24, 0x87,
+	0x32, 0xb8, 0x0d, 0xaf, 0x33, 0xcd, 0xdf, 0x53, 0xb3, 0xad, 0x84, 0x76, 0x07, 0xf4, 0x97, 0xc3,
+	0xd5, 0xe9, 0xfd, 0x95, 0x28, 0x58, 0xbe, 0x5d, 0xda, 0xff, 0xa5, 0xbf, 0x1e, 0xae, 0x4e, 0xd1,
+	0x22, 0x0c, 0x8c, 0x3e, 0x23, 0x3c, 0xe0, 0x52, 0x9b, 0x5a, 0x66, 0x6b, 0x23, 0x78, 0x7c, 0xef,
+	0xae, 0x16, 0xf8, 0x77, 0x6a, 0x74, 0x8e, 0xb1, 0x36, 0xac, 0x36, 0x4b, 0x1b, 0x42, 0xdc, 0x9d,
+	0xa0, 0x74, 0x30, 0x1b, 0x11, 0x9f, 0x10, 0x69, 0x12, 0x22, 0xaf, 0x9b, 0x84, 0xe6, 0xc7, 0x76,
+	0x89, 0xcb, 0xdb, 0x31, 0x5a, 0xf4, 0xdd, 0x39, 0xcb, 0x44, 0xcf, 0xf1, 0xb1, 0x50, 0xdc, 0x4b,
+	0xf4, 0xfe, 0x43, 0xa2, 0x27, 0x14, 0xb7, 0xf5, 0x39, 0xb9, 0xde, 0x25, 0xe8, 0x66, 0x97, 0xa0,
+	0xdf, 0xbb, 0x04, 0x5d, 0xee, 0x93, 0xd6, 0xcd, 0x3e, 0x69, 0xfd, 0xdc, 0x27, 0xad, 0x37, 0x43,
+	0x77, 0x33, 0xfd, 0x25, 0xfd, 0xb8, 0xfd, 0xe4, 0xed, 0x65, 0x5d, 0x27, 0xfb, 0xec, 0x6f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x9a, 0x41, 0x61, 0x1b, 0xdd, 0x02, 0x00, 0x00,
+}
+
+func (m *Incentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Incentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIncentive(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIncentive(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Incentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentive(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovIncentive(uint64(m.PoolId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIncentive(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIncentive(uint64(l))
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Incentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -26,9 +26,25 @@ const ModuleName = "swap"
 // Distinct prefixes with shared roots (ex. <pools_generic> and <pools_stableswap>) are allowed as long as neither fully contains the other.
 
 var (
-	NextPoolIDPrefix = []byte("next_pool_id")
-	PausedPrefix     = []byte("paused")
-	PoolsPrefix      = []byte("pools_generic")
+	NextPoolIDPrefix      = []byte("next_pool_id")
+	PausedPrefix          = []byte("paused")
+	PoolsPrefix           = []byte("pools_generic")
+	NextIncentiveIDPrefix = []byte("next_incentive_id")
+	IncentivesPrefix      = []byte("incentives")
+	IncentiveStartsPrefix = []byte("incentive_starts")
+	PoolIncentivesPrefix  = []byte("pool_incentives")
+	WithdrawAddressPrefix = []byte("withdraw_addresses")
+	FeeTiersPrefix        = []byte("fee_tiers")
+	FeeExemptPrefix       = []byte("fee_exempt")
+	SwapVolumesPrefix     = []byte("swap_volumes")
+	ParamsPrefix          = []byte("params")
+	RolesPrefix           = []byte("roles")
+	WithdrawOnlyPrefix    = []byte("withdraw_only")
+	PauseFlagsPrefix      = []byte("pause_flags")
+	DenomRegistryPrefix   = []byte("denom_registry")
+	DenomBlocklistPrefix  = []byte("denom_blocklist")
+	AuthorityKey          = []byte("authority")
+	PendingAuthorityKey   = []byte("pending_authority")
 
 	StableSwapPoolsPrefix                     = []byte("pools_stableswap")
 	StableSwapUsersTotalBondedSharesPrefix    = []byte("stableswap_users_total_bonded_shares")
@@ -36,4 +52,8 @@ var (
 	StableSwapPoolsTotalUnbondingSharesPrefix = []byte("stableswap_pools_total_unbonding_shares")
 	StableSwapUnbondingPositionsPrefix        = []byte("stableswap_unbonding_positions")
 	StableSwapBondedPositionsPrefix           = []byte("stableswap_bonded_positions")
+	StableSwapAutoCompoundPrefix              = []byte("stableswap_auto_compound")
+	StableSwapLockedPositionsPrefix           = []byte("stableswap_locked_positions")
+	StableSwapScheduledUpdatesPrefix          = []byte("stableswap_scheduled_updates")
+	StableSwapNextScheduledUpdateIDPrefix     = []byte("stableswap_next_scheduled_update_id")
 )
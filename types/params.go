@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultRampLimits are the amplification coefficient ramp limits used when unset in the params.
+var DefaultRampLimits = RampLimits{
+	MaxAChange:      10,
+	MinRampDuration: 86400,
+}
+
+// GetRampLimitsOrDefault returns the amplification coefficient ramp limits, defaulting to DefaultRampLimits.
+func (p Params) GetRampLimitsOrDefault() RampLimits {
+	if p.RampLimits == nil {
+		return DefaultRampLimits
+	}
+	return *p.RampLimits
+}
+
+// DefaultIncentiveLimits are the incentive program limits used when unset in the params.
+var DefaultIncentiveLimits = IncentiveLimits{
+	MaxDuration:      365 * 24 * time.Hour,
+	MaxActivePerPool: 10,
+}
+
+// GetIncentiveLimitsOrDefault returns the incentive program limits, defaulting to DefaultIncentiveLimits.
+func (p Params) GetIncentiveLimitsOrDefault() IncentiveLimits {
+	if p.IncentiveLimits == nil {
+		return DefaultIncentiveLimits
+	}
+	return *p.IncentiveLimits
+}
+
+// ValidateParams ensures that the module params are within their allowed ranges.
+func ValidateParams(params Params) error {
+	if params.BaseMinimumDeposit < 0 {
+		return fmt.Errorf("base minimum deposit must be non-negative, got %d", params.BaseMinimumDeposit)
+	}
+	if params.MaxAddLiquiditySlippagePercentage <= 0 || params.MaxAddLiquiditySlippagePercentage > 1e6 {
+		return fmt.Errorf("max add liquidity slippage percentage must be between 1 and 1e6, got %d", params.MaxAddLiquiditySlippagePercentage)
+	}
+	if params.UnbondingBlockDelta <= 0 {
+		return fmt.Errorf("unbonding block delta must be positive, got %d", params.UnbondingBlockDelta)
+	}
+	if params.MinPoolUpdateDelay < 0 {
+		return fmt.Errorf("min pool update delay must be non-negative, got %d", params.MinPoolUpdateDelay)
+	}
+	if params.PermissionlessPoolCreation != nil {
+		if err := ValidatePermissionlessPoolCreation(*params.PermissionlessPoolCreation); err != nil {
+			return fmt.Errorf("invalid permissionless pool creation: %w", err)
+		}
+	}
+	if params.RampLimits != nil {
+		if err := ValidateRampLimits(*params.RampLimits); err != nil {
+			return fmt.Errorf("invalid ramp limits: %w", err)
+		}
+	}
+	if params.IncentiveLimits != nil {
+		if err := ValidateIncentiveLimits(*params.IncentiveLimits); err != nil {
+			return fmt.Errorf("invalid incentive limits: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateRampLimits ensures that the amplification coefficient ramp limits are consistent.
+func ValidateRampLimits(limits RampLimits) error {
+	if limits.MaxAChange < 1 {
+		return fmt.Errorf("max A change must be at least 1, got %d", limits.MaxAChange)
+	}
+	if limits.MinRampDuration < 0 {
+		return fmt.Errorf("min ramp duration must be non-negative, got %d", limits.MinRampDuration)
+	}
+	return nil
+}
+
+// ValidateIncentiveLimits ensures that the creation fee is valid, and that the incentive program limits are positive.
+func ValidateIncentiveLimits(limits IncentiveLimits) error {
+	if err := limits.CreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid creation fee: %w", err)
+	}
+	if limits.MaxDuration <= 0 {
+		return fmt.Errorf("max duration must be positive, got %s", limits.MaxDuration)
+	}
+	if limits.MaxActivePerPool == 0 {
+		return fmt.Errorf("max active incentives per pool must be positive, got %d", limits.MaxActivePerPool)
+	}
+	return nil
+}
+
+// ValidatePermissionlessPoolCreation ensures that the creation fee is valid, and that the pool parameter bounds are consistent.
+func ValidatePermissionlessPoolCreation(creation PermissionlessPoolCreation) error {
+	if err := creation.CreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid creation fee: %w", err)
+	}
+	if creation.MinA <= 0 || creation.MaxA < creation.MinA {
+		return fmt.Errorf("amplification coefficient bounds must be positive and increasing, got [%d, %d]", creation.MinA, creation.MaxA)
+	}
+	if creation.MaxRewardsFee < 0 {
+		return fmt.Errorf("max rewards fee must be non-negative, got %d", creation.MaxRewardsFee)
+	}
+	if creation.MaxProtocolFeePercentage < 0 || creation.MaxProtocolFeePercentage > 100 {
+		return fmt.Errorf("max protocol fee percentage must be between 0 and 100, got %d", creation.MaxProtocolFeePercentage)
+	}
+	if creation.MaxRateMultiplierDeviation.IsNil() || creation.MaxRateMultiplierDeviation.IsNegative() {
+		return fmt.Errorf("max rate multiplier deviation must be non-negative, got %s", creation.MaxRateMultiplierDeviation)
+	}
+	if !creation.MaxExitFeePercentage.IsNil() && (creation.MaxExitFeePercentage.IsNegative() || creation.MaxExitFeePercentage.GTE(math.LegacyNewDec(100))) {
+		return fmt.Errorf("max exit fee percentage must be >= 0 and < 100, got %s", creation.MaxExitFeePercentage)
+	}
+	if creation.MaxUnbondingDuration < 0 {
+		return fmt.Errorf("max unbonding duration must be non-negative, got %s", creation.MaxUnbondingDuration)
+	}
+	return nil
+}
@@ -0,0 +1,1664 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/swap/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	// Minimum amount of the base token required for depositing into liquidity pools.
+	BaseMinimumDeposit int64 `protobuf:"varint,1,opt,name=base_minimum_deposit,json=baseMinimumDeposit,proto3" json:"base_minimum_deposit,omitempty"`
+	// Maximum slippage a user may encounter when adding liquidity, where 1e6 is 100%.
+	MaxAddLiquiditySlippagePercentage int64 `protobuf:"varint,2,opt,name=max_add_liquidity_slippage_percentage,json=maxAddLiquiditySlippagePercentage,proto3" json:"max_add_liquidity_slippage_percentage,omitempty"`
+	// Number of blocks between the StableSwap unbonding BeginBlocker executions.
+	UnbondingBlockDelta int64 `protobuf:"varint,3,opt,name=unbonding_block_delta,json=unbondingBlockDelta,proto3" json:"unbonding_block_delta,omitempty"`
+	// Settings of the permissionless pool creation, which is disabled when unset.
+	PermissionlessPoolCreation *PermissionlessPoolCreation `protobuf:"bytes,4,opt,name=permissionless_pool_creation,json=permissionlessPoolCreation,proto3" json:"permissionless_pool_creation,omitempty"`
+	// Minimum delay, in seconds, between the scheduling of a pool update and its execution.
+	MinPoolUpdateDelay int64 `protobuf:"varint,5,opt,name=min_pool_update_delay,json=minPoolUpdateDelay,proto3" json:"min_pool_update_delay,omitempty"`
+	// Limits of the amplification coefficient ramps, the default limits are used when unset.
+	RampLimits *RampLimits `protobuf:"bytes,6,opt,name=ramp_limits,json=rampLimits,proto3" json:"ramp_limits,omitempty"`
+	// Limits of the incentive programs, the default limits are used when unset.
+	IncentiveLimits *IncentiveLimits `protobuf:"bytes,7,opt,name=incentive_limits,json=incentiveLimits,proto3" json:"incentive_limits,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c439ff931c09e950, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBaseMinimumDeposit() int64 {
+	if m != nil {
+		return m.BaseMinimumDeposit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAddLiquiditySlippagePercentage() int64 {
+	if m != nil {
+		return m.MaxAddLiquiditySlippagePercentage
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingBlockDelta() int64 {
+	if m != nil {
+		return m.UnbondingBlockDelta
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionlessPoolCreation() *PermissionlessPoolCreation {
+	if m != nil {
+		return m.PermissionlessPoolCreation
+	}
+	return nil
+}
+
+func (m *Params) GetMinPoolUpdateDelay() int64 {
+	if m != nil {
+		return m.MinPoolUpdateDelay
+	}
+	return 0
+}
+
+func (m *Params) GetRampLimits() *RampLimits {
+	if m != nil {
+		return m.RampLimits
+	}
+	return nil
+}
+
+func (m *Params) GetIncentiveLimits() *IncentiveLimits {
+	if m != nil {
+		return m.IncentiveLimits
+	}
+	return nil
+}
+
+type IncentiveLimits struct {
+	// Fee paid by the accounts creating an incentive program.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee"`
+	// Whether the creation fee funds the community pool, it is burned otherwise.
+	FundCommunityPool bool `protobuf:"varint,2,opt,name=fund_community_pool,json=fundCommunityPool,proto3" json:"fund_community_pool,omitempty"`
+	// Maximum duration between the start and the end time of an incentive program.
+	MaxDuration time.Duration `protobuf:"bytes,3,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+	// Maximum number of incentive programs, started or not, streaming to the same pool.
+	MaxActivePerPool uint64 `protobuf:"varint,4,opt,name=max_active_per_pool,json=maxActivePerPool,proto3" json:"max_active_per_pool,omitempty"`
+}
+
+func (m *IncentiveLimits) Reset()         { *m = IncentiveLimits{} }
+func (m *IncentiveLimits) String() string { return proto.CompactTextString(m) }
+func (*IncentiveLimits) ProtoMessage()    {}
+func (*IncentiveLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c439ff931c09e950, []int{1}
+}
+func (m *IncentiveLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveLimits.Merge(m, src)
+}
+func (m *IncentiveLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveLimits proto.InternalMessageInfo
+
+func (m *IncentiveLimits) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
+func (m *IncentiveLimits) GetFundCommunityPool() bool {
+	if m != nil {
+		return m.FundCommunityPool
+	}
+	return false
+}
+
+func (m *IncentiveLimits) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *IncentiveLimits) GetMaxActivePerPool() uint64 {
+	if m != nil {
+		return m.MaxActivePerPool
+	}
+	return 0
+}
+
+type RampLimits struct {
+	// Maximum factor by which the amplification coefficient can increase or decrease over a single ramp.
+	MaxAChange int64 `protobuf:"varint,1,opt,name=max_a_change,json=maxAChange,proto3" json:"max_a_change,omitempty"`
+	// Minimum duration, in seconds, of a ramp, which is also required between the start of two ramps.
+	MinRampDuration int64 `protobuf:"varint,2,opt,name=min_ramp_duration,json=minRampDuration,proto3" json:"min_ramp_duration,omitempty"`
+}
+
+func (m *RampLimits) Reset()         { *m = RampLimits{} }
+func (m *RampLimits) String() string { return proto.CompactTextString(m) }
+func (*RampLimits) ProtoMessage()    {}
+func (*RampLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c439ff931c09e950, []int{2}
+}
+func (m *RampLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RampLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RampLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RampLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampLimits.Merge(m, src)
+}
+func (m *RampLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *RampLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampLimits proto.InternalMessageInfo
+
+func (m *RampLimits) GetMaxAChange() int64 {
+	if m != nil {
+		return m.MaxAChange
+	}
+	return 0
+}
+
+func (m *RampLimits) GetMinRampDuration() int64 {
+	if m != nil {
+		return m.MinRampDuration
+	}
+	return 0
+}
+
+type PermissionlessPoolCreation struct {
+	// Fee paid by the accounts creating a pool without the pool manager role.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee"`
+	// Whether the creation fee funds the community pool, it is burned otherwise.
+	FundCommunityPool bool `protobuf:"varint,2,opt,name=fund_community_pool,json=fundCommunityPool,proto3" json:"fund_community_pool,omitempty"`
+	// Minimum amplification coefficient of the created pools.
+	MinA int64 `protobuf:"varint,3,opt,name=min_a,json=minA,proto3" json:"min_a,omitempty"`
+	// Maximum amplification coefficient of the created pools.
+	MaxA int64 `protobuf:"varint,4,opt,name=max_a,json=maxA,proto3" json:"max_a,omitempty"`
+	// Maximum rewards fee of the created pools.
+	MaxRewardsFee int64 `protobuf:"varint,5,opt,name=max_rewards_fee,json=maxRewardsFee,proto3" json:"max_rewards_fee,omitempty"`
+	// Maximum protocol fee percentage of the created pools.
+	MaxProtocolFeePercentage int64 `protobuf:"varint,6,opt,name=max_protocol_fee_percentage,json=maxProtocolFeePercentage,proto3" json:"max_protocol_fee_percentage,omitempty"`
+	// Maximum relative deviation between the pair and the base rate multipliers of the created pools.
+	MaxRateMultiplierDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_rate_multiplier_deviation,json=maxRateMultiplierDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate_multiplier_deviation"`
+	// Maximum exit fee percentage of the created pools exit fee tiers, which cannot be set when zero.
+	MaxExitFeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_exit_fee_percentage,json=maxExitFeePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_exit_fee_percentage"`
+	// Maximum unbonding duration of the created pools unbonding tiers, which cannot be set when zero.
+	MaxUnbondingDuration time.Duration `protobuf:"bytes,9,opt,name=max_unbonding_duration,json=maxUnbondingDuration,proto3,stdduration" json:"max_unbonding_duration"`
+}
+
+func (m *PermissionlessPoolCreation) Reset()         { *m = PermissionlessPoolCreation{} }
+func (m *PermissionlessPoolCreation) String() string { return proto.CompactTextString(m) }
+func (*PermissionlessPoolCreation) ProtoMessage()    {}
+func (*PermissionlessPoolCreation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c439ff931c09e950, []int{3}
+}
+func (m *PermissionlessPoolCreation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermissionlessPoolCreation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermissionlessPoolCreation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermissionlessPoolCreation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionlessPoolCreation.Merge(m, src)
+}
+func (m *PermissionlessPoolCreation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermissionlessPoolCreation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionlessPoolCreation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionlessPoolCreation proto.InternalMessageInfo
+
+func (m *PermissionlessPoolCreation) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
+func (m *PermissionlessPoolCreation) GetFundCommunityPool() bool {
+	if m != nil {
+		return m.FundCommunityPool
+	}
+	return false
+}
+
+func (m *PermissionlessPoolCreation) GetMinA() int64 {
+	if m != nil {
+		return m.MinA
+	}
+	return 0
+}
+
+func (m *PermissionlessPoolCreation) GetMaxA() int64 {
+	if m != nil {
+		return m.MaxA
+	}
+	return 0
+}
+
+func (m *PermissionlessPoolCreation) GetMaxRewardsFee() int64 {
+	if m != nil {
+		return m.MaxRewardsFee
+	}
+	return 0
+}
+
+func (m *PermissionlessPoolCreation) GetMaxProtocolFeePercentage() int64 {
+	if m != nil {
+		return m.MaxProtocolFeePercentage
+	}
+	return 0
+}
+
+func (m *PermissionlessPoolCreation) GetMaxUnbondingDuration() time.Duration {
+	if m != nil {
+		return m.MaxUnbondingDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "noble.swap.v1.Params")
+	proto.RegisterType((*IncentiveLimits)(nil), "noble.swap.v1.IncentiveLimits")
+	proto.RegisterType((*RampLimits)(nil), "noble.swap.v1.RampLimits")
+	proto.RegisterType((*PermissionlessPoolCreation)(nil), "noble.swap.v1.PermissionlessPoolCreation")
+}
+
+func init() { proto.RegisterFile("noble/swap/v1/params.proto", fileDescriptor_c439ff931c09e950) }
+
+var fileDescriptor_c439ff931c09e950 = []byte{
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x9b, 0x1f, 0xa4, 0x93, 0x54, 0x69, 0x26, 0x29, 0x6c, 0xb7, 0xb0, 0x09, 0x91, 0x40,
+	0x21, 0x52, 0x6c, 0xb6, 0x48, 0x1c, 0x90, 0x38, 0x24, 0x59, 0x22, 0x55, 0x4a, 0xa5, 0x95, 0x51,
+	0x0f, 0xf4, 0x32, 0x9a, 0xb5, 0x5f, 0x9c, 0x51, 0x3c, 0x33, 0xc6, 0x33, 0xde, 0x7a, 0x39, 0xf3,
+	0x07, 0x70, 0x44, 0xfc, 0x05, 0xc0, 0xa9, 0x07, 0xee, 0x5c, 0x7b, 0xac, 0x38, 0x21, 0x0e, 0x2d,
+	0x4a, 0x0e, 0xf9, 0x37, 0xd0, 0x9b, 0xb1, 0xb7, 0xcd, 0x4a, 0x91, 0x90, 0x38, 0x71, 0xd9, 0xb5,
+	0xdf, 0xf7, 0xe6, 0x7b, 0x9f, 0xbf, 0xf9, 0x66, 0x48, 0x57, 0xe9, 0x51, 0x0e, 0x91, 0x79, 0xc6,
+	0x8b, 0x68, 0xdc, 0x8f, 0x0a, 0x5e, 0x72, 0x69, 0xc2, 0xa2, 0xd4, 0x56, 0xd3, 0x3b, 0x0e, 0x0b,
+	0x11, 0x0b, 0xc7, 0xfd, 0xee, 0x3a, 0x97, 0x42, 0xe9, 0xc8, 0xfd, 0xfa, 0x8e, 0x6e, 0x2f, 0xd1,
+	0x46, 0x6a, 0x13, 0x8d, 0xb8, 0x81, 0x68, 0xdc, 0x1f, 0x81, 0xe5, 0xfd, 0x28, 0xd1, 0x42, 0x35,
+	0xf8, 0x7d, 0x8f, 0x33, 0xf7, 0x16, 0xf9, 0x97, 0x06, 0xda, 0xcc, 0x74, 0xa6, 0x7d, 0x1d, 0x9f,
+	0x5a, 0xc2, 0x4c, 0xeb, 0x2c, 0x87, 0xc8, 0xbd, 0x8d, 0xaa, 0xd3, 0x28, 0xad, 0x4a, 0x6e, 0x85,
+	0x6e, 0x08, 0x77, 0xae, 0xe6, 0xc9, 0xd2, 0xd0, 0x69, 0xa4, 0x9f, 0x92, 0x4d, 0x1c, 0xcb, 0xa4,
+	0x50, 0x42, 0x56, 0x92, 0xa5, 0x50, 0x68, 0x23, 0x6c, 0x27, 0xd8, 0x0e, 0x76, 0xe7, 0x63, 0x8a,
+	0xd8, 0x63, 0x0f, 0x0d, 0x3c, 0x42, 0x87, 0xe4, 0x23, 0xc9, 0x6b, 0xc6, 0xd3, 0x94, 0xe5, 0xe2,
+	0xdb, 0x4a, 0xa4, 0xc2, 0x4e, 0x98, 0xc9, 0x45, 0x51, 0xf0, 0x0c, 0x58, 0x01, 0x65, 0x02, 0xca,
+	0xf2, 0x0c, 0x3a, 0xb7, 0x1c, 0xc5, 0x87, 0x92, 0xd7, 0x07, 0x69, 0x7a, 0xd2, 0xb6, 0x7e, 0xdd,
+	0x74, 0x0e, 0xa7, 0x8d, 0xf4, 0x21, 0xb9, 0x57, 0xa9, 0x91, 0x56, 0xa9, 0x50, 0x19, 0x1b, 0xe5,
+	0x3a, 0x39, 0x67, 0x29, 0xe4, 0x96, 0x77, 0xe6, 0x1d, 0xc3, 0xc6, 0x14, 0x3c, 0x44, 0x6c, 0x80,
+	0x10, 0x3d, 0x27, 0xef, 0x17, 0x50, 0x4a, 0x61, 0x8c, 0xd0, 0x2a, 0x07, 0x63, 0x58, 0xa1, 0x75,
+	0xce, 0x92, 0x12, 0xdc, 0x87, 0x76, 0x16, 0xb6, 0x83, 0xdd, 0x95, 0x87, 0x9f, 0x84, 0xd7, 0xcc,
+	0x0f, 0x87, 0xd7, 0x96, 0x0c, 0xb5, 0xce, 0x8f, 0x9a, 0x05, 0x71, 0xb7, 0xb8, 0x11, 0xa3, 0x7d,
+	0x72, 0x4f, 0x0a, 0xe5, 0x27, 0x54, 0x45, 0xca, 0x2d, 0xa0, 0x40, 0x3e, 0xe9, 0x2c, 0x7a, 0x97,
+	0xa4, 0x50, 0xd8, 0xff, 0xc4, 0x41, 0x03, 0x44, 0xe8, 0x17, 0x64, 0xa5, 0xe4, 0xb2, 0x60, 0xb9,
+	0x90, 0xc2, 0x9a, 0xce, 0x92, 0x93, 0x73, 0x7f, 0x46, 0x4e, 0xcc, 0x65, 0x71, 0xe2, 0x1a, 0x62,
+	0x52, 0x4e, 0x9f, 0xe9, 0x23, 0x72, 0x57, 0x28, 0x34, 0x47, 0x8c, 0xa1, 0x25, 0x78, 0xc7, 0x11,
+	0xf4, 0x66, 0x08, 0x1e, 0xb5, 0x6d, 0x0d, 0xcb, 0x9a, 0xb8, 0x5e, 0xd8, 0xf9, 0xfd, 0x16, 0x59,
+	0x9b, 0x69, 0xa2, 0xdf, 0x07, 0x64, 0xb5, 0xf5, 0x89, 0x9d, 0x02, 0x74, 0x82, 0xed, 0x79, 0x27,
+	0xae, 0x49, 0x16, 0xee, 0x79, 0xd8, 0xc4, 0x30, 0x3c, 0xd2, 0x42, 0x1d, 0x1e, 0xbf, 0x78, 0xb5,
+	0x35, 0xf7, 0xeb, 0xeb, 0xad, 0xdd, 0x4c, 0xd8, 0xb3, 0x6a, 0x14, 0x26, 0x5a, 0x36, 0x31, 0x6c,
+	0xfe, 0xf6, 0x4d, 0x7a, 0x1e, 0xd9, 0x49, 0x01, 0xc6, 0x2d, 0x30, 0x3f, 0x5d, 0x3d, 0xdf, 0x5b,
+	0xcd, 0x21, 0xe3, 0xc9, 0x84, 0x61, 0x90, 0xcd, 0xcf, 0x57, 0xcf, 0xf7, 0x82, 0x78, 0xa5, 0x1d,
+	0x7b, 0x0c, 0x40, 0x43, 0xb2, 0x71, 0x5a, 0xa9, 0x94, 0x25, 0x5a, 0xca, 0x4a, 0x61, 0x88, 0xd0,
+	0x5f, 0x97, 0x9a, 0xe5, 0x78, 0x1d, 0xa1, 0xa3, 0x16, 0x41, 0x73, 0xe9, 0x31, 0x59, 0xc5, 0xdc,
+	0xb5, 0x51, 0x76, 0xe1, 0x40, 0xd5, 0x3e, 0xeb, 0x61, 0x9b, 0xf5, 0x70, 0xd0, 0x34, 0x1c, 0x2e,
+	0xa3, 0xea, 0x1f, 0x5f, 0x6f, 0x05, 0xf1, 0x8a, 0xe4, 0x75, 0x5b, 0xa6, 0xfb, 0x64, 0xc3, 0xe5,
+	0x37, 0x71, 0xf6, 0x16, 0x50, 0xfa, 0xb9, 0x18, 0x98, 0x85, 0xf8, 0x2e, 0xa6, 0xd5, 0x21, 0x43,
+	0x28, 0x71, 0xec, 0xce, 0x53, 0x42, 0xde, 0x6c, 0x13, 0xdd, 0xf6, 0x22, 0x38, 0x4b, 0xce, 0xb8,
+	0xca, 0xa0, 0x39, 0x26, 0x04, 0x57, 0x1d, 0xb9, 0x0a, 0xdd, 0x23, 0xeb, 0x98, 0x15, 0xb7, 0xf9,
+	0x53, 0xad, 0xfe, 0x28, 0xac, 0x49, 0xa1, 0x90, 0xab, 0x95, 0xb2, 0xf3, 0xcb, 0x22, 0xe9, 0xde,
+	0x1c, 0xc9, 0xff, 0xeb, 0x46, 0x6d, 0x90, 0x45, 0x74, 0xa0, 0x3d, 0xbe, 0x0b, 0x52, 0xa8, 0x03,
+	0x57, 0x44, 0xe3, 0x9c, 0xcf, 0x58, 0xe4, 0xf5, 0x01, 0xfd, 0x98, 0xac, 0x61, 0xb1, 0x84, 0x67,
+	0xbc, 0x4c, 0x8d, 0xfb, 0x44, 0x7f, 0xa2, 0xee, 0x48, 0x5e, 0xc7, 0xbe, 0x8a, 0x0a, 0xbe, 0x24,
+	0x0f, 0xb0, 0xcf, 0x6d, 0x71, 0xa2, 0x73, 0x6c, 0x7c, 0xfb, 0xa2, 0x59, 0x72, 0x6b, 0x3a, 0x92,
+	0xd7, 0xc3, 0xa6, 0xe3, 0x18, 0xde, 0xbe, 0x5f, 0x26, 0xe4, 0x03, 0x37, 0x06, 0xcf, 0xad, 0xac,
+	0x72, 0x2b, 0x8a, 0x5c, 0x40, 0xc9, 0x52, 0x18, 0x0b, 0xbf, 0x3d, 0x78, 0xb8, 0x6e, 0x1f, 0x7e,
+	0x8e, 0xe6, 0xfd, 0xf5, 0x6a, 0xeb, 0x81, 0xb7, 0xca, 0xa4, 0xe7, 0xa1, 0xd0, 0x91, 0xe4, 0xf6,
+	0x2c, 0x3c, 0x71, 0x0e, 0x0d, 0x20, 0xf9, 0xe3, 0xb7, 0x7d, 0xd2, 0xb8, 0x3f, 0x80, 0xc4, 0x9b,
+	0xd5, 0x45, 0xb1, 0xdc, 0xc2, 0xe3, 0x29, 0xf5, 0xa0, 0x65, 0xa6, 0x92, 0xbc, 0x87, 0xa3, 0xa1,
+	0x16, 0x76, 0x56, 0xf5, 0xf2, 0x7f, 0x1a, 0xba, 0x29, 0x79, 0xfd, 0x55, 0x2d, 0xec, 0xf5, 0x2f,
+	0xfd, 0x86, 0xbc, 0x8b, 0xe3, 0xde, 0xdc, 0xa6, 0xd3, 0x04, 0xde, 0xfe, 0xf7, 0xa7, 0x05, 0xa9,
+	0x9f, 0xb4, 0x0c, 0x53, 0x3c, 0x7c, 0x71, 0xd1, 0x0b, 0x5e, 0x5e, 0xf4, 0x82, 0xbf, 0x2f, 0x7a,
+	0xc1, 0x0f, 0x97, 0xbd, 0xb9, 0x97, 0x97, 0xbd, 0xb9, 0x3f, 0x2f, 0x7b, 0x73, 0x4f, 0x37, 0xdd,
+	0x6d, 0xe4, 0x2f, 0xa6, 0x7a, 0xf2, 0x9d, 0x8f, 0xd7, 0x68, 0xc9, 0x8d, 0xf8, 0xec, 0x9f, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x8f, 0xb7, 0xab, 0xc6, 0x1b, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncentiveLimits != nil {
+		{
+			size, err := m.IncentiveLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RampLimits != nil {
+		{
+			size, err := m.RampLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinPoolUpdateDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPoolUpdateDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PermissionlessPoolCreation != nil {
+		{
+			size, err := m.PermissionlessPoolCreation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UnbondingBlockDelta != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnbondingBlockDelta))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAddLiquiditySlippagePercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAddLiquiditySlippagePercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseMinimumDeposit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseMinimumDeposit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxActivePerPool != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActivePerPool))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.FundCommunityPool {
+		i--
+		if m.FundCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RampLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RampLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RampLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinRampDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinRampDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAChange != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAChange))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermissionlessPoolCreation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermissionlessPoolCreation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermissionlessPoolCreation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxUnbondingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxUnbondingDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxExitFeePercentage.Size()
+		i -= size
+		if _, err := m.MaxExitFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxRateMultiplierDeviation.Size()
+		i -= size
+		if _, err := m.MaxRateMultiplierDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxProtocolFeePercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProtocolFeePercentage))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRewardsFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRewardsFee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxA != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxA))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinA != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinA))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FundCommunityPool {
+		i--
+		if m.FundCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseMinimumDeposit != 0 {
+		n += 1 + sovParams(uint64(m.BaseMinimumDeposit))
+	}
+	if m.MaxAddLiquiditySlippagePercentage != 0 {
+		n += 1 + sovParams(uint64(m.MaxAddLiquiditySlippagePercentage))
+	}
+	if m.UnbondingBlockDelta != 0 {
+		n += 1 + sovParams(uint64(m.UnbondingBlockDelta))
+	}
+	if m.PermissionlessPoolCreation != nil {
+		l = m.PermissionlessPoolCreation.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinPoolUpdateDelay != 0 {
+		n += 1 + sovParams(uint64(m.MinPoolUpdateDelay))
+	}
+	if m.RampLimits != nil {
+		l = m.RampLimits.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.IncentiveLimits != nil {
+		l = m.IncentiveLimits.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FundCommunityPool {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxActivePerPool != 0 {
+		n += 1 + sovParams(uint64(m.MaxActivePerPool))
+	}
+	return n
+}
+
+func (m *RampLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAChange != 0 {
+		n += 1 + sovParams(uint64(m.MaxAChange))
+	}
+	if m.MinRampDuration != 0 {
+		n += 1 + sovParams(uint64(m.MinRampDuration))
+	}
+	return n
+}
+
+func (m *PermissionlessPoolCreation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FundCommunityPool {
+		n += 2
+	}
+	if m.MinA != 0 {
+		n += 1 + sovParams(uint64(m.MinA))
+	}
+	if m.MaxA != 0 {
+		n += 1 + sovParams(uint64(m.MaxA))
+	}
+	if m.MaxRewardsFee != 0 {
+		n += 1 + sovParams(uint64(m.MaxRewardsFee))
+	}
+	if m.MaxProtocolFeePercentage != 0 {
+		n += 1 + sovParams(uint64(m.MaxProtocolFeePercentage))
+	}
+	l = m.MaxRateMultiplierDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxExitFeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxUnbondingDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMinimumDeposit", wireType)
+			}
+			m.BaseMinimumDeposit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseMinimumDeposit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAddLiquiditySlippagePercentage", wireType)
+			}
+			m.MaxAddLiquiditySlippagePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAddLiquiditySlippagePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBlockDelta", wireType)
+			}
+			m.UnbondingBlockDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingBlockDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessPoolCreation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PermissionlessPoolCreation == nil {
+				m.PermissionlessPoolCreation = &PermissionlessPoolCreation{}
+			}
+			if err := m.PermissionlessPoolCreation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolUpdateDelay", wireType)
+			}
+			m.MinPoolUpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoolUpdateDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RampLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RampLimits == nil {
+				m.RampLimits = &RampLimits{}
+			}
+			if err := m.RampLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IncentiveLimits == nil {
+				m.IncentiveLimits = &IncentiveLimits{}
+			}
+			if err := m.IncentiveLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundCommunityPool = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActivePerPool", wireType)
+			}
+			m.MaxActivePerPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActivePerPool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RampLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RampLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RampLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAChange", wireType)
+			}
+			m.MaxAChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAChange |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRampDuration", wireType)
+			}
+			m.MinRampDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRampDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermissionlessPoolCreation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermissionlessPoolCreation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermissionlessPoolCreation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundCommunityPool = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinA", wireType)
+			}
+			m.MinA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxA", wireType)
+			}
+			m.MaxA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardsFee", wireType)
+			}
+			m.MaxRewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProtocolFeePercentage", wireType)
+			}
+			m.MaxProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateMultiplierDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRateMultiplierDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExitFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxExitFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxUnbondingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "fmt"
+
+// PauseActivities contains all the pool activities that can be paused, ordered by their flag.
+var PauseActivities = []PauseActivity{
+	PAUSE_ACTIVITY_SWAPS,
+	PAUSE_ACTIVITY_ADD_LIQUIDITY,
+	PAUSE_ACTIVITY_REMOVE_LIQUIDITY,
+	PAUSE_ACTIVITY_REWARD_CLAIMS,
+	PAUSE_ACTIVITY_UNBONDING,
+}
+
+// ActivitiesToFlags combines the activities into the pause flags bitmask, ensuring that they are
+// non-empty and valid.
+func ActivitiesToFlags(activities []PauseActivity) (uint32, error) {
+	if len(activities) == 0 {
+		return 0, fmt.Errorf("at least one activity is required")
+	}
+
+	var flags uint32
+	for _, activity := range activities {
+		if _, ok := PauseActivity_name[int32(activity)]; !ok || activity == PAUSE_ACTIVITY_UNSPECIFIED {
+			return 0, fmt.Errorf("unknown activity %d", activity)
+		}
+		flags |= uint32(activity)
+	}
+	return flags, nil
+}
+
+// FlagsToActivities expands the pause flags bitmask into the list of paused activities.
+func FlagsToActivities(flags uint32) []PauseActivity {
+	var activities []PauseActivity
+	for _, activity := range PauseActivities {
+		if flags&uint32(activity) != 0 {
+			activities = append(activities, activity)
+		}
+	}
+	return activities
+}
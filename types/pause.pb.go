@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/swap/v1/pause.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PauseActivity defines the pool activities that can be paused independently, each represented by a bit of the
+// pool pause flags.
+type PauseActivity int32
+
+const (
+	PAUSE_ACTIVITY_UNSPECIFIED PauseActivity = 0
+	// Swaps routed through the pool, including the protocol fees conversions.
+	PAUSE_ACTIVITY_SWAPS PauseActivity = 1
+	// Deposits of liquidity into the pool.
+	PAUSE_ACTIVITY_ADD_LIQUIDITY PauseActivity = 2
+	// Removals of liquidity from the pool.
+	PAUSE_ACTIVITY_REMOVE_LIQUIDITY PauseActivity = 4
+	// Claims of the pool rewards.
+	PAUSE_ACTIVITY_REWARD_CLAIMS PauseActivity = 8
+	// Processing of the pool expired locks and pending unbondings.
+	PAUSE_ACTIVITY_UNBONDING PauseActivity = 16
+)
+
+var PauseActivity_name = map[int32]string{
+	0:  "PAUSE_ACTIVITY_UNSPECIFIED",
+	1:  "PAUSE_ACTIVITY_SWAPS",
+	2:  "PAUSE_ACTIVITY_ADD_LIQUIDITY",
+	4:  "PAUSE_ACTIVITY_REMOVE_LIQUIDITY",
+	8:  "PAUSE_ACTIVITY_REWARD_CLAIMS",
+	16: "PAUSE_ACTIVITY_UNBONDING",
+}
+
+var PauseActivity_value = map[string]int32{
+	"PAUSE_ACTIVITY_UNSPECIFIED":      0,
+	"PAUSE_ACTIVITY_SWAPS":            1,
+	"PAUSE_ACTIVITY_ADD_LIQUIDITY":    2,
+	"PAUSE_ACTIVITY_REMOVE_LIQUIDITY": 4,
+	"PAUSE_ACTIVITY_REWARD_CLAIMS":    8,
+	"PAUSE_ACTIVITY_UNBONDING":        16,
+}
+
+func (x PauseActivity) String() string {
+	return proto.EnumName(PauseActivity_name, int32(x))
+}
+
+func (PauseActivity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_385763aea4e63af0, []int{0}
+}
+
+type PausedActivities struct {
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Activities paused in the pool.
+	Activities []PauseActivity `protobuf:"varint,2,rep,packed,name=activities,proto3,enum=noble.swap.v1.PauseActivity" json:"activities,omitempty"`
+}
+
+func (m *PausedActivities) Reset()         { *m = PausedActivities{} }
+func (m *PausedActivities) String() string { return proto.CompactTextString(m) }
+func (*PausedActivities) ProtoMessage()    {}
+func (*PausedActivities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_385763aea4e63af0, []int{0}
+}
+func (m *PausedActivities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedActivities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedActivities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedActivities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedActivities.Merge(m, src)
+}
+func (m *PausedActivities) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedActivities) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedActivities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedActivities proto.InternalMessageInfo
+
+func (m *PausedActivities) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PausedActivities) GetActivities() []PauseActivity {
+	if m != nil {
+		return m.Activities
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("noble.swap.v1.PauseActivity", PauseActivity_name, PauseActivity_value)
+	proto.RegisterType((*PausedActivities)(nil), "noble.swap.v1.PausedActivities")
+}
+
+func init() { proto.RegisterFile("noble/swap/v1/pause.proto", fileDescriptor_385763aea4e63af0) }
+
+var fileDescriptor_385763aea4e63af0 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0xcb, 0x4f, 0xca,
+	0x49, 0xd5, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x2d, 0x4e, 0xd5,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0x4b, 0xe9, 0x81, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
+	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x52, 0x26, 0x97, 0x40,
+	0x00, 0x48, 0x4f, 0x8a, 0x63, 0x72, 0x49, 0x66, 0x59, 0x66, 0x49, 0x66, 0x6a, 0xb1, 0x90, 0x38,
+	0x17, 0x7b, 0x41, 0x7e, 0x7e, 0x4e, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10,
+	0x1b, 0x88, 0xeb, 0x99, 0x22, 0x64, 0xc3, 0xc5, 0x95, 0x08, 0x57, 0x26, 0xc1, 0xa4, 0xc0, 0xac,
+	0xc1, 0x67, 0x24, 0xa3, 0x87, 0x62, 0x8d, 0x1e, 0xd8, 0x34, 0xa8, 0x61, 0x95, 0x41, 0x48, 0xea,
+	0xb5, 0xae, 0x31, 0x72, 0xf1, 0xa2, 0xc8, 0x0a, 0xc9, 0x71, 0x49, 0x05, 0x38, 0x86, 0x06, 0xbb,
+	0xc6, 0x3b, 0x3a, 0x87, 0x78, 0x86, 0x79, 0x86, 0x44, 0xc6, 0x87, 0xfa, 0x05, 0x07, 0xb8, 0x3a,
+	0x7b, 0xba, 0x79, 0xba, 0xba, 0x08, 0x30, 0x08, 0x49, 0x70, 0x89, 0xa0, 0xc9, 0x07, 0x87, 0x3b,
+	0x06, 0x04, 0x0b, 0x30, 0x0a, 0x29, 0x70, 0xc9, 0xa0, 0xc9, 0x38, 0xba, 0xb8, 0xc4, 0xfb, 0x78,
+	0x06, 0x86, 0x7a, 0xba, 0x78, 0x86, 0x44, 0x0a, 0x30, 0x09, 0x29, 0x73, 0xc9, 0xa3, 0xa9, 0x08,
+	0x72, 0xf5, 0xf5, 0x0f, 0x73, 0x45, 0x52, 0xc4, 0x82, 0xc5, 0x98, 0x20, 0xd7, 0x70, 0xc7, 0x20,
+	0x97, 0x78, 0x67, 0x1f, 0x47, 0x4f, 0xdf, 0x60, 0x01, 0x0e, 0x21, 0x19, 0x2e, 0x09, 0x0c, 0x27,
+	0x3a, 0xf9, 0xfb, 0xb9, 0x78, 0xfa, 0xb9, 0x0b, 0x08, 0x48, 0xb1, 0x74, 0x2c, 0x96, 0x63, 0x70,
+	0xd2, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
+	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x11, 0x70, 0xa8, 0x40,
+	0x02, 0xa8, 0xa2, 0xb2, 0x4a, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xf4, 0xc6,
+	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0x50, 0x93, 0xf7, 0xbc, 0x01, 0x00, 0x00,
+}
+
+func (m *PausedActivities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedActivities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedActivities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Activities) > 0 {
+		dAtA2 := make([]byte, len(m.Activities)*10)
+		var j1 int
+		for _, num := range m.Activities {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPause(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPause(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPause(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PausedActivities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPause(uint64(m.PoolId))
+	}
+	if len(m.Activities) > 0 {
+		l = 0
+		for _, e := range m.Activities {
+			l += sovPause(uint64(e))
+		}
+		n += 1 + sovPause(uint64(l)) + l
+	}
+	return n
+}
+
+func sovPause(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPause(x uint64) (n int) {
+	return sovPause(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PausedActivities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedActivities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedActivities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v PauseActivity
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPause
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PauseActivity(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Activities = append(m.Activities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPause
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPause
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPause
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Activities) == 0 {
+					m.Activities = make([]PauseActivity, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PauseActivity
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPause
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PauseActivity(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Activities = append(m.Activities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activities", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPause(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPause
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPause(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPause
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPause
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPause
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPause        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPause          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPause = fmt.Errorf("proto: unexpected end of group")
+)
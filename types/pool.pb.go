@@ -37,6 +37,8 @@ type Pool struct {
 	Algorithm Algorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=noble.swap.v1.Algorithm" json:"algorithm,omitempty"`
 	// Pair asset denom in the pool.
 	Pair string `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Whether the pool has been permanently closed.
+	Closed bool `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -100,6 +102,13 @@ func (m *Pool) GetPair() string {
 	return ""
 }
 
+func (m *Pool) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 type PoolDetails struct {
 	// ID of the Pool.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -117,6 +126,8 @@ type PoolDetails struct {
 	ProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees"`
 	// Amount of rewards fees currently collected.
 	RewardFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=reward_fees,json=rewardFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_fees"`
+	// Whether the pool has been permanently closed.
+	Closed bool `protobuf:"varint,9,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
 func (m *PoolDetails) Reset()         { *m = PoolDetails{} }
@@ -208,6 +219,13 @@ func (m *PoolDetails) GetRewardFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PoolDetails) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Pool)(nil), "noble.swap.v1.Pool")
 	proto.RegisterType((*PoolDetails)(nil), "noble.swap.v1.PoolDetails")
@@ -216,38 +234,39 @@ func init() {
 func init() { proto.RegisterFile("noble/swap/v1/pool.proto", fileDescriptor_102f059b6e5555f8) }
 
 var fileDescriptor_102f059b6e5555f8 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xa9, 0x69, 0xc8, 0x25, 0xad, 0xe0, 0x94, 0xe1, 0x5a, 0x09, 0xd7, 0xea, 0x64,
-	0x55, 0xca, 0x9d, 0x12, 0x24, 0x66, 0x12, 0x50, 0x67, 0x64, 0x36, 0x96, 0xe8, 0x6c, 0x5f, 0xdd,
-	0x13, 0x8e, 0x5f, 0xe3, 0x73, 0x52, 0xcc, 0x82, 0xc4, 0xc0, 0xcc, 0xc4, 0xc0, 0x27, 0x40, 0x4c,
-	0x1d, 0xfa, 0x21, 0x2a, 0xa6, 0x8a, 0x89, 0x09, 0x50, 0x32, 0x74, 0xe3, 0x33, 0x20, 0xfb, 0x6c,
-	0xaa, 0x7e, 0x81, 0xa8, 0x8b, 0x7d, 0xe7, 0xe7, 0xfd, 0xf3, 0x7b, 0xfd, 0x3e, 0x98, 0x26, 0xe0,
-	0xc7, 0x92, 0xeb, 0x33, 0x91, 0xf2, 0xe5, 0x88, 0xa7, 0x00, 0x31, 0x4b, 0x33, 0xc8, 0x81, 0xec,
-	0x54, 0x0a, 0x2b, 0x15, 0xb6, 0x1c, 0xed, 0x3f, 0x14, 0x73, 0x95, 0x00, 0xaf, 0x9e, 0x26, 0x62,
-	0xdf, 0x0e, 0x40, 0xcf, 0x41, 0x73, 0x5f, 0x68, 0xc9, 0x97, 0x23, 0x5f, 0xe6, 0x62, 0xc4, 0x03,
-	0x50, 0x49, 0xad, 0xef, 0x19, 0x7d, 0x56, 0xdd, 0xb8, 0xb9, 0xd4, 0xd2, 0x20, 0x82, 0x08, 0xcc,
-	0xf7, 0xf2, 0xd4, 0x24, 0x44, 0x00, 0x51, 0x2c, 0x79, 0x75, 0xf3, 0x17, 0x27, 0x5c, 0x24, 0x45,
-	0x2d, 0x3d, 0xba, 0xcd, 0x29, 0xe2, 0x08, 0x32, 0x95, 0x9f, 0xce, 0x8d, 0x7c, 0xf8, 0x19, 0x61,
-	0xeb, 0x05, 0x40, 0x4c, 0x76, 0x71, 0x5b, 0x85, 0x14, 0x39, 0xc8, 0xb5, 0xbc, 0xb6, 0x0a, 0xc9,
-	0x18, 0x77, 0x44, 0x18, 0x66, 0x52, 0x6b, 0xda, 0x76, 0x90, 0xdb, 0x9d, 0xd2, 0x1f, 0x17, 0xc3,
-	0x41, 0xcd, 0x32, 0x31, 0xca, 0xcb, 0x3c, 0x53, 0x49, 0xe4, 0x35, 0x81, 0xe4, 0x09, 0xee, 0xfe,
-	0xaf, 0x4f, 0xb7, 0x1c, 0xe4, 0xee, 0x8e, 0x29, 0xbb, 0xf5, 0x37, 0xd8, 0xa4, 0xd1, 0xbd, 0x9b,
-	0x50, 0x42, 0xb0, 0x95, 0x0a, 0x95, 0x51, 0xab, 0x6c, 0xe4, 0x55, 0xe7, 0xc3, 0xbf, 0x16, 0xee,
-	0x95, 0x60, 0xcf, 0x65, 0x2e, 0x54, 0xac, 0xef, 0x1a, 0x1f, 0x79, 0x8a, 0x3b, 0xa1, 0x41, 0xa3,
-	0xf7, 0x1c, 0xe4, 0xf6, 0xc6, 0x03, 0x66, 0x96, 0xc0, 0x9a, 0x25, 0xb0, 0x49, 0x52, 0x4c, 0x1f,
-	0x7c, 0xbf, 0x18, 0xf6, 0x9b, 0xe2, 0xe5, 0x3c, 0x5e, 0x93, 0x46, 0xde, 0xe3, 0x6e, 0xac, 0xde,
-	0x2c, 0x54, 0xa8, 0xf2, 0x82, 0x6e, 0x3b, 0x5b, 0x6e, 0x6f, 0xbc, 0xc7, 0xea, 0x01, 0x4a, 0x67,
-	0xb0, 0xda, 0x19, 0xec, 0x19, 0xa8, 0x64, 0x7a, 0x7c, 0xf9, 0xeb, 0xa0, 0xf5, 0xed, 0xf7, 0x81,
-	0x1b, 0xa9, 0xfc, 0x74, 0xe1, 0xb3, 0x00, 0xe6, 0xb5, 0x33, 0xea, 0xd7, 0x50, 0x87, 0xaf, 0x79,
-	0x5e, 0xa4, 0x52, 0x57, 0x09, 0xfa, 0xcb, 0xf5, 0xf9, 0x51, 0x3f, 0x96, 0x91, 0x08, 0x8a, 0x59,
-	0xe9, 0x2d, 0xfd, 0xf5, 0xfa, 0xfc, 0x08, 0x79, 0x37, 0x3d, 0xc9, 0x47, 0x84, 0x77, 0x2a, 0xd8,
-	0x00, 0xe2, 0xd9, 0x89, 0x94, 0x9a, 0x76, 0x36, 0x45, 0xd1, 0x6f, 0xfa, 0x1e, 0x4b, 0xa9, 0xc9,
-	0x07, 0x84, 0x7b, 0x99, 0x3c, 0x13, 0x59, 0x68, 0x30, 0xee, 0x6f, 0x0a, 0x03, 0x9b, 0xae, 0x25,
-	0xc4, 0x94, 0x5d, 0xae, 0x6c, 0x74, 0xb5, 0xb2, 0xd1, 0x9f, 0x95, 0x8d, 0x3e, 0xad, 0xed, 0xd6,
-	0xd5, 0xda, 0x6e, 0xfd, 0x5c, 0xdb, 0xad, 0x57, 0x83, 0x6a, 0x7f, 0xc6, 0x27, 0x6f, 0x8b, 0x77,
-	0xa6, 0xae, 0xbf, 0x5d, 0x8d, 0xf0, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0x27, 0x52,
-	0x14, 0x09, 0x04, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xb3, 0x69, 0x9a, 0x34, 0x9b, 0xb4, 0xfa, 0x3e, 0x2b, 0x42, 0xdb, 0x4a, 0xb8, 0x51,
+	0x4f, 0x56, 0xa5, 0xec, 0x2a, 0x41, 0xe2, 0x4c, 0x02, 0xea, 0x19, 0x99, 0x1b, 0x97, 0x68, 0x6d,
+	0x6f, 0xdd, 0x15, 0x1b, 0x4f, 0xf0, 0x3a, 0x29, 0xe6, 0x82, 0xc4, 0xa1, 0x67, 0xce, 0x3c, 0x01,
+	0xe2, 0x80, 0x7a, 0xe8, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0x00, 0x25, 0x87, 0xbe, 0x06, 0xf2,
+	0xae, 0x4d, 0xe8, 0x0b, 0x54, 0x5c, 0xec, 0x1d, 0xff, 0x67, 0x76, 0x7e, 0xe3, 0xf9, 0x63, 0x92,
+	0x40, 0xa0, 0x04, 0xd3, 0xe7, 0x7c, 0xce, 0x96, 0x43, 0x36, 0x07, 0x50, 0x74, 0x9e, 0x42, 0x06,
+	0xce, 0xae, 0x51, 0x68, 0xa1, 0xd0, 0xe5, 0xf0, 0xe0, 0x7f, 0x3e, 0x93, 0x09, 0x30, 0xf3, 0xb4,
+	0x19, 0x07, 0x6e, 0x08, 0x7a, 0x06, 0x9a, 0x05, 0x5c, 0x0b, 0xb6, 0x1c, 0x06, 0x22, 0xe3, 0x43,
+	0x16, 0x82, 0x4c, 0x4a, 0x7d, 0xdf, 0xea, 0x53, 0x13, 0x31, 0x1b, 0x94, 0x52, 0x2f, 0x86, 0x18,
+	0xec, 0xf7, 0xe2, 0x54, 0x15, 0xc4, 0x00, 0xb1, 0x12, 0xcc, 0x44, 0xc1, 0xe2, 0x94, 0xf1, 0x24,
+	0x2f, 0xa5, 0x87, 0x77, 0x39, 0xb9, 0x8a, 0x21, 0x95, 0xd9, 0xd9, 0xcc, 0xca, 0x47, 0x5f, 0x10,
+	0x6e, 0x3c, 0x07, 0x50, 0xce, 0x1e, 0xae, 0xcb, 0x88, 0xa0, 0x3e, 0xf2, 0x1a, 0x7e, 0x5d, 0x46,
+	0xce, 0x08, 0xb7, 0x78, 0x14, 0xa5, 0x42, 0x6b, 0x52, 0xef, 0x23, 0xaf, 0x3d, 0x21, 0xdf, 0xae,
+	0x06, 0xbd, 0x92, 0x65, 0x6c, 0x95, 0x17, 0x59, 0x2a, 0x93, 0xd8, 0xaf, 0x12, 0x9d, 0xc7, 0xb8,
+	0xfd, 0xe7, 0x7e, 0xb2, 0xd5, 0x47, 0xde, 0xde, 0x88, 0xd0, 0x3b, 0x7f, 0x83, 0x8e, 0x2b, 0xdd,
+	0xdf, 0xa4, 0x3a, 0x0e, 0x6e, 0xcc, 0xb9, 0x4c, 0x49, 0xa3, 0x68, 0xe4, 0x9b, 0xb3, 0xf3, 0x00,
+	0x37, 0x43, 0x05, 0x5a, 0x44, 0x64, 0xbb, 0x8f, 0xbc, 0x1d, 0xbf, 0x8c, 0x8e, 0x2e, 0xb6, 0x71,
+	0xa7, 0x00, 0x7e, 0x26, 0x32, 0x2e, 0x95, 0xfe, 0xe7, 0xb8, 0x9f, 0xe0, 0x56, 0x64, 0xd1, 0x0c,
+	0x78, 0x67, 0xd4, 0xa3, 0x76, 0x39, 0xb4, 0x5a, 0x0e, 0x1d, 0x27, 0xf9, 0xe4, 0xbf, 0xaf, 0x57,
+	0x83, 0x6e, 0x75, 0x79, 0x31, 0x8f, 0x5f, 0x95, 0x39, 0xef, 0x70, 0x5b, 0xc9, 0xd7, 0x0b, 0x19,
+	0xc9, 0x2c, 0x27, 0xcd, 0xfe, 0x96, 0xd7, 0x19, 0xed, 0xd3, 0x72, 0x80, 0xc2, 0x31, 0xb4, 0x74,
+	0x0c, 0x7d, 0x0a, 0x32, 0x99, 0x9c, 0x5c, 0xff, 0x38, 0xac, 0x7d, 0xfe, 0x79, 0xe8, 0xc5, 0x32,
+	0x3b, 0x5b, 0x04, 0x34, 0x84, 0x59, 0xe9, 0x98, 0xf2, 0x35, 0xd0, 0xd1, 0x2b, 0x96, 0xe5, 0x73,
+	0xa1, 0x4d, 0x81, 0xfe, 0x78, 0x7b, 0x79, 0xdc, 0x55, 0x22, 0xe6, 0x61, 0x3e, 0x2d, 0x3c, 0xa7,
+	0x3f, 0xdd, 0x5e, 0x1e, 0x23, 0x7f, 0xd3, 0xd3, 0xb9, 0x40, 0x78, 0xd7, 0xc0, 0x86, 0xa0, 0xa6,
+	0xa7, 0x42, 0x68, 0xd2, 0xba, 0x2f, 0x8a, 0x6e, 0xd5, 0xf7, 0x44, 0x08, 0xed, 0xbc, 0x47, 0xb8,
+	0x93, 0x8a, 0x73, 0x9e, 0x46, 0x16, 0x63, 0xe7, 0xbe, 0x30, 0xb0, 0xed, 0x6a, 0x20, 0x36, 0x46,
+	0x6c, 0xff, 0x6d, 0xc4, 0x09, 0xbd, 0x5e, 0xb9, 0xe8, 0x66, 0xe5, 0xa2, 0x5f, 0x2b, 0x17, 0x7d,
+	0x58, 0xbb, 0xb5, 0x9b, 0xb5, 0x5b, 0xfb, 0xbe, 0x76, 0x6b, 0x2f, 0x7b, 0x66, 0xaf, 0xd6, 0x3f,
+	0x6f, 0xf2, 0xb7, 0xb6, 0x5f, 0xd0, 0x34, 0xa3, 0x3d, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x79,
+	0x08, 0xda, 0xff, 0x39, 0x04, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -270,6 +289,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Pair) > 0 {
 		i -= len(m.Pair)
 		copy(dAtA[i:], m.Pair)
@@ -317,6 +346,16 @@ func (m *PoolDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.RewardFees) > 0 {
 		for iNdEx := len(m.RewardFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -429,6 +468,9 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPool(uint64(l))
 	}
+	if m.Closed {
+		n += 2
+	}
 	return n
 }
 
@@ -474,6 +516,9 @@ func (m *PoolDetails) Size() (n int) {
 			n += 1 + l + sovPool(uint64(l))
 		}
 	}
+	if m.Closed {
+		n += 2
+	}
 	return n
 }
 
@@ -614,6 +659,26 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -904,6 +969,26 @@ func (m *PoolDetails) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
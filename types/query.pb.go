@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -253,7 +255,8 @@ func (m *QueryPaused) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryPaused proto.InternalMessageInfo
 
 type QueryPausedResponse struct {
-	PausedPools []uint64 `protobuf:"varint,1,rep,packed,name=paused_pools,json=pausedPools,proto3" json:"paused_pools,omitempty"`
+	PausedPools      []uint64           `protobuf:"varint,1,rep,packed,name=paused_pools,json=pausedPools,proto3" json:"paused_pools,omitempty"`
+	PausedActivities []PausedActivities `protobuf:"bytes,2,rep,name=paused_activities,json=pausedActivities,proto3" json:"paused_activities"`
 }
 
 func (m *QueryPausedResponse) Reset()         { *m = QueryPausedResponse{} }
@@ -296,6 +299,13 @@ func (m *QueryPausedResponse) GetPausedPools() []uint64 {
 	return nil
 }
 
+func (m *QueryPausedResponse) GetPausedActivities() []PausedActivities {
+	if m != nil {
+		return m.PausedActivities
+	}
+	return nil
+}
+
 type QueryPools struct {
 }
 
@@ -465,10 +475,12 @@ func (m *QueryPoolResponse) GetPool() *PoolDetails {
 }
 
 type QuerySimulateSwap struct {
-	Signer string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
-	Routes []Route    `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes"`
-	Min    types.Coin `protobuf:"bytes,4,opt,name=min,proto3" json:"min"`
+	Signer          string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Amount          types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Routes          []Route    `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes"`
+	Min             types.Coin `protobuf:"bytes,4,opt,name=min,proto3" json:"min"`
+	Affiliate       string     `protobuf:"bytes,5,opt,name=affiliate,proto3" json:"affiliate,omitempty"`
+	AffiliateFeeBps int64      `protobuf:"varint,6,opt,name=affiliate_fee_bps,json=affiliateFeeBps,proto3" json:"affiliate_fee_bps,omitempty"`
 }
 
 func (m *QuerySimulateSwap) Reset()         { *m = QuerySimulateSwap{} }
@@ -532,906 +544,5352 @@ func (m *QuerySimulateSwap) GetMin() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryRates)(nil), "noble.swap.v1.QueryRates")
-	proto.RegisterType((*QueryRatesResponse)(nil), "noble.swap.v1.QueryRatesResponse")
-	proto.RegisterType((*QueryRate)(nil), "noble.swap.v1.QueryRate")
-	proto.RegisterType((*QueryRateResponse)(nil), "noble.swap.v1.QueryRateResponse")
-	proto.RegisterType((*QueryPaused)(nil), "noble.swap.v1.QueryPaused")
-	proto.RegisterType((*QueryPausedResponse)(nil), "noble.swap.v1.QueryPausedResponse")
-	proto.RegisterType((*QueryPools)(nil), "noble.swap.v1.QueryPools")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "noble.swap.v1.QueryPoolsResponse")
-	proto.RegisterType((*QueryPool)(nil), "noble.swap.v1.QueryPool")
-	proto.RegisterType((*QueryPoolResponse)(nil), "noble.swap.v1.QueryPoolResponse")
-	proto.RegisterType((*QuerySimulateSwap)(nil), "noble.swap.v1.QuerySimulateSwap")
+func (m *QuerySimulateSwap) GetAffiliate() string {
+	if m != nil {
+		return m.Affiliate
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("noble/swap/v1/query.proto", fileDescriptor_b809424106593213) }
+func (m *QuerySimulateSwap) GetAffiliateFeeBps() int64 {
+	if m != nil {
+		return m.AffiliateFeeBps
+	}
+	return 0
+}
 
-var fileDescriptor_b809424106593213 = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6a, 0xdb, 0x4a,
-	0x18, 0xb5, 0x12, 0xc9, 0x17, 0x8f, 0x93, 0x0b, 0x99, 0xf8, 0x26, 0x8a, 0x6e, 0xae, 0xae, 0x23,
-	0x0a, 0x4d, 0x53, 0xd0, 0x60, 0xf7, 0x27, 0xd0, 0x4d, 0xa9, 0x9b, 0x4d, 0x02, 0x81, 0x54, 0x59,
-	0xb5, 0x9b, 0x30, 0x8e, 0x07, 0x45, 0x20, 0x69, 0x54, 0x8f, 0x9c, 0xc4, 0x0d, 0xd9, 0x74, 0xd5,
-	0x65, 0xa1, 0xaf, 0xd0, 0x45, 0x97, 0x7d, 0x8c, 0x40, 0x37, 0x81, 0x6e, 0xba, 0x2a, 0x25, 0x29,
-	0xf4, 0x35, 0xca, 0xfc, 0x58, 0xb6, 0x54, 0xb9, 0x04, 0xba, 0x09, 0x9a, 0x39, 0x27, 0xe7, 0x7c,
-	0x73, 0xbe, 0x99, 0xcf, 0x60, 0x25, 0xa6, 0xdd, 0x90, 0x20, 0x76, 0x82, 0x13, 0x74, 0xdc, 0x42,
-	0x2f, 0x07, 0xa4, 0x3f, 0x74, 0x93, 0x3e, 0x4d, 0x29, 0x9c, 0x17, 0x90, 0xcb, 0x21, 0xf7, 0xb8,
-	0x65, 0x2d, 0xe0, 0x28, 0x88, 0x29, 0x12, 0x7f, 0x25, 0xc3, 0xb2, 0x0f, 0x29, 0x8b, 0x28, 0x43,
-	0x5d, 0xcc, 0x08, 0x3a, 0x6e, 0x75, 0x49, 0x8a, 0x5b, 0xe8, 0x90, 0x06, 0xb1, 0xc2, 0xff, 0x55,
-	0xb8, 0x50, 0x2d, 0xc8, 0x5b, 0x0d, 0x9f, 0xfa, 0x54, 0x7c, 0x22, 0xfe, 0xa5, 0x76, 0x57, 0x7d,
-	0x4a, 0xfd, 0x90, 0x20, 0x9c, 0x04, 0x08, 0xc7, 0x31, 0x4d, 0x71, 0x1a, 0xd0, 0x98, 0x29, 0xf4,
-	0xbf, 0x7c, 0xb5, 0x38, 0xf4, 0x69, 0x3f, 0x48, 0x8f, 0x22, 0x05, 0x9b, 0x79, 0x38, 0xa1, 0x34,
-	0x2c, 0x47, 0xfa, 0x38, 0x25, 0xe5, 0x88, 0x38, 0xad, 0x44, 0x96, 0xf2, 0x48, 0x7a, 0x2a, 0xf7,
-	0x9d, 0x2d, 0x00, 0x9e, 0xf1, 0x73, 0x78, 0x38, 0x25, 0x0c, 0x3e, 0x04, 0xb5, 0xac, 0x0c, 0x53,
-	0x6b, 0x6a, 0xeb, 0x7f, 0xb7, 0x4d, 0x37, 0x97, 0x9c, 0xfb, 0x64, 0x84, 0x7b, 0x63, 0xaa, 0xb3,
-	0x03, 0xe0, 0x58, 0xc5, 0x23, 0x2c, 0xa1, 0x31, 0x23, 0xf0, 0x3e, 0x30, 0x78, 0x6d, 0xcc, 0xd4,
-	0x9a, 0xb3, 0xeb, 0xf5, 0xf6, 0x62, 0x41, 0x89, 0x93, 0x3b, 0xb5, 0x8b, 0xaf, 0xff, 0x57, 0x3e,
-	0xfc, 0xf8, 0xb8, 0xa1, 0x79, 0x92, 0xec, 0x3c, 0x07, 0xb5, 0x4c, 0x0b, 0x36, 0x80, 0xd1, 0x23,
-	0x31, 0x95, 0xc5, 0xd4, 0x3c, 0xb9, 0xc8, 0x97, 0x39, 0x73, 0xf3, 0x32, 0xb7, 0xc1, 0x42, 0x26,
-	0xfd, 0x87, 0x55, 0xce, 0x83, 0xba, 0x90, 0xda, 0xc3, 0x03, 0x46, 0x7a, 0xce, 0x63, 0xb0, 0x38,
-	0xb1, 0xcc, 0xb4, 0xd7, 0xc1, 0x5c, 0x22, 0x76, 0x0e, 0x78, 0xfb, 0xa4, 0x85, 0xde, 0x31, 0xa4,
-	0x52, 0x5d, 0x42, 0x7b, 0x1c, 0x71, 0xe6, 0x54, 0x1f, 0xe4, 0x6a, 0x57, 0xe5, 0x29, 0x56, 0x99,
-	0xda, 0x26, 0x30, 0xc6, 0x32, 0xf5, 0xb6, 0x55, 0xa8, 0x94, 0x93, 0xb7, 0x48, 0x8a, 0x83, 0x90,
-	0x8d, 0x2c, 0x24, 0xdf, 0xb9, 0xa5, 0x22, 0xe5, 0x0c, 0xb8, 0x0c, 0xfe, 0xe2, 0xbb, 0x07, 0x41,
-	0x4f, 0x84, 0xaa, 0x7b, 0x55, 0xbe, 0xdc, 0xee, 0x39, 0x3b, 0x2a, 0x1d, 0xce, 0xca, 0x3c, 0x1f,
-	0x00, 0x9d, 0xc3, 0x82, 0x7a, 0x23, 0x4b, 0x41, 0x77, 0x3e, 0x69, 0x4a, 0x6c, 0x3f, 0x88, 0x06,
-	0x21, 0x4e, 0xc9, 0xfe, 0x09, 0x4e, 0xe0, 0x12, 0xa8, 0xb2, 0xc0, 0x8f, 0x49, 0x5f, 0xb5, 0x53,
-	0xad, 0xe0, 0x26, 0xa8, 0xe2, 0x88, 0x0e, 0xe2, 0x54, 0x34, 0xb3, 0xde, 0x5e, 0x71, 0xe5, 0x5b,
-	0x73, 0xf9, 0x5b, 0x74, 0xd5, 0x5b, 0x74, 0x9f, 0xd2, 0x20, 0xee, 0xe8, 0xbc, 0x13, 0x9e, 0xa2,
-	0xc3, 0x36, 0xa8, 0xf6, 0xe9, 0x80, 0x37, 0x6f, 0x56, 0x44, 0xd2, 0x28, 0x36, 0x8f, 0x83, 0xa3,
-	0xff, 0x91, 0x4c, 0xd8, 0x02, 0xb3, 0x51, 0x10, 0x9b, 0xfa, 0xcd, 0x9c, 0x38, 0xb7, 0xfd, 0xde,
-	0x00, 0x86, 0x38, 0x0d, 0x0c, 0x41, 0x55, 0xb6, 0x18, 0x16, 0xa3, 0x98, 0x68, 0xbf, 0xe5, 0x4c,
-	0xc7, 0x46, 0xc1, 0x3a, 0xce, 0x1b, 0x1e, 0xd7, 0xeb, 0xcf, 0xdf, 0xdf, 0xcd, 0x2c, 0xc3, 0x7f,
-	0x50, 0xe1, 0xb1, 0x4b, 0x8f, 0x23, 0x60, 0x88, 0x1b, 0x00, 0x57, 0x4a, 0x05, 0x39, 0x64, 0xad,
-	0x4d, 0x85, 0x32, 0xab, 0xb5, 0xb1, 0xd5, 0x12, 0x6c, 0xa0, 0x5f, 0xe7, 0x0a, 0x83, 0x14, 0xe8,
-	0xe2, 0x72, 0x98, 0xd3, 0xd4, 0xac, 0xe6, 0x34, 0x24, 0xb3, 0xb9, 0x3b, 0xb6, 0x69, 0x42, 0xbb,
-	0xcc, 0x06, 0x9d, 0xa9, 0x9b, 0x77, 0x0e, 0x87, 0x60, 0x2e, 0x77, 0x35, 0x4a, 0xe5, 0x27, 0x19,
-	0x96, 0x5d, 0x60, 0xec, 0x32, 0x9f, 0xef, 0x67, 0xf6, 0xb7, 0x85, 0xf3, 0xda, 0x23, 0x6d, 0xc3,
-	0x59, 0x2d, 0x98, 0x33, 0xa5, 0x73, 0xc0, 0x37, 0x78, 0xaa, 0x72, 0xda, 0x95, 0xa6, 0x2a, 0xa0,
-	0xf2, 0x54, 0x73, 0xd3, 0xed, 0xf7, 0xa9, 0x8a, 0x21, 0x01, 0x23, 0xa0, 0x8b, 0x29, 0x66, 0x4e,
-	0x53, 0x2b, 0x4f, 0x75, 0x72, 0x3c, 0x39, 0x77, 0xc6, 0x36, 0x36, 0x5c, 0x2d, 0xb3, 0x41, 0x67,
-	0x62, 0x2a, 0x9e, 0x77, 0xdc, 0x8b, 0x2b, 0x5b, 0xbb, 0xbc, 0xb2, 0xb5, 0x6f, 0x57, 0xb6, 0xf6,
-	0xf6, 0xda, 0xae, 0x5c, 0x5e, 0xdb, 0x95, 0x2f, 0xd7, 0x76, 0xe5, 0x45, 0x43, 0xe8, 0x4b, 0xab,
-	0xd3, 0xe1, 0x2b, 0x94, 0x0e, 0x13, 0xc2, 0xba, 0x55, 0xf1, 0x13, 0x70, 0xef, 0x67, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0xbb, 0xc8, 0x64, 0x37, 0x07, 0x00, 0x00,
+type QueryIncentives struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryIncentives) Reset()         { *m = QueryIncentives{} }
+func (m *QueryIncentives) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentives) ProtoMessage()    {}
+func (*QueryIncentives) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{11}
+}
+func (m *QueryIncentives) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentives.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentives) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentives.Merge(m, src)
+}
+func (m *QueryIncentives) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentives) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentives.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIncentives proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Retrieves a list of the currently paused Pools.
-	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
-	// Retrieves the details of all Pools.
-	Pools(ctx context.Context, in *QueryPools, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Retrieves details of a specific Pool.
-	Pool(ctx context.Context, in *QueryPool, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// Simulates a token swap simulation.
-	SimulateSwap(ctx context.Context, in *QuerySimulateSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
-	// Retrieves exchange rates for all tokens, with the optionality of filtering by algorithm.
-	Rates(ctx context.Context, in *QueryRates, opts ...grpc.CallOption) (*QueryRatesResponse, error)
-	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
-	Rate(ctx context.Context, in *QueryRate, opts ...grpc.CallOption) (*QueryRateResponse, error)
+type QueryIncentivesByPool struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryIncentivesByPool) Reset()         { *m = QueryIncentivesByPool{} }
+func (m *QueryIncentivesByPool) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivesByPool) ProtoMessage()    {}
+func (*QueryIncentivesByPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{12}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIncentivesByPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
-	out := new(QueryPausedResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Paused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIncentivesByPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivesByPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPools, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryIncentivesByPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivesByPool.Merge(m, src)
+}
+func (m *QueryIncentivesByPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivesByPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivesByPool.DiscardUnknown(m)
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPool, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIncentivesByPool proto.InternalMessageInfo
+
+func (m *QueryIncentivesByPool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
-	out := new(MsgSwapResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/SimulateSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryIncentivesResponse struct {
+	Incentives []Incentive `protobuf:"bytes,1,rep,name=incentives,proto3" json:"incentives"`
 }
 
-func (c *queryClient) Rates(ctx context.Context, in *QueryRates, opts ...grpc.CallOption) (*QueryRatesResponse, error) {
-	out := new(QueryRatesResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Rates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIncentivesResponse) Reset()         { *m = QueryIncentivesResponse{} }
+func (m *QueryIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivesResponse) ProtoMessage()    {}
+func (*QueryIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{13}
+}
+func (m *QueryIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivesResponse.Merge(m, src)
+}
+func (m *QueryIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Rate(ctx context.Context, in *QueryRate, opts ...grpc.CallOption) (*QueryRateResponse, error) {
-	out := new(QueryRateResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Rate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivesResponse) GetIncentives() []Incentive {
+	if m != nil {
+		return m.Incentives
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Retrieves a list of the currently paused Pools.
-	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
-	// Retrieves the details of all Pools.
-	Pools(context.Context, *QueryPools) (*QueryPoolsResponse, error)
-	// Retrieves details of a specific Pool.
-	Pool(context.Context, *QueryPool) (*QueryPoolResponse, error)
-	// Simulates a token swap simulation.
-	SimulateSwap(context.Context, *QuerySimulateSwap) (*MsgSwapResponse, error)
-	// Retrieves exchange rates for all tokens, with the optionality of filtering by algorithm.
-	Rates(context.Context, *QueryRates) (*QueryRatesResponse, error)
-	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
-	Rate(context.Context, *QueryRate) (*QueryRateResponse, error)
+type QueryWithdrawAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWithdrawAddress) Reset()         { *m = QueryWithdrawAddress{} }
+func (m *QueryWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawAddress) ProtoMessage()    {}
+func (*QueryWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{14}
 }
-
-func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+func (m *QueryWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPools) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *QueryWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPool) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QueryWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawAddress.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwap) (*MsgSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+func (m *QueryWithdrawAddress) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Rates(ctx context.Context, req *QueryRates) (*QueryRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rates not implemented")
+func (m *QueryWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawAddress.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Rate(ctx context.Context, req *QueryRate) (*QueryRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
+
+var xxx_messageInfo_QueryWithdrawAddress proto.InternalMessageInfo
+
+func (m *QueryWithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryWithdrawAddressResponse struct {
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaused)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Paused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/Paused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+func (m *QueryWithdrawAddressResponse) Reset()         { *m = QueryWithdrawAddressResponse{} }
+func (m *QueryWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{15}
+}
+func (m *QueryWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPools)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPools))
+var xxx_messageInfo_QueryWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPool))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryFeeTiers struct {
 }
 
-func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateSwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateSwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/SimulateSwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwap))
+func (m *QueryFeeTiers) Reset()         { *m = QueryFeeTiers{} }
+func (m *QueryFeeTiers) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTiers) ProtoMessage()    {}
+func (*QueryFeeTiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{16}
+}
+func (m *QueryFeeTiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeTiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTiers.Merge(m, src)
+}
+func (m *QueryFeeTiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTiers.DiscardUnknown(m)
 }
 
-func _Query_Rates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Rates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/Rates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Rates(ctx, req.(*QueryRates))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryFeeTiers proto.InternalMessageInfo
+
+type QueryFeeTiersResponse struct {
+	Tiers  []FeeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+	Exempt []string  `protobuf:"bytes,2,rep,name=exempt,proto3" json:"exempt,omitempty"`
 }
 
-func _Query_Rate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Rate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.v1.Query/Rate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Rate(ctx, req.(*QueryRate))
+func (m *QueryFeeTiersResponse) Reset()         { *m = QueryFeeTiersResponse{} }
+func (m *QueryFeeTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTiersResponse) ProtoMessage()    {}
+func (*QueryFeeTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{17}
+}
+func (m *QueryFeeTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTiersResponse.Merge(m, src)
+}
+func (m *QueryFeeTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTiersResponse.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.swap.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Paused",
-			Handler:    _Query_Paused_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "SimulateSwap",
-			Handler:    _Query_SimulateSwap_Handler,
-		},
-		{
-			MethodName: "Rates",
-			Handler:    _Query_Rates_Handler,
-		},
-		{
-			MethodName: "Rate",
-			Handler:    _Query_Rate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/swap/v1/query.proto",
+var xxx_messageInfo_QueryFeeTiersResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTiersResponse) GetTiers() []FeeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
 }
 
-func (m *QueryRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeTiersResponse) GetExempt() []string {
+	if m != nil {
+		return m.Exempt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountFeeTier struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Algorithm != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Algorithm))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAccountFeeTier) Reset()         { *m = QueryAccountFeeTier{} }
+func (m *QueryAccountFeeTier) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeTier) ProtoMessage()    {}
+func (*QueryAccountFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{18}
+}
+func (m *QueryAccountFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeTier.Merge(m, src)
+}
+func (m *QueryAccountFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeTier.DiscardUnknown(m)
 }
 
-func (m *QueryRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountFeeTier proto.InternalMessageInfo
+
+func (m *QueryAccountFeeTier) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAccountFeeTierResponse struct {
+	Volume   cosmossdk_io_math.Int       `protobuf:"bytes,1,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+	Exempt   bool                        `protobuf:"varint,3,opt,name=exempt,proto3" json:"exempt,omitempty"`
 }
 
-func (m *QueryRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rates) > 0 {
-		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAccountFeeTierResponse) Reset()         { *m = QueryAccountFeeTierResponse{} }
+func (m *QueryAccountFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeTierResponse) ProtoMessage()    {}
+func (*QueryAccountFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{19}
+}
+func (m *QueryAccountFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAccountFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeTierResponse.Merge(m, src)
+}
+func (m *QueryAccountFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeTierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountFeeTierResponse proto.InternalMessageInfo
+
+func (m *QueryAccountFeeTierResponse) GetExempt() bool {
+	if m != nil {
+		return m.Exempt
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParams struct {
 }
 
-func (m *QueryRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Algorithm != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Algorithm))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParams) Reset()         { *m = QueryParams{} }
+func (m *QueryParams) String() string { return proto.CompactTextString(m) }
+func (*QueryParams) ProtoMessage()    {}
+func (*QueryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{20}
 }
-
-func (m *QueryRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParams.Merge(m, src)
+}
+func (m *QueryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParams.DiscardUnknown(m)
 }
 
-func (m *QueryRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParams proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rates) > 0 {
-		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{21}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-
-func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRoles struct {
 }
 
-func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PausedPools) > 0 {
-		dAtA2 := make([]byte, len(m.PausedPools)*10)
-		var j1 int
-		for _, num := range m.PausedPools {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *QueryRoles) Reset()         { *m = QueryRoles{} }
+func (m *QueryRoles) String() string { return proto.CompactTextString(m) }
+func (*QueryRoles) ProtoMessage()    {}
+func (*QueryRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{22}
+}
+func (m *QueryRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintQuery(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPools) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoles.Merge(m, src)
 }
-
-func (m *QueryPools) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRoles) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoles.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryRoles proto.InternalMessageInfo
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRolesResponse struct {
+	Grants []RoleGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRolesResponse) Reset()         { *m = QueryRolesResponse{} }
+func (m *QueryRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesResponse) ProtoMessage()    {}
+func (*QueryRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{23}
+}
+func (m *QueryRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesResponse.Merge(m, src)
+}
+func (m *QueryRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRolesResponse proto.InternalMessageInfo
+
+func (m *QueryRolesResponse) GetGrants() []RoleGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRolesByAddress struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRolesByAddress) Reset()         { *m = QueryRolesByAddress{} }
+func (m *QueryRolesByAddress) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesByAddress) ProtoMessage()    {}
+func (*QueryRolesByAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{24}
+}
+func (m *QueryRolesByAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRolesByAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesByAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRolesByAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesByAddress.Merge(m, src)
+}
+func (m *QueryRolesByAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRolesByAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesByAddress.DiscardUnknown(m)
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRolesByAddress proto.InternalMessageInfo
+
+func (m *QueryRolesByAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRolesByAddressResponse struct {
+	Roles []Role `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=noble.swap.v1.Role" json:"roles,omitempty"`
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRolesByAddressResponse) Reset()         { *m = QueryRolesByAddressResponse{} }
+func (m *QueryRolesByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRolesByAddressResponse) ProtoMessage()    {}
+func (*QueryRolesByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{25}
+}
+func (m *QueryRolesByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRolesByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRolesByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRolesByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRolesByAddressResponse.Merge(m, src)
+}
+func (m *QueryRolesByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRolesByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRolesByAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySimulateSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRolesByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryRolesByAddressResponse) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySimulateSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWithdrawOnly struct {
 }
 
-func (m *QuerySimulateSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryWithdrawOnly) Reset()         { *m = QueryWithdrawOnly{} }
+func (m *QueryWithdrawOnly) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawOnly) ProtoMessage()    {}
+func (*QueryWithdrawOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{26}
+}
+func (m *QueryWithdrawOnly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawOnly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QueryWithdrawOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawOnly.Merge(m, src)
+}
+func (m *QueryWithdrawOnly) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawOnly proto.InternalMessageInfo
+
+type QueryWithdrawOnlyResponse struct {
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
+}
+
+func (m *QueryWithdrawOnlyResponse) Reset()         { *m = QueryWithdrawOnlyResponse{} }
+func (m *QueryWithdrawOnlyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawOnlyResponse) ProtoMessage()    {}
+func (*QueryWithdrawOnlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{27}
+}
+func (m *QueryWithdrawOnlyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawOnlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawOnlyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawOnlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawOnlyResponse.Merge(m, src)
+}
+func (m *QueryWithdrawOnlyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawOnlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawOnlyResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryWithdrawOnlyResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawOnlyResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryRates) Size() (n int) {
-	if m == nil {
-		return 0
+
+type QueryAllowedDenoms struct {
+}
+
+func (m *QueryAllowedDenoms) Reset()         { *m = QueryAllowedDenoms{} }
+func (m *QueryAllowedDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenoms) ProtoMessage()    {}
+func (*QueryAllowedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{28}
+}
+func (m *QueryAllowedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Algorithm != 0 {
-		n += 1 + sovQuery(uint64(m.Algorithm))
+}
+func (m *QueryAllowedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenoms.Merge(m, src)
+}
+func (m *QueryAllowedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenoms proto.InternalMessageInfo
+
+type QueryAllowedDenomsResponse struct {
+	Denoms []AllowedDenom `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms"`
+}
+
+func (m *QueryAllowedDenomsResponse) Reset()         { *m = QueryAllowedDenomsResponse{} }
+func (m *QueryAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsResponse) ProtoMessage()    {}
+func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{29}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAllowedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsResponse.Merge(m, src)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAllowedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsResponse) GetDenoms() []AllowedDenom {
+	if m != nil {
+		return m.Denoms
 	}
-	var l int
-	_ = l
-	if len(m.Rates) > 0 {
-		for _, e := range m.Rates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryBlockedDenoms struct {
+}
+
+func (m *QueryBlockedDenoms) Reset()         { *m = QueryBlockedDenoms{} }
+func (m *QueryBlockedDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedDenoms) ProtoMessage()    {}
+func (*QueryBlockedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{30}
+}
+func (m *QueryBlockedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBlockedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedDenoms.Merge(m, src)
+}
+func (m *QueryBlockedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedDenoms.DiscardUnknown(m)
 }
 
-func (m *QueryRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBlockedDenoms proto.InternalMessageInfo
+
+type QueryBlockedDenomsResponse struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryBlockedDenomsResponse) Reset()         { *m = QueryBlockedDenomsResponse{} }
+func (m *QueryBlockedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedDenomsResponse) ProtoMessage()    {}
+func (*QueryBlockedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{31}
+}
+func (m *QueryBlockedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Algorithm != 0 {
-		n += 1 + sovQuery(uint64(m.Algorithm))
+}
+func (m *QueryBlockedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedDenomsResponse.Merge(m, src)
+}
+func (m *QueryBlockedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryAuthority struct {
+}
+
+func (m *QueryAuthority) Reset()         { *m = QueryAuthority{} }
+func (m *QueryAuthority) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthority) ProtoMessage()    {}
+func (*QueryAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{32}
+}
+func (m *QueryAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Rates) > 0 {
-		for _, e := range m.Rates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthority.Merge(m, src)
+}
+func (m *QueryAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuthority proto.InternalMessageInfo
+
+type QueryAuthorityResponse struct {
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PendingAuthority string `protobuf:"bytes,2,opt,name=pending_authority,json=pendingAuthority,proto3" json:"pending_authority,omitempty"`
+}
+
+func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{} }
+func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorityResponse) ProtoMessage()    {}
+func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b809424106593213, []int{33}
+}
+func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorityResponse.Merge(m, src)
+}
+func (m *QueryAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPaused) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAuthorityResponse proto.InternalMessageInfo
+
+func (m *QueryAuthorityResponse) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAuthorityResponse) GetPendingAuthority() string {
+	if m != nil {
+		return m.PendingAuthority
 	}
-	var l int
-	_ = l
-	if len(m.PausedPools) > 0 {
-		l = 0
-		for _, e := range m.PausedPools {
-			l += sovQuery(uint64(e))
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryRates)(nil), "noble.swap.v1.QueryRates")
+	proto.RegisterType((*QueryRatesResponse)(nil), "noble.swap.v1.QueryRatesResponse")
+	proto.RegisterType((*QueryRate)(nil), "noble.swap.v1.QueryRate")
+	proto.RegisterType((*QueryRateResponse)(nil), "noble.swap.v1.QueryRateResponse")
+	proto.RegisterType((*QueryPaused)(nil), "noble.swap.v1.QueryPaused")
+	proto.RegisterType((*QueryPausedResponse)(nil), "noble.swap.v1.QueryPausedResponse")
+	proto.RegisterType((*QueryPools)(nil), "noble.swap.v1.QueryPools")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "noble.swap.v1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPool)(nil), "noble.swap.v1.QueryPool")
+	proto.RegisterType((*QueryPoolResponse)(nil), "noble.swap.v1.QueryPoolResponse")
+	proto.RegisterType((*QuerySimulateSwap)(nil), "noble.swap.v1.QuerySimulateSwap")
+	proto.RegisterType((*QueryIncentives)(nil), "noble.swap.v1.QueryIncentives")
+	proto.RegisterType((*QueryIncentivesByPool)(nil), "noble.swap.v1.QueryIncentivesByPool")
+	proto.RegisterType((*QueryIncentivesResponse)(nil), "noble.swap.v1.QueryIncentivesResponse")
+	proto.RegisterType((*QueryWithdrawAddress)(nil), "noble.swap.v1.QueryWithdrawAddress")
+	proto.RegisterType((*QueryWithdrawAddressResponse)(nil), "noble.swap.v1.QueryWithdrawAddressResponse")
+	proto.RegisterType((*QueryFeeTiers)(nil), "noble.swap.v1.QueryFeeTiers")
+	proto.RegisterType((*QueryFeeTiersResponse)(nil), "noble.swap.v1.QueryFeeTiersResponse")
+	proto.RegisterType((*QueryAccountFeeTier)(nil), "noble.swap.v1.QueryAccountFeeTier")
+	proto.RegisterType((*QueryAccountFeeTierResponse)(nil), "noble.swap.v1.QueryAccountFeeTierResponse")
+	proto.RegisterType((*QueryParams)(nil), "noble.swap.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "noble.swap.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRoles)(nil), "noble.swap.v1.QueryRoles")
+	proto.RegisterType((*QueryRolesResponse)(nil), "noble.swap.v1.QueryRolesResponse")
+	proto.RegisterType((*QueryRolesByAddress)(nil), "noble.swap.v1.QueryRolesByAddress")
+	proto.RegisterType((*QueryRolesByAddressResponse)(nil), "noble.swap.v1.QueryRolesByAddressResponse")
+	proto.RegisterType((*QueryWithdrawOnly)(nil), "noble.swap.v1.QueryWithdrawOnly")
+	proto.RegisterType((*QueryWithdrawOnlyResponse)(nil), "noble.swap.v1.QueryWithdrawOnlyResponse")
+	proto.RegisterType((*QueryAllowedDenoms)(nil), "noble.swap.v1.QueryAllowedDenoms")
+	proto.RegisterType((*QueryAllowedDenomsResponse)(nil), "noble.swap.v1.QueryAllowedDenomsResponse")
+	proto.RegisterType((*QueryBlockedDenoms)(nil), "noble.swap.v1.QueryBlockedDenoms")
+	proto.RegisterType((*QueryBlockedDenomsResponse)(nil), "noble.swap.v1.QueryBlockedDenomsResponse")
+	proto.RegisterType((*QueryAuthority)(nil), "noble.swap.v1.QueryAuthority")
+	proto.RegisterType((*QueryAuthorityResponse)(nil), "noble.swap.v1.QueryAuthorityResponse")
+}
+
+func init() { proto.RegisterFile("noble/swap/v1/query.proto", fileDescriptor_b809424106593213) }
+
+var fileDescriptor_b809424106593213 = []byte{
+	// 1624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0xb1, 0x1b, 0x9f, 0xdc, 0xa7, 0x49, 0xea, 0x6c, 0x12, 0x27, 0xdd, 0xa6, 0xfd,
+	0xe7, 0xa2, 0x7a, 0x93, 0xfc, 0xa1, 0x45, 0x54, 0x20, 0xc5, 0x8d, 0x4a, 0x53, 0x51, 0xb5, 0x75,
+	0x91, 0x2a, 0x10, 0xc2, 0xda, 0xd8, 0x53, 0x67, 0xd5, 0xf5, 0x8e, 0xf1, 0xac, 0x93, 0x98, 0xd2,
+	0x17, 0x24, 0x24, 0x78, 0x40, 0xe2, 0x22, 0x24, 0x24, 0xbe, 0x00, 0x8f, 0x3c, 0xf0, 0x21, 0xfa,
+	0x58, 0xd1, 0x17, 0xc4, 0x43, 0x85, 0x52, 0x24, 0xbe, 0x06, 0x9a, 0xcb, 0xce, 0x5e, 0xbc, 0xeb,
+	0x58, 0xe2, 0xa5, 0xf2, 0xcc, 0xef, 0x9c, 0xf3, 0xfb, 0xed, 0x99, 0x99, 0x73, 0x4e, 0x03, 0xf3,
+	0x2e, 0x39, 0x70, 0xb0, 0x49, 0x8f, 0xad, 0xa6, 0x79, 0xb4, 0x6d, 0x7e, 0xda, 0xc6, 0xad, 0x4e,
+	0xb1, 0xd9, 0x22, 0x1e, 0x41, 0xe3, 0x1c, 0x2a, 0x32, 0xa8, 0x78, 0xb4, 0xad, 0x4f, 0x5b, 0x0d,
+	0xdb, 0x25, 0x26, 0xff, 0x57, 0x58, 0xe8, 0x85, 0x2a, 0xa1, 0x0d, 0x42, 0xcd, 0x03, 0x8b, 0x62,
+	0xf3, 0x68, 0xfb, 0x00, 0x7b, 0xd6, 0xb6, 0x59, 0x25, 0xb6, 0x2b, 0xf1, 0x05, 0x89, 0xf3, 0xa8,
+	0xb1, 0xf0, 0xfa, 0xbc, 0x00, 0x2b, 0x7c, 0x65, 0x8a, 0x85, 0x84, 0x66, 0xea, 0xa4, 0x4e, 0xc4,
+	0x3e, 0xfb, 0x25, 0x77, 0x17, 0xeb, 0x84, 0xd4, 0x1d, 0x6c, 0x5a, 0x4d, 0xdb, 0xb4, 0x5c, 0x97,
+	0x78, 0x96, 0x67, 0x13, 0xd7, 0xf7, 0x59, 0x8a, 0x7e, 0x88, 0xe5, 0xd4, 0x49, 0xcb, 0xf6, 0x0e,
+	0x1b, 0x3e, 0x5b, 0x14, 0xae, 0x61, 0x97, 0xf8, 0xd0, 0x62, 0x14, 0x7a, 0x8c, 0x71, 0xc5, 0xb3,
+	0x71, 0x2b, 0x39, 0xae, 0xed, 0x56, 0xb1, 0xeb, 0xd9, 0x47, 0x58, 0xc2, 0x7a, 0x14, 0x6e, 0x5a,
+	0x2d, 0xab, 0x41, 0x93, 0x39, 0x9b, 0x56, 0x9b, 0xfa, 0x6e, 0xf9, 0x18, 0x44, 0x88, 0x93, 0x8c,
+	0xb4, 0x2c, 0x2f, 0xc5, 0xa7, 0x45, 0x9c, 0x14, 0x84, 0x9f, 0x98, 0x40, 0xe6, 0xa2, 0x88, 0x77,
+	0x22, 0xf6, 0x8d, 0x3d, 0x80, 0x07, 0xec, 0x2c, 0xca, 0x96, 0x87, 0x29, 0xba, 0x06, 0x39, 0x95,
+	0xaf, 0xbc, 0xb6, 0xa2, 0xad, 0x4d, 0xec, 0xe4, 0x8b, 0x91, 0xd3, 0x2f, 0xee, 0xfa, 0x78, 0x39,
+	0x30, 0x35, 0xee, 0x00, 0x0a, 0xa2, 0x94, 0x31, 0x6d, 0x12, 0x97, 0x62, 0xf4, 0x06, 0x64, 0x98,
+	0x6a, 0x9a, 0xd7, 0x56, 0x86, 0xd6, 0x46, 0x77, 0xce, 0xc7, 0x22, 0x31, 0xe3, 0x52, 0xee, 0xf9,
+	0xab, 0xe5, 0x81, 0x5f, 0xfe, 0xf9, 0x75, 0x43, 0x2b, 0x0b, 0x63, 0xe3, 0x43, 0xc8, 0xa9, 0x58,
+	0x68, 0x06, 0x32, 0xfc, 0x84, 0xb8, 0x98, 0x5c, 0x59, 0x2c, 0xa2, 0x32, 0x07, 0xfb, 0x97, 0xb9,
+	0x0f, 0xd3, 0x2a, 0xf4, 0x7f, 0x54, 0x39, 0x0e, 0xa3, 0x3c, 0xd4, 0x7d, 0x76, 0x96, 0x35, 0xe3,
+	0x27, 0x0d, 0xce, 0x87, 0xd6, 0x2a, 0xf8, 0x1a, 0x8c, 0xf1, 0xd3, 0xae, 0x55, 0xd8, 0xc9, 0x0a,
+	0x8e, 0xe1, 0x52, 0x46, 0x84, 0x1a, 0x15, 0xd0, 0x7d, 0x86, 0xa0, 0x47, 0x30, 0x2d, 0x2d, 0xad,
+	0xaa, 0x67, 0x1f, 0xd9, 0x9e, 0x8d, 0x69, 0x7e, 0x90, 0x4b, 0x5a, 0x8e, 0x49, 0x12, 0x1c, 0xbb,
+	0xca, 0x2c, 0x2c, 0x6f, 0xaa, 0x19, 0x03, 0x8d, 0x31, 0x79, 0xc2, 0x9c, 0xc6, 0xb8, 0x2b, 0x4f,
+	0x8a, 0xaf, 0x94, 0xcc, 0xeb, 0x90, 0x09, 0xf4, 0x8d, 0xee, 0xe8, 0x71, 0x42, 0x42, 0x9c, 0x3d,
+	0xec, 0x59, 0xb6, 0x43, 0x7d, 0xed, 0xc2, 0xde, 0x58, 0x95, 0x87, 0xc5, 0x2c, 0xd0, 0x05, 0x38,
+	0xc7, 0x76, 0x2b, 0x76, 0x8d, 0x1f, 0xd7, 0x70, 0x39, 0xcb, 0x96, 0xfb, 0x35, 0xe3, 0x8e, 0xcc,
+	0x3b, 0xb3, 0x52, 0x9c, 0x6f, 0xc2, 0x30, 0x83, 0xb9, 0x69, 0x5f, 0x94, 0xdc, 0xdc, 0xf8, 0x71,
+	0x50, 0x06, 0x7b, 0x68, 0x37, 0xda, 0x8e, 0xe5, 0xe1, 0x87, 0xc7, 0x56, 0x13, 0xcd, 0x41, 0x96,
+	0xda, 0x75, 0x17, 0xb7, 0xe4, 0x45, 0x91, 0x2b, 0x74, 0x1d, 0xb2, 0x56, 0x83, 0xb4, 0x5d, 0x8f,
+	0x5f, 0x93, 0xd1, 0x9d, 0xf9, 0xa2, 0xac, 0x2f, 0xac, 0x52, 0x15, 0x65, 0xa5, 0x2a, 0xde, 0x24,
+	0xb6, 0x5b, 0x1a, 0x66, 0x49, 0x2c, 0x4b, 0x73, 0xb4, 0x03, 0xd9, 0x16, 0x69, 0xb3, 0x6b, 0x31,
+	0xc4, 0x53, 0x32, 0x13, 0xbf, 0x16, 0x0c, 0xf4, 0x7d, 0x84, 0x25, 0xda, 0x86, 0xa1, 0x86, 0xed,
+	0xe6, 0x87, 0xfb, 0x63, 0x62, 0xb6, 0x68, 0x11, 0x72, 0xd6, 0xe3, 0xc7, 0xb6, 0x63, 0x5b, 0x1e,
+	0xce, 0x67, 0xb8, 0xf4, 0x60, 0x03, 0x6d, 0xc0, 0xb4, 0x5a, 0x54, 0x58, 0x39, 0x3a, 0x68, 0xd2,
+	0x7c, 0x76, 0x45, 0x5b, 0x1b, 0x2a, 0x4f, 0x2a, 0xe0, 0x16, 0xc6, 0xa5, 0x26, 0x35, 0xa6, 0x61,
+	0x92, 0xa7, 0x65, 0xdf, 0xaf, 0x4b, 0xd4, 0xd8, 0x82, 0xd9, 0xd8, 0x56, 0xe9, 0x8c, 0x83, 0xfa,
+	0x04, 0x2e, 0xc4, 0x3c, 0xd4, 0x71, 0xdd, 0x04, 0x50, 0x25, 0xcf, 0xbf, 0x27, 0xf1, 0x47, 0xa7,
+	0xdc, 0xc2, 0x37, 0x32, 0xe4, 0x66, 0x6c, 0xc1, 0x0c, 0x8f, 0xff, 0xc8, 0xf6, 0x0e, 0x6b, 0x2d,
+	0xeb, 0x78, 0xb7, 0x56, 0x6b, 0x61, 0x4a, 0x51, 0x1e, 0xce, 0x59, 0xe2, 0xa7, 0x3c, 0x3f, 0x7f,
+	0x69, 0xec, 0xc3, 0x62, 0x92, 0x87, 0x92, 0xb5, 0x0e, 0x53, 0xc7, 0x12, 0xaa, 0x44, 0x43, 0x4c,
+	0x1e, 0x47, 0x5d, 0x8c, 0x49, 0x18, 0xe7, 0xa1, 0x6e, 0x61, 0xfc, 0x81, 0x8d, 0x5b, 0xd4, 0x38,
+	0x94, 0xf9, 0xf1, 0x37, 0xc2, 0xcf, 0x81, 0x15, 0x7e, 0xff, 0x33, 0xe7, 0x62, 0x9f, 0x29, 0xed,
+	0x23, 0x55, 0x81, 0xdb, 0xb3, 0x6b, 0x88, 0x4f, 0x70, 0xa3, 0xe9, 0xf1, 0x97, 0x9b, 0x2b, 0xcb,
+	0x95, 0x61, 0xca, 0xea, 0xb0, 0x5b, 0xad, 0xb2, 0xdb, 0x25, 0x03, 0xf4, 0xf8, 0xec, 0x97, 0x1a,
+	0x2c, 0x24, 0x78, 0x28, 0x85, 0xb7, 0x21, 0x7b, 0x44, 0x9c, 0x76, 0x03, 0x0b, 0xc7, 0xd2, 0x16,
+	0x93, 0xf2, 0xe7, 0xab, 0xe5, 0x59, 0x71, 0xe9, 0x68, 0xed, 0x49, 0xd1, 0x26, 0x66, 0xc3, 0xf2,
+	0x0e, 0x8b, 0xfb, 0xae, 0xf7, 0xfb, 0x6f, 0x57, 0x41, 0xde, 0xc6, 0x7d, 0xd7, 0x13, 0x8a, 0xa5,
+	0x3f, 0x2a, 0xc3, 0x48, 0xcd, 0xa6, 0x55, 0xf5, 0x46, 0x72, 0xa5, 0x6b, 0x32, 0xd6, 0x42, 0x77,
+	0xac, 0xf7, 0x71, 0xdd, 0xaa, 0x76, 0xf6, 0x70, 0x35, 0x14, 0x71, 0x0f, 0x57, 0x45, 0x44, 0x15,
+	0x27, 0x94, 0x86, 0xa1, 0x15, 0x6d, 0x6d, 0x44, 0xa5, 0x21, 0x28, 0x9a, 0xac, 0x39, 0x1a, 0xf7,
+	0x54, 0xcd, 0x64, 0x4b, 0xf5, 0x6d, 0x6f, 0x41, 0x56, 0x74, 0x4f, 0x59, 0x1a, 0x66, 0xbb, 0xca,
+	0x1f, 0x03, 0xc3, 0xd9, 0x97, 0xf6, 0xaa, 0xd4, 0x95, 0x89, 0x83, 0xa9, 0xf1, 0xc0, 0x6f, 0x4a,
+	0x6c, 0xa5, 0xa2, 0xdf, 0x80, 0x6c, 0xbd, 0x65, 0xb9, 0x5e, 0xda, 0x1d, 0x66, 0xd6, 0xef, 0x31,
+	0x83, 0x08, 0x81, 0x70, 0x51, 0xe7, 0xc8, 0x43, 0x96, 0x3a, 0x67, 0x5f, 0xdf, 0xdb, 0xf2, 0x18,
+	0xa3, 0x0e, 0xa1, 0xdb, 0x9b, 0x61, 0xdd, 0x5b, 0x68, 0x99, 0xe8, 0xee, 0x3d, 0xc4, 0xc1, 0x65,
+	0x61, 0x61, 0x9c, 0x97, 0x65, 0xcf, 0x7f, 0x08, 0xf7, 0x5c, 0xa7, 0x63, 0xbc, 0x03, 0xf3, 0x5d,
+	0x9b, 0x2a, 0xf8, 0x0a, 0x8c, 0xc8, 0x57, 0x1e, 0xeb, 0x3b, 0xe7, 0xc4, 0x6b, 0xa7, 0xc6, 0x8c,
+	0xcc, 0xd0, 0xae, 0xe3, 0x90, 0x63, 0x5c, 0xdb, 0x63, 0xcd, 0x95, 0x1a, 0x1f, 0x83, 0xde, 0xbd,
+	0xab, 0xa2, 0xbe, 0x0b, 0x59, 0xde, 0x84, 0xfd, 0xfc, 0x2d, 0x74, 0x35, 0xde, 0xc0, 0x2b, 0x92,
+	0x42, 0xe1, 0xa5, 0x38, 0x4b, 0x0e, 0xa9, 0x3e, 0x51, 0x9c, 0x37, 0x24, 0x67, 0x64, 0x57, 0x71,
+	0x2e, 0x45, 0x38, 0x73, 0xfe, 0x77, 0xf8, 0x21, 0xa7, 0x60, 0x42, 0x08, 0x6e, 0x7b, 0x87, 0xac,
+	0xd3, 0x77, 0x8c, 0x2a, 0xcc, 0x45, 0x77, 0x54, 0x28, 0x56, 0x70, 0xfd, 0x4d, 0x79, 0x58, 0xc1,
+	0x06, 0xda, 0x84, 0xe9, 0x26, 0x76, 0x6b, 0xb6, 0x5b, 0xaf, 0x04, 0x56, 0xfc, 0x55, 0x94, 0xa7,
+	0x24, 0xa0, 0x42, 0xee, 0x9c, 0x4e, 0x43, 0x86, 0xb3, 0x20, 0x07, 0xb2, 0xa2, 0x27, 0xa3, 0x78,
+	0x1b, 0x0b, 0xcd, 0x04, 0xba, 0x91, 0x8e, 0xf9, 0xf2, 0x0c, 0xe3, 0x2b, 0xf6, 0x65, 0x5f, 0xbc,
+	0xfc, 0xfb, 0x87, 0xc1, 0x0b, 0x68, 0xd6, 0x4c, 0x98, 0x1b, 0x6b, 0xe8, 0x10, 0x32, 0x62, 0x64,
+	0x98, 0x4f, 0x0c, 0xc8, 0x20, 0xfd, 0x62, 0x2a, 0xa4, 0xa8, 0x2e, 0x06, 0x54, 0x73, 0x68, 0xc6,
+	0xec, 0x9e, 0x43, 0x29, 0x22, 0x30, 0xcc, 0xfb, 0x45, 0x3e, 0x2d, 0x9a, 0xbe, 0x92, 0x86, 0x28,
+	0x9a, 0xcd, 0x80, 0x66, 0x05, 0x15, 0x92, 0x68, 0xcc, 0xa7, 0xf2, 0x9a, 0x3e, 0x43, 0x1d, 0x18,
+	0x8b, 0xb4, 0xf5, 0xc4, 0xf0, 0x61, 0x0b, 0xbd, 0x10, 0xb3, 0xb8, 0x4b, 0xeb, 0x6c, 0x5f, 0xd1,
+	0xff, 0x8f, 0x33, 0x5f, 0x7c, 0x5b, 0xdb, 0x30, 0x16, 0x63, 0xe4, 0x54, 0xc6, 0xa9, 0xb0, 0x0d,
+	0x96, 0x55, 0x31, 0x03, 0x27, 0x66, 0x95, 0x43, 0xc9, 0x59, 0x8d, 0xcc, 0xbc, 0xbd, 0xb3, 0xca,
+	0x47, 0x47, 0xd4, 0x80, 0x61, 0x3e, 0xdb, 0xe6, 0xd3, 0xa2, 0x25, 0x67, 0x35, 0x3c, 0xb4, 0x1a,
+	0xeb, 0x01, 0x4d, 0x01, 0x2d, 0x26, 0xd1, 0x98, 0x4f, 0xf9, 0xeb, 0x78, 0x86, 0x3e, 0x07, 0x08,
+	0xda, 0x39, 0x2a, 0x24, 0x85, 0x0e, 0x70, 0xfd, 0x4a, 0x6f, 0x5c, 0x09, 0xb8, 0x12, 0x08, 0x58,
+	0x40, 0xf3, 0x66, 0xca, 0xff, 0x8d, 0x28, 0xfa, 0x5e, 0x83, 0xa9, 0xae, 0xf9, 0x63, 0xb5, 0x37,
+	0x89, 0xb0, 0xea, 0x5b, 0xca, 0x56, 0x20, 0xe5, 0x32, 0xba, 0x94, 0x2a, 0x25, 0x74, 0xcd, 0x7e,
+	0xd6, 0x60, 0x32, 0x3e, 0x82, 0x5c, 0x4a, 0x62, 0x8b, 0x19, 0xe9, 0x9b, 0x7d, 0x18, 0x29, 0x5d,
+	0xd7, 0x02, 0x5d, 0x9b, 0x68, 0x3d, 0xa6, 0x2b, 0x3e, 0xb4, 0x98, 0x4f, 0xe5, 0x8f, 0x67, 0xa8,
+	0x0d, 0x23, 0xfe, 0x44, 0x82, 0x16, 0x93, 0x08, 0x7d, 0x54, 0x5f, 0xed, 0x85, 0x2a, 0x1d, 0x97,
+	0x03, 0x1d, 0x3a, 0xca, 0x9b, 0xc9, 0xff, 0xc9, 0xa5, 0xe8, 0x3b, 0x0d, 0x26, 0x62, 0xf3, 0x49,
+	0x62, 0xc5, 0x8a, 0xda, 0xe8, 0x1b, 0x67, 0xdb, 0x28, 0x25, 0x66, 0xa0, 0x64, 0x15, 0x19, 0x69,
+	0x4a, 0x42, 0xa9, 0xe0, 0x85, 0x95, 0xb5, 0xf6, 0xb4, 0xc2, 0xca, 0xb0, 0xb4, 0xc2, 0x1a, 0x1e,
+	0x2a, 0xce, 0x2a, 0xac, 0x9c, 0x83, 0x95, 0x00, 0xd6, 0x6b, 0x53, 0x4a, 0x00, 0x83, 0x52, 0x4a,
+	0x40, 0x78, 0xc2, 0x38, 0xa3, 0x04, 0x70, 0x82, 0x6f, 0x34, 0x98, 0x88, 0xcd, 0x10, 0x46, 0x6a,
+	0x60, 0x65, 0x93, 0x9c, 0xeb, 0xe4, 0xd1, 0xa2, 0x77, 0xdd, 0xe5, 0x2a, 0x42, 0x79, 0xfe, 0x52,
+	0x83, 0xb1, 0xf0, 0x0c, 0x91, 0x5c, 0x78, 0xc3, 0x16, 0xfa, 0xda, 0x59, 0x16, 0xfd, 0xd5, 0x2a,
+	0xff, 0x1d, 0x5c, 0x25, 0x8c, 0xf6, 0x6b, 0x0d, 0xc6, 0x23, 0x63, 0x07, 0x4a, 0xcc, 0x77, 0xc4,
+	0x44, 0x5f, 0x3f, 0xd3, 0x44, 0x49, 0xd9, 0x08, 0xa4, 0x2c, 0xa3, 0x25, 0x33, 0xfe, 0x97, 0x22,
+	0xee, 0x72, 0x55, 0x4c, 0x15, 0x5c, 0x4b, 0x64, 0x1c, 0x49, 0xd6, 0x12, 0x31, 0x49, 0xd6, 0x92,
+	0x38, 0xd4, 0xf4, 0xd6, 0x72, 0x20, 0x5c, 0x7c, 0x2d, 0x27, 0x90, 0x53, 0x73, 0x07, 0x5a, 0x4a,
+	0xfc, 0x5e, 0x1f, 0xd6, 0x2f, 0xf7, 0x84, 0xfb, 0xab, 0x0a, 0x6a, 0xf8, 0x29, 0x15, 0x9f, 0x9f,
+	0x16, 0xb4, 0x17, 0xa7, 0x05, 0xed, 0xaf, 0xd3, 0x82, 0xf6, 0xed, 0xeb, 0xc2, 0xc0, 0x8b, 0xd7,
+	0x85, 0x81, 0x3f, 0x5e, 0x17, 0x06, 0x3e, 0x9a, 0xe1, 0x04, 0x82, 0xeb, 0xa4, 0xf3, 0x99, 0xe9,
+	0x75, 0x9a, 0x98, 0x1e, 0x64, 0xf9, 0x9f, 0x95, 0xfe, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xf8, 0x28, 0xf3, 0x77, 0x4f, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Retrieves a list of the currently paused Pools.
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	// Retrieves the details of all Pools.
+	Pools(ctx context.Context, in *QueryPools, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// Retrieves details of a specific Pool.
+	Pool(ctx context.Context, in *QueryPool, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// Simulates a token swap simulation.
+	SimulateSwap(ctx context.Context, in *QuerySimulateSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	// Retrieves exchange rates for all tokens, with the optionality of filtering by algorithm.
+	Rates(ctx context.Context, in *QueryRates, opts ...grpc.CallOption) (*QueryRatesResponse, error)
+	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
+	Rate(ctx context.Context, in *QueryRate, opts ...grpc.CallOption) (*QueryRateResponse, error)
+	// Retrieves all the active incentive programs.
+	Incentives(ctx context.Context, in *QueryIncentives, opts ...grpc.CallOption) (*QueryIncentivesResponse, error)
+	// Retrieves the active incentive programs of a specific Pool.
+	IncentivesByPool(ctx context.Context, in *QueryIncentivesByPool, opts ...grpc.CallOption) (*QueryIncentivesResponse, error)
+	// Retrieves the address receiving the rewards of a specific user.
+	WithdrawAddress(ctx context.Context, in *QueryWithdrawAddress, opts ...grpc.CallOption) (*QueryWithdrawAddressResponse, error)
+	// Retrieves the swap fee discount tiers and the fee exempt accounts.
+	FeeTiers(ctx context.Context, in *QueryFeeTiers, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error)
+	// Retrieves the rolling swap volume and the fee discount of a specific account.
+	AccountFeeTier(ctx context.Context, in *QueryAccountFeeTier, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error)
+	// Retrieves the module params.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Retrieves all the role grants.
+	Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	// Retrieves the roles granted to a specific address.
+	RolesByAddress(ctx context.Context, in *QueryRolesByAddress, opts ...grpc.CallOption) (*QueryRolesByAddressResponse, error)
+	// Retrieves the IDs of the pools in withdraw-only mode.
+	WithdrawOnly(ctx context.Context, in *QueryWithdrawOnly, opts ...grpc.CallOption) (*QueryWithdrawOnlyResponse, error)
+	// Retrieves the registry of the denoms allowed as pool pairs.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenoms, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Retrieves the denoms blocked from the swaps.
+	BlockedDenoms(ctx context.Context, in *QueryBlockedDenoms, opts ...grpc.CallOption) (*QueryBlockedDenomsResponse, error)
+	// Retrieves the current authority, and the pending one if any.
+	Authority(ctx context.Context, in *QueryAuthority, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPools, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pool(ctx context.Context, in *QueryPool, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/SimulateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rates(ctx context.Context, in *QueryRates, opts ...grpc.CallOption) (*QueryRatesResponse, error) {
+	out := new(QueryRatesResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Rates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rate(ctx context.Context, in *QueryRate, opts ...grpc.CallOption) (*QueryRateResponse, error) {
+	out := new(QueryRateResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Rate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Incentives(ctx context.Context, in *QueryIncentives, opts ...grpc.CallOption) (*QueryIncentivesResponse, error) {
+	out := new(QueryIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Incentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivesByPool(ctx context.Context, in *QueryIncentivesByPool, opts ...grpc.CallOption) (*QueryIncentivesResponse, error) {
+	out := new(QueryIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/IncentivesByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawAddress(ctx context.Context, in *QueryWithdrawAddress, opts ...grpc.CallOption) (*QueryWithdrawAddressResponse, error) {
+	out := new(QueryWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/WithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTiers(ctx context.Context, in *QueryFeeTiers, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error) {
+	out := new(QueryFeeTiersResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/FeeTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeTier(ctx context.Context, in *QueryAccountFeeTier, opts ...grpc.CallOption) (*QueryAccountFeeTierResponse, error) {
+	out := new(QueryAccountFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/AccountFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Roles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RolesByAddress(ctx context.Context, in *QueryRolesByAddress, opts ...grpc.CallOption) (*QueryRolesByAddressResponse, error) {
+	out := new(QueryRolesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/RolesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawOnly(ctx context.Context, in *QueryWithdrawOnly, opts ...grpc.CallOption) (*QueryWithdrawOnlyResponse, error) {
+	out := new(QueryWithdrawOnlyResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/WithdrawOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenoms, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/AllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedDenoms(ctx context.Context, in *QueryBlockedDenoms, opts ...grpc.CallOption) (*QueryBlockedDenomsResponse, error) {
+	out := new(QueryBlockedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/BlockedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authority(ctx context.Context, in *QueryAuthority, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
+	out := new(QueryAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.v1.Query/Authority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves a list of the currently paused Pools.
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	// Retrieves the details of all Pools.
+	Pools(context.Context, *QueryPools) (*QueryPoolsResponse, error)
+	// Retrieves details of a specific Pool.
+	Pool(context.Context, *QueryPool) (*QueryPoolResponse, error)
+	// Simulates a token swap simulation.
+	SimulateSwap(context.Context, *QuerySimulateSwap) (*MsgSwapResponse, error)
+	// Retrieves exchange rates for all tokens, with the optionality of filtering by algorithm.
+	Rates(context.Context, *QueryRates) (*QueryRatesResponse, error)
+	// Retrieves exchange rates for a specific token, with the optionality of filtering by algorithm.
+	Rate(context.Context, *QueryRate) (*QueryRateResponse, error)
+	// Retrieves all the active incentive programs.
+	Incentives(context.Context, *QueryIncentives) (*QueryIncentivesResponse, error)
+	// Retrieves the active incentive programs of a specific Pool.
+	IncentivesByPool(context.Context, *QueryIncentivesByPool) (*QueryIncentivesResponse, error)
+	// Retrieves the address receiving the rewards of a specific user.
+	WithdrawAddress(context.Context, *QueryWithdrawAddress) (*QueryWithdrawAddressResponse, error)
+	// Retrieves the swap fee discount tiers and the fee exempt accounts.
+	FeeTiers(context.Context, *QueryFeeTiers) (*QueryFeeTiersResponse, error)
+	// Retrieves the rolling swap volume and the fee discount of a specific account.
+	AccountFeeTier(context.Context, *QueryAccountFeeTier) (*QueryAccountFeeTierResponse, error)
+	// Retrieves the module params.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Retrieves all the role grants.
+	Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error)
+	// Retrieves the roles granted to a specific address.
+	RolesByAddress(context.Context, *QueryRolesByAddress) (*QueryRolesByAddressResponse, error)
+	// Retrieves the IDs of the pools in withdraw-only mode.
+	WithdrawOnly(context.Context, *QueryWithdrawOnly) (*QueryWithdrawOnlyResponse, error)
+	// Retrieves the registry of the denoms allowed as pool pairs.
+	AllowedDenoms(context.Context, *QueryAllowedDenoms) (*QueryAllowedDenomsResponse, error)
+	// Retrieves the denoms blocked from the swaps.
+	BlockedDenoms(context.Context, *QueryBlockedDenoms) (*QueryBlockedDenomsResponse, error)
+	// Retrieves the current authority, and the pending one if any.
+	Authority(context.Context, *QueryAuthority) (*QueryAuthorityResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPools) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPool) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+}
+func (*UnimplementedQueryServer) Rates(ctx context.Context, req *QueryRates) (*QueryRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rates not implemented")
+}
+func (*UnimplementedQueryServer) Rate(ctx context.Context, req *QueryRate) (*QueryRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
+}
+func (*UnimplementedQueryServer) Incentives(ctx context.Context, req *QueryIncentives) (*QueryIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incentives not implemented")
+}
+func (*UnimplementedQueryServer) IncentivesByPool(ctx context.Context, req *QueryIncentivesByPool) (*QueryIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivesByPool not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawAddress(ctx context.Context, req *QueryWithdrawAddress) (*QueryWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) FeeTiers(ctx context.Context, req *QueryFeeTiers) (*QueryFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTiers not implemented")
+}
+func (*UnimplementedQueryServer) AccountFeeTier(ctx context.Context, req *QueryAccountFeeTier) (*QueryAccountFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeTier not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Roles(ctx context.Context, req *QueryRoles) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (*UnimplementedQueryServer) RolesByAddress(ctx context.Context, req *QueryRolesByAddress) (*QueryRolesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolesByAddress not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawOnly(ctx context.Context, req *QueryWithdrawOnly) (*QueryWithdrawOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawOnly not implemented")
+}
+func (*UnimplementedQueryServer) AllowedDenoms(ctx context.Context, req *QueryAllowedDenoms) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) BlockedDenoms(ctx context.Context, req *QueryBlockedDenoms) (*QueryBlockedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthority) (*QueryAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPools)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPools))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/SimulateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Rates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rates(ctx, req.(*QueryRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Rate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rate(ctx, req.(*QueryRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Incentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentives)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Incentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Incentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Incentives(ctx, req.(*QueryIncentives))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivesByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivesByPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivesByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/IncentivesByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivesByPool(ctx, req.(*QueryIncentivesByPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/WithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawAddress(ctx, req.(*QueryWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/FeeTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTiers(ctx, req.(*QueryFeeTiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeeTier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/AccountFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeTier(ctx, req.(*QueryAccountFeeTier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Roles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RolesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRolesByAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RolesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/RolesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RolesByAddress(ctx, req.(*QueryRolesByAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/WithdrawOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawOnly(ctx, req.(*QueryWithdrawOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/AllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/BlockedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedDenoms(ctx, req.(*QueryBlockedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.v1.Query/Authority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authority(ctx, req.(*QueryAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.swap.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "SimulateSwap",
+			Handler:    _Query_SimulateSwap_Handler,
+		},
+		{
+			MethodName: "Rates",
+			Handler:    _Query_Rates_Handler,
+		},
+		{
+			MethodName: "Rate",
+			Handler:    _Query_Rate_Handler,
+		},
+		{
+			MethodName: "Incentives",
+			Handler:    _Query_Incentives_Handler,
+		},
+		{
+			MethodName: "IncentivesByPool",
+			Handler:    _Query_IncentivesByPool_Handler,
+		},
+		{
+			MethodName: "WithdrawAddress",
+			Handler:    _Query_WithdrawAddress_Handler,
+		},
+		{
+			MethodName: "FeeTiers",
+			Handler:    _Query_FeeTiers_Handler,
+		},
+		{
+			MethodName: "AccountFeeTier",
+			Handler:    _Query_AccountFeeTier_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "RolesByAddress",
+			Handler:    _Query_RolesByAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawOnly",
+			Handler:    _Query_WithdrawOnly_Handler,
+		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "BlockedDenoms",
+			Handler:    _Query_BlockedDenoms_Handler,
+		},
+		{
+			MethodName: "Authority",
+			Handler:    _Query_Authority_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/swap/v1/query.proto",
+}
+
+func (m *QueryRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedActivities) > 0 {
+		for iNdEx := len(m.PausedActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PausedPools) > 0 {
+		dAtA2 := make([]byte, len(m.PausedPools)*10)
+		var j1 int
+		for _, num := range m.PausedPools {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPools) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPools) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AffiliateFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AffiliateFeeBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Affiliate) > 0 {
+		i -= len(m.Affiliate)
+		copy(dAtA[i:], m.Affiliate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Affiliate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Min.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentives) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentives) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentives) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivesByPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivesByPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivesByPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for iNdEx := len(m.Incentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Incentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exempt) > 0 {
+		for iNdEx := len(m.Exempt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exempt[iNdEx])
+			copy(dAtA[i:], m.Exempt[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Exempt[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exempt {
+		i--
+		if m.Exempt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesByAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesByAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesByAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRolesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRolesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRolesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		dAtA8 := make([]byte, len(m.Roles)*10)
+		var j7 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawOnly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawOnly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawOnlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawOnlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawOnlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA10 := make([]byte, len(m.PoolIds)*10)
+		var j9 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingAuthority) > 0 {
+		i -= len(m.PendingAuthority)
+		copy(dAtA[i:], m.PendingAuthority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		n += 1 + sovQuery(uint64(m.Algorithm))
+	}
+	return n
+}
+
+func (m *QueryRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovQuery(uint64(m.Algorithm))
+	}
+	return n
+}
+
+func (m *QueryRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedPools) > 0 {
+		l = 0
+		for _, e := range m.PausedPools {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.PausedActivities) > 0 {
+		for _, e := range m.PausedActivities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Min.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Affiliate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AffiliateFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.AffiliateFeeBps))
+	}
+	return n
+}
+
+func (m *QueryIncentives) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryIncentivesByPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Exempt) > 0 {
+		for _, s := range m.Exempt {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Exempt {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRolesByAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRolesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryWithdrawOnly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWithdrawOnlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryAllowedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PendingAuthority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= Algorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, Rate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= Algorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, Rate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedPools = append(m.PausedPools, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedPools) == 0 {
+					m.PausedPools = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedPools = append(m.PausedPools, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedActivities = append(m.PausedActivities, PausedActivities{})
+			if err := m.PausedActivities[len(m.PausedActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPools) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPools: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPools: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &PoolDetails{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &PoolDetails{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Affiliate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateFeeBps", wireType)
+			}
+			m.AffiliateFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AffiliateFeeBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentives) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentives: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentives: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesByPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesByPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesByPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, Incentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, FeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exempt = append(m.Exempt, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountFeeTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountFeeTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exempt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exempt = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func (m *QueryPools) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
-}
-
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QuerySimulateSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.Min.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryRates) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1454,17 +5912,17 @@ func (m *QueryRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.Algorithm = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1474,11 +5932,25 @@ func (m *QueryRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Algorithm |= Algorithm(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1500,7 +5972,7 @@ func (m *QueryRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,15 +5995,65 @@ func (m *QueryRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1558,8 +6080,8 @@ func (m *QueryRatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rates = append(m.Rates, Rate{})
-			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, RoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1584,7 +6106,7 @@ func (m *QueryRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRate) Unmarshal(dAtA []byte) error {
+func (m *QueryRolesByAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,15 +6129,15 @@ func (m *QueryRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRolesByAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRolesByAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1643,27 +6165,8 @@ func (m *QueryRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
-			}
-			m.Algorithm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Algorithm |= Algorithm(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1685,7 +6188,7 @@ func (m *QueryRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRolesByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1708,46 +6211,81 @@ func (m *QueryRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRolesByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRolesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rates = append(m.Rates, Rate{})
-			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1769,7 +6307,7 @@ func (m *QueryRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPaused) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawOnly) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1792,10 +6330,10 @@ func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawOnly: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawOnly: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1819,7 +6357,7 @@ func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawOnlyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1842,10 +6380,10 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawOnlyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawOnlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1865,7 +6403,7 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.PausedPools = append(m.PausedPools, v)
+				m.PoolIds = append(m.PoolIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1900,8 +6438,8 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.PausedPools) == 0 {
-					m.PausedPools = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -1919,10 +6457,10 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.PausedPools = append(m.PausedPools, v)
+					m.PoolIds = append(m.PoolIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PausedPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -1945,7 +6483,7 @@ func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPools) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1968,10 +6506,10 @@ func (m *QueryPools) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPools: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPools: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1995,7 +6533,7 @@ func (m *QueryPools) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,15 +6556,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2053,8 +6591,8 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &PoolDetails{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, AllowedDenom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2079,7 +6617,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPool) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2102,31 +6640,12 @@ func (m *QueryPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2148,7 +6667,7 @@ func (m *QueryPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2171,17 +6690,17 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2191,27 +6710,23 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &PoolDetails{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2234,7 +6749,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateSwap) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2257,82 +6772,67 @@ func (m *QuerySimulateSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2342,31 +6842,29 @@ func (m *QuerySimulateSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, Route{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAuthority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2376,24 +6874,23 @@ func (m *QuerySimulateSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
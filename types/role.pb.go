@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/swap/v1/role.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Role int32
+
+const (
+	ROLE_UNSPECIFIED Role = 0
+	// Allowed to pause and unpause the pools.
+	ROLE_PAUSER Role = 1
+	// Allowed to create and update the pools.
+	ROLE_POOL_MANAGER Role = 2
+	// Allowed to withdraw the protocol fees.
+	ROLE_FEE_COLLECTOR Role = 3
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_PAUSER",
+	2: "ROLE_POOL_MANAGER",
+	3: "ROLE_FEE_COLLECTOR",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":   0,
+	"ROLE_PAUSER":        1,
+	"ROLE_POOL_MANAGER":  2,
+	"ROLE_FEE_COLLECTOR": 3,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3318c3086105227, []int{0}
+}
+
+type RoleGrant struct {
+	// Address the role is granted to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Granted role.
+	Role Role `protobuf:"varint,2,opt,name=role,proto3,enum=noble.swap.v1.Role" json:"role,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3318c3086105227, []int{0}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("noble.swap.v1.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleGrant)(nil), "noble.swap.v1.RoleGrant")
+}
+
+func init() { proto.RegisterFile("noble/swap/v1/role.proto", fileDescriptor_a3318c3086105227) }
+
+var fileDescriptor_a3318c3086105227 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xcb, 0x4f, 0xca,
+	0x49, 0xd5, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0xca, 0xcf, 0x49, 0xd5, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0xcb, 0xe8, 0x81, 0x64, 0xf4, 0xca, 0x0c, 0xa5, 0x24,
+	0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x92, 0xfa, 0x10, 0x0e, 0x44, 0xa5, 0x94, 0x48,
+	0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x1c, 0xc4, 0x82, 0x88, 0x2a, 0x65, 0x70, 0x71, 0x06, 0xe5, 0xe7,
+	0xa4, 0xba, 0x17, 0x25, 0xe6, 0x95, 0x08, 0x19, 0x71, 0xb1, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16,
+	0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0x35, 0xc5,
+	0x11, 0x22, 0x13, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x04, 0x53, 0x28, 0xa4, 0xce, 0xc5, 0x02,
+	0x72, 0x8e, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0xb0, 0x1e, 0x8a, 0x7b, 0xf4, 0x40, 0x66,
+	0x07, 0x81, 0x15, 0x68, 0x25, 0x71, 0xb1, 0x80, 0x78, 0x42, 0x22, 0x5c, 0x02, 0x41, 0xfe, 0x3e,
+	0xae, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42,
+	0xfc, 0x5c, 0xdc, 0x60, 0xd1, 0x00, 0xc7, 0xd0, 0x60, 0xd7, 0x20, 0x01, 0x46, 0x21, 0x51, 0x2e,
+	0x41, 0x88, 0x80, 0xbf, 0xbf, 0x4f, 0xbc, 0xaf, 0xa3, 0x9f, 0xa3, 0xbb, 0x6b, 0x90, 0x00, 0x93,
+	0x90, 0x18, 0x97, 0x10, 0x58, 0xd8, 0xcd, 0xd5, 0x35, 0xde, 0xd9, 0xdf, 0xc7, 0xc7, 0xd5, 0x39,
+	0xc4, 0x3f, 0x48, 0x80, 0x59, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x93, 0xde, 0x89, 0x47, 0x72,
+	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
+	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x89, 0x80, 0x5d, 0x04, 0x71, 0x5c, 0x45, 0x65, 0x95,
+	0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x10, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x39, 0x5b, 0xd0, 0xba, 0x60, 0x01, 0x00, 0x00,
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRole(uint64(m.Role))
+	}
+	return n
+}
+
+func sovRole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRole(x uint64) (n int) {
+	return sovRole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRole = fmt.Errorf("proto: unexpected end of group")
+)
@@ -32,6 +32,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePool{}, "swap/stableswap/UpdatePool", nil)
 	cdc.RegisterConcrete(&MsgAddLiquidity{}, "swap/stableswap/AddLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "swap/stableswap/RemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "swap/stableswap/TransferPosition", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "swap/stableswap/SetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgScheduleUpdatePool{}, "swap/stableswap/ScheduleUpdatePool", nil)
+	cdc.RegisterConcrete(&MsgCancelPoolUpdate{}, "swap/stableswap/CancelPoolUpdate", nil)
+	cdc.RegisterConcrete(&MsgRampA{}, "swap/stableswap/RampA", nil)
+	cdc.RegisterConcrete(&MsgStopRampA{}, "swap/stableswap/StopRampA", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -39,6 +45,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdatePool{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveLiquidity{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddLiquidity{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransferPosition{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAutoCompound{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgScheduleUpdatePool{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelPoolUpdate{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRampA{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgStopRampA{})
 
 	registry.RegisterInterface(
 		"swap.v1.Pool",
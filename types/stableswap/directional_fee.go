@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package stableswap
+
+import "fmt"
+
+// ValidateDirectionalFee ensures that the fees of both directions are non-negative and, when
+// the dynamic fee model is enabled, within its bounds. A nil directional fee is valid.
+func ValidateDirectionalFee(directionalFee *DirectionalFee, dynamicFee *DynamicFee) error {
+	if directionalFee == nil {
+		return nil
+	}
+	if directionalFee.PairToBaseFee < 0 || directionalFee.BaseToPairFee < 0 {
+		return fmt.Errorf("fees cannot be negative, got %d and %d", directionalFee.PairToBaseFee, directionalFee.BaseToPairFee)
+	}
+	for _, fee := range []int64{directionalFee.PairToBaseFee, directionalFee.BaseToPairFee} {
+		if err := ValidateDynamicFee(dynamicFee, fee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package stableswap
+
+import "fmt"
+
+// ValidateDynamicFee ensures that the dynamic fee has a non-negative multiplier and bounds
+// containing the base rewards fee. A nil dynamic fee is valid.
+func ValidateDynamicFee(dynamicFee *DynamicFee, rewardsFee int64) error {
+	if dynamicFee == nil {
+		return nil
+	}
+	if dynamicFee.Multiplier.IsNil() || dynamicFee.Multiplier.IsNegative() {
+		return fmt.Errorf("multiplier must be non-negative, got %s", dynamicFee.Multiplier)
+	}
+	if dynamicFee.MinFee < 0 {
+		return fmt.Errorf("min fee cannot be negative, got %d", dynamicFee.MinFee)
+	}
+	if dynamicFee.MinFee > rewardsFee || rewardsFee > dynamicFee.MaxFee {
+		return fmt.Errorf("rewards fee %d must be between min fee %d and max fee %d", rewardsFee, dynamicFee.MinFee, dynamicFee.MaxFee)
+	}
+	return nil
+}
@@ -53,6 +53,22 @@ type PoolCreated struct {
 	FutureATime int64 `protobuf:"varint,9,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 	// Rate multipliers applied to the coins.
 	RateMultipliers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=rate_multipliers,json=rateMultipliers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate_multipliers"`
+	// Unbonding tiers of the pool.
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,11,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// Exit fee tiers of the pool.
+	ExitFeeTiers []ExitFeeTier `protobuf:"bytes,12,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []LockTier `protobuf:"bytes,13,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers"`
+	// Recipients of the protocol fees.
+	FeeSplits []FeeSplit `protobuf:"bytes,14,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+	// Dynamic rewards fee model.
+	DynamicFee *DynamicFee `protobuf:"bytes,15,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,16,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+	// Address of the account that created the pool.
+	Creator string `protobuf:"bytes,17,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Fee paid by the creator, empty when created by the authority or a pool manager.
+	CreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,18,rep,name=creation_fee,json=creationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creation_fee"`
 }
 
 func (m *PoolCreated) Reset()         { *m = PoolCreated{} }
@@ -158,6 +174,62 @@ func (m *PoolCreated) GetRateMultipliers() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *PoolCreated) GetUnbondingTiers() []UnbondingTier {
+	if m != nil {
+		return m.UnbondingTiers
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetExitFeeTiers() []ExitFeeTier {
+	if m != nil {
+		return m.ExitFeeTiers
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetLockTiers() []LockTier {
+	if m != nil {
+		return m.LockTiers
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetFeeSplits() []FeeSplit {
+	if m != nil {
+		return m.FeeSplits
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetDynamicFee() *DynamicFee {
+	if m != nil {
+		return m.DynamicFee
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetDirectionalFee() *DirectionalFee {
+	if m != nil {
+		return m.DirectionalFee
+	}
+	return nil
+}
+
+func (m *PoolCreated) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PoolCreated) GetCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreationFee
+	}
+	return nil
+}
+
 type PoolUpdated struct {
 	// ID of the updated pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -171,6 +243,18 @@ type PoolUpdated struct {
 	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 	// Rate multipliers applied to the coins.
 	RateMultipliers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rate_multipliers,json=rateMultipliers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate_multipliers"`
+	// Unbonding tiers of the pool.
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,7,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// Exit fee tiers of the pool.
+	ExitFeeTiers []ExitFeeTier `protobuf:"bytes,8,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []LockTier `protobuf:"bytes,9,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers"`
+	// Recipients of the protocol fees.
+	FeeSplits []FeeSplit `protobuf:"bytes,10,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+	// Dynamic rewards fee model.
+	DynamicFee *DynamicFee `protobuf:"bytes,11,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,12,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
 }
 
 func (m *PoolUpdated) Reset()         { *m = PoolUpdated{} }
@@ -248,6 +332,48 @@ func (m *PoolUpdated) GetRateMultipliers() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
+func (m *PoolUpdated) GetUnbondingTiers() []UnbondingTier {
+	if m != nil {
+		return m.UnbondingTiers
+	}
+	return nil
+}
+
+func (m *PoolUpdated) GetExitFeeTiers() []ExitFeeTier {
+	if m != nil {
+		return m.ExitFeeTiers
+	}
+	return nil
+}
+
+func (m *PoolUpdated) GetLockTiers() []LockTier {
+	if m != nil {
+		return m.LockTiers
+	}
+	return nil
+}
+
+func (m *PoolUpdated) GetFeeSplits() []FeeSplit {
+	if m != nil {
+		return m.FeeSplits
+	}
+	return nil
+}
+
+func (m *PoolUpdated) GetDynamicFee() *DynamicFee {
+	if m != nil {
+		return m.DynamicFee
+	}
+	return nil
+}
+
+func (m *PoolUpdated) GetDirectionalFee() *DirectionalFee {
+	if m != nil {
+		return m.DirectionalFee
+	}
+	return nil
+}
+
 type LiquidityAdded struct {
 	// Address of the liquidity provider.
 	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
@@ -324,6 +450,8 @@ type LiquidityRemoved struct {
 	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
 	// Time when the removed liquidity will be unlocked.
 	UnlockTime time.Time `protobuf:"bytes,5,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+	// Exit fee paid for an instant liquidity removal.
+	ExitFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=exit_fee,json=exitFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exit_fee"`
 }
 
 func (m *LiquidityRemoved) Reset()         { *m = LiquidityRemoved{} }
@@ -387,480 +515,3511 @@ func (m *LiquidityRemoved) GetUnlockTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*PoolCreated)(nil), "noble.swap.stableswap.v1.PoolCreated")
-	proto.RegisterType((*PoolUpdated)(nil), "noble.swap.stableswap.v1.PoolUpdated")
-	proto.RegisterType((*LiquidityAdded)(nil), "noble.swap.stableswap.v1.LiquidityAdded")
-	proto.RegisterType((*LiquidityRemoved)(nil), "noble.swap.stableswap.v1.LiquidityRemoved")
+func (m *LiquidityRemoved) GetExitFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExitFee
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("noble/swap/stableswap/v1/events.proto", fileDescriptor_ebef50c59245cec9)
+type PositionTransferred struct {
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the provider transferring the position.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Address of the provider receiving the position.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Shares transferred to the receiver.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
 }
 
-var fileDescriptor_ebef50c59245cec9 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0x93, 0x34, 0x4d, 0x26, 0x7d, 0x7d, 0x7d, 0xa3, 0x87, 0xea, 0xa6, 0x28, 0x89, 0x22,
-	0x90, 0xa2, 0x4a, 0xb5, 0x95, 0x22, 0x55, 0x62, 0xd9, 0xb4, 0x54, 0x42, 0x2a, 0xa8, 0xb2, 0xca,
-	0x86, 0x8d, 0x35, 0xb6, 0x6f, 0x9d, 0x51, 0x6d, 0x8f, 0xf1, 0x8c, 0x53, 0xc2, 0x37, 0xb0, 0xe8,
-	0x9a, 0x2f, 0x40, 0xac, 0xba, 0xe0, 0x0b, 0x58, 0x75, 0x83, 0x54, 0xb1, 0x02, 0x16, 0x2d, 0x6a,
-	0x17, 0xfd, 0x0d, 0xe4, 0x19, 0x27, 0x29, 0x42, 0x2a, 0xb0, 0x81, 0x0d, 0x9b, 0x64, 0xee, 0x3d,
-	0xe7, 0xde, 0xeb, 0x39, 0x67, 0x34, 0x83, 0xee, 0x46, 0xcc, 0x09, 0xc0, 0xe4, 0x87, 0x24, 0x36,
-	0xb9, 0x20, 0x4e, 0x00, 0x72, 0x39, 0xec, 0x99, 0x30, 0x84, 0x48, 0x70, 0x23, 0x4e, 0x98, 0x60,
-	0x58, 0x97, 0x34, 0x23, 0xc3, 0x8c, 0x29, 0xcd, 0x18, 0xf6, 0x1a, 0xff, 0x91, 0x90, 0x46, 0xcc,
-	0x94, 0xbf, 0x8a, 0xdc, 0x68, 0xba, 0x8c, 0x87, 0x8c, 0x9b, 0x0e, 0xe1, 0x60, 0x0e, 0x7b, 0x0e,
-	0x08, 0xd2, 0x33, 0x5d, 0x46, 0xa3, 0x1c, 0x5f, 0x52, 0xb8, 0x2d, 0x23, 0x53, 0x05, 0x39, 0xf4,
-	0xbf, 0xcf, 0x7c, 0xa6, 0xf2, 0xd9, 0x2a, 0xcf, 0xb6, 0x7c, 0xc6, 0xfc, 0x00, 0x4c, 0x19, 0x39,
-	0xe9, 0xbe, 0x29, 0x68, 0x08, 0x5c, 0x90, 0x30, 0x56, 0x84, 0xce, 0xbb, 0x12, 0xaa, 0xef, 0x32,
-	0x16, 0x6c, 0x26, 0x40, 0x04, 0x78, 0x78, 0x11, 0xcd, 0xc6, 0x8c, 0x05, 0x36, 0xf5, 0x74, 0xad,
-	0xad, 0x75, 0xcb, 0x56, 0x25, 0x0b, 0x1f, 0x7a, 0xf8, 0x36, 0xaa, 0x91, 0xc0, 0x67, 0x09, 0x15,
-	0x83, 0x50, 0x2f, 0xb6, 0xb5, 0x6e, 0xcd, 0x9a, 0x26, 0x30, 0x46, 0xe5, 0x98, 0xd0, 0x44, 0x2f,
-	0x49, 0x40, 0xae, 0xf1, 0x3a, 0x5a, 0x94, 0x33, 0x5c, 0x16, 0xd8, 0xfb, 0x00, 0x76, 0x0c, 0x89,
-	0x0b, 0x91, 0x20, 0x3e, 0xe8, 0xe5, 0xb6, 0xd6, 0x2d, 0x59, 0xb7, 0xc6, 0xf0, 0x36, 0xc0, 0xee,
-	0x04, 0xc4, 0x2d, 0x54, 0x4f, 0xe0, 0x90, 0x24, 0x1e, 0xcf, 0xca, 0xf4, 0x19, 0xc9, 0x45, 0x79,
-	0x6a, 0x1b, 0x00, 0x2f, 0xa3, 0x1a, 0x8d, 0xa8, 0xa0, 0x24, 0xb0, 0x89, 0x5e, 0x91, 0x70, 0x35,
-	0x4f, 0x6c, 0xe0, 0x3b, 0x68, 0x7e, 0x02, 0xda, 0xd9, 0x6e, 0xf5, 0x59, 0xc9, 0x98, 0x1b, 0x33,
-	0xf6, 0x68, 0x08, 0x78, 0x09, 0x55, 0xf7, 0x53, 0x91, 0x26, 0x60, 0x13, 0xbd, 0x2a, 0xf1, 0x59,
-	0x15, 0x6f, 0xe0, 0x0e, 0xfa, 0x67, 0x0c, 0xa9, 0xfa, 0x9a, 0xc4, 0xeb, 0x39, 0x2e, 0xcb, 0x5f,
-	0x6a, 0x68, 0x21, 0x21, 0x02, 0xec, 0x30, 0x0d, 0x04, 0x8d, 0x03, 0x0a, 0x09, 0xd7, 0x51, 0xbb,
-	0xd4, 0xad, 0xaf, 0x2d, 0x19, 0xb9, 0x2d, 0x99, 0x87, 0x46, 0xee, 0xa1, 0xb1, 0xc9, 0x68, 0xd4,
-	0xdf, 0x3e, 0x39, 0x6b, 0x15, 0xde, 0x9c, 0xb7, 0xba, 0x3e, 0x15, 0x83, 0xd4, 0x31, 0x5c, 0x16,
-	0xe6, 0x1e, 0xe6, 0x7f, 0xab, 0xdc, 0x3b, 0x30, 0xc5, 0x28, 0x06, 0x2e, 0x0b, 0xf8, 0xab, 0xab,
-	0xe3, 0x95, 0xb9, 0x00, 0x7c, 0xe2, 0x8e, 0xec, 0xec, 0x14, 0xf0, 0xd7, 0x57, 0xc7, 0x2b, 0x9a,
-	0xf5, 0x6f, 0x36, 0xfa, 0xd1, 0x74, 0x72, 0xe7, 0x7d, 0x51, 0x99, 0xf8, 0x24, 0xf6, 0x6e, 0x36,
-	0xf1, 0x06, 0x4b, 0x8a, 0xbf, 0x60, 0x49, 0xe9, 0x3b, 0x4b, 0xae, 0xeb, 0x59, 0xfe, 0x81, 0x9e,
-	0x33, 0x3f, 0xa9, 0x67, 0xe5, 0x8f, 0xe9, 0x79, 0x54, 0x44, 0xf3, 0x3b, 0xf4, 0x59, 0x4a, 0x3d,
-	0x2a, 0x46, 0x1b, 0x9e, 0x07, 0x1e, 0x6e, 0xa0, 0x6a, 0x9c, 0xb0, 0x21, 0xf5, 0x20, 0x91, 0x9a,
-	0xd6, 0xac, 0x49, 0x7c, 0x5d, 0xee, 0xe2, 0x37, 0x72, 0x8f, 0x50, 0x85, 0x84, 0x2c, 0x8d, 0x84,
-	0x5e, 0xfa, 0x5d, 0x7b, 0xc9, 0x07, 0xe2, 0xc7, 0xa8, 0xc2, 0x07, 0x24, 0x01, 0x2e, 0xed, 0xa8,
-	0xf5, 0xd7, 0xb3, 0xfe, 0x9f, 0xcf, 0x5a, 0xcb, 0xaa, 0x1b, 0xf7, 0x0e, 0x0c, 0xca, 0xcc, 0x90,
-	0x88, 0x81, 0xb1, 0x23, 0x9b, 0x6c, 0x81, 0xfb, 0xe1, 0xed, 0x2a, 0xca, 0x3f, 0x70, 0x0b, 0xdc,
-	0xbc, 0x9f, 0xea, 0xd2, 0xf9, 0x54, 0x44, 0x0b, 0x13, 0x49, 0x2c, 0x08, 0xd9, 0xf0, 0xaf, 0x28,
-	0xf8, 0x01, 0xaa, 0xa7, 0x51, 0xc0, 0xdc, 0x83, 0xe9, 0xc1, 0xae, 0xaf, 0x35, 0x0c, 0x75, 0xe7,
-	0x1a, 0xe3, 0x3b, 0xd7, 0xd8, 0x1b, 0xdf, 0xb9, 0xfd, 0x6a, 0x36, 0xf0, 0xe8, 0xbc, 0xa5, 0x59,
-	0x48, 0x15, 0x66, 0x50, 0xff, 0xfe, 0xc9, 0x45, 0x53, 0x3b, 0xbd, 0x68, 0x6a, 0x5f, 0x2e, 0x9a,
-	0xda, 0xd1, 0x65, 0xb3, 0x70, 0x7a, 0xd9, 0x2c, 0x7c, 0xbc, 0x6c, 0x16, 0x9e, 0xb6, 0xe4, 0x5b,
-	0xa1, 0x5e, 0x90, 0xe7, 0xa3, 0x17, 0x6a, 0xab, 0xd7, 0x5e, 0x1b, 0xa7, 0x22, 0x87, 0xdc, 0xfb,
-	0x1a, 0x00, 0x00, 0xff, 0xff, 0x28, 0x76, 0x47, 0xda, 0x8d, 0x06, 0x00, 0x00,
+func (m *PositionTransferred) Reset()         { *m = PositionTransferred{} }
+func (m *PositionTransferred) String() string { return proto.CompactTextString(m) }
+func (*PositionTransferred) ProtoMessage()    {}
+func (*PositionTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{4}
 }
-
-func (m *PoolCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PoolCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionTransferred.Merge(m, src)
+}
+func (m *PositionTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionTransferred.DiscardUnknown(m)
 }
 
-func (m *PoolCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateMultipliers) > 0 {
-		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.FutureATime != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FutureATime))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.FutureA != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FutureA))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.InitialATime != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.InitialATime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.InitialA != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.InitialA))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.RewardsFee != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardsFee))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ProtocolFeePercentage != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolFeePercentage))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Algorithm) > 0 {
-		i -= len(m.Algorithm)
-		copy(dAtA[i:], m.Algorithm)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Algorithm)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_PositionTransferred proto.InternalMessageInfo
+
+func (m *PositionTransferred) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *PositionTransferred) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PoolUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PositionTransferred) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PoolUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AutoCompoundUpdated struct {
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the liquidity provider.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Whether the rewards are compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *PoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateMultipliers) > 0 {
-		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+func (m *AutoCompoundUpdated) Reset()         { *m = AutoCompoundUpdated{} }
+func (m *AutoCompoundUpdated) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundUpdated) ProtoMessage()    {}
+func (*AutoCompoundUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{5}
+}
+func (m *AutoCompoundUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FutureATime != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FutureATime))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *AutoCompoundUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundUpdated.Merge(m, src)
+}
+func (m *AutoCompoundUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundUpdated proto.InternalMessageInfo
+
+func (m *AutoCompoundUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.FutureA != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FutureA))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *AutoCompoundUpdated) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	if m.RewardsFee != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.RewardsFee))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *AutoCompoundUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.ProtocolFeePercentage != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolFeePercentage))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+type RewardsCompounded struct {
+	// ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the liquidity provider.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Amount of rewards compounded into the pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Shares minted for the provider.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *RewardsCompounded) Reset()         { *m = RewardsCompounded{} }
+func (m *RewardsCompounded) String() string { return proto.CompactTextString(m) }
+func (*RewardsCompounded) ProtoMessage()    {}
+func (*RewardsCompounded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{6}
+}
+func (m *RewardsCompounded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsCompounded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsCompounded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *RewardsCompounded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsCompounded.Merge(m, src)
+}
+func (m *RewardsCompounded) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsCompounded) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsCompounded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsCompounded proto.InternalMessageInfo
+
+func (m *RewardsCompounded) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LiquidityAdded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardsCompounded) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LiquidityAdded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardsCompounded) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *LiquidityAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type PoolUpdateScheduled struct {
+	// ID of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the pool to update.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Timestamp from which the update is executed.
+	ExecutionTime int64 `protobuf:"varint,3,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Address of the account that scheduled the update.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *PoolUpdateScheduled) Reset()         { *m = PoolUpdateScheduled{} }
+func (m *PoolUpdateScheduled) String() string { return proto.CompactTextString(m) }
+func (*PoolUpdateScheduled) ProtoMessage()    {}
+func (*PoolUpdateScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{7}
+}
+func (m *PoolUpdateScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolUpdateScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolUpdateScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *PoolUpdateScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolUpdateScheduled.Merge(m, src)
+}
+func (m *PoolUpdateScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolUpdateScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolUpdateScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolUpdateScheduled proto.InternalMessageInfo
+
+func (m *PoolUpdateScheduled) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *PoolUpdateScheduled) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PoolUpdateScheduled) GetExecutionTime() int64 {
+	if m != nil {
+		return m.ExecutionTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LiquidityRemoved) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PoolUpdateScheduled) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LiquidityRemoved) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PoolUpdateCancelled struct {
+	// ID of the cancelled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the pool the update was scheduled for.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Address of the account that cancelled the update.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *LiquidityRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintEvents(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PoolUpdateCancelled) Reset()         { *m = PoolUpdateCancelled{} }
+func (m *PoolUpdateCancelled) String() string { return proto.CompactTextString(m) }
+func (*PoolUpdateCancelled) ProtoMessage()    {}
+func (*PoolUpdateCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{8}
+}
+func (m *PoolUpdateCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolUpdateCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolUpdateCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *PoolUpdateCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolUpdateCancelled.Merge(m, src)
+}
+func (m *PoolUpdateCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolUpdateCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolUpdateCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolUpdateCancelled proto.InternalMessageInfo
+
+func (m *PoolUpdateCancelled) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *PoolUpdateCancelled) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Provider) > 0 {
-		i -= len(m.Provider)
-		copy(dAtA[i:], m.Provider)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PoolUpdateCancelled) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+type ScheduledPoolUpdateExecuted struct {
+	// ID of the executed update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the updated pool.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Error of the execution, empty when the update has been applied.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduledPoolUpdateExecuted) Reset()         { *m = ScheduledPoolUpdateExecuted{} }
+func (m *ScheduledPoolUpdateExecuted) String() string { return proto.CompactTextString(m) }
+func (*ScheduledPoolUpdateExecuted) ProtoMessage()    {}
+func (*ScheduledPoolUpdateExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{9}
+}
+func (m *ScheduledPoolUpdateExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledPoolUpdateExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledPoolUpdateExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *PoolCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledPoolUpdateExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledPoolUpdateExecuted.Merge(m, src)
+}
+func (m *ScheduledPoolUpdateExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledPoolUpdateExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledPoolUpdateExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledPoolUpdateExecuted proto.InternalMessageInfo
+
+func (m *ScheduledPoolUpdateExecuted) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
+	return 0
+}
+
+func (m *ScheduledPoolUpdateExecuted) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	l = len(m.Algorithm)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *ScheduledPoolUpdateExecuted) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ProtocolFeePercentage != 0 {
-		n += 1 + sovEvents(uint64(m.ProtocolFeePercentage))
+	return ""
+}
+
+type RampAStarted struct {
+	// ID of the ramped pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amplification coefficient from which the ramp starts.
+	InitialA int64 `protobuf:"varint,2,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// Amplification coefficient reached at the end of the ramp.
+	FutureA int64 `protobuf:"varint,3,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Timestamp at which the ramp starts.
+	InitialATime int64 `protobuf:"varint,4,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
+	// Timestamp at which the ramp ends.
+	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+}
+
+func (m *RampAStarted) Reset()         { *m = RampAStarted{} }
+func (m *RampAStarted) String() string { return proto.CompactTextString(m) }
+func (*RampAStarted) ProtoMessage()    {}
+func (*RampAStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{10}
+}
+func (m *RampAStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RampAStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RampAStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.RewardsFee != 0 {
-		n += 1 + sovEvents(uint64(m.RewardsFee))
+}
+func (m *RampAStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampAStarted.Merge(m, src)
+}
+func (m *RampAStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *RampAStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampAStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RampAStarted proto.InternalMessageInfo
+
+func (m *RampAStarted) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.InitialA != 0 {
-		n += 1 + sovEvents(uint64(m.InitialA))
+	return 0
+}
+
+func (m *RampAStarted) GetInitialA() int64 {
+	if m != nil {
+		return m.InitialA
 	}
-	if m.InitialATime != 0 {
-		n += 1 + sovEvents(uint64(m.InitialATime))
+	return 0
+}
+
+func (m *RampAStarted) GetFutureA() int64 {
+	if m != nil {
+		return m.FutureA
 	}
-	if m.FutureA != 0 {
-		n += 1 + sovEvents(uint64(m.FutureA))
+	return 0
+}
+
+func (m *RampAStarted) GetInitialATime() int64 {
+	if m != nil {
+		return m.InitialATime
 	}
-	if m.FutureATime != 0 {
-		n += 1 + sovEvents(uint64(m.FutureATime))
+	return 0
+}
+
+func (m *RampAStarted) GetFutureATime() int64 {
+	if m != nil {
+		return m.FutureATime
 	}
-	if len(m.RateMultipliers) > 0 {
-		for _, e := range m.RateMultipliers {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+type RampAStopped struct {
+	// ID of the pool whose ramp has been stopped.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amplification coefficient at which the pool has been frozen.
+	CurrentA int64 `protobuf:"varint,2,opt,name=current_a,json=currentA,proto3" json:"current_a,omitempty"`
+	// Timestamp at which the ramp has been stopped.
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *RampAStopped) Reset()         { *m = RampAStopped{} }
+func (m *RampAStopped) String() string { return proto.CompactTextString(m) }
+func (*RampAStopped) ProtoMessage()    {}
+func (*RampAStopped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebef50c59245cec9, []int{11}
+}
+func (m *RampAStopped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RampAStopped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RampAStopped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RampAStopped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RampAStopped.Merge(m, src)
+}
+func (m *RampAStopped) XXX_Size() int {
+	return m.Size()
+}
+func (m *RampAStopped) XXX_DiscardUnknown() {
+	xxx_messageInfo_RampAStopped.DiscardUnknown(m)
 }
 
-func (m *PoolUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	if m.ProtocolFeePercentage != 0 {
-		n += 1 + sovEvents(uint64(m.ProtocolFeePercentage))
-	}
-	if m.RewardsFee != 0 {
-		n += 1 + sovEvents(uint64(m.RewardsFee))
-	}
-	if m.FutureA != 0 {
-		n += 1 + sovEvents(uint64(m.FutureA))
+var xxx_messageInfo_RampAStopped proto.InternalMessageInfo
+
+func (m *RampAStopped) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.FutureATime != 0 {
-		n += 1 + sovEvents(uint64(m.FutureATime))
+	return 0
+}
+
+func (m *RampAStopped) GetCurrentA() int64 {
+	if m != nil {
+		return m.CurrentA
 	}
-	if len(m.RateMultipliers) > 0 {
-		for _, e := range m.RateMultipliers {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	return 0
+}
+
+func (m *RampAStopped) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return n
+	return 0
 }
 
-func (m *LiquidityAdded) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*PoolCreated)(nil), "noble.swap.stableswap.v1.PoolCreated")
+	proto.RegisterType((*PoolUpdated)(nil), "noble.swap.stableswap.v1.PoolUpdated")
+	proto.RegisterType((*LiquidityAdded)(nil), "noble.swap.stableswap.v1.LiquidityAdded")
+	proto.RegisterType((*LiquidityRemoved)(nil), "noble.swap.stableswap.v1.LiquidityRemoved")
+	proto.RegisterType((*PositionTransferred)(nil), "noble.swap.stableswap.v1.PositionTransferred")
+	proto.RegisterType((*AutoCompoundUpdated)(nil), "noble.swap.stableswap.v1.AutoCompoundUpdated")
+	proto.RegisterType((*RewardsCompounded)(nil), "noble.swap.stableswap.v1.RewardsCompounded")
+	proto.RegisterType((*PoolUpdateScheduled)(nil), "noble.swap.stableswap.v1.PoolUpdateScheduled")
+	proto.RegisterType((*PoolUpdateCancelled)(nil), "noble.swap.stableswap.v1.PoolUpdateCancelled")
+	proto.RegisterType((*ScheduledPoolUpdateExecuted)(nil), "noble.swap.stableswap.v1.ScheduledPoolUpdateExecuted")
+	proto.RegisterType((*RampAStarted)(nil), "noble.swap.stableswap.v1.RampAStarted")
+	proto.RegisterType((*RampAStopped)(nil), "noble.swap.stableswap.v1.RampAStopped")
+}
+
+func init() {
+	proto.RegisterFile("noble/swap/stableswap/v1/events.proto", fileDescriptor_ebef50c59245cec9)
+}
+
+var fileDescriptor_ebef50c59245cec9 = []byte{
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xa9, 0x3f, 0xc6, 0xae, 0x93, 0x6c, 0x0b, 0xdd, 0x24, 0xc8, 0x8e, 0x4c, 0x23,
+	0xac, 0x4a, 0xdd, 0x55, 0x82, 0x54, 0x89, 0xa3, 0xf3, 0x61, 0x09, 0x29, 0xa0, 0xb0, 0x49, 0x2b,
+	0x04, 0x48, 0xd6, 0x78, 0xf7, 0xc5, 0x19, 0x65, 0x77, 0x67, 0x99, 0x99, 0x75, 0x63, 0x04, 0x37,
+	0x8e, 0x1c, 0x22, 0x71, 0xe3, 0x2f, 0x00, 0x4e, 0x15, 0xe2, 0xc0, 0x9f, 0xd0, 0x13, 0xaa, 0xb8,
+	0x80, 0x38, 0xb4, 0x28, 0x39, 0xf4, 0xdf, 0x40, 0x33, 0xbb, 0xeb, 0x8f, 0x14, 0x27, 0x0d, 0xa4,
+	0x11, 0x42, 0x5c, 0x92, 0x79, 0x1f, 0xf3, 0x7b, 0xcf, 0x3b, 0xef, 0xf7, 0xe6, 0x0d, 0x5a, 0x0e,
+	0x68, 0xc7, 0x03, 0x8b, 0x3f, 0xc4, 0xa1, 0xc5, 0x05, 0xee, 0x78, 0xa0, 0x96, 0xbd, 0x15, 0x0b,
+	0x7a, 0x10, 0x08, 0x6e, 0x86, 0x8c, 0x0a, 0xaa, 0x1b, 0xca, 0xcd, 0x94, 0x36, 0x73, 0xe8, 0x66,
+	0xf6, 0x56, 0x16, 0xe6, 0xb0, 0x4f, 0x02, 0x6a, 0xa9, 0xbf, 0xb1, 0xf3, 0x42, 0xd5, 0xa1, 0xdc,
+	0xa7, 0xdc, 0xea, 0x60, 0x0e, 0x56, 0x6f, 0xa5, 0x03, 0x02, 0xaf, 0x58, 0x0e, 0x25, 0x41, 0x62,
+	0x9f, 0x8f, 0xed, 0x6d, 0x25, 0x59, 0xb1, 0x90, 0x98, 0x6e, 0x76, 0x69, 0x97, 0xc6, 0x7a, 0xb9,
+	0x4a, 0xb4, 0xb5, 0x2e, 0xa5, 0x5d, 0x0f, 0x2c, 0x25, 0x75, 0xa2, 0x3d, 0x4b, 0x10, 0x1f, 0xb8,
+	0xc0, 0x7e, 0x98, 0x38, 0xbc, 0x39, 0xf1, 0x57, 0x84, 0x94, 0x7a, 0xb1, 0x53, 0xfd, 0xe7, 0x02,
+	0x2a, 0x6d, 0x53, 0xea, 0xad, 0x33, 0xc0, 0x02, 0x5c, 0xfd, 0x16, 0xca, 0x4b, 0x6b, 0x9b, 0xb8,
+	0x86, 0xb6, 0xa4, 0x35, 0xa6, 0xed, 0x9c, 0x14, 0xdf, 0x75, 0xf5, 0x37, 0x50, 0x11, 0x7b, 0x5d,
+	0xca, 0x88, 0xd8, 0xf7, 0x8d, 0xcc, 0x92, 0xd6, 0x28, 0xda, 0x43, 0x85, 0xae, 0xa3, 0xe9, 0x10,
+	0x13, 0x66, 0x64, 0x95, 0x41, 0xad, 0xf5, 0x7b, 0xe8, 0x96, 0x8a, 0xe1, 0x50, 0xaf, 0xbd, 0x07,
+	0xd0, 0x0e, 0x81, 0x39, 0x10, 0x08, 0xdc, 0x05, 0x63, 0x7a, 0x49, 0x6b, 0x64, 0xed, 0xd7, 0x52,
+	0x73, 0x0b, 0x60, 0x7b, 0x60, 0xd4, 0x6b, 0xa8, 0xc4, 0xe0, 0x21, 0x66, 0x2e, 0x97, 0xdb, 0x8c,
+	0x6b, 0xca, 0x17, 0x25, 0xaa, 0x16, 0x80, 0xbe, 0x88, 0x8a, 0x24, 0x20, 0x82, 0x60, 0xaf, 0x8d,
+	0x8d, 0x9c, 0x32, 0x17, 0x12, 0x45, 0x53, 0xbf, 0x8d, 0x2a, 0x03, 0x63, 0x5b, 0x7e, 0x12, 0x23,
+	0xaf, 0x3c, 0xca, 0xa9, 0xc7, 0x2e, 0xf1, 0x41, 0x9f, 0x47, 0x85, 0xbd, 0x48, 0x44, 0x0c, 0xda,
+	0xd8, 0x28, 0x28, 0x7b, 0x3e, 0x96, 0x9b, 0x7a, 0x1d, 0x5d, 0x4f, 0x4d, 0xf1, 0xfe, 0xa2, 0xb2,
+	0x97, 0x12, 0xbb, 0xda, 0xfe, 0x95, 0x86, 0x66, 0x19, 0x16, 0xd0, 0xf6, 0x23, 0x4f, 0x90, 0xd0,
+	0x23, 0xc0, 0xb8, 0x81, 0x96, 0xb2, 0x8d, 0xd2, 0xea, 0xbc, 0x99, 0x9c, 0x9d, 0x3c, 0x68, 0x33,
+	0x39, 0x68, 0x73, 0x9d, 0x92, 0x60, 0xad, 0xf5, 0xf8, 0x69, 0x6d, 0xea, 0xfb, 0x67, 0xb5, 0x46,
+	0x97, 0x88, 0xfd, 0xa8, 0x63, 0x3a, 0xd4, 0x4f, 0x0e, 0x3a, 0xf9, 0x77, 0x97, 0xbb, 0x07, 0x96,
+	0xe8, 0x87, 0xc0, 0xd5, 0x06, 0xfe, 0xcd, 0xf3, 0x47, 0x77, 0xca, 0x1e, 0x74, 0xb1, 0xd3, 0x6f,
+	0xcb, 0x52, 0xe1, 0xdf, 0x3e, 0x7f, 0x74, 0x47, 0xb3, 0x67, 0x64, 0xe8, 0xf7, 0x86, 0x91, 0xf5,
+	0x8f, 0xd1, 0x4c, 0x14, 0x74, 0x68, 0xe0, 0x92, 0xa0, 0xdb, 0x16, 0x2a, 0x99, 0x92, 0x4a, 0xe6,
+	0x2d, 0x73, 0x52, 0x89, 0x9a, 0xf7, 0xd3, 0x0d, 0xbb, 0x04, 0xd8, 0x5a, 0x51, 0xa6, 0x16, 0xa3,
+	0x57, 0xa2, 0x51, 0x0b, 0xd7, 0x1f, 0xa0, 0x0a, 0x1c, 0x12, 0xa1, 0x8e, 0x30, 0xc6, 0x2e, 0x2b,
+	0xec, 0xe5, 0xc9, 0xd8, 0x9b, 0x87, 0x44, 0xb4, 0x00, 0x4e, 0x23, 0x97, 0x61, 0xa8, 0xe7, 0xfa,
+	0x16, 0x42, 0x1e, 0x75, 0x0e, 0x12, 0xcc, 0xeb, 0x0a, 0xb3, 0x3e, 0x19, 0x73, 0x8b, 0x3a, 0x07,
+	0xa7, 0x01, 0x8b, 0x5e, 0xa2, 0x54, 0x68, 0x32, 0x41, 0x1e, 0x7a, 0x44, 0x70, 0xa3, 0x72, 0x1e,
+	0x5a, 0x0b, 0x60, 0x47, 0xba, 0x8e, 0xa1, 0xed, 0x25, 0x4a, 0xae, 0x6f, 0xa2, 0x92, 0xdb, 0x0f,
+	0xb0, 0x4f, 0x1c, 0x55, 0x82, 0x33, 0x4b, 0x5a, 0xa3, 0xb4, 0x7a, 0x7b, 0x32, 0xdc, 0x46, 0xec,
+	0xdc, 0x02, 0xb0, 0x91, 0x3b, 0x58, 0xeb, 0x1f, 0xa0, 0x19, 0x97, 0x30, 0x70, 0x04, 0xa1, 0x01,
+	0x56, 0x24, 0x30, 0x66, 0x15, 0x54, 0xe3, 0x0c, 0xa8, 0xe1, 0x06, 0x09, 0x57, 0x71, 0xc7, 0x64,
+	0xdd, 0x40, 0x79, 0x47, 0x52, 0x95, 0x32, 0x63, 0x4e, 0x71, 0x2d, 0x15, 0xf5, 0x2f, 0x35, 0x54,
+	0x56, 0x6b, 0x42, 0x03, 0x15, 0x4a, 0xbf, 0xaa, 0x7a, 0x2c, 0xa5, 0x61, 0x5b, 0x00, 0xf5, 0x9f,
+	0x72, 0x71, 0x43, 0xb9, 0x1f, 0xba, 0x67, 0x37, 0x94, 0x33, 0xda, 0x43, 0xe6, 0x02, 0xed, 0x21,
+	0xfb, 0x42, 0x7b, 0x18, 0xe5, 0xf6, 0xf4, 0x39, 0xdc, 0xbe, 0xf6, 0x92, 0xdc, 0xce, 0xfd, 0x9b,
+	0xb8, 0x9d, 0x7f, 0x85, 0xdc, 0x2e, 0xbc, 0x02, 0x6e, 0x17, 0x2f, 0x95, 0xdb, 0xe8, 0x72, 0xb9,
+	0x5d, 0xba, 0x3c, 0x6e, 0x97, 0xff, 0x19, 0xb7, 0xeb, 0x47, 0x19, 0x54, 0xd9, 0x22, 0x9f, 0x46,
+	0xc4, 0x25, 0xa2, 0xdf, 0x74, 0x5d, 0x70, 0xf5, 0x05, 0x54, 0x08, 0x19, 0xed, 0x11, 0x17, 0x98,
+	0xa2, 0x4f, 0xd1, 0x1e, 0xc8, 0xa3, 0xcc, 0xca, 0x8c, 0x31, 0xab, 0x8f, 0x72, 0xd8, 0xa7, 0x51,
+	0x20, 0x8c, 0xec, 0x55, 0x95, 0x6d, 0x12, 0x50, 0x7f, 0x1f, 0xe5, 0xf8, 0x3e, 0x66, 0xc0, 0x15,
+	0xf3, 0x8a, 0x6b, 0xf7, 0x24, 0xfe, 0xef, 0x4f, 0x6b, 0x8b, 0x31, 0x1a, 0x77, 0x0f, 0x4c, 0x42,
+	0x2d, 0x1f, 0x8b, 0x7d, 0x73, 0x4b, 0x81, 0x6c, 0x80, 0xf3, 0xcb, 0x8f, 0x77, 0x51, 0x92, 0xe0,
+	0x06, 0x38, 0x09, 0x5e, 0x8c, 0x52, 0xff, 0x35, 0x8b, 0x66, 0x07, 0x9f, 0xc4, 0x06, 0x9f, 0xf6,
+	0xfe, 0xff, 0x28, 0xb2, 0x82, 0xa3, 0x20, 0xe1, 0x57, 0xd2, 0xc3, 0x4a, 0xab, 0x0b, 0x66, 0x3c,
+	0x0f, 0x9a, 0xe9, 0x3c, 0x68, 0xee, 0xa6, 0xf3, 0xe0, 0x5a, 0x41, 0x06, 0x3c, 0x7a, 0x56, 0xd3,
+	0x6c, 0x14, 0x6f, 0x54, 0x8d, 0xee, 0x73, 0x54, 0x48, 0xc9, 0x7f, 0x75, 0xfd, 0x2d, 0x9f, 0xf4,
+	0x89, 0xfa, 0x0f, 0x1a, 0xba, 0xb1, 0x4d, 0x39, 0x91, 0xe5, 0xbf, 0xcb, 0x70, 0xc0, 0xf7, 0x80,
+	0xb1, 0xb3, 0xee, 0x8b, 0xd7, 0x51, 0x8e, 0x43, 0x20, 0xcf, 0x3c, 0x9e, 0x3e, 0x13, 0x49, 0x56,
+	0x03, 0x03, 0x07, 0x48, 0x0f, 0xd2, 0xf1, 0x73, 0x20, 0x5f, 0x7a, 0x39, 0xba, 0xe8, 0x46, 0x33,
+	0x12, 0x74, 0x9d, 0xfa, 0x21, 0x8d, 0x02, 0xf7, 0xdc, 0x3b, 0x6e, 0xb4, 0x52, 0x33, 0xa7, 0x2a,
+	0xd5, 0x40, 0x79, 0x08, 0x64, 0x77, 0x70, 0x55, 0xda, 0x05, 0x3b, 0x15, 0xeb, 0x5f, 0x67, 0xd0,
+	0x9c, 0x1d, 0xdf, 0x67, 0x69, 0xa4, 0xbf, 0x1b, 0xe4, 0x3f, 0xd4, 0x0a, 0xbe, 0x90, 0xf5, 0x92,
+	0xce, 0x15, 0x3b, 0xce, 0x3e, 0xb8, 0x91, 0x07, 0xae, 0x5e, 0x41, 0x99, 0xc1, 0x17, 0xc9, 0x10,
+	0x77, 0x72, 0x03, 0x58, 0x96, 0x77, 0x1d, 0x38, 0x91, 0x9a, 0x8f, 0x14, 0x71, 0xe2, 0xd1, 0xe1,
+	0xfa, 0x40, 0xab, 0x58, 0x21, 0xcb, 0x8c, 0x74, 0x03, 0x60, 0x71, 0xda, 0x76, 0x22, 0xd5, 0x1f,
+	0x8c, 0x86, 0x5f, 0xc7, 0x81, 0x03, 0xde, 0x85, 0xc2, 0x0f, 0x71, 0xb3, 0x63, 0xb8, 0x9f, 0xa0,
+	0xc5, 0xc1, 0x8f, 0x19, 0x06, 0xd8, 0x54, 0x39, 0x5d, 0x04, 0xff, 0x26, 0xba, 0x06, 0x8c, 0xd1,
+	0x14, 0x3e, 0x16, 0xea, 0xdf, 0x69, 0xa8, 0x6c, 0x63, 0x3f, 0x6c, 0xee, 0x08, 0xcc, 0xce, 0x2c,
+	0xd5, 0xb1, 0x47, 0x55, 0xe6, 0xd4, 0xa3, 0x6a, 0x74, 0xa4, 0xca, 0x8e, 0x8f, 0x54, 0x2f, 0xbe,
+	0xb7, 0xa6, 0xff, 0xe2, 0xbd, 0xf5, 0x12, 0x83, 0x57, 0xfd, 0xc3, 0x41, 0xaa, 0x34, 0x0c, 0xcf,
+	0x49, 0xd5, 0x89, 0x18, 0x83, 0x40, 0x0c, 0x53, 0x4d, 0x14, 0x4d, 0xf9, 0x12, 0x1d, 0x39, 0x5c,
+	0xb5, 0x5e, 0x7b, 0xe7, 0xf1, 0x71, 0x55, 0x7b, 0x72, 0x5c, 0xd5, 0xfe, 0x38, 0xae, 0x6a, 0x47,
+	0x27, 0xd5, 0xa9, 0x27, 0x27, 0xd5, 0xa9, 0xdf, 0x4e, 0xaa, 0x53, 0x1f, 0xd5, 0xd4, 0xd5, 0x1c,
+	0x5f, 0xd8, 0x87, 0xfd, 0xcf, 0xe2, 0xf2, 0x1e, 0x79, 0x2d, 0x77, 0x72, 0xaa, 0x9d, 0xbe, 0xfd,
+	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x24, 0x61, 0x48, 0x13, 0x10, 0x00, 0x00,
+}
+
+func (m *PoolCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CreationFee) > 0 {
+		for iNdEx := len(m.CreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.DirectionalFee != nil {
+		{
+			size, err := m.DirectionalFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func (m *LiquidityRemoved) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DynamicFee != nil {
+		{
+			size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.ExitFeeTiers) > 0 {
+		for iNdEx := len(m.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RateMultipliers) > 0 {
+		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InitialATime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InitialATime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardsFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RewardsFee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProtocolFeePercentage != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolFeePercentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DirectionalFee != nil {
+		{
+			size, err := m.DirectionalFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.DynamicFee != nil {
+		{
+			size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for iNdEx := len(m.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateMultipliers) > 0 {
+		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RewardsFee != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RewardsFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProtocolFeePercentage != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolFeePercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExitFee) > 0 {
+		for iNdEx := len(m.ExitFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEvents(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsCompounded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsCompounded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsCompounded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolUpdateScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolUpdateScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolUpdateScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutionTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolUpdateCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolUpdateCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolUpdateCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledPoolUpdateExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledPoolUpdateExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledPoolUpdateExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RampAStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RampAStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RampAStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FutureATime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitialATime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InitialATime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RampAStopped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RampAStopped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RampAStopped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentA != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentA))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProtocolFeePercentage != 0 {
+		n += 1 + sovEvents(uint64(m.ProtocolFeePercentage))
+	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovEvents(uint64(m.RewardsFee))
+	}
+	if m.InitialA != 0 {
+		n += 1 + sovEvents(uint64(m.InitialA))
+	}
+	if m.InitialATime != 0 {
+		n += 1 + sovEvents(uint64(m.InitialATime))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovEvents(uint64(m.FutureA))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovEvents(uint64(m.FutureATime))
+	}
+	if len(m.RateMultipliers) > 0 {
+		for _, e := range m.RateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for _, e := range m.ExitFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.DynamicFee != nil {
+		l = m.DynamicFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DirectionalFee != nil {
+		l = m.DirectionalFee.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if len(m.CreationFee) > 0 {
+		for _, e := range m.CreationFee {
+			l = e.Size()
+			n += 2 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.ProtocolFeePercentage != 0 {
+		n += 1 + sovEvents(uint64(m.ProtocolFeePercentage))
+	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovEvents(uint64(m.RewardsFee))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovEvents(uint64(m.FutureA))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovEvents(uint64(m.FutureATime))
+	}
+	if len(m.RateMultipliers) > 0 {
+		for _, e := range m.RateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for _, e := range m.ExitFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.DynamicFee != nil {
+		l = m.DynamicFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DirectionalFee != nil {
+		l = m.DirectionalFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *LiquidityRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.ExitFee) > 0 {
+		for _, e := range m.ExitFee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PositionTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *AutoCompoundUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardsCompounded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *PoolUpdateScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.ExecutionTime != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutionTime))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolUpdateCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledPoolUpdateExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RampAStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.InitialA != 0 {
+		n += 1 + sovEvents(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovEvents(uint64(m.FutureA))
+	}
+	if m.InitialATime != 0 {
+		n += 1 + sovEvents(uint64(m.InitialATime))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovEvents(uint64(m.FutureATime))
+	}
+	return n
+}
+
+func (m *RampAStopped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.CurrentA != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentA))
+	}
+	if m.Time != 0 {
+		n += 1 + sovEvents(uint64(m.Time))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			m.ProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			m.InitialA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+			}
+			m.InitialATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+			}
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
+			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFeeTiers = append(m.ExitFeeTiers, ExitFeeTier{})
+			if err := m.ExitFeeTiers[len(m.ExitFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, FeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFee == nil {
+				m.DynamicFee = &DynamicFee{}
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectionalFee == nil {
+				m.DirectionalFee = &DirectionalFee{}
+			}
+			if err := m.DirectionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFee = append(m.CreationFee, types.Coin{})
+			if err := m.CreationFee[len(m.CreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			m.ProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+			}
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
+			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFeeTiers = append(m.ExitFeeTiers, ExitFeeTier{})
+			if err := m.ExitFeeTiers[len(m.ExitFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, FeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFee == nil {
+				m.DynamicFee = &DynamicFee{}
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectionalFee == nil {
+				m.DirectionalFee = &DirectionalFee{}
+			}
+			if err := m.DirectionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFee = append(m.ExitFee, types.Coin{})
+			if err := m.ExitFee[len(m.ExitFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PoolCreated) Unmarshal(dAtA []byte) error {
+func (m *RewardsCompounded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,10 +4042,10 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsCompounded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsCompounded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -910,7 +4069,7 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -938,13 +4097,13 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -954,29 +4113,31 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			m.ProtocolFeePercentage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -986,54 +4147,81 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.RewardsFee = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RewardsFee |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.InitialA = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialA |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolUpdateScheduled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolUpdateScheduled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolUpdateScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.InitialATime = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1043,16 +4231,16 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialATime |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.FutureA = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1062,16 +4250,16 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureA |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
-			m.FutureATime = 0
+			m.ExecutionTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1081,16 +4269,16 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureATime |= int64(b&0x7F) << shift
+				m.ExecutionTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1100,25 +4288,23 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
-			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1141,7 +4327,7 @@ func (m *PoolCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
+func (m *PoolUpdateCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1164,17 +4350,17 @@ func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolUpdateCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolUpdateCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PoolId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1184,73 +4370,16 @@ func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
-			}
-			m.ProtocolFeePercentage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
-			}
-			m.RewardsFee = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RewardsFee |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
-			}
-			m.FutureA = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FutureA |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.FutureATime = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1260,16 +4389,16 @@ func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureATime |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1279,25 +4408,23 @@ func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
-			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1320,7 +4447,7 @@ func (m *PoolUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
+func (m *ScheduledPoolUpdateExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,17 +4470,17 @@ func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledPoolUpdateExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledPoolUpdateExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1363,24 +4490,11 @@ func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
@@ -1402,41 +4516,7 @@ func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1464,9 +4544,7 @@ func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1489,7 +4567,7 @@ func (m *LiquidityAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
+func (m *RampAStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,17 +4590,17 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: RampAStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RampAStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1532,29 +4610,16 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
 			}
-			m.PoolId = 0
+			m.InitialA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1564,16 +4629,16 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.InitialA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
 			}
-			var msglen int
+			m.FutureA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1583,31 +4648,35 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FutureA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InitialATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
 			}
-			var stringLen uint64
+			m.FutureATime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1617,31 +4686,66 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FutureATime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RampAStopped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RampAStopped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RampAStopped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1651,25 +4755,49 @@ func (m *LiquidityRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentA", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.CurrentA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
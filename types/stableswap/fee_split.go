@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package stableswap
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxFeeSplits is the maximum number of protocol fee recipients of a pool.
+const MaxFeeSplits = 10
+
+// ValidateFeeSplits ensures that the splits have unique names, positive weights and a recipient
+// consistent with their type. An empty list is valid.
+func ValidateFeeSplits(splits []FeeSplit) error {
+	if len(splits) > MaxFeeSplits {
+		return fmt.Errorf("at most %d splits are allowed, got %d", MaxFeeSplits, len(splits))
+	}
+
+	names := make(map[string]bool)
+	for i, split := range splits {
+		if split.Name == "" {
+			return fmt.Errorf("split %d name must be set", i)
+		}
+		if names[split.Name] {
+			return fmt.Errorf("split %d name %s is duplicated", i, split.Name)
+		}
+		names[split.Name] = true
+
+		if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return fmt.Errorf("split %s weight must be positive, got %s", split.Name, split.Weight)
+		}
+
+		switch split.RecipientType {
+		case FEE_RECIPIENT_ADDRESS, FEE_RECIPIENT_MODULE:
+			if split.Recipient == "" {
+				return fmt.Errorf("split %s recipient must be set", split.Name)
+			}
+		case FEE_RECIPIENT_COMMUNITY_POOL, FEE_RECIPIENT_BURN:
+			if split.Recipient != "" {
+				return fmt.Errorf("split %s recipient must be empty for type %s", split.Name, split.RecipientType)
+			}
+		default:
+			return fmt.Errorf("split %s has an invalid recipient type %s", split.Name, split.RecipientType)
+		}
+	}
+	return nil
+}
+
+// TotalFeeSplitsWeight returns the sum of the weights of the splits.
+func TotalFeeSplitsWeight(splits []FeeSplit) math.LegacyDec {
+	total := math.LegacyZeroDec()
+	for _, split := range splits {
+		total = total.Add(split.Weight)
+	}
+	return total
+}
@@ -34,6 +34,10 @@ type GenesisState struct {
 	UsersTotalUnbondingShares []UsersTotalUnbondingSharesEntry `protobuf:"bytes,4,rep,name=users_total_unbonding_shares,json=usersTotalUnbondingShares,proto3" json:"users_total_unbonding_shares"`
 	BondedPositions           []BondedPositionEntry            `protobuf:"bytes,5,rep,name=bonded_positions,json=bondedPositions,proto3" json:"bonded_positions"`
 	UnbondingPositions        []UnbondingPositionEntry         `protobuf:"bytes,6,rep,name=unbonding_positions,json=unbondingPositions,proto3" json:"unbonding_positions"`
+	AutoCompound              []AutoCompoundEntry              `protobuf:"bytes,7,rep,name=auto_compound,json=autoCompound,proto3" json:"auto_compound"`
+	LockedPositions           []LockedPositionEntry            `protobuf:"bytes,8,rep,name=locked_positions,json=lockedPositions,proto3" json:"locked_positions"`
+	NextScheduledUpdateId     uint64                           `protobuf:"varint,9,opt,name=next_scheduled_update_id,json=nextScheduledUpdateId,proto3" json:"next_scheduled_update_id,omitempty"`
+	ScheduledUpdates          []ScheduledPoolUpdate            `protobuf:"bytes,10,rep,name=scheduled_updates,json=scheduledUpdates,proto3" json:"scheduled_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -111,6 +115,34 @@ func (m *GenesisState) GetUnbondingPositions() []UnbondingPositionEntry {
 	return nil
 }
 
+func (m *GenesisState) GetAutoCompound() []AutoCompoundEntry {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedPositions() []LockedPositionEntry {
+	if m != nil {
+		return m.LockedPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledUpdateId() uint64 {
+	if m != nil {
+		return m.NextScheduledUpdateId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledUpdates() []ScheduledPoolUpdate {
+	if m != nil {
+		return m.ScheduledUpdates
+	}
+	return nil
+}
+
 type BondedPositionEntry struct {
 	PoolId         uint64         `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	Address        string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -398,6 +430,118 @@ func (m *UsersTotalUnbondingSharesEntry) GetAddress() string {
 	return ""
 }
 
+type AutoCompoundEntry struct {
+	PoolId  uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *AutoCompoundEntry) Reset()         { *m = AutoCompoundEntry{} }
+func (m *AutoCompoundEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundEntry) ProtoMessage()    {}
+func (*AutoCompoundEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a989ac8066dfce, []int{6}
+}
+func (m *AutoCompoundEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundEntry.Merge(m, src)
+}
+func (m *AutoCompoundEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundEntry proto.InternalMessageInfo
+
+func (m *AutoCompoundEntry) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *AutoCompoundEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type LockedPositionEntry struct {
+	LockEndTime int64  `protobuf:"varint,1,opt,name=lock_end_time,json=lockEndTime,proto3" json:"lock_end_time,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PoolId      uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *LockedPositionEntry) Reset()         { *m = LockedPositionEntry{} }
+func (m *LockedPositionEntry) String() string { return proto.CompactTextString(m) }
+func (*LockedPositionEntry) ProtoMessage()    {}
+func (*LockedPositionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a989ac8066dfce, []int{7}
+}
+func (m *LockedPositionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedPositionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedPositionEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedPositionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedPositionEntry.Merge(m, src)
+}
+func (m *LockedPositionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedPositionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedPositionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedPositionEntry proto.InternalMessageInfo
+
+func (m *LockedPositionEntry) GetLockEndTime() int64 {
+	if m != nil {
+		return m.LockEndTime
+	}
+	return 0
+}
+
+func (m *LockedPositionEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockedPositionEntry) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.swap.stableswap.v1.GenesisState")
 	proto.RegisterMapType((map[uint64]Pool)(nil), "noble.swap.stableswap.v1.GenesisState.PoolsEntry")
@@ -406,6 +550,8 @@ func init() {
 	proto.RegisterType((*PoolsTotalUnbondingSharesEntry)(nil), "noble.swap.stableswap.v1.PoolsTotalUnbondingSharesEntry")
 	proto.RegisterType((*UsersTotalBondedSharesEntry)(nil), "noble.swap.stableswap.v1.UsersTotalBondedSharesEntry")
 	proto.RegisterType((*UsersTotalUnbondingSharesEntry)(nil), "noble.swap.stableswap.v1.UsersTotalUnbondingSharesEntry")
+	proto.RegisterType((*AutoCompoundEntry)(nil), "noble.swap.stableswap.v1.AutoCompoundEntry")
+	proto.RegisterType((*LockedPositionEntry)(nil), "noble.swap.stableswap.v1.LockedPositionEntry")
 }
 
 func init() {
@@ -413,48 +559,58 @@ func init() {
 }
 
 var fileDescriptor_c3a989ac8066dfce = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xd5, 0x49, 0xaa, 0x5e, 0x7f, 0xfa, 0xb5, 0xbd, 0xa2, 0xe2, 0xa4, 0x95, 0x13, 0x05,
-	0x09, 0x2c, 0x50, 0x6d, 0x52, 0x3e, 0x54, 0x18, 0xa3, 0x22, 0x84, 0x04, 0xa8, 0x72, 0xa9, 0x40,
-	0x0c, 0x04, 0x27, 0x3e, 0xb9, 0x56, 0x13, 0x9f, 0x95, 0x3b, 0x07, 0xc2, 0xc2, 0xcc, 0xc6, 0x9f,
-	0x80, 0xc4, 0xc2, 0xc8, 0xc0, 0xce, 0x5a, 0x89, 0xa5, 0x62, 0x42, 0x0c, 0x15, 0x4a, 0x06, 0xfe,
-	0x07, 0x26, 0x74, 0x67, 0x1b, 0x3b, 0x1f, 0x36, 0x1f, 0x62, 0x60, 0xb1, 0xee, 0xee, 0x7d, 0xde,
-	0xf7, 0x79, 0x1e, 0xbf, 0xaf, 0xcf, 0xf0, 0xac, 0x4b, 0x5a, 0x1d, 0xac, 0xd3, 0x27, 0xa6, 0xa7,
-	0x53, 0x66, 0xb6, 0x3a, 0x58, 0x2c, 0xfb, 0x75, 0xdd, 0xc6, 0x2e, 0xa6, 0x0e, 0xd5, 0xbc, 0x1e,
-	0x61, 0x04, 0xc9, 0x02, 0xa7, 0xf1, 0xa0, 0x16, 0xe3, 0xb4, 0x7e, 0xbd, 0xbc, 0x62, 0x76, 0x1d,
-	0x97, 0xe8, 0xe2, 0x19, 0x80, 0xcb, 0xa5, 0x36, 0xa1, 0x5d, 0x42, 0x9b, 0x62, 0xa7, 0x07, 0x9b,
-	0x30, 0x74, 0xca, 0x26, 0x36, 0x09, 0xce, 0xf9, 0x2a, 0x3c, 0x3d, 0x93, 0xaa, 0xc2, 0x23, 0xa4,
-	0x13, 0x82, 0xce, 0x65, 0x80, 0xa8, 0xc3, 0x1c, 0xe2, 0x06, 0xc0, 0xda, 0xb7, 0x02, 0xfc, 0xef,
-	0x66, 0xa0, 0x7e, 0x8f, 0x99, 0x0c, 0xa3, 0x3b, 0xb0, 0xc0, 0xeb, 0x50, 0x19, 0x54, 0x25, 0x75,
-	0x71, 0xab, 0xae, 0xa5, 0x99, 0xd1, 0x92, 0x69, 0xda, 0x2e, 0xcf, 0xb9, 0xe1, 0xb2, 0xde, 0xa0,
-	0x91, 0x3f, 0x3a, 0xa9, 0xe4, 0x8c, 0xa0, 0x0a, 0x7a, 0x0e, 0x37, 0xc4, 0xa2, 0xc9, 0x08, 0x33,
-	0x3b, 0x4d, 0xdf, 0x6d, 0x11, 0xd7, 0x72, 0x5c, 0xbb, 0x49, 0x0f, 0xcc, 0x1e, 0xa6, 0xf2, 0x9c,
-	0x60, 0xd9, 0x4e, 0x67, 0x11, 0x85, 0xef, 0xf1, 0xe4, 0xfd, 0x28, 0x77, 0x4f, 0xa4, 0x26, 0xc9,
-	0x4a, 0x5e, 0x1a, 0x0a, 0xf5, 0x61, 0xc9, 0xa7, 0xb8, 0x17, 0x09, 0xe0, 0x41, 0x6c, 0x45, 0xec,
-	0x92, 0x60, 0xbf, 0x92, 0xce, 0xbe, 0xcf, 0x53, 0x45, 0xdd, 0x86, 0x48, 0x9c, 0xa6, 0x5e, 0xf3,
-	0x67, 0x42, 0xb8, 0xf1, 0x24, 0xef, 0x94, 0xf1, 0xfc, 0xcf, 0x8c, 0xc7, 0xd4, 0x59, 0xc6, 0xfd,
-	0x34, 0x14, 0x7a, 0x04, 0x97, 0x43, 0xb3, 0x51, 0xcb, 0xa9, 0x5c, 0x10, 0xa4, 0x9b, 0xe9, 0xa4,
-	0x81, 0x85, 0xdd, 0x30, 0x21, 0xc9, 0xb4, 0xd4, 0x1a, 0x0b, 0x51, 0x64, 0xc3, 0xd5, 0xd8, 0x54,
-	0x4c, 0x51, 0x14, 0x14, 0x17, 0x33, 0x7c, 0x45, 0x49, 0xb3, 0x58, 0x90, 0x3f, 0x19, 0xa5, 0xe5,
-	0x07, 0x10, 0xc6, 0xd3, 0x85, 0x96, 0xa1, 0x74, 0x88, 0x07, 0x32, 0xa8, 0x02, 0x35, 0x6f, 0xf0,
-	0x25, 0xba, 0x0c, 0x0b, 0x7d, 0xb3, 0xe3, 0x63, 0x79, 0xae, 0x0a, 0xd4, 0xc5, 0x2d, 0x25, 0x7b,
-	0x96, 0x8c, 0x00, 0x7c, 0x7d, 0x6e, 0x1b, 0xd4, 0xde, 0x03, 0xb8, 0x3a, 0xc3, 0x31, 0x3a, 0x0d,
-	0xe7, 0xf9, 0x40, 0x35, 0x1d, 0x2b, 0xe4, 0x29, 0xf2, 0xed, 0x2d, 0x0b, 0xc9, 0x70, 0xde, 0xb4,
-	0xac, 0x1e, 0xa6, 0x54, 0x90, 0x2d, 0x18, 0xd1, 0x16, 0x6d, 0xc0, 0x05, 0xe6, 0x74, 0x31, 0x65,
-	0x66, 0xd7, 0x93, 0xa5, 0x2a, 0x50, 0x25, 0x23, 0x3e, 0x40, 0xf7, 0xe1, 0xd2, 0x44, 0x2f, 0xe4,
-	0xbc, 0x10, 0xab, 0xfe, 0x6a, 0x2b, 0xc2, 0xf7, 0xf3, 0xff, 0x78, 0x17, 0x6a, 0x1f, 0x00, 0x5c,
-	0x9b, 0xfd, 0x42, 0xc7, 0x15, 0x81, 0x49, 0x45, 0xe9, 0x4e, 0x12, 0xe6, 0xa5, 0x31, 0xf3, 0x8f,
-	0x21, 0x9a, 0x6e, 0x78, 0xe8, 0xe3, 0xc2, 0x6f, 0xf4, 0x3b, 0xb4, 0xb2, 0x32, 0xd5, 0xea, 0xda,
-	0x0b, 0x00, 0x95, 0xec, 0xef, 0x3d, 0xbd, 0x35, 0x77, 0x61, 0xf1, 0xc7, 0x95, 0x02, 0xd4, 0x85,
-	0xc6, 0x55, 0x4e, 0xf2, 0xf9, 0xa4, 0xb2, 0x1e, 0x5c, 0xa9, 0xd4, 0x3a, 0xd4, 0x1c, 0xa2, 0x77,
-	0x4d, 0x76, 0xa0, 0xdd, 0xc6, 0xb6, 0xd9, 0x1e, 0xec, 0xe0, 0xf6, 0xc7, 0x77, 0x9b, 0x30, 0xbc,
-	0x71, 0x77, 0x70, 0xfb, 0xcd, 0xd7, 0xb7, 0xe7, 0x81, 0x11, 0x56, 0xa9, 0xbd, 0x02, 0x70, 0x3d,
-	0xe3, 0xeb, 0xff, 0x93, 0x19, 0x89, 0x25, 0x4a, 0x7f, 0x45, 0xe2, 0x6b, 0x00, 0x95, 0xec, 0x5b,
-	0xe2, 0x1f, 0x50, 0xd9, 0xb8, 0x76, 0x34, 0x54, 0xc0, 0xf1, 0x50, 0x01, 0x5f, 0x86, 0x0a, 0x78,
-	0x39, 0x52, 0x72, 0xc7, 0x23, 0x25, 0xf7, 0x69, 0xa4, 0xe4, 0x1e, 0x56, 0xc4, 0x88, 0x04, 0x83,
-	0xf3, 0x74, 0xf0, 0x4c, 0x67, 0x03, 0x0f, 0xd3, 0xc4, 0xef, 0xaa, 0x55, 0x14, 0xff, 0xa8, 0x4b,
-	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x01, 0x25, 0x78, 0x79, 0x07, 0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x4f, 0xdb, 0x48,
+	0x14, 0xcf, 0x90, 0x10, 0xc8, 0x03, 0x16, 0x32, 0xec, 0xb2, 0xe6, 0x43, 0x26, 0xca, 0x4a, 0xbb,
+	0xd1, 0x22, 0xec, 0x85, 0xfd, 0x62, 0xf7, 0xd6, 0x14, 0x5a, 0x21, 0xd1, 0x0a, 0x05, 0x68, 0xab,
+	0x1e, 0x6a, 0x9c, 0x78, 0x14, 0x2c, 0x1c, 0x8f, 0x95, 0x19, 0xa7, 0xa4, 0x97, 0x9e, 0x7b, 0xeb,
+	0x9f, 0x50, 0xa9, 0x97, 0x1e, 0x7b, 0xe8, 0xbd, 0x57, 0xa4, 0xaa, 0x12, 0xea, 0xa9, 0xea, 0x01,
+	0x55, 0x70, 0xe8, 0xbf, 0x51, 0xcd, 0xd8, 0x21, 0xce, 0x87, 0x43, 0x8b, 0x7a, 0xe8, 0x25, 0x9a,
+	0x99, 0xf7, 0xf1, 0x7b, 0xbf, 0xc9, 0xef, 0x3d, 0x0f, 0xfc, 0xea, 0xd2, 0xb2, 0x43, 0x74, 0xf6,
+	0xd0, 0xf4, 0x74, 0xc6, 0xcd, 0xb2, 0x43, 0xe4, 0xb2, 0xb1, 0xa2, 0x57, 0x89, 0x4b, 0x98, 0xcd,
+	0x34, 0xaf, 0x4e, 0x39, 0xc5, 0x8a, 0xf4, 0xd3, 0x84, 0x51, 0x6b, 0xfb, 0x69, 0x8d, 0x95, 0xb9,
+	0xac, 0x59, 0xb3, 0x5d, 0xaa, 0xcb, 0xdf, 0xc0, 0x79, 0x6e, 0xb6, 0x42, 0x59, 0x8d, 0x32, 0x43,
+	0xee, 0xf4, 0x60, 0x13, 0x9a, 0x7e, 0xac, 0xd2, 0x2a, 0x0d, 0xce, 0xc5, 0x2a, 0x3c, 0xfd, 0x25,
+	0xb6, 0x0a, 0x8f, 0x52, 0x27, 0x74, 0xfa, 0x6d, 0x80, 0x13, 0xb3, 0xb9, 0x4d, 0xdd, 0xd0, 0x51,
+	0x8f, 0x75, 0x64, 0x95, 0x03, 0x62, 0xf9, 0x0e, 0xb1, 0x0c, 0xdf, 0xb3, 0x4c, 0x4e, 0x82, 0x80,
+	0xfc, 0xdb, 0x51, 0x18, 0xbf, 0x19, 0xd0, 0xdd, 0xe1, 0x26, 0x27, 0xf8, 0x16, 0x0c, 0x0b, 0x60,
+	0xa6, 0xa0, 0x5c, 0xb2, 0x30, 0xb6, 0xba, 0xa2, 0xc5, 0xb1, 0xd7, 0xa2, 0x61, 0xda, 0xb6, 0x88,
+	0xd9, 0x70, 0x79, 0xbd, 0x59, 0x4c, 0x1d, 0x9f, 0x2e, 0x26, 0x4a, 0x41, 0x16, 0xfc, 0x18, 0x16,
+	0xe4, 0xc2, 0xe0, 0x94, 0x9b, 0x8e, 0xe1, 0xbb, 0x65, 0xea, 0x5a, 0xb6, 0x5b, 0x35, 0xd8, 0x81,
+	0x59, 0x27, 0x4c, 0x19, 0x92, 0x28, 0x6b, 0xf1, 0x28, 0x32, 0xf1, 0xae, 0x08, 0xde, 0x6b, 0xc5,
+	0xee, 0xc8, 0xd0, 0x28, 0xd8, 0xac, 0x17, 0xe7, 0x85, 0x1b, 0x30, 0xeb, 0x33, 0x52, 0x6f, 0x15,
+	0x20, 0x8c, 0xc4, 0x6a, 0xa1, 0x27, 0x25, 0xfa, 0xdf, 0xf1, 0xe8, 0x7b, 0x22, 0x54, 0xe6, 0x2d,
+	0xca, 0xc0, 0x5e, 0xe8, 0x19, 0xbf, 0xaf, 0x8b, 0x20, 0x1e, 0xc5, 0xed, 0x21, 0x9e, 0xba, 0x8c,
+	0x78, 0x1b, 0x7a, 0x10, 0x71, 0x3f, 0xce, 0x0b, 0x3f, 0x80, 0xa9, 0x90, 0x6c, 0x4b, 0x23, 0x4c,
+	0x19, 0x96, 0xa0, 0xcb, 0xf1, 0xa0, 0x01, 0x85, 0xed, 0x30, 0x20, 0x8a, 0x34, 0x59, 0xee, 0x30,
+	0x31, 0x5c, 0x85, 0xe9, 0x36, 0xa9, 0x36, 0x44, 0x5a, 0x42, 0xfc, 0x31, 0x80, 0x57, 0x2b, 0xa8,
+	0x1f, 0x0a, 0xf6, 0xbb, 0xad, 0x0c, 0xdf, 0x81, 0x09, 0xd3, 0xe7, 0xd4, 0xa8, 0xd0, 0x9a, 0x47,
+	0x7d, 0xd7, 0x52, 0x46, 0x24, 0xc4, 0x52, 0x3c, 0xc4, 0x35, 0x9f, 0xd3, 0xeb, 0xa1, 0x77, 0x34,
+	0xfb, 0xb8, 0x19, 0x31, 0x88, 0x0b, 0x72, 0x68, 0xe5, 0xb0, 0xe3, 0x82, 0x46, 0x2f, 0xbb, 0xa0,
+	0x2d, 0x19, 0xd1, 0xf7, 0x82, 0x9c, 0x0e, 0x13, 0xc3, 0xff, 0x82, 0xe2, 0x92, 0x23, 0x6e, 0x74,
+	0x77, 0x9e, 0x61, 0x5b, 0x4a, 0x26, 0x87, 0x0a, 0xa9, 0xd2, 0x4f, 0xc2, 0xbe, 0xd3, 0x32, 0xef,
+	0x49, 0xeb, 0xa6, 0x85, 0xf7, 0x21, 0xdb, 0x1d, 0xc3, 0x14, 0xb8, 0xac, 0xb2, 0x8b, 0x3c, 0xa2,
+	0x63, 0x82, 0x5c, 0x61, 0x65, 0x53, 0xac, 0x13, 0x82, 0xcd, 0xdd, 0x03, 0x68, 0x37, 0x2c, 0x9e,
+	0x82, 0xe4, 0x21, 0x69, 0x2a, 0x48, 0xd6, 0x24, 0x96, 0xf8, 0x2f, 0x18, 0x6e, 0x98, 0x8e, 0x4f,
+	0x94, 0xa1, 0x1c, 0x2a, 0x8c, 0xad, 0xaa, 0x83, 0xdb, 0xb3, 0x14, 0x38, 0xff, 0x3f, 0xb4, 0x86,
+	0xf2, 0xaf, 0x11, 0x4c, 0xf7, 0x11, 0x11, 0xfe, 0x19, 0x46, 0x44, 0x8f, 0x0a, 0xee, 0x01, 0x4e,
+	0x5a, 0x6c, 0x37, 0x2d, 0xac, 0xc0, 0x88, 0x69, 0x59, 0x75, 0xc2, 0x98, 0x04, 0xcb, 0x94, 0x5a,
+	0x5b, 0xbc, 0x00, 0x19, 0x6e, 0xd7, 0x08, 0xe3, 0x66, 0xcd, 0x53, 0x92, 0x39, 0x54, 0x48, 0x96,
+	0xda, 0x07, 0xf8, 0x2e, 0x4c, 0x76, 0xc9, 0x5b, 0x49, 0xc9, 0x62, 0x0b, 0x5f, 0xaa, 0xee, 0xf0,
+	0x76, 0x7e, 0xe8, 0x14, 0x76, 0xfe, 0x0d, 0x82, 0x99, 0xfe, 0x1a, 0xed, 0xac, 0x08, 0x75, 0x57,
+	0x14, 0xcf, 0x24, 0x42, 0x3e, 0xd9, 0x41, 0x7e, 0x1f, 0x70, 0x6f, 0x0f, 0x85, 0x3c, 0x96, 0xbe,
+	0xa2, 0x85, 0x42, 0x2a, 0xd9, 0x9e, 0xee, 0xc9, 0x3f, 0x41, 0xa0, 0x0e, 0x1e, 0xa1, 0xf1, 0x7f,
+	0xcd, 0x6d, 0x48, 0x5f, 0x4c, 0x69, 0x54, 0xc8, 0x14, 0xff, 0x11, 0x20, 0x1f, 0x4e, 0x17, 0xe7,
+	0x83, 0xcf, 0x1a, 0xb3, 0x0e, 0x35, 0x9b, 0xea, 0x35, 0x93, 0x1f, 0x68, 0x5b, 0xa4, 0x6a, 0x56,
+	0x9a, 0xeb, 0xa4, 0xf2, 0xee, 0xd5, 0x32, 0x84, 0x5f, 0xbd, 0x75, 0x52, 0x79, 0xf1, 0xe9, 0xe5,
+	0xef, 0xa8, 0x14, 0x66, 0xc9, 0x3f, 0x43, 0x30, 0x3f, 0x60, 0xa0, 0x5e, 0x45, 0x23, 0xed, 0x12,
+	0x93, 0xdf, 0xa4, 0xc4, 0xe7, 0x08, 0xd4, 0xc1, 0x83, 0xf7, 0x7b, 0xa8, 0xf2, 0x06, 0x64, 0x7b,
+	0x46, 0xdc, 0x15, 0xea, 0xca, 0x3b, 0x30, 0xdd, 0x67, 0x9e, 0xe1, 0x3c, 0x4c, 0x88, 0x59, 0x66,
+	0x10, 0xd7, 0x32, 0x84, 0xbc, 0x43, 0xa9, 0x8f, 0x89, 0xc3, 0x0d, 0xd7, 0xda, 0xb5, 0x6b, 0xe4,
+	0x0a, 0x62, 0x2f, 0xfe, 0x77, 0x7c, 0xa6, 0xa2, 0x93, 0x33, 0x15, 0x7d, 0x3c, 0x53, 0xd1, 0xd3,
+	0x73, 0x35, 0x71, 0x72, 0xae, 0x26, 0xde, 0x9f, 0xab, 0x89, 0xfb, 0x8b, 0x52, 0xd8, 0x81, 0xdc,
+	0x8f, 0x9a, 0x8f, 0x74, 0xde, 0xf4, 0x08, 0x8b, 0xbc, 0x5f, 0xca, 0x69, 0xf9, 0x58, 0xf9, 0xf3,
+	0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0x13, 0xa0, 0xc5, 0xb3, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -477,6 +633,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for iNdEx := len(m.ScheduledUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextScheduledUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledUpdateId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.LockedPositions) > 0 {
+		for iNdEx := len(m.LockedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AutoCompound) > 0 {
+		for iNdEx := len(m.AutoCompound) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompound[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.UnbondingPositions) > 0 {
 		for iNdEx := len(m.UnbondingPositions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -800,6 +1003,81 @@ func (m *UsersTotalUnbondingSharesEntry) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedPositionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedPositionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedPositionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockEndTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LockEndTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -856,6 +1134,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompound) > 0 {
+		for _, e := range m.AutoCompound {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedPositions) > 0 {
+		for _, e := range m.LockedPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledUpdateId))
+	}
+	if len(m.ScheduledUpdates) > 0 {
+		for _, e := range m.ScheduledUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -951,6 +1250,41 @@ func (m *UsersTotalUnbondingSharesEntry) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LockedPositionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockEndTime != 0 {
+		n += 1 + sovGenesis(uint64(m.LockEndTime))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1271,14 +1605,135 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompound = append(m.AutoCompound, AutoCompoundEntry{})
+			if err := m.AutoCompound[len(m.AutoCompound)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedPositions = append(m.LockedPositions, LockedPositionEntry{})
+			if err := m.LockedPositions[len(m.LockedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledUpdateId", wireType)
+			}
+			m.NextScheduledUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledUpdates = append(m.ScheduledUpdates, ScheduledPoolUpdate{})
+			if err := m.ScheduledUpdates[len(m.ScheduledUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1971,6 +2426,227 @@ func (m *UsersTotalUnbondingSharesEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedPositionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedPositionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedPositionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+			}
+			m.LockEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockEndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
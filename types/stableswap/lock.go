@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package stableswap
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// ValidateLockTiers ensures that the tiers have strictly increasing positive durations, with
+// multipliers greater than or equal to 1. An empty list is valid.
+func ValidateLockTiers(tiers []LockTier) error {
+	for i, tier := range tiers {
+		if tier.Duration <= 0 {
+			return fmt.Errorf("tier %d duration must be positive, got %s", i, tier.Duration)
+		}
+		if i > 0 && tier.Duration <= tiers[i-1].Duration {
+			return fmt.Errorf("tier %d duration must be greater than the previous tier, got %s", i, tier.Duration)
+		}
+		if tier.Multiplier.IsNil() || tier.Multiplier.LT(math.LegacyOneDec()) {
+			return fmt.Errorf("tier %d multiplier must be >= 1, got %s", i, tier.Multiplier)
+		}
+	}
+	return nil
+}
+
+// IsLocked returns true if the shares of the position cannot be unbonded at the given time.
+func (p BondedPosition) IsLocked(currentTime time.Time) bool {
+	return p.LockEndTime.After(currentTime)
+}
+
+// IsBoosted returns true if the position has a reward multiplier greater than 1.
+func (p BondedPosition) IsBoosted() bool {
+	return p.Multiplier != nil && p.Multiplier.GT(math.LegacyOneDec())
+}
+
+// HasSameLockAndBoost returns true if both positions are either unlocked at the given time or locked until
+// the same time, and have the same reward multiplier.
+func (p BondedPosition) HasSameLockAndBoost(other BondedPosition, currentTime time.Time) bool {
+	if (p.IsLocked(currentTime) || other.IsLocked(currentTime)) && !p.LockEndTime.Equal(other.LockEndTime) {
+		return false
+	}
+	if p.IsBoosted() != other.IsBoosted() {
+		return false
+	}
+	return !p.IsBoosted() || p.Multiplier.Equal(*other.Multiplier)
+}
+
+// BoostedShares returns the additional reward weight of the position given by its multiplier.
+func (p BondedPosition) BoostedShares() math.LegacyDec {
+	if !p.IsBoosted() {
+		return math.LegacyZeroDec()
+	}
+	return p.Balance.Mul(p.Multiplier.Sub(math.LegacyOneDec()))
+}
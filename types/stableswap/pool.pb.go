@@ -13,6 +13,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -32,6 +33,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// buf:lint:ignore ENUM_VALUE_PREFIX
+type FeeRecipientType int32
+
+const (
+	// buf:lint:ignore ENUM_ZERO_VALUE_SUFFIX
+	FEE_RECIPIENT_UNSPECIFIED    FeeRecipientType = 0
+	FEE_RECIPIENT_ADDRESS        FeeRecipientType = 1
+	FEE_RECIPIENT_MODULE         FeeRecipientType = 2
+	FEE_RECIPIENT_COMMUNITY_POOL FeeRecipientType = 3
+	FEE_RECIPIENT_BURN           FeeRecipientType = 4
+)
+
+var FeeRecipientType_name = map[int32]string{
+	0: "FEE_RECIPIENT_UNSPECIFIED",
+	1: "FEE_RECIPIENT_ADDRESS",
+	2: "FEE_RECIPIENT_MODULE",
+	3: "FEE_RECIPIENT_COMMUNITY_POOL",
+	4: "FEE_RECIPIENT_BURN",
+}
+
+var FeeRecipientType_value = map[string]int32{
+	"FEE_RECIPIENT_UNSPECIFIED":    0,
+	"FEE_RECIPIENT_ADDRESS":        1,
+	"FEE_RECIPIENT_MODULE":         2,
+	"FEE_RECIPIENT_COMMUNITY_POOL": 3,
+	"FEE_RECIPIENT_BURN":           4,
+}
+
+func (x FeeRecipientType) String() string {
+	return proto.EnumName(FeeRecipientType_name, int32(x))
+}
+
+func (FeeRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{0}
+}
+
 type Pool struct {
 	// Protocol fee percentage for the pool.
 	ProtocolFeePercentage int64 `protobuf:"varint,1,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
@@ -51,6 +88,26 @@ type Pool struct {
 	TotalShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_shares"`
 	// Time when the first liquidity was added to start tracking rewards.
 	InitialRewardsTime time.Time `protobuf:"bytes,9,opt,name=initial_rewards_time,json=initialRewardsTime,proto3,stdtime" json:"initial_rewards_time"`
+	// Unbonding tiers applied when removing liquidity, the default schedule is used when empty.
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,10,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// Exit fee tiers applied to instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []ExitFeeTier `protobuf:"bytes,11,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers"`
+	// Lock tiers available when adding liquidity, which is disabled when empty.
+	LockTiers []LockTier `protobuf:"bytes,12,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers"`
+	// Additional reward weight of the locked positions, on top of the total shares.
+	TotalBoostedShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=total_boosted_shares,json=totalBoostedShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_boosted_shares"`
+	// Cumulative rewards distributed per unit of reward weight, for each denom.
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,14,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share"`
+	// Cumulative incentives distributed per unit of reward weight, for each denom.
+	IncentivePerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,15,rep,name=incentive_per_share,json=incentivePerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"incentive_per_share"`
+	// Recipients of the protocol fees, which are kept in the pool when empty.
+	FeeSplits []FeeSplit `protobuf:"bytes,16,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+	// Dynamic rewards fee model based on the pool imbalance, which is disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,17,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Rewards fees applied to each swap direction, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,18,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
+	// Rewards deposited while the pool had no reward weight, credited to the providers with the next deposit.
+	PendingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,19,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_rewards"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -142,8 +199,387 @@ func (m *Pool) GetInitialRewardsTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Pool) GetUnbondingTiers() []UnbondingTier {
+	if m != nil {
+		return m.UnbondingTiers
+	}
+	return nil
+}
+
+func (m *Pool) GetExitFeeTiers() []ExitFeeTier {
+	if m != nil {
+		return m.ExitFeeTiers
+	}
+	return nil
+}
+
+func (m *Pool) GetLockTiers() []LockTier {
+	if m != nil {
+		return m.LockTiers
+	}
+	return nil
+}
+
+func (m *Pool) GetRewardPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerShare
+	}
+	return nil
+}
+
+func (m *Pool) GetIncentivePerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.IncentivePerShare
+	}
+	return nil
+}
+
+func (m *Pool) GetFeeSplits() []FeeSplit {
+	if m != nil {
+		return m.FeeSplits
+	}
+	return nil
+}
+
+func (m *Pool) GetDynamicFee() *DynamicFee {
+	if m != nil {
+		return m.DynamicFee
+	}
+	return nil
+}
+
+func (m *Pool) GetDirectionalFee() *DirectionalFee {
+	if m != nil {
+		return m.DirectionalFee
+	}
+	return nil
+}
+
+func (m *Pool) GetPendingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+type UnbondingTier struct {
+	// Percentage of the pool total shares above which the tier applies.
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+	// Unbonding duration of the tier.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *UnbondingTier) Reset()         { *m = UnbondingTier{} }
+func (m *UnbondingTier) String() string { return proto.CompactTextString(m) }
+func (*UnbondingTier) ProtoMessage()    {}
+func (*UnbondingTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{1}
+}
+func (m *UnbondingTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingTier.Merge(m, src)
+}
+func (m *UnbondingTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingTier proto.InternalMessageInfo
+
+func (m *UnbondingTier) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type ExitFeeTier struct {
+	// Percentage of the pool total shares above which the tier applies.
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+	// Percentage of the removed liquidity paid as exit fee.
+	FeePercentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_percentage,json=feePercentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percentage"`
+}
+
+func (m *ExitFeeTier) Reset()         { *m = ExitFeeTier{} }
+func (m *ExitFeeTier) String() string { return proto.CompactTextString(m) }
+func (*ExitFeeTier) ProtoMessage()    {}
+func (*ExitFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{2}
+}
+func (m *ExitFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExitFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExitFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExitFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitFeeTier.Merge(m, src)
+}
+func (m *ExitFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExitFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitFeeTier proto.InternalMessageInfo
+
+type LockTier struct {
+	// Duration for which the liquidity is locked.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Multiplier applied to the reward weight of the locked shares.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *LockTier) Reset()         { *m = LockTier{} }
+func (m *LockTier) String() string { return proto.CompactTextString(m) }
+func (*LockTier) ProtoMessage()    {}
+func (*LockTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{3}
+}
+func (m *LockTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockTier.Merge(m, src)
+}
+func (m *LockTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockTier proto.InternalMessageInfo
+
+func (m *LockTier) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type FeeSplit struct {
+	// Unique name of the split, used to identify it in events.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the recipient receiving the split.
+	RecipientType FeeRecipientType `protobuf:"varint,2,opt,name=recipient_type,json=recipientType,proto3,enum=noble.swap.stableswap.v1.FeeRecipientType" json:"recipient_type,omitempty"`
+	// Address or module name of the recipient, empty for the community pool and burn types.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Relative weight of the split over the protocol fees.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{4}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+func (m *FeeSplit) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeSplit) GetRecipientType() FeeRecipientType {
+	if m != nil {
+		return m.RecipientType
+	}
+	return FEE_RECIPIENT_UNSPECIFIED
+}
+
+func (m *FeeSplit) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type DynamicFee struct {
+	// Multiplier applied to the pool imbalance to scale the rewards fee.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	// Minimum rewards fee applied to the swaps.
+	MinFee int64 `protobuf:"varint,2,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	// Maximum rewards fee applied to the swaps.
+	MaxFee int64 `protobuf:"varint,3,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (m *DynamicFee) Reset()         { *m = DynamicFee{} }
+func (m *DynamicFee) String() string { return proto.CompactTextString(m) }
+func (*DynamicFee) ProtoMessage()    {}
+func (*DynamicFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{5}
+}
+func (m *DynamicFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFee.Merge(m, src)
+}
+func (m *DynamicFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFee proto.InternalMessageInfo
+
+func (m *DynamicFee) GetMinFee() int64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *DynamicFee) GetMaxFee() int64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+type DirectionalFee struct {
+	// Rewards fee applied to the swaps from the pair denom to the base denom.
+	PairToBaseFee int64 `protobuf:"varint,1,opt,name=pair_to_base_fee,json=pairToBaseFee,proto3" json:"pair_to_base_fee,omitempty"`
+	// Rewards fee applied to the swaps from the base denom to the pair denom.
+	BaseToPairFee int64 `protobuf:"varint,2,opt,name=base_to_pair_fee,json=baseToPairFee,proto3" json:"base_to_pair_fee,omitempty"`
+}
+
+func (m *DirectionalFee) Reset()         { *m = DirectionalFee{} }
+func (m *DirectionalFee) String() string { return proto.CompactTextString(m) }
+func (*DirectionalFee) ProtoMessage()    {}
+func (*DirectionalFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8de1ac129241c997, []int{6}
+}
+func (m *DirectionalFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DirectionalFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DirectionalFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DirectionalFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectionalFee.Merge(m, src)
+}
+func (m *DirectionalFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DirectionalFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectionalFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectionalFee proto.InternalMessageInfo
+
+func (m *DirectionalFee) GetPairToBaseFee() int64 {
+	if m != nil {
+		return m.PairToBaseFee
+	}
+	return 0
+}
+
+func (m *DirectionalFee) GetBaseToPairFee() int64 {
+	if m != nil {
+		return m.BaseToPairFee
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("noble.swap.stableswap.v1.FeeRecipientType", FeeRecipientType_name, FeeRecipientType_value)
 	proto.RegisterType((*Pool)(nil), "noble.swap.stableswap.v1.Pool")
+	proto.RegisterType((*UnbondingTier)(nil), "noble.swap.stableswap.v1.UnbondingTier")
+	proto.RegisterType((*ExitFeeTier)(nil), "noble.swap.stableswap.v1.ExitFeeTier")
+	proto.RegisterType((*LockTier)(nil), "noble.swap.stableswap.v1.LockTier")
+	proto.RegisterType((*FeeSplit)(nil), "noble.swap.stableswap.v1.FeeSplit")
+	proto.RegisterType((*DynamicFee)(nil), "noble.swap.stableswap.v1.DynamicFee")
+	proto.RegisterType((*DirectionalFee)(nil), "noble.swap.stableswap.v1.DirectionalFee")
 }
 
 func init() {
@@ -151,42 +587,83 @@ func init() {
 }
 
 var fileDescriptor_8de1ac129241c997 = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xd8, 0xd8, 0x5a, 0xb7, 0xc0, 0x88, 0x86, 0x48, 0x3b, 0x29, 0xa9, 0x06, 0x87, 0x6a,
-	0x52, 0x6d, 0x75, 0x48, 0x93, 0xe0, 0xb6, 0x32, 0xf5, 0x04, 0xd2, 0x54, 0x26, 0x24, 0xb8, 0x44,
-	0x4e, 0xfa, 0x9a, 0x5a, 0x4b, 0xe2, 0x28, 0x76, 0x3b, 0xca, 0x6f, 0xe0, 0xb0, 0x33, 0xbf, 0x00,
-	0x71, 0xda, 0x61, 0xfc, 0x87, 0x89, 0xd3, 0xc4, 0x09, 0x71, 0xd8, 0x50, 0x7b, 0xd8, 0xdf, 0x40,
-	0xb1, 0x9d, 0x8d, 0x4b, 0xeb, 0xf7, 0xbe, 0xef, 0x7d, 0xcf, 0xfa, 0xfc, 0x05, 0x3d, 0x4b, 0x79,
-	0x10, 0x03, 0x11, 0x27, 0x34, 0x23, 0x42, 0xd2, 0x20, 0x06, 0x75, 0x9c, 0xf5, 0x48, 0xc6, 0x79,
-	0x8c, 0xb3, 0x9c, 0x4b, 0x6e, 0x3b, 0x8a, 0x84, 0x0b, 0x04, 0xdf, 0x91, 0xf0, 0xac, 0xd7, 0x7a,
-	0x4c, 0x13, 0x96, 0x72, 0xa2, 0x7e, 0x35, 0xb9, 0xe5, 0x86, 0x5c, 0x24, 0x5c, 0x90, 0x80, 0x0a,
-	0x20, 0xb3, 0x5e, 0x00, 0x92, 0xf6, 0x48, 0xc8, 0x59, 0x6a, 0xf0, 0xa6, 0xc6, 0x7d, 0x55, 0x11,
-	0x5d, 0x18, 0x68, 0x33, 0xe2, 0x11, 0xd7, 0xfd, 0xe2, 0x64, 0xba, 0x5e, 0xc4, 0x79, 0x14, 0x03,
-	0x51, 0x55, 0x30, 0x1d, 0x13, 0xc9, 0x12, 0x10, 0x92, 0x26, 0x99, 0x26, 0x6c, 0xff, 0x58, 0x45,
-	0xab, 0x87, 0x9c, 0xc7, 0xf6, 0x1e, 0x7a, 0xaa, 0x3a, 0x21, 0x8f, 0xfd, 0x31, 0x80, 0x9f, 0x41,
-	0x1e, 0x42, 0x2a, 0x69, 0x04, 0x8e, 0xd5, 0xb6, 0x3a, 0x2b, 0xc3, 0x27, 0x25, 0x3c, 0x00, 0x38,
-	0xbc, 0x05, 0x6d, 0x0f, 0xd5, 0x73, 0x38, 0xa1, 0xf9, 0x48, 0x14, 0x63, 0xce, 0x3d, 0xc5, 0x45,
-	0xa6, 0x35, 0x00, 0xb0, 0xb7, 0x50, 0x8d, 0xa5, 0x4c, 0x32, 0x1a, 0xfb, 0xd4, 0x59, 0x51, 0x70,
-	0xd5, 0x34, 0xf6, 0xed, 0x26, 0xaa, 0x8e, 0xa7, 0x72, 0x9a, 0x83, 0x4f, 0x9d, 0x55, 0x85, 0xad,
-	0xeb, 0x7a, 0xdf, 0x7e, 0x8e, 0x1e, 0xde, 0xce, 0xf9, 0xc5, 0xb5, 0x9d, 0xfb, 0x8a, 0xd0, 0x28,
-	0x87, 0x8f, 0x58, 0x02, 0xf6, 0x36, 0x7a, 0x50, 0x0a, 0x68, 0xd2, 0x9a, 0x22, 0xd5, 0x8d, 0x8a,
-	0xe2, 0x7c, 0xb1, 0xd0, 0x46, 0x4e, 0x25, 0xf8, 0xc9, 0x34, 0x96, 0x2c, 0x8b, 0x19, 0xe4, 0xc2,
-	0x59, 0x6f, 0xaf, 0x74, 0xea, 0xbb, 0x4d, 0x6c, 0x4c, 0x2c, 0x1c, 0xc7, 0xc6, 0x71, 0xfc, 0x9a,
-	0xb3, 0xb4, 0x3f, 0xb8, 0xb8, 0xf2, 0x2a, 0xdf, 0xaf, 0xbd, 0x4e, 0xc4, 0xe4, 0x64, 0x1a, 0xe0,
-	0x90, 0x27, 0xc6, 0x71, 0xf3, 0xd7, 0x15, 0xa3, 0x63, 0x22, 0xe7, 0x19, 0x08, 0x35, 0x20, 0xbe,
-	0xde, 0x9c, 0xed, 0x34, 0x62, 0x88, 0x68, 0x38, 0xf7, 0x8b, 0x37, 0x13, 0xdf, 0x6e, 0xce, 0x76,
-	0xac, 0xe1, 0xa3, 0x62, 0xf5, 0xdb, 0xbb, 0xcd, 0xf6, 0x07, 0xd4, 0x90, 0x5c, 0xd2, 0xd8, 0x17,
-	0x13, 0x9a, 0x83, 0x70, 0xaa, 0x6d, 0xab, 0x53, 0xeb, 0xef, 0x15, 0xeb, 0xfe, 0x5c, 0x79, 0x5b,
-	0x5a, 0x5c, 0x8c, 0x8e, 0x31, 0xe3, 0x24, 0xa1, 0x72, 0x82, 0xdf, 0x28, 0xcd, 0x03, 0x08, 0x7f,
-	0x9d, 0x77, 0x91, 0xb9, 0xef, 0x01, 0x84, 0x5a, 0xbe, 0xae, 0xb4, 0xde, 0x29, 0x29, 0xfb, 0x3d,
-	0xda, 0x2c, 0x3d, 0x2b, 0x1f, 0x45, 0x99, 0x52, 0x6b, 0x5b, 0x9d, 0xfa, 0x6e, 0x0b, 0xeb, 0x34,
-	0xe0, 0x32, 0x0d, 0xf8, 0xa8, 0x4c, 0x43, 0xbf, 0x5a, 0xac, 0x3f, 0xbd, 0xf6, 0xac, 0xa1, 0x6d,
-	0x14, 0x86, 0x5a, 0xa0, 0xa0, 0xbc, 0xda, 0xf8, 0x79, 0xde, 0x6d, 0x98, 0xdc, 0xe2, 0x22, 0x2e,
-	0xfd, 0x97, 0x17, 0x0b, 0xd7, 0xba, 0x5c, 0xb8, 0xd6, 0xdf, 0x85, 0x6b, 0x9d, 0x2e, 0xdd, 0xca,
-	0xe5, 0xd2, 0xad, 0xfc, 0x5e, 0xba, 0x95, 0x8f, 0x9e, 0xe2, 0xe9, 0xd4, 0x7f, 0x9a, 0x7f, 0xd6,
-	0x0e, 0xfd, 0xf7, 0x7d, 0x04, 0x6b, 0x6a, 0xfd, 0x8b, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0x5a, 0xc6, 0x98, 0x3f, 0x03, 0x00, 0x00,
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6b, 0x1b, 0xd7,
+	0x17, 0xf7, 0xc4, 0xfe, 0x3b, 0xd2, 0xd1, 0xc3, 0xca, 0x8d, 0xf3, 0xcf, 0xd8, 0x49, 0x25, 0xa3,
+	0xa6, 0xc4, 0xb8, 0x64, 0x84, 0x53, 0x08, 0xb4, 0x9b, 0x12, 0x59, 0x12, 0x18, 0xfc, 0x50, 0x64,
+	0x39, 0x90, 0x96, 0x32, 0x5c, 0x8d, 0x8e, 0xa5, 0x8b, 0x47, 0x73, 0xc5, 0xcc, 0x95, 0x63, 0xf7,
+	0x13, 0x94, 0xd2, 0x45, 0xba, 0x69, 0x4b, 0xb7, 0x81, 0x50, 0xba, 0xca, 0x22, 0x9f, 0xa0, 0x50,
+	0x08, 0x5d, 0x85, 0xae, 0x4a, 0x17, 0x49, 0x49, 0x16, 0xf9, 0x1a, 0xe5, 0x3e, 0x46, 0x2f, 0xea,
+	0xb4, 0x24, 0x6d, 0x36, 0xf6, 0xdc, 0xf3, 0x3b, 0xe7, 0x77, 0x8e, 0xce, 0xeb, 0x5e, 0x78, 0x37,
+	0xe0, 0x2d, 0x1f, 0x4b, 0xd1, 0x5d, 0xda, 0x2f, 0x45, 0x82, 0xb6, 0x7c, 0x54, 0x9f, 0x47, 0xeb,
+	0xa5, 0x3e, 0xe7, 0xbe, 0xd3, 0x0f, 0xb9, 0xe0, 0xc4, 0x56, 0x4a, 0x8e, 0x44, 0x9c, 0x91, 0x92,
+	0x73, 0xb4, 0xbe, 0x7c, 0x8e, 0xf6, 0x58, 0xc0, 0x4b, 0xea, 0xaf, 0x56, 0x5e, 0xce, 0x7b, 0x3c,
+	0xea, 0xf1, 0xa8, 0xd4, 0xa2, 0x11, 0x96, 0x8e, 0xd6, 0x5b, 0x28, 0xe8, 0x7a, 0xc9, 0xe3, 0x2c,
+	0x30, 0xf8, 0x92, 0xc6, 0x5d, 0x75, 0x2a, 0xe9, 0x83, 0x81, 0x16, 0x3b, 0xbc, 0xc3, 0xb5, 0x5c,
+	0x7e, 0xc5, 0x84, 0x1d, 0xce, 0x3b, 0x3e, 0x96, 0xd4, 0xa9, 0x35, 0x38, 0x28, 0xb5, 0x07, 0x21,
+	0x15, 0x8c, 0xc7, 0x84, 0x85, 0x69, 0x5c, 0xb0, 0x1e, 0x46, 0x82, 0xf6, 0xfa, 0x5a, 0xa1, 0xf8,
+	0x73, 0x1a, 0xe6, 0xea, 0x9c, 0xfb, 0xe4, 0x06, 0x5c, 0x54, 0x12, 0x8f, 0xfb, 0xee, 0x01, 0xa2,
+	0xdb, 0xc7, 0xd0, 0xc3, 0x40, 0xd0, 0x0e, 0xda, 0xd6, 0x8a, 0xb5, 0x3a, 0xdb, 0xb8, 0x10, 0xc3,
+	0x35, 0xc4, 0xfa, 0x10, 0x24, 0x05, 0x48, 0x85, 0x78, 0x97, 0x86, 0xed, 0x48, 0x9a, 0xd9, 0x67,
+	0x94, 0x2e, 0x18, 0x51, 0x0d, 0x91, 0x5c, 0x82, 0x24, 0x0b, 0x98, 0x60, 0xd4, 0x77, 0xa9, 0x3d,
+	0xab, 0xe0, 0x84, 0x11, 0xdc, 0x24, 0x4b, 0x90, 0x38, 0x18, 0x88, 0x41, 0x88, 0x2e, 0xb5, 0xe7,
+	0x14, 0x76, 0x56, 0x9f, 0x6f, 0x92, 0x2b, 0x90, 0x1d, 0xda, 0xb9, 0x32, 0x6c, 0xfb, 0x7f, 0x4a,
+	0x21, 0x1d, 0x1b, 0x37, 0x59, 0x0f, 0x49, 0x11, 0x32, 0x31, 0x81, 0x56, 0x9a, 0x57, 0x4a, 0x29,
+	0xc3, 0xa2, 0x74, 0xbe, 0xb2, 0x20, 0x17, 0x52, 0x81, 0x6e, 0x6f, 0xe0, 0x0b, 0xd6, 0xf7, 0x19,
+	0x86, 0x91, 0x7d, 0x76, 0x65, 0x76, 0x35, 0x75, 0x7d, 0xc9, 0x31, 0x49, 0x96, 0x15, 0x71, 0x4c,
+	0x45, 0x9c, 0x0d, 0xce, 0x82, 0x72, 0xed, 0xf1, 0xd3, 0xc2, 0xcc, 0x8f, 0xcf, 0x0a, 0xab, 0x1d,
+	0x26, 0xba, 0x83, 0x96, 0xe3, 0xf1, 0x9e, 0xa9, 0x88, 0xf9, 0x77, 0x2d, 0x6a, 0x1f, 0x96, 0xc4,
+	0x49, 0x1f, 0x23, 0x65, 0x10, 0x7d, 0xff, 0xf2, 0xe1, 0x5a, 0xda, 0xc7, 0x0e, 0xf5, 0x4e, 0x5c,
+	0x59, 0xd3, 0xe8, 0x87, 0x97, 0x0f, 0xd7, 0xac, 0xc6, 0x82, 0x74, 0xbd, 0x3d, 0xf2, 0x4c, 0xee,
+	0x40, 0x5a, 0x70, 0x41, 0x7d, 0x37, 0xea, 0xd2, 0x10, 0x23, 0x3b, 0xb1, 0x62, 0xad, 0x26, 0xcb,
+	0x37, 0xa4, 0xbb, 0xdf, 0x9f, 0x16, 0x2e, 0x69, 0xf2, 0xa8, 0x7d, 0xe8, 0x30, 0x5e, 0xea, 0x51,
+	0xd1, 0x75, 0xb6, 0x14, 0x67, 0x05, 0xbd, 0x5f, 0x1f, 0x5d, 0x03, 0x13, 0x6f, 0x05, 0x3d, 0x4d,
+	0x9f, 0x52, 0x5c, 0x7b, 0x8a, 0x8a, 0xdc, 0x86, 0xc5, 0x38, 0x67, 0x71, 0x51, 0x54, 0x52, 0x92,
+	0x2b, 0xd6, 0x6a, 0xea, 0xfa, 0xb2, 0xa3, 0xbb, 0xc1, 0x89, 0xbb, 0xc1, 0x69, 0xc6, 0xdd, 0x50,
+	0x4e, 0x48, 0xf7, 0xf7, 0x9e, 0x15, 0xac, 0x06, 0x31, 0x0c, 0x0d, 0x4d, 0xa0, 0x32, 0xf8, 0x29,
+	0x2c, 0x0c, 0x82, 0x16, 0x0f, 0xda, 0x2c, 0xe8, 0xb8, 0x42, 0xe5, 0x0f, 0x54, 0xfe, 0xae, 0x3a,
+	0xa7, 0xb5, 0xbf, 0xb3, 0x1f, 0x1b, 0x34, 0x19, 0x86, 0xe5, 0xa4, 0xe4, 0xd7, 0x11, 0x67, 0x07,
+	0xe3, 0x88, 0x0c, 0x3a, 0x8b, 0xc7, 0x4c, 0xa8, 0xae, 0xd3, 0xdc, 0x29, 0xc5, 0xfd, 0xde, 0xe9,
+	0xdc, 0xd5, 0x63, 0x26, 0x6a, 0x88, 0xd3, 0xcc, 0x69, 0x1c, 0xc9, 0x23, 0xb2, 0x05, 0xe0, 0x73,
+	0xef, 0xd0, 0x70, 0xa6, 0x15, 0x67, 0xf1, 0x74, 0xce, 0x2d, 0xee, 0x1d, 0x4e, 0x13, 0x26, 0x7d,
+	0x23, 0x8c, 0x48, 0x17, 0x16, 0x75, 0xd5, 0x5a, 0x9c, 0x47, 0x02, 0xdb, 0x71, 0xf5, 0x32, 0x6f,
+	0x54, 0x3d, 0xa2, 0x38, 0xcb, 0x9a, 0xd2, 0x14, 0xf1, 0x6b, 0xd9, 0xae, 0x2a, 0xf9, 0x72, 0x08,
+	0xb5, 0x1f, 0x3b, 0xab, 0xc2, 0xbf, 0xfc, 0x97, 0xed, 0x5a, 0x41, 0x4f, 0x75, 0xec, 0xa6, 0xe9,
+	0xd8, 0xf7, 0xff, 0x41, 0xc7, 0x1a, 0x9b, 0xd3, 0x9a, 0x36, 0xab, 0x03, 0xa8, 0x63, 0xa8, 0x82,
+	0x22, 0xdf, 0x5a, 0x70, 0x9e, 0x05, 0x72, 0xe6, 0xd9, 0x11, 0x8e, 0x85, 0xb5, 0xf0, 0x76, 0xc3,
+	0x3a, 0x37, 0x8c, 0x61, 0x18, 0xd9, 0x16, 0x80, 0x6c, 0x9c, 0xa8, 0xef, 0x33, 0x11, 0xd9, 0xb9,
+	0xbf, 0xab, 0x72, 0x0d, 0x71, 0x4f, 0xaa, 0x4e, 0x54, 0xf9, 0xc0, 0x08, 0x23, 0x52, 0x85, 0x54,
+	0xfb, 0x24, 0xa0, 0x3d, 0xe6, 0xa9, 0x6d, 0x76, 0x4e, 0xcd, 0xcd, 0x95, 0xd3, 0xe9, 0x2a, 0x5a,
+	0xb9, 0x86, 0xd8, 0x80, 0xf6, 0xf0, 0x9b, 0xdc, 0x82, 0x85, 0x36, 0x0b, 0xd1, 0x93, 0x9b, 0x98,
+	0xaa, 0x7d, 0x6a, 0x13, 0x45, 0xb5, 0xfa, 0x0a, 0xaa, 0x91, 0x81, 0xa4, 0xcb, 0xb6, 0x27, 0xce,
+	0xe4, 0x4b, 0x0b, 0x16, 0xfa, 0xa8, 0x27, 0xd0, 0xcc, 0xb6, 0x7d, 0xfe, 0x6d, 0xed, 0xb0, 0xac,
+	0xf1, 0x6c, 0x76, 0xc2, 0x47, 0xb9, 0x5f, 0x1e, 0x5d, 0x4b, 0xc7, 0x81, 0xcb, 0xeb, 0xa3, 0xf8,
+	0xc0, 0x82, 0xcc, 0xc4, 0xc4, 0x93, 0x26, 0x24, 0x45, 0x37, 0xc4, 0xa8, 0xcb, 0xfd, 0xb6, 0xba,
+	0x42, 0x5e, 0x7f, 0x4a, 0x46, 0x44, 0xe4, 0x63, 0x48, 0xc4, 0x57, 0x9c, 0xba, 0x6b, 0xe4, 0xcf,
+	0x9f, 0xde, 0x6a, 0x15, 0xa3, 0xa0, 0x97, 0xda, 0x77, 0x72, 0xa9, 0x0d, 0x8d, 0x8a, 0x3f, 0x59,
+	0x90, 0x1a, 0x5b, 0x1f, 0xff, 0x51, 0x98, 0x9f, 0x41, 0x76, 0xea, 0x12, 0x3d, 0xf3, 0x46, 0xd4,
+	0x99, 0x83, 0xf1, 0x4b, 0xb7, 0x78, 0xdf, 0x82, 0x44, 0xbc, 0xaf, 0x26, 0x52, 0x62, 0xbd, 0x46,
+	0x4a, 0xc8, 0x6d, 0x80, 0xd1, 0xcd, 0xf8, 0x86, 0x81, 0x8e, 0x31, 0x15, 0x9f, 0x59, 0x90, 0x88,
+	0xe7, 0x8d, 0x10, 0x98, 0x0b, 0x68, 0x4f, 0x3f, 0x26, 0x92, 0x0d, 0xf5, 0x4d, 0x6e, 0x41, 0x36,
+	0x44, 0x8f, 0xf5, 0x19, 0x06, 0xc2, 0x95, 0x5d, 0xa8, 0x9c, 0x67, 0xaf, 0xaf, 0xbd, 0x72, 0x7e,
+	0x1b, 0xb1, 0x49, 0xf3, 0xa4, 0x8f, 0x8d, 0x4c, 0x38, 0x7e, 0x24, 0x97, 0x21, 0x39, 0x14, 0xa8,
+	0xd7, 0x46, 0xb2, 0x31, 0x12, 0x90, 0x1d, 0x98, 0xbf, 0x8b, 0xac, 0xd3, 0x15, 0xea, 0xb1, 0xf1,
+	0xfa, 0xbf, 0xd2, 0xb0, 0x14, 0xbf, 0xb1, 0x00, 0x46, 0x2b, 0x60, 0x2a, 0x91, 0xd6, 0xbf, 0x95,
+	0x48, 0x72, 0x11, 0xce, 0xf6, 0x58, 0x30, 0xf6, 0xbe, 0x9a, 0xef, 0xb1, 0x40, 0x3a, 0x94, 0x00,
+	0x3d, 0x56, 0xc0, 0xac, 0x01, 0xe8, 0x71, 0x0d, 0xb1, 0xd8, 0x82, 0xec, 0xe4, 0x3e, 0x21, 0x57,
+	0x21, 0xd7, 0xa7, 0x2c, 0x74, 0x05, 0x77, 0xe5, 0x9e, 0x50, 0x36, 0xfa, 0x61, 0x97, 0x91, 0xf2,
+	0x26, 0x2f, 0xd3, 0x08, 0x8d, 0xa2, 0x52, 0x10, 0xdc, 0x55, 0x06, 0x23, 0xaf, 0x19, 0x29, 0x6f,
+	0xf2, 0x3a, 0x65, 0x61, 0x0d, 0x71, 0xed, 0x81, 0x05, 0xb9, 0xe9, 0x72, 0x90, 0x77, 0x60, 0xa9,
+	0x56, 0xad, 0xba, 0x8d, 0xea, 0xc6, 0x66, 0x7d, 0xb3, 0xba, 0xd3, 0x74, 0xf7, 0x77, 0xf6, 0xea,
+	0xd5, 0x8d, 0xcd, 0xda, 0x66, 0xb5, 0x92, 0x9b, 0x21, 0x4b, 0x70, 0x61, 0x12, 0xbe, 0x59, 0xa9,
+	0x34, 0xaa, 0x7b, 0x7b, 0x39, 0x8b, 0xd8, 0xb0, 0x38, 0x09, 0x6d, 0xef, 0x56, 0xf6, 0xb7, 0xaa,
+	0xb9, 0x33, 0x64, 0x05, 0x2e, 0x4f, 0x22, 0x1b, 0xbb, 0xdb, 0xdb, 0xfb, 0x3b, 0x9b, 0xcd, 0x3b,
+	0x6e, 0x7d, 0x77, 0x77, 0x2b, 0x37, 0x4b, 0xfe, 0x0f, 0x64, 0x52, 0xa3, 0xbc, 0xdf, 0xd8, 0xc9,
+	0xcd, 0x2d, 0xcf, 0x7d, 0x71, 0x3f, 0x3f, 0x53, 0xfe, 0xf0, 0xf1, 0xf3, 0xbc, 0xf5, 0xe4, 0x79,
+	0xde, 0xfa, 0xe3, 0x79, 0xde, 0xba, 0xf7, 0x22, 0x3f, 0xf3, 0xe4, 0x45, 0x7e, 0xe6, 0xb7, 0x17,
+	0xf9, 0x99, 0x4f, 0x0a, 0xaa, 0xad, 0x74, 0xb3, 0x1d, 0x9f, 0x7c, 0xae, 0x37, 0xe1, 0xd8, 0x5b,
+	0xbf, 0x35, 0xaf, 0x26, 0xe8, 0x83, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x52, 0xc7, 0x26,
+	0x0b, 0x0c, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -209,28 +686,10 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.InitialRewardsTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialRewardsTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintPool(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.TotalShares.Size()
-		i -= size
-		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPool(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.RateMultipliers) > 0 {
-		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -238,22 +697,180 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPool(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if m.FutureATime != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.FutureATime))
+	if m.DirectionalFee != nil {
+		{
+			size, err := m.DirectionalFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.InitialATime != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.InitialATime))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x92
 	}
-	if m.FutureA != 0 {
-		i = encodeVarintPool(dAtA, i, uint64(m.FutureA))
-		i--
+	if m.DynamicFee != nil {
+		{
+			size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.IncentivePerShare) > 0 {
+		for iNdEx := len(m.IncentivePerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size := m.TotalBoostedShares.Size()
+		i -= size
+		if _, err := m.TotalBoostedShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for iNdEx := len(m.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.InitialRewardsTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialRewardsTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPool(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.RateMultipliers) > 0 {
+		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InitialATime != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.InitialATime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.FutureA))
+		i--
 		dAtA[i] = 0x20
 	}
 	if m.InitialA != 0 {
@@ -274,61 +891,1420 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnbondingTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UnbondingTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintPool(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExitFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExitFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExitFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProtocolFeePercentage != 0 {
-		n += 1 + sovPool(uint64(m.ProtocolFeePercentage))
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	if m.RewardsFee != 0 {
-		n += 1 + sovPool(uint64(m.RewardsFee))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	if m.InitialA != 0 {
-		n += 1 + sovPool(uint64(m.InitialA))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.FutureA != 0 {
-		n += 1 + sovPool(uint64(m.FutureA))
+	return dAtA[:n], nil
+}
+
+func (m *LockTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	if m.InitialATime != 0 {
-		n += 1 + sovPool(uint64(m.InitialATime))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if m.FutureATime != 0 {
-		n += 1 + sovPool(uint64(m.FutureATime))
+	i -= n5
+	i = encodeVarintPool(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RateMultipliers) > 0 {
-		for _, e := range m.RateMultipliers {
-			l = e.Size()
-			n += 1 + l + sovPool(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RecipientType != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.RecipientType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFee != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MaxFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinFee != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.MinFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DirectionalFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DirectionalFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DirectionalFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseToPairFee != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BaseToPairFee))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairToBaseFee != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PairToBaseFee))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolFeePercentage != 0 {
+		n += 1 + sovPool(uint64(m.ProtocolFeePercentage))
+	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovPool(uint64(m.RewardsFee))
+	}
+	if m.InitialA != 0 {
+		n += 1 + sovPool(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovPool(uint64(m.FutureA))
+	}
+	if m.InitialATime != 0 {
+		n += 1 + sovPool(uint64(m.InitialATime))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovPool(uint64(m.FutureATime))
+	}
+	if len(m.RateMultipliers) > 0 {
+		for _, e := range m.RateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialRewardsTime)
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for _, e := range m.ExitFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	l = m.TotalBoostedShares.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.IncentivePerShare) > 0 {
+		for _, e := range m.IncentivePerShare {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 2 + l + sovPool(uint64(l))
+		}
+	}
+	if m.DynamicFee != nil {
+		l = m.DynamicFee.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if m.DirectionalFee != nil {
+		l = m.DirectionalFee.Size()
+		n += 2 + l + sovPool(uint64(l))
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 2 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *ExitFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *LockTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovPool(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.RecipientType != 0 {
+		n += 1 + sovPool(uint64(m.RecipientType))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *DynamicFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Multiplier.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.MinFee != 0 {
+		n += 1 + sovPool(uint64(m.MinFee))
+	}
+	if m.MaxFee != 0 {
+		n += 1 + sovPool(uint64(m.MaxFee))
+	}
+	return n
+}
+
+func (m *DirectionalFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairToBaseFee != 0 {
+		n += 1 + sovPool(uint64(m.PairToBaseFee))
+	}
+	if m.BaseToPairFee != 0 {
+		n += 1 + sovPool(uint64(m.BaseToPairFee))
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			m.ProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			m.InitialA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+			}
+			m.InitialATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+			}
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
+			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRewardsTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.InitialRewardsTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFeeTiers = append(m.ExitFeeTiers, ExitFeeTier{})
+			if err := m.ExitFeeTiers[len(m.ExitFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBoostedShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBoostedShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerShare = append(m.RewardPerShare, types.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePerShare = append(m.IncentivePerShare, types.DecCoin{})
+			if err := m.IncentivePerShare[len(m.IncentivePerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, FeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFee == nil {
+				m.DynamicFee = &DynamicFee{}
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectionalFee == nil {
+				m.DirectionalFee = &DirectionalFee{}
+			}
+			if err := m.DirectionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.Coin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.TotalShares.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.InitialRewardsTime)
-	n += 1 + l + sovPool(uint64(l))
-	return n
-}
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -351,17 +2327,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ProtocolFeePercentage = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -371,16 +2347,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
 			}
-			m.RewardsFee = 0
+			m.RecipientType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -390,16 +2379,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardsFee |= int64(b&0x7F) << shift
+				m.RecipientType |= FeeRecipientType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.InitialA = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -409,16 +2398,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialA |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.FutureA = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -428,16 +2430,81 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureA |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
 			}
-			m.InitialATime = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -447,16 +2514,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialATime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
-			m.FutureATime = 0
+			m.MinFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -466,16 +2548,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureATime |= int64(b&0x7F) << shift
+				m.MinFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
-			var msglen int
+			m.MaxFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -485,31 +2567,66 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPool
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPool
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
-			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DirectionalFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DirectionalFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DirectionalFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairToBaseFee", wireType)
+			}
+			m.PairToBaseFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -519,31 +2636,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PairToBaseFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPool
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRewardsTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseToPairFee", wireType)
 			}
-			var msglen int
+			m.BaseToPairFee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -553,25 +2655,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BaseToPairFee |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPool
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.InitialRewardsTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -39,6 +39,14 @@ type BondedPosition struct {
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 	// Time when the rewards were collected.
 	RewardsPeriodStart time.Time `protobuf:"bytes,3,opt,name=rewards_period_start,json=rewardsPeriodStart,proto3,stdtime" json:"rewards_period_start"`
+	// Time until which the shares are locked, zero if not locked.
+	LockEndTime time.Time `protobuf:"bytes,4,opt,name=lock_end_time,json=lockEndTime,proto3,stdtime" json:"lock_end_time"`
+	// Multiplier applied to the reward weight of the shares while locked, empty if not boosted.
+	Multiplier *cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier,omitempty"`
+	// Pool cumulative reward per share at the time the rewards were collected.
+	RewardPerShareCheckpoint github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=reward_per_share_checkpoint,json=rewardPerShareCheckpoint,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share_checkpoint"`
+	// Pool cumulative incentive per share at the time the incentives were collected.
+	IncentivePerShareCheckpoint github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=incentive_per_share_checkpoint,json=incentivePerShareCheckpoint,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"incentive_per_share_checkpoint"`
 }
 
 func (m *BondedPosition) Reset()         { *m = BondedPosition{} }
@@ -88,6 +96,27 @@ func (m *BondedPosition) GetRewardsPeriodStart() time.Time {
 	return time.Time{}
 }
 
+func (m *BondedPosition) GetLockEndTime() time.Time {
+	if m != nil {
+		return m.LockEndTime
+	}
+	return time.Time{}
+}
+
+func (m *BondedPosition) GetRewardPerShareCheckpoint() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerShareCheckpoint
+	}
+	return nil
+}
+
+func (m *BondedPosition) GetIncentivePerShareCheckpoint() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.IncentivePerShareCheckpoint
+	}
+	return nil
+}
+
 type UnbondingPosition struct {
 	// Amount of shares removed.
 	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
@@ -154,37 +183,45 @@ func init() {
 }
 
 var fileDescriptor_ca8412ebbf400a9f = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6b, 0x1b, 0x4f,
-	0x10, 0xd5, 0xc9, 0x20, 0xdb, 0xeb, 0x1f, 0x3f, 0xf0, 0xe1, 0xe2, 0xac, 0xc0, 0x9d, 0x70, 0x13,
-	0x61, 0xf0, 0x2e, 0x72, 0x20, 0x90, 0x2a, 0x70, 0x31, 0xa9, 0x42, 0x10, 0xce, 0x9f, 0x22, 0xcd,
-	0xb1, 0x77, 0xbb, 0x39, 0x2d, 0xbe, 0xdb, 0x39, 0x6e, 0x57, 0x72, 0x94, 0x4f, 0xe1, 0x3a, 0x4d,
-	0xda, 0x90, 0xca, 0x45, 0x3e, 0x84, 0x4b, 0x93, 0x2a, 0xa4, 0xb0, 0x83, 0x54, 0xf8, 0x5b, 0x84,
-	0xb0, 0x7f, 0x64, 0xa7, 0x35, 0x69, 0xa4, 0x9d, 0x99, 0x37, 0xef, 0x3d, 0x66, 0xe6, 0xd0, 0x43,
-	0x09, 0x79, 0xc5, 0x89, 0x3a, 0xa5, 0x0d, 0x51, 0x9a, 0xe6, 0x15, 0xb7, 0xcf, 0xd9, 0x88, 0x34,
-	0xa0, 0x84, 0x16, 0x20, 0x71, 0xd3, 0x82, 0x86, 0x30, 0xb2, 0x40, 0x6c, 0xaa, 0xf8, 0x0e, 0x88,
-	0x67, 0xa3, 0xfe, 0x36, 0xad, 0x85, 0x04, 0x62, 0x7f, 0x1d, 0xb8, 0x1f, 0x17, 0xa0, 0x6a, 0x50,
-	0x24, 0xa7, 0x8a, 0x93, 0xd9, 0x28, 0xe7, 0x9a, 0x8e, 0x48, 0x01, 0xc2, 0x93, 0xf5, 0x77, 0x5d,
-	0x3d, 0xb3, 0x11, 0x71, 0x81, 0x2f, 0xed, 0x94, 0x50, 0x82, 0xcb, 0x9b, 0x97, 0xcf, 0x26, 0x25,
-	0x40, 0x59, 0x71, 0x62, 0xa3, 0x7c, 0xfa, 0x9e, 0x68, 0x51, 0x73, 0xa5, 0x69, 0xdd, 0x38, 0xc0,
-	0xde, 0xef, 0x00, 0xfd, 0x9f, 0x82, 0x64, 0x9c, 0x8d, 0xbd, 0xef, 0x70, 0x8c, 0xd6, 0x73, 0x5a,
-	0x51, 0x59, 0xf0, 0x28, 0x18, 0x04, 0xc3, 0xcd, 0xf4, 0xf1, 0xc5, 0x55, 0xd2, 0xf9, 0x79, 0x95,
-	0x3c, 0x70, 0x82, 0x8a, 0x9d, 0x60, 0x01, 0xa4, 0xa6, 0x7a, 0x82, 0x5f, 0xf0, 0x92, 0x16, 0xf3,
-	0x23, 0x5e, 0x7c, 0xff, 0x76, 0x80, 0xbc, 0x9f, 0x23, 0x5e, 0x7c, 0xb9, 0x39, 0xdf, 0x0f, 0x8e,
-	0x57, 0x34, 0x61, 0x8a, 0x36, 0x6f, 0x75, 0xa3, 0xee, 0x20, 0x18, 0x6e, 0x1d, 0xf6, 0xb1, 0x73,
-	0x86, 0x57, 0xce, 0xf0, 0xeb, 0x15, 0x22, 0xdd, 0x30, 0x7a, 0x67, 0xd7, 0x49, 0x70, 0x7c, 0xd7,
-	0x16, 0xbe, 0x45, 0x3b, 0x2d, 0x3f, 0xa5, 0x2d, 0x53, 0x59, 0xc3, 0x5b, 0x01, 0x2c, 0x53, 0x9a,
-	0xb6, 0x3a, 0x5a, 0xbb, 0x07, 0x5d, 0xe8, 0x19, 0xc6, 0x96, 0xe0, 0x95, 0xe9, 0xdf, 0xfb, 0xdc,
-	0x45, 0xdb, 0x6f, 0x64, 0x0e, 0x92, 0x09, 0x59, 0xde, 0xce, 0xe0, 0x25, 0xea, 0xa9, 0x09, 0x6d,
-	0xb9, 0xfa, 0xc7, 0x11, 0x78, 0x96, 0x70, 0x8e, 0x7a, 0xb4, 0x86, 0xa9, 0xd4, 0x51, 0x77, 0xb0,
-	0x36, 0xdc, 0x3a, 0xdc, 0xc5, 0x1e, 0x69, 0x36, 0x8d, 0xfd, 0xa6, 0xf1, 0x33, 0x10, 0x32, 0x7d,
-	0x6e, 0xa4, 0xbe, 0x5e, 0x27, 0xc3, 0x52, 0xe8, 0xc9, 0x34, 0xc7, 0x05, 0xd4, 0x7e, 0xd3, 0xfe,
-	0xef, 0x40, 0xb1, 0x13, 0xa2, 0xe7, 0x0d, 0x57, 0xb6, 0x41, 0x7d, 0xba, 0x39, 0xdf, 0xff, 0xaf,
-	0xb2, 0x2e, 0x32, 0x73, 0x2b, 0xca, 0x4b, 0x3b, 0xc1, 0xf0, 0x29, 0xda, 0xe0, 0x92, 0x65, 0x66,
-	0x92, 0xf7, 0x1a, 0xd6, 0x3a, 0x97, 0xcc, 0xe4, 0xd3, 0x27, 0x17, 0x8b, 0x38, 0xb8, 0x5c, 0xc4,
-	0xc1, 0xaf, 0x45, 0x1c, 0x9c, 0x2d, 0xe3, 0xce, 0xe5, 0x32, 0xee, 0xfc, 0x58, 0xc6, 0x9d, 0x77,
-	0x89, 0x3d, 0x65, 0x77, 0xe0, 0x1f, 0xe6, 0x1f, 0x9d, 0xa9, 0xbf, 0x3e, 0x87, 0xbc, 0x67, 0x15,
-	0x1e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x1a, 0x2b, 0xd7, 0x2e, 0x03, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0xb3, 0xed, 0xaf, 0x69, 0x3b, 0xfd, 0x29, 0x74, 0xe9, 0x61, 0x9b, 0xca, 0x6e, 0xe9,
+	0xc5, 0x50, 0xe9, 0x0c, 0xa9, 0x20, 0x78, 0x12, 0xb6, 0x55, 0x14, 0x44, 0x62, 0xab, 0x1e, 0xbc,
+	0x2c, 0xb3, 0xbb, 0xe3, 0x66, 0xc8, 0xee, 0xcc, 0xb2, 0x33, 0x49, 0x8d, 0xaf, 0xa2, 0x67, 0x2f,
+	0xde, 0x44, 0xc4, 0x43, 0x0f, 0xbe, 0x88, 0x1e, 0x8b, 0x27, 0xf1, 0xd0, 0x4a, 0x72, 0xe8, 0x0b,
+	0xf0, 0x0d, 0xc8, 0xfc, 0x49, 0x5a, 0xb0, 0x88, 0x41, 0xf0, 0x92, 0xcc, 0xcc, 0xf3, 0x3c, 0xdf,
+	0xe7, 0xf3, 0x4c, 0xbe, 0x19, 0x70, 0x93, 0xf1, 0x38, 0x27, 0x48, 0x1c, 0xe0, 0x12, 0x09, 0x89,
+	0xe3, 0x9c, 0xe8, 0x65, 0xbf, 0x85, 0x4a, 0x2e, 0xa8, 0xa4, 0x9c, 0xc1, 0xb2, 0xe2, 0x92, 0xbb,
+	0x9e, 0x4e, 0x84, 0x2a, 0x0a, 0x2f, 0x12, 0x61, 0xbf, 0xd5, 0x58, 0xc6, 0x05, 0x65, 0x1c, 0xe9,
+	0x4f, 0x93, 0xdc, 0xf0, 0x13, 0x2e, 0x0a, 0x2e, 0x50, 0x8c, 0x05, 0x41, 0xfd, 0x56, 0x4c, 0x24,
+	0x6e, 0xa1, 0x84, 0x53, 0x2b, 0xd6, 0x58, 0x35, 0xf1, 0x48, 0xef, 0x90, 0xd9, 0xd8, 0xd0, 0x4a,
+	0xc6, 0x33, 0x6e, 0xce, 0xd5, 0xca, 0x9e, 0x06, 0x19, 0xe7, 0x59, 0x4e, 0x90, 0xde, 0xc5, 0xbd,
+	0x57, 0x48, 0xd2, 0x82, 0x08, 0x89, 0x8b, 0xd2, 0x24, 0x6c, 0xfc, 0x98, 0x03, 0xd7, 0x43, 0xce,
+	0x52, 0x92, 0xb6, 0x2d, 0xb7, 0xdb, 0x06, 0xf3, 0x31, 0xce, 0x31, 0x4b, 0x88, 0xe7, 0xac, 0x3b,
+	0xcd, 0xc5, 0xf0, 0xce, 0xf1, 0x69, 0x50, 0xfb, 0x76, 0x1a, 0xac, 0x99, 0x86, 0x22, 0xed, 0x42,
+	0xca, 0x51, 0x81, 0x65, 0x07, 0x3e, 0x26, 0x19, 0x4e, 0x06, 0xbb, 0x24, 0xf9, 0xf2, 0x79, 0x0b,
+	0x58, 0x9e, 0x5d, 0x92, 0x7c, 0x38, 0x3f, 0xda, 0x74, 0xf6, 0xc6, 0x32, 0x6e, 0x08, 0x16, 0x27,
+	0x7d, 0xbd, 0x99, 0x75, 0xa7, 0xb9, 0xb4, 0xdd, 0x80, 0x86, 0x0c, 0x8e, 0xc9, 0xe0, 0xb3, 0x71,
+	0x46, 0xb8, 0xa0, 0xfa, 0x1d, 0x9e, 0x05, 0xce, 0xde, 0x45, 0x99, 0xfb, 0x02, 0xac, 0x54, 0xe4,
+	0x00, 0x57, 0xa9, 0x88, 0x4a, 0x52, 0x51, 0x9e, 0x46, 0x42, 0xe2, 0x4a, 0x7a, 0xb3, 0x53, 0xc8,
+	0xb9, 0x56, 0xa1, 0xad, 0x05, 0xf6, 0x55, 0xbd, 0xfb, 0x10, 0x5c, 0xcb, 0x79, 0xd2, 0x8d, 0x08,
+	0x4b, 0x23, 0xd5, 0xcd, 0xfb, 0x6f, 0x0a, 0xc1, 0x25, 0x55, 0x7a, 0x9f, 0xa5, 0x2a, 0xe6, 0x3e,
+	0x05, 0xa0, 0xe8, 0xe5, 0x92, 0x96, 0x39, 0x25, 0x95, 0x37, 0xa7, 0xaf, 0xae, 0x75, 0x7c, 0x1a,
+	0x38, 0x53, 0x5d, 0xdd, 0xde, 0x25, 0x11, 0xf7, 0xbd, 0x03, 0xd6, 0x0c, 0xb3, 0x1a, 0x3a, 0x12,
+	0x1d, 0x5c, 0x91, 0x28, 0xe9, 0x90, 0xa4, 0x5b, 0x72, 0xca, 0xa4, 0x57, 0x5f, 0x9f, 0x6d, 0x2e,
+	0x6d, 0xdf, 0x80, 0xb6, 0x5c, 0xd9, 0x06, 0x5a, 0xdb, 0x28, 0xad, 0x1d, 0x4e, 0x59, 0xf8, 0x48,
+	0xd1, 0x7e, 0x3c, 0x0b, 0x6e, 0x65, 0x54, 0x76, 0x7a, 0x31, 0x4c, 0x78, 0x61, 0x9d, 0x63, 0xbf,
+	0xb6, 0x44, 0xda, 0x45, 0x72, 0x50, 0x12, 0x31, 0xae, 0x11, 0x6f, 0xcf, 0x8f, 0x36, 0xff, 0xcf,
+	0x35, 0x60, 0xa4, 0xec, 0x27, 0xcc, 0x0f, 0xea, 0x19, 0x96, 0x36, 0xa9, 0xf6, 0x15, 0xc9, 0xce,
+	0x04, 0xc4, 0xfd, 0xe4, 0x00, 0x9f, 0xb2, 0x84, 0x30, 0x49, 0xfb, 0xe4, 0x6a, 0xd6, 0xf9, 0x7f,
+	0xcb, 0xba, 0x36, 0xc1, 0xf9, 0x15, 0x77, 0xe3, 0xdd, 0x0c, 0x58, 0x7e, 0xce, 0x62, 0xce, 0x52,
+	0xca, 0xb2, 0x89, 0xf1, 0x9f, 0x80, 0xba, 0xa6, 0x16, 0x7f, 0xe9, 0x7b, 0xab, 0xe2, 0x0e, 0x40,
+	0x1d, 0x17, 0xbc, 0xc7, 0xa4, 0x37, 0xa3, 0x67, 0x5f, 0xbd, 0x72, 0x76, 0x3d, 0xf8, 0x03, 0x3b,
+	0x78, 0xf3, 0x0f, 0x06, 0xff, 0xdd, 0xd4, 0xb6, 0xa1, 0x7b, 0x0f, 0x2c, 0x4c, 0x0c, 0x3d, 0xcd,
+	0x3f, 0x64, 0x9e, 0x18, 0x33, 0x87, 0x77, 0x8f, 0x87, 0xbe, 0x73, 0x32, 0xf4, 0x9d, 0xef, 0x43,
+	0xdf, 0x39, 0x1c, 0xf9, 0xb5, 0x93, 0x91, 0x5f, 0xfb, 0x3a, 0xf2, 0x6b, 0x2f, 0x03, 0xfd, 0x7e,
+	0x99, 0x57, 0xed, 0xf5, 0xe0, 0x8d, 0x81, 0xba, 0xf4, 0x06, 0xc6, 0x75, 0xdd, 0xe1, 0xf6, 0xcf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x81, 0x3e, 0x00, 0xec, 0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *BondedPosition) Marshal() (dAtA []byte, err error) {
@@ -207,21 +244,69 @@ func (m *BondedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RewardsPeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RewardsPeriodStart):])
+	if len(m.IncentivePerShareCheckpoint) > 0 {
+		for iNdEx := len(m.IncentivePerShareCheckpoint) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePerShareCheckpoint[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardPerShareCheckpoint) > 0 {
+		for iNdEx := len(m.RewardPerShareCheckpoint) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShareCheckpoint[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPosition(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Multiplier != nil {
+		{
+			size := m.Multiplier.Size()
+			i -= size
+			if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPosition(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LockEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LockEndTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintPosition(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RewardsPeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RewardsPeriodStart):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintPosition(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPosition(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x12
 	{
 		size := m.Balance.Size()
@@ -256,12 +341,12 @@ func (m *UnbondingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintPosition(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintPosition(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.Amount) > 0 {
@@ -314,6 +399,24 @@ func (m *BondedPosition) Size() (n int) {
 	n += 1 + l + sovPosition(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RewardsPeriodStart)
 	n += 1 + l + sovPosition(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LockEndTime)
+	n += 1 + l + sovPosition(uint64(l))
+	if m.Multiplier != nil {
+		l = m.Multiplier.Size()
+		n += 1 + l + sovPosition(uint64(l))
+	}
+	if len(m.RewardPerShareCheckpoint) > 0 {
+		for _, e := range m.RewardPerShareCheckpoint {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
+	if len(m.IncentivePerShareCheckpoint) > 0 {
+		for _, e := range m.IncentivePerShareCheckpoint {
+			l = e.Size()
+			n += 1 + l + sovPosition(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -471,6 +574,143 @@ func (m *BondedPosition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Multiplier = &v
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShareCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerShareCheckpoint = append(m.RewardPerShareCheckpoint, types.DecCoin{})
+			if err := m.RewardPerShareCheckpoint[len(m.RewardPerShareCheckpoint)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePerShareCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePerShareCheckpoint = append(m.IncentivePerShareCheckpoint, types.DecCoin{})
+			if err := m.IncentivePerShareCheckpoint[len(m.IncentivePerShareCheckpoint)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPosition(dAtA[iNdEx:])
@@ -20,6 +20,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -413,6 +414,191 @@ func (m *QueryRewardsByProviderResponse) GetRewards() []QueryRewardsResponseEntr
 	return nil
 }
 
+type QueryIncentivesByProvider struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *QueryIncentivesByProvider) Reset()         { *m = QueryIncentivesByProvider{} }
+func (m *QueryIncentivesByProvider) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivesByProvider) ProtoMessage()    {}
+func (*QueryIncentivesByProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{8}
+}
+func (m *QueryIncentivesByProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivesByProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivesByProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivesByProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivesByProvider.Merge(m, src)
+}
+func (m *QueryIncentivesByProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivesByProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivesByProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivesByProvider proto.InternalMessageInfo
+
+func (m *QueryIncentivesByProvider) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type QueryIncentivesByProviderResponse struct {
+	Incentives []QueryRewardsResponseEntry `protobuf:"bytes,1,rep,name=incentives,proto3" json:"incentives"`
+}
+
+func (m *QueryIncentivesByProviderResponse) Reset()         { *m = QueryIncentivesByProviderResponse{} }
+func (m *QueryIncentivesByProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivesByProviderResponse) ProtoMessage()    {}
+func (*QueryIncentivesByProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{9}
+}
+func (m *QueryIncentivesByProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivesByProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivesByProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivesByProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivesByProviderResponse.Merge(m, src)
+}
+func (m *QueryIncentivesByProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivesByProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivesByProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivesByProviderResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivesByProviderResponse) GetIncentives() []QueryRewardsResponseEntry {
+	if m != nil {
+		return m.Incentives
+	}
+	return nil
+}
+
+type QueryUnbondingPeriod struct {
+	PoolId uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *QueryUnbondingPeriod) Reset()         { *m = QueryUnbondingPeriod{} }
+func (m *QueryUnbondingPeriod) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingPeriod) ProtoMessage()    {}
+func (*QueryUnbondingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{10}
+}
+func (m *QueryUnbondingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingPeriod.Merge(m, src)
+}
+func (m *QueryUnbondingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingPeriod proto.InternalMessageInfo
+
+func (m *QueryUnbondingPeriod) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryUnbondingPeriodResponse struct {
+	UnbondingPeriod time.Duration `protobuf:"bytes,1,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	EndTime         time.Time     `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryUnbondingPeriodResponse) Reset()         { *m = QueryUnbondingPeriodResponse{} }
+func (m *QueryUnbondingPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingPeriodResponse) ProtoMessage()    {}
+func (*QueryUnbondingPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{11}
+}
+func (m *QueryUnbondingPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingPeriodResponse.Merge(m, src)
+}
+func (m *QueryUnbondingPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingPeriodResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingPeriodResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingPeriodResponse) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *QueryUnbondingPeriodResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 type QueryBondedPositionResponseEntry struct {
 	PoolId    uint64                      `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	Shares    cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
@@ -423,7 +609,7 @@ func (m *QueryBondedPositionResponseEntry) Reset()         { *m = QueryBondedPos
 func (m *QueryBondedPositionResponseEntry) String() string { return proto.CompactTextString(m) }
 func (*QueryBondedPositionResponseEntry) ProtoMessage()    {}
 func (*QueryBondedPositionResponseEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79e9b83b56fa5817, []int{8}
+	return fileDescriptor_79e9b83b56fa5817, []int{12}
 }
 func (m *QueryBondedPositionResponseEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +662,7 @@ func (m *QueryUnbondingPositionResponseEntry) Reset()         { *m = QueryUnbond
 func (m *QueryUnbondingPositionResponseEntry) String() string { return proto.CompactTextString(m) }
 func (*QueryUnbondingPositionResponseEntry) ProtoMessage()    {}
 func (*QueryUnbondingPositionResponseEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79e9b83b56fa5817, []int{9}
+	return fileDescriptor_79e9b83b56fa5817, []int{13}
 }
 func (m *QueryUnbondingPositionResponseEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +714,7 @@ func (m *QueryRewardsResponseEntry) Reset()         { *m = QueryRewardsResponseE
 func (m *QueryRewardsResponseEntry) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardsResponseEntry) ProtoMessage()    {}
 func (*QueryRewardsResponseEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79e9b83b56fa5817, []int{10}
+	return fileDescriptor_79e9b83b56fa5817, []int{14}
 }
 func (m *QueryRewardsResponseEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,6 +757,213 @@ func (m *QueryRewardsResponseEntry) GetAmount() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+type QueryScheduledPoolUpdates struct {
+}
+
+func (m *QueryScheduledPoolUpdates) Reset()         { *m = QueryScheduledPoolUpdates{} }
+func (m *QueryScheduledPoolUpdates) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledPoolUpdates) ProtoMessage()    {}
+func (*QueryScheduledPoolUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{15}
+}
+func (m *QueryScheduledPoolUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledPoolUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledPoolUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledPoolUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledPoolUpdates.Merge(m, src)
+}
+func (m *QueryScheduledPoolUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledPoolUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledPoolUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledPoolUpdates proto.InternalMessageInfo
+
+type QueryScheduledPoolUpdatesResponse struct {
+	Updates []ScheduledPoolUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+}
+
+func (m *QueryScheduledPoolUpdatesResponse) Reset()         { *m = QueryScheduledPoolUpdatesResponse{} }
+func (m *QueryScheduledPoolUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledPoolUpdatesResponse) ProtoMessage()    {}
+func (*QueryScheduledPoolUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{16}
+}
+func (m *QueryScheduledPoolUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledPoolUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledPoolUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledPoolUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledPoolUpdatesResponse.Merge(m, src)
+}
+func (m *QueryScheduledPoolUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledPoolUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledPoolUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledPoolUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledPoolUpdatesResponse) GetUpdates() []ScheduledPoolUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+type QueryAmplification struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryAmplification) Reset()         { *m = QueryAmplification{} }
+func (m *QueryAmplification) String() string { return proto.CompactTextString(m) }
+func (*QueryAmplification) ProtoMessage()    {}
+func (*QueryAmplification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{17}
+}
+func (m *QueryAmplification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmplification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmplification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmplification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmplification.Merge(m, src)
+}
+func (m *QueryAmplification) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmplification) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmplification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmplification proto.InternalMessageInfo
+
+func (m *QueryAmplification) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryAmplificationResponse struct {
+	// Current effective amplification coefficient, interpolated along the ramp.
+	CurrentA cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=current_a,json=currentA,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"current_a"`
+	// Amplification coefficient at the start of the ramp.
+	InitialA int64 `protobuf:"varint,2,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
+	// Amplification coefficient at the end of the ramp.
+	FutureA int64 `protobuf:"varint,3,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Timestamp at which the ramp starts.
+	InitialATime int64 `protobuf:"varint,4,opt,name=initial_a_time,json=initialATime,proto3" json:"initial_a_time,omitempty"`
+	// Timestamp at which the ramp ends.
+	FutureATime int64 `protobuf:"varint,5,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
+	// Whether the ramp is still in progress.
+	Ramping bool `protobuf:"varint,6,opt,name=ramping,proto3" json:"ramping,omitempty"`
+}
+
+func (m *QueryAmplificationResponse) Reset()         { *m = QueryAmplificationResponse{} }
+func (m *QueryAmplificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmplificationResponse) ProtoMessage()    {}
+func (*QueryAmplificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79e9b83b56fa5817, []int{18}
+}
+func (m *QueryAmplificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmplificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmplificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmplificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmplificationResponse.Merge(m, src)
+}
+func (m *QueryAmplificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmplificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmplificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmplificationResponse proto.InternalMessageInfo
+
+func (m *QueryAmplificationResponse) GetInitialA() int64 {
+	if m != nil {
+		return m.InitialA
+	}
+	return 0
+}
+
+func (m *QueryAmplificationResponse) GetFutureA() int64 {
+	if m != nil {
+		return m.FutureA
+	}
+	return 0
+}
+
+func (m *QueryAmplificationResponse) GetInitialATime() int64 {
+	if m != nil {
+		return m.InitialATime
+	}
+	return 0
+}
+
+func (m *QueryAmplificationResponse) GetFutureATime() int64 {
+	if m != nil {
+		return m.FutureATime
+	}
+	return 0
+}
+
+func (m *QueryAmplificationResponse) GetRamping() bool {
+	if m != nil {
+		return m.Ramping
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryPositionsByProvider)(nil), "noble.swap.stableswap.v1.QueryPositionsByProvider")
 	proto.RegisterType((*QueryPositionsByProviderResponse)(nil), "noble.swap.stableswap.v1.QueryPositionsByProviderResponse")
@@ -580,9 +973,17 @@ func init() {
 	proto.RegisterType((*QueryUnbondingPositionsByProviderResponse)(nil), "noble.swap.stableswap.v1.QueryUnbondingPositionsByProviderResponse")
 	proto.RegisterType((*QueryRewardsByProvider)(nil), "noble.swap.stableswap.v1.QueryRewardsByProvider")
 	proto.RegisterType((*QueryRewardsByProviderResponse)(nil), "noble.swap.stableswap.v1.QueryRewardsByProviderResponse")
+	proto.RegisterType((*QueryIncentivesByProvider)(nil), "noble.swap.stableswap.v1.QueryIncentivesByProvider")
+	proto.RegisterType((*QueryIncentivesByProviderResponse)(nil), "noble.swap.stableswap.v1.QueryIncentivesByProviderResponse")
+	proto.RegisterType((*QueryUnbondingPeriod)(nil), "noble.swap.stableswap.v1.QueryUnbondingPeriod")
+	proto.RegisterType((*QueryUnbondingPeriodResponse)(nil), "noble.swap.stableswap.v1.QueryUnbondingPeriodResponse")
 	proto.RegisterType((*QueryBondedPositionResponseEntry)(nil), "noble.swap.stableswap.v1.QueryBondedPositionResponseEntry")
 	proto.RegisterType((*QueryUnbondingPositionResponseEntry)(nil), "noble.swap.stableswap.v1.QueryUnbondingPositionResponseEntry")
 	proto.RegisterType((*QueryRewardsResponseEntry)(nil), "noble.swap.stableswap.v1.QueryRewardsResponseEntry")
+	proto.RegisterType((*QueryScheduledPoolUpdates)(nil), "noble.swap.stableswap.v1.QueryScheduledPoolUpdates")
+	proto.RegisterType((*QueryScheduledPoolUpdatesResponse)(nil), "noble.swap.stableswap.v1.QueryScheduledPoolUpdatesResponse")
+	proto.RegisterType((*QueryAmplification)(nil), "noble.swap.stableswap.v1.QueryAmplification")
+	proto.RegisterType((*QueryAmplificationResponse)(nil), "noble.swap.stableswap.v1.QueryAmplificationResponse")
 }
 
 func init() {
@@ -590,61 +991,87 @@ func init() {
 }
 
 var fileDescriptor_79e9b83b56fa5817 = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0x40, 0x31, 0x78, 0x68, 0x05, 0x2c, 0x55, 0x65, 0xbb, 0x68, 0xed, 0x6e, 0xab, 0xca,
-	0x45, 0x65, 0x16, 0x9b, 0x96, 0xf2, 0xa3, 0x55, 0xab, 0x05, 0x5a, 0x55, 0xaa, 0x2a, 0xea, 0xb6,
-	0x52, 0xd5, 0x8b, 0xb5, 0xeb, 0x9d, 0x9a, 0x15, 0xf6, 0xce, 0x76, 0x67, 0x6d, 0xba, 0x54, 0xbd,
-	0xf4, 0x94, 0x23, 0x52, 0x6e, 0xfc, 0x01, 0x51, 0x94, 0x13, 0x87, 0x28, 0x87, 0x1c, 0x73, 0xe2,
-	0x48, 0x92, 0x4b, 0x14, 0x24, 0x88, 0x20, 0x12, 0xca, 0x25, 0x7f, 0x43, 0xb4, 0xb3, 0xb3, 0xeb,
-	0x0d, 0x78, 0xb1, 0x9d, 0x10, 0xe5, 0x02, 0x3b, 0x33, 0xef, 0x7b, 0xdf, 0xfb, 0x66, 0xde, 0x7c,
-	0x63, 0xf8, 0x89, 0x49, 0xb4, 0x3a, 0x96, 0xe9, 0x96, 0x6a, 0xc9, 0xd4, 0x51, 0xb5, 0x3a, 0x66,
-	0x9f, 0xad, 0xa2, 0xfc, 0x77, 0x13, 0xdb, 0x2e, 0xb2, 0x6c, 0xe2, 0x10, 0x21, 0xcd, 0xa2, 0x90,
-	0xb7, 0x84, 0xda, 0x51, 0xa8, 0x55, 0xcc, 0x4e, 0xa8, 0x0d, 0xc3, 0x24, 0x32, 0xfb, 0xeb, 0x07,
-	0x67, 0xc5, 0x2a, 0xa1, 0x0d, 0x42, 0x65, 0x4d, 0xa5, 0x58, 0x6e, 0x15, 0x35, 0xec, 0xa8, 0x45,
-	0xb9, 0x4a, 0x0c, 0x93, 0xaf, 0x7f, 0xc8, 0xd7, 0x19, 0xc1, 0x39, 0xa6, 0x6c, 0xc6, 0x5f, 0xac,
-	0xb0, 0x91, 0xec, 0x0f, 0xf8, 0xd2, 0xfb, 0x35, 0x52, 0x23, 0xfe, 0xbc, 0xf7, 0xc5, 0x67, 0xa7,
-	0x6a, 0x84, 0xd4, 0xea, 0x58, 0x56, 0x2d, 0x43, 0x56, 0x4d, 0x93, 0x38, 0xaa, 0x63, 0x10, 0x33,
-	0xc0, 0xe4, 0xf8, 0x2a, 0x1b, 0x69, 0xcd, 0xbf, 0x64, 0xc7, 0x68, 0x60, 0xea, 0xa8, 0x0d, 0xcb,
-	0x0f, 0x90, 0xe6, 0x61, 0xfa, 0x17, 0x8f, 0x7e, 0x9d, 0x50, 0x83, 0x01, 0x15, 0x77, 0xdd, 0x26,
-	0x2d, 0x43, 0xc7, 0xb6, 0x90, 0x85, 0x23, 0x16, 0xff, 0x4e, 0x83, 0x3c, 0x28, 0xa4, 0xca, 0xe1,
-	0x58, 0x7a, 0x36, 0x00, 0xf3, 0x71, 0xc0, 0x32, 0xa6, 0x16, 0x31, 0x29, 0x16, 0x2c, 0x38, 0xae,
-	0x11, 0x53, 0xc7, 0x7a, 0xc5, 0x0a, 0xa2, 0xd2, 0x20, 0x3f, 0x58, 0x18, 0x2d, 0x2d, 0xa1, 0xb8,
-	0x1d, 0x45, 0x2c, 0xab, 0xc2, 0x60, 0x41, 0xee, 0x20, 0xe1, 0x9a, 0xe9, 0xd8, 0xae, 0x92, 0xda,
-	0x3f, 0xca, 0x25, 0x6e, 0x9e, 0xed, 0x4d, 0x83, 0xf2, 0x98, 0xf6, 0x52, 0x1c, 0x15, 0x5c, 0x38,
-	0xd9, 0x34, 0xbd, 0x49, 0xc3, 0xac, 0x45, 0x48, 0x07, 0x18, 0xe9, 0x37, 0x5d, 0x48, 0x7f, 0x0f,
-	0x90, 0x5d, 0x79, 0x85, 0xe6, 0xf9, 0x50, 0x2a, 0xfc, 0x01, 0x87, 0x6d, 0xbc, 0xa5, 0xda, 0x3a,
-	0x4d, 0x0f, 0x32, 0xba, 0xb9, 0x2e, 0x74, 0x65, 0x3f, 0x3a, 0x96, 0x24, 0x48, 0x27, 0x7d, 0x0d,
-	0xc5, 0x0e, 0x9b, 0xd2, 0xeb, 0x49, 0xed, 0x02, 0xf8, 0xe9, 0xe5, 0xf0, 0xb7, 0x77, 0x5e, 0xd2,
-	0xb7, 0xf0, 0xa3, 0xce, 0x5b, 0xdf, 0xab, 0xba, 0x1b, 0x00, 0x7e, 0xd6, 0x35, 0x43, 0x28, 0x30,
-	0xa6, 0x3d, 0xc0, 0x9b, 0x6f, 0x0f, 0xe9, 0x0b, 0xf8, 0x41, 0xf4, 0xd4, 0x7b, 0x94, 0xb7, 0xcd,
-	0x8f, 0xfe, 0x02, 0x2a, 0x94, 0x14, 0x69, 0x3b, 0x70, 0xb5, 0x6d, 0x77, 0x08, 0xf8, 0x15, 0xbf,
-	0xe4, 0x70, 0x05, 0x11, 0x0e, 0x5b, 0x84, 0xd4, 0x2b, 0x86, 0xce, 0x6a, 0x7f, 0x47, 0x19, 0xf2,
-	0xb3, 0x24, 0xbd, 0xd9, 0x1f, 0x75, 0xe1, 0x67, 0x98, 0xa4, 0x1b, 0xaa, 0x8d, 0xbd, 0x3b, 0x08,
-	0x0a, 0x29, 0x65, 0xde, 0x23, 0x7a, 0x7c, 0x94, 0xe3, 0x26, 0x48, 0xf5, 0x4d, 0x64, 0x10, 0xb9,
-	0xa1, 0x3a, 0x1b, 0xe8, 0x27, 0x5c, 0x53, 0xab, 0xee, 0x2a, 0xae, 0x3e, 0xb8, 0x3d, 0x03, 0xb9,
-	0xf3, 0xad, 0xe2, 0x2a, 0xcf, 0xe7, 0x67, 0x11, 0x7e, 0x80, 0xa9, 0xd0, 0xc2, 0xd2, 0x83, 0x79,
-	0x50, 0x18, 0x2d, 0x65, 0x91, 0x6f, 0x72, 0x28, 0x30, 0x39, 0xf4, 0x5b, 0x10, 0xa1, 0xbc, 0xe7,
-	0xd1, 0xed, 0x1c, 0xe7, 0x80, 0x9f, 0xa5, 0x8d, 0x95, 0x9e, 0x03, 0xf8, 0x71, 0x0f, 0xc7, 0xda,
-	0x55, 0xa0, 0x0a, 0xc7, 0xdb, 0x2d, 0x75, 0x25, 0x52, 0xc7, 0xc2, 0x7c, 0xbf, 0xfa, 0x9a, 0x57,
-	0xe1, 0x08, 0x36, 0xf5, 0x8a, 0x57, 0x7b, 0xff, 0x92, 0x87, 0xb1, 0xa9, 0x7b, 0x8b, 0xd2, 0x1d,
-	0x00, 0x33, 0xb1, 0x0d, 0xd0, 0x55, 0xa6, 0x0b, 0x93, 0x6a, 0x83, 0x34, 0x4d, 0x87, 0x7b, 0x69,
-	0x06, 0xf1, 0xb2, 0xbd, 0x57, 0x0e, 0xf1, 0x57, 0x0e, 0xad, 0x10, 0xc3, 0x54, 0xbe, 0xf7, 0x0a,
-	0xb8, 0x75, 0x9c, 0x2b, 0xd4, 0x0c, 0x67, 0xa3, 0xa9, 0xa1, 0x2a, 0x69, 0xf0, 0x87, 0x8c, 0xff,
-	0x9b, 0xa1, 0xfa, 0xa6, 0xec, 0xb8, 0x16, 0xa6, 0x0c, 0x40, 0x77, 0xcf, 0xf6, 0xa6, 0xdf, 0xad,
-	0xb3, 0x2d, 0xa9, 0x78, 0xef, 0x24, 0xe5, 0xd4, 0x3e, 0x61, 0xe9, 0x7e, 0x12, 0x0e, 0xb1, 0xc2,
-	0x85, 0x7b, 0x00, 0x4e, 0x76, 0x32, 0x88, 0x52, 0x97, 0x96, 0xef, 0x80, 0xc9, 0x2e, 0xf5, 0x8f,
-	0x09, 0x76, 0x4c, 0x5a, 0xbe, 0xe6, 0x15, 0xf8, 0xff, 0xc3, 0xa7, 0xd7, 0x07, 0x66, 0x05, 0x24,
-	0xc7, 0xfe, 0x84, 0x08, 0x1d, 0x46, 0xfe, 0x37, 0xb8, 0xd1, 0xff, 0x09, 0x87, 0x00, 0x66, 0xe2,
-	0x9d, 0x7c, 0xa1, 0x2f, 0xa3, 0x8d, 0x0a, 0xfa, 0xee, 0x55, 0x91, 0xa1, 0x2c, 0xa5, 0x2d, 0xeb,
-	0x2b, 0xe1, 0xcb, 0x78, 0x59, 0xbe, 0x89, 0xcf, 0x74, 0x54, 0x77, 0x06, 0xe0, 0xd4, 0xa5, 0x66,
-	0xbe, 0xdc, 0xaf, 0xcb, 0x46, 0x35, 0xae, 0xbc, 0x06, 0x38, 0x94, 0xb9, 0xd6, 0x96, 0xb9, 0x24,
-	0x2c, 0xc4, 0xcb, 0x0c, 0xaf, 0x61, 0x67, 0xa5, 0x77, 0x01, 0x9c, 0xb8, 0x68, 0xe6, 0xb3, 0xbd,
-	0xb9, 0x6f, 0x44, 0xd3, 0x42, 0xbf, 0x88, 0x50, 0xc8, 0x62, 0x5b, 0x08, 0x12, 0x3e, 0x8f, 0x17,
-	0xc2, 0x0d, 0x3d, 0x52, 0xbc, 0xb2, 0xb8, 0x7f, 0x22, 0x82, 0x83, 0x13, 0x11, 0x3c, 0x39, 0x11,
-	0xc1, 0xce, 0xa9, 0x98, 0x38, 0x38, 0x15, 0x13, 0x8f, 0x4e, 0xc5, 0xc4, 0x9f, 0x39, 0x46, 0xee,
-	0x97, 0xf4, 0x8f, 0xbb, 0xed, 0xdf, 0xd3, 0x48, 0x42, 0x2d, 0xc9, 0x3c, 0x67, 0xee, 0x45, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x58, 0x1f, 0x68, 0xed, 0x3a, 0x0b, 0x00, 0x00,
+	// 1267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc4, 0x6d, 0x6c, 0x4f, 0x1a, 0xda, 0x4e, 0x23, 0xb0, 0x9d, 0xc8, 0x0e, 0x4b, 0x85,
+	0x42, 0xa9, 0x77, 0xeb, 0x24, 0xa4, 0x69, 0x52, 0x3e, 0xe2, 0xa6, 0xa0, 0x4a, 0x08, 0x05, 0x87,
+	0x4a, 0x88, 0x03, 0xd6, 0xd8, 0x3b, 0x71, 0x46, 0xb5, 0x77, 0x96, 0xfd, 0x48, 0x70, 0xab, 0x0a,
+	0x09, 0x2e, 0x5c, 0x90, 0x2a, 0x71, 0x41, 0xfd, 0x03, 0x10, 0xe2, 0x54, 0x09, 0x84, 0x04, 0xe2,
+	0xc4, 0x01, 0xf5, 0x58, 0x01, 0x07, 0x44, 0xa5, 0x16, 0x25, 0x48, 0x11, 0x17, 0xfe, 0x06, 0xb4,
+	0xb3, 0xb3, 0x1f, 0x71, 0x76, 0xb3, 0x76, 0x48, 0xc5, 0xa5, 0xf5, 0xec, 0xfb, 0xfc, 0xcd, 0x7b,
+	0xf3, 0xde, 0x4f, 0x81, 0x67, 0x35, 0xd6, 0x68, 0x13, 0xc5, 0xdc, 0xc2, 0xba, 0x62, 0x5a, 0xb8,
+	0xd1, 0x26, 0xfc, 0xe7, 0x66, 0x45, 0xf9, 0xc0, 0x26, 0x46, 0x57, 0xd6, 0x0d, 0x66, 0x31, 0x94,
+	0xe3, 0x5a, 0xb2, 0x23, 0x92, 0x03, 0x2d, 0x79, 0xb3, 0x52, 0x38, 0x8d, 0x3b, 0x54, 0x63, 0x0a,
+	0xff, 0xd7, 0x55, 0x2e, 0x14, 0x9b, 0xcc, 0xec, 0x30, 0x53, 0x69, 0x60, 0x93, 0x28, 0x9b, 0x95,
+	0x06, 0xb1, 0x70, 0x45, 0x69, 0x32, 0xaa, 0x09, 0xf9, 0x84, 0x90, 0xf3, 0x00, 0x3d, 0x91, 0x0a,
+	0x79, 0x57, 0x58, 0xe7, 0x27, 0xc5, 0x3d, 0x08, 0xd1, 0x78, 0x8b, 0xb5, 0x98, 0xfb, 0xdd, 0xf9,
+	0x25, 0xbe, 0x4e, 0xb6, 0x18, 0x6b, 0xb5, 0x89, 0x82, 0x75, 0xaa, 0x60, 0x4d, 0x63, 0x16, 0xb6,
+	0x28, 0xd3, 0x3c, 0x9b, 0xa2, 0x90, 0xf2, 0x53, 0xc3, 0x5e, 0x57, 0x54, 0xdb, 0xe0, 0x0a, 0x42,
+	0x5e, 0xea, 0x95, 0x5b, 0xb4, 0x43, 0x4c, 0x0b, 0x77, 0x74, 0xa1, 0xa0, 0xc4, 0xde, 0x8f, 0xd9,
+	0xdc, 0x20, 0xaa, 0xdd, 0x26, 0x6a, 0xdd, 0xd6, 0x55, 0x6c, 0x11, 0xd7, 0x40, 0x9a, 0x87, 0xb9,
+	0xb7, 0x1d, 0x3c, 0xab, 0xcc, 0xa4, 0x3c, 0x93, 0x6a, 0x77, 0xd5, 0x60, 0x9b, 0x54, 0x25, 0x06,
+	0x2a, 0xc0, 0x8c, 0x2e, 0x7e, 0xe7, 0xc0, 0x14, 0x98, 0xce, 0xd6, 0xfc, 0xb3, 0xf4, 0xf7, 0x30,
+	0x9c, 0x8a, 0x33, 0xac, 0x11, 0x53, 0x67, 0x9a, 0x49, 0x90, 0x0e, 0x4f, 0x35, 0x98, 0xa6, 0x12,
+	0xb5, 0xae, 0x7b, 0x5a, 0x39, 0x30, 0x95, 0x9a, 0x1e, 0x9d, 0x59, 0x94, 0xe3, 0x4a, 0x24, 0x73,
+	0xaf, 0x55, 0x6e, 0xe6, 0xf9, 0xf6, 0x1c, 0x5e, 0xd5, 0x2c, 0xa3, 0x5b, 0xcd, 0xde, 0x7f, 0x54,
+	0x1a, 0xfa, 0x6a, 0xf7, 0xde, 0x39, 0x50, 0x3b, 0xd9, 0xd8, 0xa3, 0x67, 0xa2, 0x2e, 0x3c, 0x63,
+	0x6b, 0xce, 0x47, 0xaa, 0xb5, 0x42, 0x41, 0x87, 0x79, 0xd0, 0x97, 0x13, 0x82, 0x5e, 0xf7, 0x2c,
+	0x13, 0xe3, 0x22, 0xbb, 0x57, 0xd5, 0x44, 0xef, 0xc2, 0xb4, 0x41, 0xb6, 0xb0, 0xa1, 0x9a, 0xb9,
+	0x14, 0x0f, 0x37, 0x9b, 0x10, 0xae, 0xe6, 0x6a, 0xc7, 0x06, 0xf1, 0xdc, 0x49, 0x97, 0x61, 0x31,
+	0xe2, 0x52, 0xfa, 0xad, 0xd4, 0x5d, 0x00, 0x9f, 0x3f, 0xd8, 0xfc, 0xff, 0xab, 0x97, 0xf4, 0x2a,
+	0x7c, 0x36, 0xfa, 0xea, 0xfb, 0x45, 0xf7, 0x25, 0x80, 0x2f, 0x24, 0x7a, 0xf0, 0x01, 0xc6, 0xb4,
+	0x07, 0x78, 0xf2, 0xed, 0x21, 0xcd, 0xc1, 0xa7, 0xc3, 0x55, 0xef, 0x13, 0xde, 0x4d, 0x51, 0xfa,
+	0x7d, 0x56, 0x3e, 0xa4, 0x50, 0xdb, 0x81, 0xa3, 0x6d, 0xbb, 0x8b, 0x30, 0xcf, 0x0d, 0xae, 0x69,
+	0x4d, 0xa2, 0x59, 0x74, 0x93, 0xf4, 0x9b, 0xf4, 0x27, 0x40, 0x54, 0x35, 0xca, 0xd2, 0x4f, 0xfc,
+	0x7d, 0x08, 0xa9, 0x2f, 0x3f, 0xa2, 0xdc, 0x43, 0x1e, 0xa5, 0x8f, 0xe0, 0x78, 0x4f, 0xd9, 0x88,
+	0x41, 0x99, 0x8a, 0x9e, 0x81, 0x69, 0x9d, 0xb1, 0x76, 0x9d, 0xaa, 0x3c, 0xf1, 0x63, 0xb5, 0x11,
+	0xe7, 0x78, 0x4d, 0x45, 0x6f, 0xc1, 0x11, 0x73, 0x03, 0x1b, 0xc4, 0x19, 0x17, 0x60, 0x3a, 0x5b,
+	0x9d, 0x77, 0xfc, 0xfe, 0xf1, 0xa8, 0x24, 0x16, 0x80, 0xa9, 0xde, 0x90, 0x29, 0x53, 0x3a, 0xd8,
+	0xda, 0x90, 0xdf, 0x24, 0x2d, 0xdc, 0xec, 0xae, 0x90, 0xe6, 0x2f, 0xdf, 0x96, 0xa1, 0x98, 0xfa,
+	0x2b, 0xa4, 0xe9, 0x26, 0x21, 0xbc, 0x48, 0xdf, 0x03, 0x38, 0x19, 0x95, 0x81, 0x7f, 0x03, 0x6b,
+	0xf0, 0x54, 0xa8, 0x1b, 0xb9, 0x8c, 0xa7, 0x34, 0x3a, 0x93, 0x97, 0xdd, 0x41, 0x2f, 0x7b, 0x83,
+	0x5e, 0x5e, 0x11, 0x8b, 0xa0, 0x3a, 0xe6, 0x64, 0xf5, 0xc5, 0xe3, 0x12, 0x10, 0x2f, 0xca, 0xee,
+	0x81, 0xb7, 0x02, 0x33, 0x44, 0x53, 0xeb, 0xce, 0x62, 0xe0, 0x38, 0x46, 0x67, 0x0a, 0xfb, 0x9c,
+	0xbd, 0xe3, 0x6d, 0x0d, 0xd7, 0xdb, 0x1d, 0xdf, 0x5b, 0x9a, 0x68, 0xaa, 0x23, 0x94, 0x1e, 0x02,
+	0x31, 0xde, 0x0f, 0x78, 0xd8, 0xa8, 0xd8, 0x73, 0x93, 0xd5, 0xe3, 0xe2, 0x02, 0x9e, 0xcc, 0x85,
+	0xa2, 0x37, 0x60, 0xd6, 0xdf, 0x77, 0xb9, 0xd4, 0xa0, 0xd8, 0x02, 0x5b, 0xe9, 0x1f, 0x00, 0x9f,
+	0xeb, 0xe3, 0x49, 0x27, 0x02, 0xc4, 0xe1, 0x02, 0x1e, 0x09, 0xd4, 0xa0, 0x9c, 0x6b, 0x2e, 0xe6,
+	0x70, 0x39, 0x53, 0x87, 0x2e, 0xe7, 0x77, 0x40, 0xbc, 0xe5, 0xa8, 0x07, 0x94, 0x08, 0xb3, 0x0b,
+	0x47, 0x70, 0x87, 0xd9, 0x9a, 0x25, 0xf6, 0x68, 0x5e, 0x16, 0x69, 0x3b, 0x94, 0x49, 0x16, 0x94,
+	0x49, 0xbe, 0xc2, 0xa8, 0x56, 0x7d, 0xdd, 0x49, 0xe0, 0xeb, 0xc7, 0xa5, 0xe9, 0x16, 0xb5, 0x36,
+	0xec, 0x86, 0xdc, 0x64, 0x1d, 0xc1, 0x8a, 0xc4, 0x7f, 0x65, 0x53, 0xbd, 0xa1, 0x58, 0x5d, 0x9d,
+	0x98, 0xdc, 0xc0, 0xbc, 0xbb, 0x7b, 0xef, 0xdc, 0x89, 0x36, 0xbf, 0x92, 0xba, 0x43, 0xba, 0x4c,
+	0x11, 0xda, 0x0d, 0x28, 0x4d, 0x88, 0xbc, 0xd7, 0x3c, 0xf6, 0xb2, 0xca, 0x58, 0xfb, 0x3a, 0x27,
+	0x30, 0xa6, 0xb4, 0x25, 0xc6, 0x4c, 0x94, 0xd0, 0x7f, 0x64, 0x35, 0x98, 0x76, 0x09, 0x8f, 0x37,
+	0x63, 0xca, 0xf1, 0x33, 0x26, 0xc2, 0xd1, 0x9e, 0xc9, 0x28, 0x1c, 0x49, 0x65, 0x88, 0x78, 0xe0,
+	0xe5, 0x8e, 0xde, 0xa6, 0xeb, 0xb4, 0xc9, 0x5f, 0x66, 0xec, 0x60, 0x91, 0x3e, 0x1b, 0x86, 0x85,
+	0xfd, 0xfa, 0xa1, 0x31, 0x90, 0x6d, 0xda, 0x86, 0x41, 0x34, 0xab, 0x8e, 0xdd, 0x59, 0x7a, 0xe8,
+	0xf6, 0xc9, 0x08, 0x47, 0xcb, 0x68, 0x02, 0x66, 0xa9, 0x46, 0x2d, 0x8a, 0xdb, 0x75, 0xcc, 0x7b,
+	0x32, 0x55, 0xcb, 0x88, 0x0f, 0xcb, 0x28, 0x0f, 0x33, 0xeb, 0xb6, 0x65, 0x1b, 0xa4, 0x8e, 0x79,
+	0x53, 0xa5, 0x6a, 0x69, 0xf7, 0xbc, 0x8c, 0xce, 0xc2, 0xa7, 0x7c, 0x3b, 0xb7, 0xeb, 0x8e, 0x71,
+	0x85, 0x13, 0x9e, 0xb1, 0xd3, 0x4f, 0x48, 0x82, 0x63, 0x9e, 0x03, 0x57, 0xe9, 0x38, 0x57, 0x1a,
+	0x15, 0x5e, 0xb8, 0x4e, 0x0e, 0xa6, 0x0d, 0xdc, 0xd1, 0xa9, 0xd6, 0xca, 0x8d, 0x4c, 0x81, 0xe9,
+	0x4c, 0xcd, 0x3b, 0xce, 0xfc, 0x76, 0x02, 0x1e, 0xe7, 0xf7, 0x81, 0x7e, 0x02, 0xf0, 0x4c, 0xd4,
+	0xc6, 0x9f, 0x49, 0xd8, 0x03, 0x11, 0x36, 0x85, 0xc5, 0xc1, 0x6d, 0xbc, 0x12, 0x48, 0x4b, 0x9f,
+	0x3a, 0xd7, 0xf7, 0xf1, 0xaf, 0x7f, 0x7d, 0x3e, 0x7c, 0x01, 0xc9, 0xf1, 0x24, 0xda, 0xa7, 0x0c,
+	0xca, 0x2d, 0x6f, 0xdb, 0xdd, 0x46, 0x0f, 0x01, 0xcc, 0xc7, 0x53, 0xb3, 0x85, 0x81, 0x98, 0x53,
+	0x18, 0xd0, 0x6b, 0x87, 0xb5, 0xf4, 0x61, 0x55, 0x03, 0x58, 0x17, 0xd1, 0x4b, 0xf1, 0xb0, 0x5c,
+	0x56, 0x56, 0x8e, 0x44, 0xb7, 0x0b, 0xe0, 0xe4, 0x81, 0xec, 0x6c, 0x69, 0x50, 0xda, 0x14, 0xc6,
+	0x78, 0xe5, 0x3f, 0x18, 0xfb, 0x30, 0xaf, 0x06, 0x30, 0x17, 0xd1, 0x42, 0x3c, 0x4c, 0x7f, 0xb6,
+	0x46, 0x23, 0xfd, 0x01, 0xc0, 0xd3, 0xfb, 0xd9, 0xd9, 0x85, 0xfe, 0x28, 0x49, 0x08, 0xd3, 0xc2,
+	0xa0, 0x16, 0x3e, 0x90, 0x4b, 0x01, 0x10, 0x19, 0x9d, 0x8f, 0x07, 0x22, 0x18, 0x5a, 0x38, 0xf9,
+	0x9f, 0x01, 0x1c, 0x8f, 0x24, 0x6a, 0x49, 0x94, 0x2a, 0xca, 0xa8, 0xb0, 0x74, 0x08, 0x23, 0x1f,
+	0xc5, 0xe5, 0x00, 0x45, 0x05, 0x29, 0xf1, 0x28, 0x02, 0xae, 0x16, 0x06, 0xf2, 0x23, 0x80, 0x27,
+	0x7b, 0x29, 0x9b, 0xdc, 0x77, 0x97, 0x70, 0xfd, 0xc2, 0xfc, 0x60, 0xfa, 0x7e, 0xe6, 0xcb, 0x41,
+	0xe6, 0xf3, 0x68, 0xae, 0xaf, 0x46, 0xe2, 0x0e, 0x94, 0x5b, 0x62, 0xf0, 0xdf, 0x76, 0x26, 0xda,
+	0x78, 0xd4, 0x3e, 0x4a, 0xac, 0x43, 0x94, 0x51, 0x62, 0x1d, 0x0e, 0xda, 0x7c, 0xd2, 0x42, 0x80,
+	0xa6, 0x8c, 0x5e, 0xec, 0xe3, 0x2f, 0x03, 0x65, 0xb1, 0xdf, 0xd0, 0x37, 0x00, 0x8e, 0xed, 0xdd,
+	0x6d, 0xe7, 0x13, 0x12, 0xd9, 0xa3, 0x5d, 0x98, 0x1b, 0x44, 0xdb, 0xcf, 0xf7, 0x95, 0x20, 0xdf,
+	0x59, 0x54, 0x89, 0xcf, 0x17, 0x87, 0xad, 0x83, 0xab, 0xaf, 0x5e, 0xba, 0xbf, 0x5d, 0x04, 0x0f,
+	0xb6, 0x8b, 0xe0, 0xcf, 0xed, 0x22, 0xb8, 0xb3, 0x53, 0x1c, 0x7a, 0xb0, 0x53, 0x1c, 0xfa, 0x7d,
+	0xa7, 0x38, 0xf4, 0x5e, 0x89, 0x47, 0x77, 0x73, 0xfa, 0xb0, 0x7b, 0xd3, 0xe5, 0x1f, 0x21, 0xaf,
+	0x8d, 0x11, 0xce, 0xa5, 0x66, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x05, 0xbc, 0x5f,
+	0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -667,6 +1094,14 @@ type QueryClient interface {
 	UnbondingPositionsByProvider(ctx context.Context, in *QueryUnbondingPositionsByProvider, opts ...grpc.CallOption) (*QueryUnbondingPositionsByProviderResponse, error)
 	// Retrieves all the rewards by a specific provider.
 	RewardsByProvider(ctx context.Context, in *QueryRewardsByProvider, opts ...grpc.CallOption) (*QueryRewardsByProviderResponse, error)
+	// Retrieves all the incentives accrued by a specific provider.
+	IncentivesByProvider(ctx context.Context, in *QueryIncentivesByProvider, opts ...grpc.CallOption) (*QueryIncentivesByProviderResponse, error)
+	// Retrieves the expected unbonding period for removing a given amount of shares from a pool.
+	UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriod, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error)
+	// Retrieves the pending scheduled pool updates.
+	ScheduledPoolUpdates(ctx context.Context, in *QueryScheduledPoolUpdates, opts ...grpc.CallOption) (*QueryScheduledPoolUpdatesResponse, error)
+	// Retrieves the current effective amplification coefficient of a pool, along with its ramp schedule.
+	Amplification(ctx context.Context, in *QueryAmplification, opts ...grpc.CallOption) (*QueryAmplificationResponse, error)
 }
 
 type queryClient struct {
@@ -713,23 +1148,67 @@ func (c *queryClient) RewardsByProvider(ctx context.Context, in *QueryRewardsByP
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Retrieves all the positions by a specific provider, including bonded/unbonded positions and rewards.
-	PositionsByProvider(context.Context, *QueryPositionsByProvider) (*QueryPositionsByProviderResponse, error)
-	// Retrieves all the bonded positions by a specific provider.
-	BondedPositionsByProvider(context.Context, *QueryBondedPositionsByProvider) (*QueryBondedPositionsByProviderResponse, error)
-	// Retrieves all the unbonding positions by a specific provider.
-	UnbondingPositionsByProvider(context.Context, *QueryUnbondingPositionsByProvider) (*QueryUnbondingPositionsByProviderResponse, error)
-	// Retrieves all the rewards by a specific provider.
-	RewardsByProvider(context.Context, *QueryRewardsByProvider) (*QueryRewardsByProviderResponse, error)
+func (c *queryClient) IncentivesByProvider(ctx context.Context, in *QueryIncentivesByProvider, opts ...grpc.CallOption) (*QueryIncentivesByProviderResponse, error) {
+	out := new(QueryIncentivesByProviderResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Query/IncentivesByProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) UnbondingPeriod(ctx context.Context, in *QueryUnbondingPeriod, opts ...grpc.CallOption) (*QueryUnbondingPeriodResponse, error) {
+	out := new(QueryUnbondingPeriodResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Query/UnbondingPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedQueryServer) PositionsByProvider(ctx context.Context, req *QueryPositionsByProvider) (*QueryPositionsByProviderResponse, error) {
+func (c *queryClient) ScheduledPoolUpdates(ctx context.Context, in *QueryScheduledPoolUpdates, opts ...grpc.CallOption) (*QueryScheduledPoolUpdatesResponse, error) {
+	out := new(QueryScheduledPoolUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Query/ScheduledPoolUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Amplification(ctx context.Context, in *QueryAmplification, opts ...grpc.CallOption) (*QueryAmplificationResponse, error) {
+	out := new(QueryAmplificationResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Query/Amplification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Retrieves all the positions by a specific provider, including bonded/unbonded positions and rewards.
+	PositionsByProvider(context.Context, *QueryPositionsByProvider) (*QueryPositionsByProviderResponse, error)
+	// Retrieves all the bonded positions by a specific provider.
+	BondedPositionsByProvider(context.Context, *QueryBondedPositionsByProvider) (*QueryBondedPositionsByProviderResponse, error)
+	// Retrieves all the unbonding positions by a specific provider.
+	UnbondingPositionsByProvider(context.Context, *QueryUnbondingPositionsByProvider) (*QueryUnbondingPositionsByProviderResponse, error)
+	// Retrieves all the rewards by a specific provider.
+	RewardsByProvider(context.Context, *QueryRewardsByProvider) (*QueryRewardsByProviderResponse, error)
+	// Retrieves all the incentives accrued by a specific provider.
+	IncentivesByProvider(context.Context, *QueryIncentivesByProvider) (*QueryIncentivesByProviderResponse, error)
+	// Retrieves the expected unbonding period for removing a given amount of shares from a pool.
+	UnbondingPeriod(context.Context, *QueryUnbondingPeriod) (*QueryUnbondingPeriodResponse, error)
+	// Retrieves the pending scheduled pool updates.
+	ScheduledPoolUpdates(context.Context, *QueryScheduledPoolUpdates) (*QueryScheduledPoolUpdatesResponse, error)
+	// Retrieves the current effective amplification coefficient of a pool, along with its ramp schedule.
+	Amplification(context.Context, *QueryAmplification) (*QueryAmplificationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PositionsByProvider(ctx context.Context, req *QueryPositionsByProvider) (*QueryPositionsByProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PositionsByProvider not implemented")
 }
 func (*UnimplementedQueryServer) BondedPositionsByProvider(ctx context.Context, req *QueryBondedPositionsByProvider) (*QueryBondedPositionsByProviderResponse, error) {
@@ -741,6 +1220,18 @@ func (*UnimplementedQueryServer) UnbondingPositionsByProvider(ctx context.Contex
 func (*UnimplementedQueryServer) RewardsByProvider(ctx context.Context, req *QueryRewardsByProvider) (*QueryRewardsByProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsByProvider not implemented")
 }
+func (*UnimplementedQueryServer) IncentivesByProvider(ctx context.Context, req *QueryIncentivesByProvider) (*QueryIncentivesByProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivesByProvider not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingPeriod(ctx context.Context, req *QueryUnbondingPeriod) (*QueryUnbondingPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingPeriod not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledPoolUpdates(ctx context.Context, req *QueryScheduledPoolUpdates) (*QueryScheduledPoolUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledPoolUpdates not implemented")
+}
+func (*UnimplementedQueryServer) Amplification(ctx context.Context, req *QueryAmplification) (*QueryAmplificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Amplification not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -818,6 +1309,78 @@ func _Query_RewardsByProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IncentivesByProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivesByProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivesByProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Query/IncentivesByProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivesByProvider(ctx, req.(*QueryIncentivesByProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Query/UnbondingPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingPeriod(ctx, req.(*QueryUnbondingPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledPoolUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledPoolUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledPoolUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Query/ScheduledPoolUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledPoolUpdates(ctx, req.(*QueryScheduledPoolUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Amplification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmplification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Amplification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Query/Amplification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Amplification(ctx, req.(*QueryAmplification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "noble.swap.stableswap.v1.Query",
@@ -839,6 +1402,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsByProvider",
 			Handler:    _Query_RewardsByProvider_Handler,
 		},
+		{
+			MethodName: "IncentivesByProvider",
+			Handler:    _Query_IncentivesByProvider_Handler,
+		},
+		{
+			MethodName: "UnbondingPeriod",
+			Handler:    _Query_UnbondingPeriod_Handler,
+		},
+		{
+			MethodName: "ScheduledPoolUpdates",
+			Handler:    _Query_ScheduledPoolUpdates_Handler,
+		},
+		{
+			MethodName: "Amplification",
+			Handler:    _Query_Amplification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/swap/stableswap/v1/query.proto",
@@ -1140,7 +1719,7 @@ func (m *QueryRewardsByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBondedPositionResponseEntry) Marshal() (dAtA []byte, err error) {
+func (m *QueryIncentivesByProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1150,23 +1729,167 @@ func (m *QueryBondedPositionResponseEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBondedPositionResponseEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIncentivesByProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBondedPositionResponseEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIncentivesByProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIncentivesByProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentivesByProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentivesByProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for iNdEx := len(m.Incentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Incentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintQuery(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBondedPositionResponseEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBondedPositionResponseEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBondedPositionResponseEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size := m.Shares.Size()
@@ -1206,12 +1929,12 @@ func (m *QueryUnbondingPositionResponseEntry) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -1274,32 +1997,183 @@ func (m *QueryRewardsResponseEntry) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduledPoolUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPositionsByProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryScheduledPoolUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPositionsByProviderResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryScheduledPoolUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledPoolUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledPoolUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledPoolUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmplification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmplification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmplification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmplificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmplificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmplificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ramping {
+		i--
+		if m.Ramping {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InitialATime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitialATime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.CurrentA.Size()
+		i -= size
+		if _, err := m.CurrentA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPositionsByProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsByProviderResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1409,6 +2283,61 @@ func (m *QueryRewardsByProviderResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryIncentivesByProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivesByProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryBondedPositionResponseEntry) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1459,13 +2388,973 @@ func (m *QueryRewardsResponseEntry) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduledPoolUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduledPoolUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAmplification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryAmplificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrentA.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InitialA != 0 {
+		n += 1 + sovQuery(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovQuery(uint64(m.FutureA))
+	}
+	if m.InitialATime != 0 {
+		n += 1 + sovQuery(uint64(m.InitialATime))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovQuery(uint64(m.FutureATime))
+	}
+	if m.Ramping {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryPositionsByProvider) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsByProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsByProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedPositions = append(m.BondedPositions, QueryBondedPositionResponseEntry{})
+			if err := m.BondedPositions[len(m.BondedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingPositions = append(m.UnbondingPositions, QueryUnbondingPositionResponseEntry{})
+			if err := m.UnbondingPositions[len(m.UnbondingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, QueryRewardsResponseEntry{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondedPositionsByProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondedPositionsByProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondedPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBondedPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBondedPositionsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBondedPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedPositions = append(m.BondedPositions, QueryBondedPositionResponseEntry{})
+			if err := m.BondedPositions[len(m.BondedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingPositionsByProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPositionsByProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingPositionsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingPositions = append(m.UnbondingPositions, QueryUnbondingPositionResponseEntry{})
+			if err := m.UnbondingPositions[len(m.UnbondingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, QueryRewardsResponseEntry{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesByProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesByProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesByProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesByProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, QueryRewardsResponseEntry{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,15 +3377,34 @@ func (m *QueryPositionsByProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsByProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1524,7 +3432,9 @@ func (m *QueryPositionsByProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1547,7 +3457,7 @@ func (m *QueryPositionsByProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingPeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1570,15 +3480,15 @@ func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionsByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedPositions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1605,48 +3515,13 @@ func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondedPositions = append(m.BondedPositions, QueryBondedPositionResponseEntry{})
-			if err := m.BondedPositions[len(m.BondedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPositions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnbondingPositions = append(m.UnbondingPositions, QueryUnbondingPositionResponseEntry{})
-			if err := m.UnbondingPositions[len(m.UnbondingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1673,8 +3548,7 @@ func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, QueryRewardsResponseEntry{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1699,7 +3573,7 @@ func (m *QueryPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBondedPositionsByProvider) Unmarshal(dAtA []byte) error {
+func (m *QueryBondedPositionResponseEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1722,15 +3596,34 @@ func (m *QueryBondedPositionsByProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBondedPositionsByProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBondedPositionResponseEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBondedPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBondedPositionResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1758,61 +3651,13 @@ func (m *QueryBondedPositionsByProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBondedPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBondedPositionsByProviderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBondedPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedPositions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1839,8 +3684,7 @@ func (m *QueryBondedPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondedPositions = append(m.BondedPositions, QueryBondedPositionResponseEntry{})
-			if err := m.BondedPositions[len(m.BondedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1865,7 +3709,7 @@ func (m *QueryBondedPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingPositionsByProvider) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingPositionResponseEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1888,15 +3732,34 @@ func (m *QueryUnbondingPositionsByProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPositionsByProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingPositionResponseEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPositionsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingPositionResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1924,7 +3787,42 @@ func (m *QueryUnbondingPositionsByProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			if err := m.UnbondingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1947,7 +3845,7 @@ func (m *QueryUnbondingPositionsByProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingPositionsByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsResponseEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,15 +3868,34 @@ func (m *QueryUnbondingPositionsByProviderResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPositionsByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsResponseEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPositionsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPositions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2005,8 +3922,8 @@ func (m *QueryUnbondingPositionsByProviderResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingPositions = append(m.UnbondingPositions, QueryUnbondingPositionResponseEntry{})
-			if err := m.UnbondingPositions[len(m.UnbondingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2031,7 +3948,7 @@ func (m *QueryUnbondingPositionsByProviderResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryRewardsByProvider) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledPoolUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,44 +3971,12 @@ func (m *QueryRewardsByProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledPoolUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledPoolUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2113,7 +3998,7 @@ func (m *QueryRewardsByProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledPoolUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,15 +4021,15 @@ func (m *QueryRewardsByProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledPoolUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledPoolUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2171,8 +4056,8 @@ func (m *QueryRewardsByProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, QueryRewardsResponseEntry{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, ScheduledPoolUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2197,7 +4082,7 @@ func (m *QueryRewardsByProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBondedPositionResponseEntry) Unmarshal(dAtA []byte) error {
+func (m *QueryAmplification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,10 +4105,10 @@ func (m *QueryBondedPositionResponseEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBondedPositionResponseEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmplification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBondedPositionResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmplification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2245,73 +4130,6 @@ func (m *QueryBondedPositionResponseEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2333,7 +4151,7 @@ func (m *QueryBondedPositionResponseEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingPositionResponseEntry) Unmarshal(dAtA []byte) error {
+func (m *QueryAmplificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,34 +4174,15 @@ func (m *QueryUnbondingPositionResponseEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingPositionResponseEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmplificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingPositionResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmplificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2411,15 +4210,15 @@ func (m *QueryUnbondingPositionResponseEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
 			}
-			var msglen int
+			m.InitialA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2429,80 +4228,16 @@ func (m *QueryUnbondingPositionResponseEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InitialA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRewardsResponseEntry) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsResponseEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
 			}
-			m.PoolId = 0
+			m.FutureA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2512,16 +4247,16 @@ func (m *QueryRewardsResponseEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.FutureA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialATime", wireType)
 			}
-			var msglen int
+			m.InitialATime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2531,26 +4266,50 @@ func (m *QueryRewardsResponseEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InitialATime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ramping", wireType)
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Ramping = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
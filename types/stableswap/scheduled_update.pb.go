@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/swap/stableswap/v1/scheduled_update.proto
+
+package stableswap
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledPoolUpdate struct {
+	// Unique identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Timestamp from which the update is executed.
+	ExecutionTime int64 `protobuf:"varint,2,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Pool update to execute, signed by the account that scheduled it.
+	Update MsgUpdatePool `protobuf:"bytes,3,opt,name=update,proto3" json:"update"`
+}
+
+func (m *ScheduledPoolUpdate) Reset()         { *m = ScheduledPoolUpdate{} }
+func (m *ScheduledPoolUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledPoolUpdate) ProtoMessage()    {}
+func (*ScheduledPoolUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a88174a9fbeb525, []int{0}
+}
+func (m *ScheduledPoolUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledPoolUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledPoolUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledPoolUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledPoolUpdate.Merge(m, src)
+}
+func (m *ScheduledPoolUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledPoolUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledPoolUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledPoolUpdate proto.InternalMessageInfo
+
+func (m *ScheduledPoolUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledPoolUpdate) GetExecutionTime() int64 {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
+func (m *ScheduledPoolUpdate) GetUpdate() MsgUpdatePool {
+	if m != nil {
+		return m.Update
+	}
+	return MsgUpdatePool{}
+}
+
+func init() {
+	proto.RegisterType((*ScheduledPoolUpdate)(nil), "noble.swap.stableswap.v1.ScheduledPoolUpdate")
+}
+
+func init() {
+	proto.RegisterFile("noble/swap/stableswap/v1/scheduled_update.proto", fileDescriptor_4a88174a9fbeb525)
+}
+
+var fileDescriptor_4a88174a9fbeb525 = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xcb, 0x4f, 0xca,
+	0x49, 0xd5, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x2e, 0x49, 0x4c, 0xca, 0x49, 0x05, 0x33, 0xcb,
+	0x0c, 0xf5, 0x8b, 0x93, 0x33, 0x52, 0x53, 0x4a, 0x73, 0x52, 0x53, 0xe2, 0x4b, 0x0b, 0x52, 0x12,
+	0x4b, 0x52, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0xc0, 0x1a, 0xf4, 0x40, 0xaa, 0xf4,
+	0x10, 0x1a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x8a, 0xf4, 0x41, 0x2c,
+	0x88, 0x7a, 0x29, 0x45, 0x9c, 0x16, 0x94, 0x54, 0x40, 0x94, 0x28, 0x4d, 0x66, 0xe4, 0x12, 0x0e,
+	0x86, 0xd9, 0x16, 0x90, 0x9f, 0x9f, 0x13, 0x0a, 0xb6, 0x50, 0x88, 0x8f, 0x8b, 0x29, 0x33, 0x45,
+	0x82, 0x51, 0x81, 0x51, 0x83, 0x25, 0x88, 0x29, 0x33, 0x45, 0x48, 0x95, 0x8b, 0x2f, 0xb5, 0x22,
+	0x35, 0xb9, 0xb4, 0x24, 0x33, 0x3f, 0x2f, 0xbe, 0x24, 0x33, 0x37, 0x55, 0x82, 0x49, 0x81, 0x51,
+	0x83, 0x39, 0x88, 0x17, 0x2e, 0x1a, 0x92, 0x99, 0x9b, 0x2a, 0xe4, 0xca, 0xc5, 0x06, 0x71, 0xb1,
+	0x04, 0xb3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xba, 0x1e, 0x2e, 0x27, 0xeb, 0xf9, 0x16, 0xa7, 0x43,
+	0xec, 0x02, 0xd9, 0xea, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb3, 0x93, 0xe5, 0x89,
+	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
+	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0x83, 0xb5, 0x43, 0x0c, 0xad, 0xa8,
+	0xac, 0xd2, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x46, 0xf2, 0x5c, 0x12, 0x1b, 0xd8, 0x5f, 0xc6, 0x80,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x23, 0x8e, 0xf7, 0x5d, 0x01, 0x00, 0x00,
+}
+
+func (m *ScheduledPoolUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledPoolUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScheduledUpdate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExecutionTime != 0 {
+		i = encodeVarintScheduledUpdate(dAtA, i, uint64(m.ExecutionTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduledUpdate(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledUpdate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledUpdate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledPoolUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduledUpdate(uint64(m.Id))
+	}
+	if m.ExecutionTime != 0 {
+		n += 1 + sovScheduledUpdate(uint64(m.ExecutionTime))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovScheduledUpdate(uint64(l))
+	return n
+}
+
+func sovScheduledUpdate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledUpdate(x uint64) (n int) {
+	return sovScheduledUpdate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledPoolUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledUpdate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledPoolUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledPoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			m.ExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledUpdate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledUpdate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledUpdate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledUpdate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledUpdate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledUpdate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledUpdate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledUpdate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledUpdate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledUpdate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledUpdate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledUpdate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledUpdate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledUpdate = fmt.Errorf("proto: unexpected end of group")
+)
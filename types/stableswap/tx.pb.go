@@ -15,18 +15,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -51,6 +55,18 @@ type MsgCreatePool struct {
 	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 	// The coins rate multipliers.
 	RateMultipliers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate_multipliers"`
+	// The unbonding tiers of the pool, the default schedule is used when empty.
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,9,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// The exit fee tiers of the pool for instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []ExitFeeTier `protobuf:"bytes,10,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []LockTier `protobuf:"bytes,11,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers"`
+	// Recipients of the protocol fees.
+	FeeSplits []FeeSplit `protobuf:"bytes,12,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+	// Dynamic rewards fee model, disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,13,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,14,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -131,14 +147,26 @@ type MsgUpdatePool struct {
 	ProtocolFeePercentage int64 `protobuf:"varint,3,opt,name=protocol_fee_percentage,json=protocolFeePercentage,proto3" json:"protocol_fee_percentage,omitempty"`
 	// The new rewards fee value.
 	RewardsFee int64 `protobuf:"varint,4,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
-	// The new initial A parameter.
+	// The current initial A parameter, which can only be updated through MsgRampA.
 	InitialA int64 `protobuf:"varint,5,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
-	// The new future A parameter.
+	// The current future A parameter, which can only be updated through MsgRampA.
 	FutureA int64 `protobuf:"varint,6,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
-	// The time to reach the future A parameter.
+	// The current time to reach the future A parameter, which can only be updated through MsgRampA.
 	FutureATime int64 `protobuf:"varint,7,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 	// The coins rate multipliers.
 	RateMultipliers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=rate_multipliers,json=rateMultipliers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rate_multipliers"`
+	// The unbonding tiers of the pool, the default schedule is used when empty.
+	UnbondingTiers []UnbondingTier `protobuf:"bytes,9,rep,name=unbonding_tiers,json=unbondingTiers,proto3" json:"unbonding_tiers"`
+	// The exit fee tiers of the pool for instant liquidity removals, which are disabled when empty.
+	ExitFeeTiers []ExitFeeTier `protobuf:"bytes,10,rep,name=exit_fee_tiers,json=exitFeeTiers,proto3" json:"exit_fee_tiers"`
+	// Lock tiers available when adding liquidity.
+	LockTiers []LockTier `protobuf:"bytes,11,rep,name=lock_tiers,json=lockTiers,proto3" json:"lock_tiers"`
+	// Recipients of the protocol fees.
+	FeeSplits []FeeSplit `protobuf:"bytes,12,rep,name=fee_splits,json=feeSplits,proto3" json:"fee_splits"`
+	// Dynamic rewards fee model, disabled when unset.
+	DynamicFee *DynamicFee `protobuf:"bytes,13,opt,name=dynamic_fee,json=dynamicFee,proto3" json:"dynamic_fee,omitempty"`
+	// Per-direction rewards fees, the rewards fee is used for both directions when unset.
+	DirectionalFee *DirectionalFee `protobuf:"bytes,14,opt,name=directional_fee,json=directionalFee,proto3" json:"directional_fee,omitempty"`
 }
 
 func (m *MsgUpdatePool) Reset()         { *m = MsgUpdatePool{} }
@@ -219,6 +247,8 @@ type MsgAddLiquidity struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 	// Maximum acceptable slippage percentage.
 	SlippagePercentage int64 `protobuf:"varint,4,opt,name=slippage_percentage,json=slippagePercentage,proto3" json:"slippage_percentage,omitempty"`
+	// Optional duration for which the liquidity is locked, matching one of the pool lock tiers.
+	LockDuration time.Duration `protobuf:"bytes,5,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
 }
 
 func (m *MsgAddLiquidity) Reset()         { *m = MsgAddLiquidity{} }
@@ -306,6 +336,8 @@ type MsgRemoveLiquidity struct {
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// The percentage of liquidity to remove.
 	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
+	// Whether to skip the unbonding period by paying the pool exit fee.
+	Instant bool `protobuf:"varint,4,opt,name=instant,proto3" json:"instant,omitempty"`
 }
 
 func (m *MsgRemoveLiquidity) Reset()         { *m = MsgRemoveLiquidity{} }
@@ -344,6 +376,8 @@ var xxx_messageInfo_MsgRemoveLiquidity proto.InternalMessageInfo
 type MsgRemoveLiquidityResponse struct {
 	// The amount of shares that are unbonding.
 	UnbondingShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=unbonding_shares,json=unbondingShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"unbonding_shares"`
+	// The exit fee paid for an instant liquidity removal.
+	ExitFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=exit_fee,json=exitFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exit_fee"`
 }
 
 func (m *MsgRemoveLiquidityResponse) Reset()         { *m = MsgRemoveLiquidityResponse{} }
@@ -379,806 +413,4142 @@ func (m *MsgRemoveLiquidityResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveLiquidityResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreatePool)(nil), "noble.swap.stableswap.v1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "noble.swap.stableswap.v1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgUpdatePool)(nil), "noble.swap.stableswap.v1.MsgUpdatePool")
-	proto.RegisterType((*MsgUpdatePoolResponse)(nil), "noble.swap.stableswap.v1.MsgUpdatePoolResponse")
-	proto.RegisterType((*MsgAddLiquidity)(nil), "noble.swap.stableswap.v1.MsgAddLiquidity")
-	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "noble.swap.stableswap.v1.MsgAddLiquidityResponse")
-	proto.RegisterType((*MsgRemoveLiquidity)(nil), "noble.swap.stableswap.v1.MsgRemoveLiquidity")
-	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse")
+func (m *MsgRemoveLiquidityResponse) GetExitFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExitFee
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("noble/swap/stableswap/v1/tx.proto", fileDescriptor_98964321a460049b) }
-
-var fileDescriptor_98964321a460049b = []byte{
-	// 838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xbf, 0x6f, 0x1c, 0x45,
-	0x14, 0xbe, 0xcd, 0x3a, 0x67, 0x7b, 0x6c, 0xcb, 0x61, 0x48, 0x74, 0xeb, 0x0d, 0xba, 0x33, 0x47,
-	0x81, 0xb1, 0xf0, 0x2e, 0x67, 0xa2, 0x20, 0x5c, 0x20, 0xdd, 0x25, 0xb2, 0x84, 0x94, 0x93, 0xa2,
-	0x0d, 0x50, 0xd0, 0xac, 0xe6, 0x76, 0xdf, 0xad, 0x47, 0xd9, 0xdd, 0x59, 0x76, 0x66, 0x2f, 0x39,
-	0x1a, 0x10, 0x15, 0x42, 0x14, 0xd4, 0x54, 0x29, 0x11, 0xd5, 0x15, 0xa9, 0x91, 0xe8, 0xd2, 0x20,
-	0x45, 0xae, 0x10, 0x45, 0x40, 0x76, 0x71, 0x94, 0xfc, 0x09, 0x68, 0x67, 0xe7, 0x7e, 0x6d, 0x64,
-	0x13, 0x22, 0x48, 0x95, 0xe6, 0x6e, 0xe6, 0x7d, 0xdf, 0xbc, 0xb7, 0xf3, 0x7d, 0x6f, 0x66, 0xd0,
-	0xeb, 0x31, 0xeb, 0x85, 0x60, 0xf3, 0x7b, 0x24, 0xb1, 0xb9, 0x20, 0xbd, 0x10, 0xe4, 0x70, 0xd0,
-	0xb2, 0xc5, 0x7d, 0x2b, 0x49, 0x99, 0x60, 0xd8, 0x90, 0x14, 0x2b, 0x8f, 0x5b, 0x33, 0x8a, 0x35,
-	0x68, 0x99, 0xaf, 0x90, 0x88, 0xc6, 0xcc, 0x96, 0xbf, 0x05, 0xd9, 0xac, 0x7b, 0x8c, 0x47, 0x8c,
-	0xdb, 0x3d, 0xc2, 0xc1, 0x1e, 0xb4, 0x7a, 0x20, 0x48, 0xcb, 0xf6, 0x18, 0x8d, 0x15, 0x5e, 0x53,
-	0x78, 0xc4, 0x83, 0xbc, 0x48, 0xc4, 0x03, 0x05, 0x6c, 0x15, 0x80, 0x2b, 0x67, 0x76, 0x31, 0x51,
-	0xd0, 0xe5, 0x80, 0x05, 0xac, 0x88, 0xe7, 0xa3, 0x22, 0xda, 0xfc, 0x45, 0x47, 0x1b, 0x5d, 0x1e,
-	0xdc, 0x48, 0x81, 0x08, 0xb8, 0xcd, 0x58, 0x88, 0xdf, 0x41, 0x55, 0x4e, 0x83, 0x18, 0x52, 0x43,
-	0xdb, 0xd6, 0x76, 0x56, 0x3b, 0xc6, 0xf1, 0xc3, 0xbd, 0xcb, 0x2a, 0x53, 0xdb, 0xf7, 0x53, 0xe0,
-	0xfc, 0x8e, 0x48, 0x69, 0x1c, 0x38, 0x8a, 0x87, 0x31, 0x5a, 0x4a, 0x08, 0x4d, 0x8d, 0x0b, 0x39,
-	0xdf, 0x91, 0x63, 0x7c, 0x1d, 0xd5, 0x64, 0x01, 0x8f, 0x85, 0x6e, 0x1f, 0xc0, 0x4d, 0x20, 0xf5,
-	0x20, 0x16, 0x24, 0x00, 0x43, 0xdf, 0xd6, 0x76, 0x74, 0xe7, 0xca, 0x04, 0x3e, 0x04, 0xb8, 0x3d,
-	0x05, 0x71, 0x03, 0xad, 0xa5, 0x70, 0x8f, 0xa4, 0x3e, 0xcf, 0x97, 0x19, 0x4b, 0x92, 0x8b, 0x54,
-	0xe8, 0x10, 0x00, 0x5f, 0x45, 0xab, 0x34, 0xa6, 0x82, 0x92, 0xd0, 0x25, 0xc6, 0x45, 0x09, 0xaf,
-	0xa8, 0x40, 0x1b, 0x6f, 0xa1, 0x95, 0x7e, 0x26, 0xb2, 0x14, 0x5c, 0x62, 0x54, 0x25, 0xb6, 0x5c,
-	0xcc, 0xdb, 0xb8, 0x89, 0x36, 0x26, 0x90, 0x2b, 0x68, 0x04, 0xc6, 0xb2, 0xc4, 0xd7, 0x14, 0xfe,
-	0x11, 0x8d, 0x00, 0x7f, 0xab, 0xa1, 0x4b, 0x29, 0x11, 0xe0, 0x46, 0x59, 0x28, 0x68, 0x12, 0x52,
-	0x48, 0xb9, 0xb1, 0xb2, 0xad, 0xef, 0xac, 0xed, 0x6f, 0x59, 0x4a, 0x82, 0xdc, 0x12, 0x4b, 0x59,
-	0x62, 0xdd, 0x60, 0x34, 0xee, 0x1c, 0x3e, 0x7a, 0xd2, 0xa8, 0xfc, 0xf8, 0x7b, 0x63, 0x27, 0xa0,
-	0xe2, 0x28, 0xeb, 0x59, 0x1e, 0x8b, 0x94, 0xf2, 0xea, 0x6f, 0x8f, 0xfb, 0x77, 0x6d, 0x31, 0x4c,
-	0x80, 0xcb, 0x05, 0xfc, 0xfb, 0xf1, 0x68, 0x77, 0x3d, 0x84, 0x80, 0x78, 0x43, 0x37, 0x37, 0x95,
-	0xff, 0x30, 0x1e, 0xed, 0x6a, 0xce, 0x66, 0x5e, 0xba, 0x3b, 0xab, 0x7c, 0xb0, 0xff, 0xf5, 0x83,
-	0x46, 0xe5, 0xcf, 0x07, 0x8d, 0xca, 0x57, 0xe3, 0xd1, 0xae, 0x12, 0xfb, 0x9b, 0xf1, 0x68, 0xd7,
-	0x2c, 0xf7, 0xd9, 0xcc, 0xbd, 0x66, 0x0d, 0x5d, 0x59, 0xb0, 0xd3, 0x01, 0x9e, 0xb0, 0x98, 0x43,
-	0xf3, 0xb8, 0x30, 0xfa, 0xe3, 0xc4, 0x7f, 0x7e, 0xa3, 0x6b, 0x68, 0x39, 0x61, 0x2c, 0x74, 0xa9,
-	0x2f, 0xbd, 0x5e, 0x72, 0xaa, 0xf9, 0xf4, 0x43, 0xff, 0xa5, 0xdb, 0x2f, 0xd2, 0xed, 0x99, 0x85,
-	0xca, 0xed, 0x59, 0x60, 0xea, 0xf6, 0x4f, 0x17, 0xd0, 0x66, 0x97, 0x07, 0x6d, 0xdf, 0xbf, 0x45,
-	0x3f, 0xcb, 0xa8, 0x4f, 0xc5, 0xf0, 0xbf, 0xf4, 0x7b, 0x88, 0xaa, 0x24, 0x62, 0x59, 0x2c, 0x0c,
-	0xfd, 0x45, 0xe9, 0xa5, 0x0a, 0x62, 0x1b, 0xbd, 0xca, 0x43, 0x9a, 0x24, 0x24, 0x58, 0x68, 0xb3,
-	0xa2, 0x75, 0xf0, 0x04, 0x9a, 0xf5, 0xd8, 0xc1, 0xb5, 0x33, 0x74, 0x7d, 0xad, 0xac, 0xeb, 0xbc,
-	0x58, 0xcd, 0x0f, 0x50, 0xad, 0xa4, 0xdf, 0x44, 0x5b, 0xfc, 0x06, 0xda, 0x88, 0x68, 0x2c, 0xc0,
-	0x77, 0xf9, 0x11, 0x49, 0x81, 0x4b, 0x39, 0x75, 0x67, 0xbd, 0x08, 0xde, 0x91, 0xb1, 0xe6, 0x5f,
-	0x1a, 0xc2, 0x5d, 0x1e, 0x38, 0x10, 0xb1, 0x01, 0xfc, 0x2f, 0x1e, 0x7c, 0x82, 0x50, 0xe9, 0x98,
-	0xad, 0x76, 0xae, 0xe7, 0x62, 0xff, 0xf6, 0xa4, 0x71, 0xb5, 0x48, 0xc9, 0xfd, 0xbb, 0x16, 0x65,
-	0x76, 0x44, 0xc4, 0x91, 0x75, 0x4b, 0x2a, 0x7a, 0x13, 0xbc, 0xe3, 0x87, 0x7b, 0x48, 0x55, 0xbc,
-	0x09, 0x5e, 0x21, 0xee, 0x5c, 0xa6, 0x83, 0xf7, 0xce, 0xd0, 0xab, 0x51, 0xd6, 0xab, 0xb4, 0xb7,
-	0xe6, 0x17, 0xc8, 0x7c, 0x7a, 0xc7, 0x53, 0xd5, 0x08, 0xba, 0x94, 0xc5, 0x3d, 0x16, 0xfb, 0x34,
-	0x0e, 0xe6, 0x85, 0x7b, 0xfe, 0x8f, 0xde, 0x9c, 0xe6, 0x2b, 0x34, 0xdf, 0xff, 0x59, 0x47, 0x7a,
-	0x97, 0x07, 0xb8, 0x8f, 0xd0, 0xdc, 0x7b, 0xf6, 0xa6, 0x75, 0xd6, 0xcb, 0x6b, 0x2d, 0xdc, 0x94,
-	0xa6, 0xfd, 0x8c, 0xc4, 0xe9, 0x96, 0xfa, 0x08, 0xcd, 0x5d, 0xa7, 0xe7, 0xd7, 0x99, 0x11, 0xff,
-	0xa1, 0xce, 0xd3, 0x87, 0x19, 0x87, 0x68, 0x7d, 0xe1, 0x20, 0xbf, 0x75, 0x6e, 0x82, 0x79, 0xaa,
-	0xd9, 0x7a, 0x66, 0xea, 0xb4, 0x5a, 0x86, 0x36, 0xcb, 0x5d, 0xfb, 0xf6, 0xb9, 0x59, 0x4a, 0x6c,
-	0xf3, 0xda, 0xbf, 0x61, 0x4f, 0xca, 0x9a, 0x17, 0xbf, 0xcc, 0x4d, 0xed, 0xbc, 0xff, 0xe8, 0xa4,
-	0xae, 0x3d, 0x3e, 0xa9, 0x6b, 0x7f, 0x9c, 0xd4, 0xb5, 0xef, 0x4e, 0xeb, 0x95, 0xc7, 0xa7, 0xf5,
-	0xca, 0xaf, 0xa7, 0xf5, 0xca, 0xa7, 0xb2, 0xff, 0x54, 0xea, 0xfb, 0xc3, 0xcf, 0x8b, 0x2b, 0x63,
-	0xae, 0x1f, 0x7b, 0x55, 0xf9, 0xc6, 0xbc, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x29,
-	0x89, 0x10, 0x8d, 0x09, 0x00, 0x00,
+type MsgTransferPosition struct {
+	// The address of the user transferring the bonded position.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The ID of the pool of the bonded position.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// The address receiving the bonded position.
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// The percentage of the available bonded shares to transfer.
+	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgTransferPosition) Reset()         { *m = MsgTransferPosition{} }
+func (m *MsgTransferPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPosition) ProtoMessage()    {}
+func (*MsgTransferPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{8}
+}
+func (m *MsgTransferPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPosition.Merge(m, src)
+}
+func (m *MsgTransferPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPosition.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgTransferPosition proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreatePool creates a new `StableSwap` Pool.
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	// UpdatePool updates the params of a `StableSwap` Pool.
-	UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error)
-	// AddLiquidity allows a user to add liquidity to a `StableSwap` liquidity pool.
-	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
-	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
-	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+type MsgTransferPositionResponse struct {
+	// The amount of shares transferred to the receiver.
+	TransferredShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=transferred_shares,json=transferredShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"transferred_shares"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferPositionResponse) Reset()         { *m = MsgTransferPositionResponse{} }
+func (m *MsgTransferPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferPositionResponse) ProtoMessage()    {}
+func (*MsgTransferPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{9}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error) {
-	out := new(MsgUpdatePoolResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/UpdatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferPositionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error) {
-	out := new(MsgAddLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/AddLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTransferPositionResponse proto.InternalMessageInfo
+
+type MsgSetAutoCompound struct {
+	// The address of the liquidity provider.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The ID of the pool to compound the rewards into.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Whether the rewards should be compounded.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
-	out := new(MsgRemoveLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/RemoveLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{10}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreatePool creates a new `StableSwap` Pool.
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	// UpdatePool updates the params of a `StableSwap` Pool.
-	UpdatePool(context.Context, *MsgUpdatePool) (*MsgUpdatePoolResponse, error)
-	// AddLiquidity allows a user to add liquidity to a `StableSwap` liquidity pool.
-	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
-	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
-	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{11}
 }
-func (*UnimplementedMsgServer) UpdatePool(ctx context.Context, req *MsgUpdatePool) (*MsgUpdatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePool not implemented")
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiquidity) (*MsgAddLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLiquidity not implemented")
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+type MsgScheduleUpdatePool struct {
+	// Address of the account scheduling the update.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Timestamp from which the update is executed.
+	ExecutionTime int64 `protobuf:"varint,2,opt,name=execution_time,json=executionTime,proto3" json:"execution_time,omitempty"`
+	// Pool update to execute, whose signer is ignored and replaced by the scheduling signer.
+	Update MsgUpdatePool `protobuf:"bytes,3,opt,name=update,proto3" json:"update"`
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.stableswap.v1.Msg/CreatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+func (m *MsgScheduleUpdatePool) Reset()         { *m = MsgScheduleUpdatePool{} }
+func (m *MsgScheduleUpdatePool) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpdatePool) ProtoMessage()    {}
+func (*MsgScheduleUpdatePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{12}
+}
+func (m *MsgScheduleUpdatePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpdatePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpdatePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleUpdatePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpdatePool.Merge(m, src)
+}
+func (m *MsgScheduleUpdatePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUpdatePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpdatePool.DiscardUnknown(m)
 }
 
-func _Msg_UpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.stableswap.v1.Msg/UpdatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePool(ctx, req.(*MsgUpdatePool))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgScheduleUpdatePool proto.InternalMessageInfo
+
+type MsgScheduleUpdatePoolResponse struct {
+	// ID of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_AddLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddLiquidity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.stableswap.v1.Msg/AddLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddLiquidity(ctx, req.(*MsgAddLiquidity))
+func (m *MsgScheduleUpdatePoolResponse) Reset()         { *m = MsgScheduleUpdatePoolResponse{} }
+func (m *MsgScheduleUpdatePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleUpdatePoolResponse) ProtoMessage()    {}
+func (*MsgScheduleUpdatePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{13}
+}
+func (m *MsgScheduleUpdatePoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleUpdatePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleUpdatePoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleUpdatePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleUpdatePoolResponse.Merge(m, src)
+}
+func (m *MsgScheduleUpdatePoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleUpdatePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleUpdatePoolResponse.DiscardUnknown(m)
 }
 
-func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveLiquidity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.swap.stableswap.v1.Msg/RemoveLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
+var xxx_messageInfo_MsgScheduleUpdatePoolResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleUpdatePoolResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.swap.stableswap.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "UpdatePool",
-			Handler:    _Msg_UpdatePool_Handler,
-		},
-		{
-			MethodName: "AddLiquidity",
-			Handler:    _Msg_AddLiquidity_Handler,
-		},
-		{
-			MethodName: "RemoveLiquidity",
-			Handler:    _Msg_RemoveLiquidity_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/swap/stableswap/v1/tx.proto",
+type MsgCancelPoolUpdate struct {
+	// Address of the account cancelling the update.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the scheduled update to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelPoolUpdate) Reset()         { *m = MsgCancelPoolUpdate{} }
+func (m *MsgCancelPoolUpdate) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPoolUpdate) ProtoMessage()    {}
+func (*MsgCancelPoolUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{14}
+}
+func (m *MsgCancelPoolUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPoolUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPoolUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelPoolUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPoolUpdate.Merge(m, src)
+}
+func (m *MsgCancelPoolUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPoolUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPoolUpdate.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelPoolUpdate proto.InternalMessageInfo
+
+type MsgCancelPoolUpdateResponse struct {
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateMultipliers) > 0 {
-		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+func (m *MsgCancelPoolUpdateResponse) Reset()         { *m = MsgCancelPoolUpdateResponse{} }
+func (m *MsgCancelPoolUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPoolUpdateResponse) ProtoMessage()    {}
+func (*MsgCancelPoolUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{15}
+}
+func (m *MsgCancelPoolUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPoolUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPoolUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FutureATime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FutureATime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.FutureA != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FutureA))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.InitialA != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InitialA))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.RewardsFee != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RewardsFee))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ProtocolFeePercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProtocolFeePercentage))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelPoolUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPoolUpdateResponse.Merge(m, src)
 }
-
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelPoolUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPoolUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPoolUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelPoolUpdateResponse proto.InternalMessageInfo
+
+type MsgRampA struct {
+	// Address of the account starting the ramp.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the pool being ramped.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amplification coefficient reached at the end of the ramp.
+	FutureA int64 `protobuf:"varint,3,opt,name=future_a,json=futureA,proto3" json:"future_a,omitempty"`
+	// Timestamp at which the ramp ends.
+	FutureATime int64 `protobuf:"varint,4,opt,name=future_a_time,json=futureATime,proto3" json:"future_a_time,omitempty"`
 }
 
-func (m *MsgUpdatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRampA) Reset()         { *m = MsgRampA{} }
+func (m *MsgRampA) String() string { return proto.CompactTextString(m) }
+func (*MsgRampA) ProtoMessage()    {}
+func (*MsgRampA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{16}
+}
+func (m *MsgRampA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRampA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRampA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRampA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRampA.Merge(m, src)
+}
+func (m *MsgRampA) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRampA) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRampA.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRampA proto.InternalMessageInfo
+
+type MsgRampAResponse struct {
+	// Amplification coefficient from which the ramp starts.
+	InitialA int64 `protobuf:"varint,1,opt,name=initial_a,json=initialA,proto3" json:"initial_a,omitempty"`
 }
 
-func (m *MsgUpdatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RateMultipliers) > 0 {
-		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+func (m *MsgRampAResponse) Reset()         { *m = MsgRampAResponse{} }
+func (m *MsgRampAResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRampAResponse) ProtoMessage()    {}
+func (*MsgRampAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{17}
+}
+func (m *MsgRampAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRampAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRampAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FutureATime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FutureATime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.FutureA != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FutureA))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.InitialA != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.InitialA))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.RewardsFee != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RewardsFee))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ProtocolFeePercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProtocolFeePercentage))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRampAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRampAResponse.Merge(m, src)
+}
+func (m *MsgRampAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRampAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRampAResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRampAResponse proto.InternalMessageInfo
+
+func (m *MsgRampAResponse) GetInitialA() int64 {
+	if m != nil {
+		return m.InitialA
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgStopRampA struct {
+	// Address of the account stopping the ramp.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the pool whose ramp is stopped.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *MsgUpdatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgStopRampA) Reset()         { *m = MsgStopRampA{} }
+func (m *MsgStopRampA) String() string { return proto.CompactTextString(m) }
+func (*MsgStopRampA) ProtoMessage()    {}
+func (*MsgStopRampA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{18}
 }
-
-func (m *MsgAddLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStopRampA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStopRampA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStopRampA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgStopRampA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStopRampA.Merge(m, src)
+}
+func (m *MsgStopRampA) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStopRampA) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStopRampA.DiscardUnknown(m)
 }
 
-func (m *MsgAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgStopRampA proto.InternalMessageInfo
+
+type MsgStopRampAResponse struct {
+	// Amplification coefficient at which the pool has been frozen.
+	CurrentA int64 `protobuf:"varint,1,opt,name=current_a,json=currentA,proto3" json:"current_a,omitempty"`
 }
 
-func (m *MsgAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlippagePercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SlippagePercentage))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgStopRampAResponse) Reset()         { *m = MsgStopRampAResponse{} }
+func (m *MsgStopRampAResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStopRampAResponse) ProtoMessage()    {}
+func (*MsgStopRampAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98964321a460049b, []int{19}
+}
+func (m *MsgStopRampAResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStopRampAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStopRampAResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgStopRampAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStopRampAResponse.Merge(m, src)
+}
+func (m *MsgStopRampAResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStopRampAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStopRampAResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStopRampAResponse proto.InternalMessageInfo
+
+func (m *MsgStopRampAResponse) GetCurrentA() int64 {
+	if m != nil {
+		return m.CurrentA
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgAddLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgCreatePool)(nil), "noble.swap.stableswap.v1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "noble.swap.stableswap.v1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgUpdatePool)(nil), "noble.swap.stableswap.v1.MsgUpdatePool")
+	proto.RegisterType((*MsgUpdatePoolResponse)(nil), "noble.swap.stableswap.v1.MsgUpdatePoolResponse")
+	proto.RegisterType((*MsgAddLiquidity)(nil), "noble.swap.stableswap.v1.MsgAddLiquidity")
+	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "noble.swap.stableswap.v1.MsgAddLiquidityResponse")
+	proto.RegisterType((*MsgRemoveLiquidity)(nil), "noble.swap.stableswap.v1.MsgRemoveLiquidity")
+	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "noble.swap.stableswap.v1.MsgRemoveLiquidityResponse")
+	proto.RegisterType((*MsgTransferPosition)(nil), "noble.swap.stableswap.v1.MsgTransferPosition")
+	proto.RegisterType((*MsgTransferPositionResponse)(nil), "noble.swap.stableswap.v1.MsgTransferPositionResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "noble.swap.stableswap.v1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "noble.swap.stableswap.v1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgScheduleUpdatePool)(nil), "noble.swap.stableswap.v1.MsgScheduleUpdatePool")
+	proto.RegisterType((*MsgScheduleUpdatePoolResponse)(nil), "noble.swap.stableswap.v1.MsgScheduleUpdatePoolResponse")
+	proto.RegisterType((*MsgCancelPoolUpdate)(nil), "noble.swap.stableswap.v1.MsgCancelPoolUpdate")
+	proto.RegisterType((*MsgCancelPoolUpdateResponse)(nil), "noble.swap.stableswap.v1.MsgCancelPoolUpdateResponse")
+	proto.RegisterType((*MsgRampA)(nil), "noble.swap.stableswap.v1.MsgRampA")
+	proto.RegisterType((*MsgRampAResponse)(nil), "noble.swap.stableswap.v1.MsgRampAResponse")
+	proto.RegisterType((*MsgStopRampA)(nil), "noble.swap.stableswap.v1.MsgStopRampA")
+	proto.RegisterType((*MsgStopRampAResponse)(nil), "noble.swap.stableswap.v1.MsgStopRampAResponse")
+}
+
+func init() { proto.RegisterFile("noble/swap/stableswap/v1/tx.proto", fileDescriptor_98964321a460049b) }
+
+var fileDescriptor_98964321a460049b = []byte{
+	// 1516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xbf, 0x6f, 0x1c, 0xc5,
+	0x17, 0xf7, 0xde, 0x39, 0xf6, 0xf9, 0xf9, 0x67, 0x36, 0x89, 0xbc, 0xbe, 0x24, 0x3e, 0x7f, 0x37,
+	0xdf, 0x80, 0xb1, 0xf0, 0x2d, 0x76, 0x42, 0x02, 0x57, 0x20, 0xf9, 0x47, 0x2c, 0x40, 0xb6, 0x14,
+	0xce, 0x49, 0x90, 0xa0, 0x38, 0xed, 0xed, 0x8e, 0xd7, 0x23, 0xef, 0xee, 0x2c, 0x3b, 0xb3, 0x8e,
+	0x8d, 0x10, 0x42, 0x88, 0x22, 0x8a, 0x52, 0x40, 0x87, 0xa8, 0x52, 0x46, 0x54, 0x16, 0x4a, 0x41,
+	0x43, 0x45, 0x93, 0x32, 0x4a, 0x85, 0x28, 0x12, 0x94, 0x14, 0x46, 0xfc, 0x05, 0x34, 0x48, 0x68,
+	0x66, 0x7f, 0xdc, 0xde, 0x9e, 0xef, 0xec, 0x18, 0x27, 0x55, 0x9a, 0x64, 0x67, 0xde, 0x9b, 0xcf,
+	0x9b, 0xf7, 0xe3, 0xf3, 0xe6, 0x9d, 0xe1, 0x7f, 0x2e, 0xa9, 0xdb, 0x48, 0xa3, 0x37, 0x75, 0x4f,
+	0xa3, 0x4c, 0xaf, 0xdb, 0x48, 0x7c, 0x6e, 0xce, 0x68, 0x6c, 0xab, 0xec, 0xf9, 0x84, 0x11, 0x59,
+	0x11, 0x2a, 0x65, 0xbe, 0x5f, 0x6e, 0xa8, 0x94, 0x37, 0x67, 0x8a, 0xc7, 0x75, 0x07, 0xbb, 0x44,
+	0x13, 0xff, 0x86, 0xca, 0xc5, 0x71, 0x83, 0x50, 0x87, 0x50, 0xad, 0xae, 0x53, 0xa4, 0x6d, 0xce,
+	0xd4, 0x11, 0xd3, 0x67, 0x34, 0x83, 0x60, 0x37, 0x92, 0x8f, 0x46, 0x72, 0x87, 0x5a, 0xdc, 0x88,
+	0x43, 0xad, 0x48, 0x30, 0x16, 0x0a, 0x6a, 0x62, 0xa5, 0x85, 0x8b, 0x48, 0x74, 0xd2, 0x22, 0x16,
+	0x09, 0xf7, 0xf9, 0x57, 0x6c, 0xc9, 0x22, 0xc4, 0xb2, 0x91, 0x26, 0x56, 0xf5, 0x60, 0x4d, 0x33,
+	0x03, 0x5f, 0x67, 0x98, 0xc4, 0x96, 0xce, 0xb5, 0xf5, 0xcc, 0x23, 0xc4, 0x0e, 0x95, 0xd4, 0x9f,
+	0x7b, 0x61, 0x70, 0x85, 0x5a, 0x0b, 0x3e, 0xd2, 0x19, 0xba, 0x4a, 0x88, 0x2d, 0xbf, 0x05, 0x3d,
+	0x14, 0x5b, 0x2e, 0xf2, 0x15, 0x69, 0x42, 0x9a, 0xec, 0x9b, 0x57, 0x1e, 0xdd, 0x9f, 0x3e, 0x19,
+	0x5d, 0x67, 0xce, 0x34, 0x7d, 0x44, 0xe9, 0x2a, 0xf3, 0xb1, 0x6b, 0x55, 0x23, 0x3d, 0x59, 0x86,
+	0x6e, 0x4f, 0xc7, 0xbe, 0x92, 0xe3, 0xfa, 0x55, 0xf1, 0x2d, 0x5f, 0x82, 0x51, 0x61, 0xc0, 0x20,
+	0x76, 0x6d, 0x0d, 0xa1, 0x9a, 0x87, 0x7c, 0x03, 0xb9, 0x4c, 0xb7, 0x90, 0x92, 0x9f, 0x90, 0x26,
+	0xf3, 0xd5, 0x53, 0xb1, 0x78, 0x09, 0xa1, 0xab, 0x89, 0x50, 0x2e, 0x41, 0xbf, 0x8f, 0x6e, 0xea,
+	0xbe, 0x49, 0xf9, 0x31, 0xa5, 0x5b, 0xe8, 0x42, 0xb4, 0xb5, 0x84, 0x90, 0x7c, 0x1a, 0xfa, 0xb0,
+	0x8b, 0x19, 0xd6, 0xed, 0x9a, 0xae, 0x1c, 0x13, 0xe2, 0x42, 0xb4, 0x31, 0x27, 0x8f, 0x41, 0x61,
+	0x2d, 0x60, 0x81, 0x8f, 0x6a, 0xba, 0xd2, 0x23, 0x64, 0xbd, 0xe1, 0x7a, 0x4e, 0x56, 0x61, 0x30,
+	0x16, 0xd5, 0x18, 0x76, 0x90, 0xd2, 0x2b, 0xe4, 0xfd, 0x91, 0xfc, 0x1a, 0x76, 0x90, 0x7c, 0x47,
+	0x82, 0x11, 0x5f, 0x67, 0xa8, 0xe6, 0x04, 0x36, 0xc3, 0x9e, 0x8d, 0x91, 0x4f, 0x95, 0xc2, 0x44,
+	0x7e, 0xb2, 0x7f, 0x76, 0xac, 0x1c, 0x85, 0x80, 0xe7, 0xb5, 0x1c, 0xe5, 0xb5, 0xbc, 0x40, 0xb0,
+	0x3b, 0xbf, 0xf4, 0xe0, 0x71, 0xa9, 0xeb, 0xc7, 0x27, 0xa5, 0x49, 0x0b, 0xb3, 0xf5, 0xa0, 0x5e,
+	0x36, 0x88, 0x13, 0xa5, 0x2f, 0xfa, 0x6f, 0x9a, 0x9a, 0x1b, 0x1a, 0xdb, 0xf6, 0x10, 0x15, 0x07,
+	0xe8, 0x0f, 0xbb, 0x3b, 0x53, 0x03, 0x36, 0xb2, 0x74, 0x63, 0xbb, 0xc6, 0x2b, 0x83, 0xde, 0xdb,
+	0xdd, 0x99, 0x92, 0xaa, 0xc3, 0xdc, 0xf4, 0x4a, 0xc3, 0xb2, 0xfc, 0x29, 0x0c, 0x07, 0x6e, 0x9d,
+	0xb8, 0x26, 0x76, 0xad, 0x1a, 0x13, 0x97, 0xe9, 0x13, 0x97, 0x79, 0xbd, 0xdc, 0xae, 0x22, 0xcb,
+	0xd7, 0xe3, 0x03, 0xd7, 0x30, 0xf2, 0xe7, 0xfb, 0xf8, 0xd5, 0x42, 0xf4, 0xa1, 0x20, 0x2d, 0xa1,
+	0xf2, 0x0d, 0x18, 0x42, 0x5b, 0x98, 0x89, 0xe4, 0x84, 0xd8, 0x20, 0xb0, 0xcf, 0xb7, 0xc7, 0xbe,
+	0xb2, 0x85, 0xd9, 0x12, 0x42, 0x59, 0xe4, 0x01, 0xd4, 0xd8, 0xa7, 0xf2, 0x32, 0x80, 0x4d, 0x8c,
+	0x8d, 0x08, 0xb3, 0x5f, 0x60, 0xaa, 0xed, 0x31, 0x97, 0x89, 0xb1, 0x91, 0x05, 0xec, 0xb3, 0xa3,
+	0x4d, 0x81, 0xc6, 0x2f, 0x48, 0x3d, 0x1b, 0x33, 0xaa, 0x0c, 0xec, 0x87, 0xb6, 0x84, 0xd0, 0x2a,
+	0x57, 0x6d, 0x42, 0x5b, 0x8b, 0x36, 0xa9, 0x7c, 0x05, 0xfa, 0xcd, 0x6d, 0x57, 0x77, 0xb0, 0x21,
+	0x8a, 0x6b, 0x70, 0x42, 0x9a, 0xec, 0x9f, 0xfd, 0x7f, 0x7b, 0xb8, 0xc5, 0x50, 0x79, 0x09, 0xa1,
+	0x2a, 0x98, 0xc9, 0xb7, 0xfc, 0x11, 0x0c, 0x9b, 0xd8, 0x47, 0x06, 0xe7, 0x9a, 0x2e, 0xca, 0x5b,
+	0x19, 0x12, 0x50, 0x93, 0x1d, 0xa0, 0x1a, 0x07, 0x38, 0xdc, 0x90, 0xd9, 0xb4, 0xae, 0xcc, 0xde,
+	0xba, 0x5b, 0xea, 0xfa, 0xf3, 0x6e, 0xa9, 0xeb, 0xeb, 0xdd, 0x9d, 0xa9, 0x88, 0x57, 0xb7, 0x77,
+	0x77, 0xa6, 0x8a, 0x59, 0xf6, 0x36, 0x88, 0xaa, 0x8e, 0xc2, 0xa9, 0x26, 0xe6, 0x56, 0x11, 0xf5,
+	0x88, 0x4b, 0x91, 0xfa, 0x4b, 0xc8, 0xe9, 0xeb, 0x9e, 0x79, 0x78, 0x4e, 0x8f, 0x42, 0x2f, 0xef,
+	0x12, 0x35, 0x6c, 0x0a, 0x5a, 0x77, 0x57, 0x7b, 0xf8, 0xf2, 0x03, 0xf3, 0x15, 0xb1, 0x5f, 0x11,
+	0xfb, 0x15, 0xb1, 0x5f, 0x00, 0xb1, 0x1b, 0x6c, 0x8d, 0x88, 0xdd, 0xd8, 0x48, 0x88, 0x7d, 0x2b,
+	0x0f, 0xc3, 0x2b, 0xd4, 0x9a, 0x33, 0xcd, 0x65, 0xfc, 0x59, 0x80, 0x4d, 0xcc, 0xb6, 0x8f, 0x92,
+	0xda, 0xdb, 0xd0, 0xa3, 0x3b, 0x24, 0x70, 0x99, 0x92, 0x7f, 0x59, 0xd4, 0x88, 0x0c, 0xca, 0x1a,
+	0x9c, 0xa0, 0x36, 0xf6, 0x3c, 0xdd, 0x6a, 0xea, 0x28, 0x61, 0x97, 0x90, 0x63, 0x51, 0xaa, 0x9d,
+	0xbc, 0x0f, 0x83, 0xa2, 0x1a, 0xe3, 0x99, 0x47, 0x74, 0x0c, 0x7e, 0xe5, 0x70, 0x28, 0x2a, 0xc7,
+	0x43, 0x51, 0x79, 0x31, 0x52, 0x98, 0x2f, 0xf0, 0x2b, 0x7f, 0xff, 0xa4, 0x24, 0x55, 0x07, 0xf8,
+	0xc9, 0x78, 0xbf, 0x72, 0xb1, 0x4d, 0x86, 0xce, 0x64, 0x33, 0x94, 0x0e, 0xbb, 0xfa, 0x1e, 0x8c,
+	0x66, 0x32, 0x11, 0x67, 0x49, 0x3e, 0x07, 0x83, 0x0e, 0x76, 0x19, 0x32, 0x6b, 0x74, 0x5d, 0xf7,
+	0x11, 0x15, 0x89, 0xc9, 0x57, 0x07, 0xc2, 0xcd, 0x55, 0xb1, 0xa7, 0xde, 0xce, 0x81, 0xbc, 0x42,
+	0xad, 0x2a, 0x72, 0xc8, 0x26, 0x7a, 0x21, 0xd9, 0xbc, 0x01, 0x90, 0xe9, 0xcd, 0x7d, 0xf3, 0x97,
+	0x78, 0x0c, 0x7e, 0x7f, 0x5c, 0x3a, 0x1d, 0x42, 0x52, 0x73, 0xa3, 0x8c, 0x89, 0xe6, 0xe8, 0x6c,
+	0xbd, 0xbc, 0x2c, 0x72, 0xb3, 0x88, 0x8c, 0x47, 0xf7, 0xa7, 0x21, 0xb2, 0xb8, 0x88, 0x8c, 0x30,
+	0x4d, 0x29, 0x24, 0x59, 0x81, 0x5e, 0xec, 0x52, 0xa6, 0xbb, 0x4c, 0xa4, 0xa7, 0x50, 0x8d, 0x97,
+	0x95, 0xcb, 0x6d, 0x22, 0x59, 0xca, 0x46, 0x32, 0xe3, 0xb5, 0xfa, 0x8f, 0x04, 0xc5, 0xd6, 0x60,
+	0x24, 0x01, 0xd5, 0x61, 0xa4, 0xd1, 0x2e, 0x53, 0x31, 0x3d, 0xbc, 0x3f, 0x8d, 0xf6, 0x1b, 0xa6,
+	0x43, 0xfe, 0x02, 0x0a, 0x71, 0xd3, 0x54, 0x72, 0x2f, 0xab, 0xf8, 0x7b, 0xa3, 0xfe, 0xaa, 0xde,
+	0xcb, 0xc1, 0x89, 0x15, 0x6a, 0x5d, 0xf3, 0x75, 0x97, 0xae, 0x21, 0xff, 0x2a, 0xa1, 0x98, 0x97,
+	0xe6, 0x51, 0x56, 0xc3, 0x45, 0x28, 0xf8, 0xc8, 0x40, 0x78, 0x13, 0xf9, 0x51, 0x2d, 0xb4, 0x07,
+	0x4b, 0x34, 0x33, 0x35, 0xd4, 0x7d, 0x54, 0x35, 0x54, 0x79, 0xa7, 0x4d, 0xa5, 0x4c, 0x64, 0x2b,
+	0x25, 0x1b, 0x12, 0xf5, 0x1b, 0x09, 0x4e, 0xef, 0x11, 0xaa, 0xa4, 0x56, 0x10, 0xc8, 0x2c, 0x92,
+	0xf9, 0xcd, 0x0c, 0x3c, 0xfc, 0xcd, 0x8f, 0xa7, 0x10, 0x23, 0xfa, 0xfe, 0x24, 0x09, 0xfa, 0xae,
+	0x22, 0x36, 0x17, 0x30, 0xb2, 0x40, 0x1c, 0x8f, 0x04, 0xae, 0x79, 0x94, 0x09, 0x53, 0xa0, 0x17,
+	0xb9, 0x3c, 0x0e, 0xa6, 0xc8, 0x57, 0xa1, 0x1a, 0x2f, 0x0f, 0x4e, 0xb3, 0xcc, 0xed, 0xd4, 0x33,
+	0x82, 0x65, 0x99, 0xdd, 0xe4, 0x71, 0xf9, 0x5b, 0x12, 0xcf, 0xce, 0xaa, 0xb1, 0x8e, 0xcc, 0xc0,
+	0x46, 0xff, 0x69, 0x7a, 0x3c, 0xcf, 0x67, 0x10, 0x64, 0x04, 0x3c, 0x35, 0xe1, 0x50, 0x96, 0x13,
+	0x3d, 0x70, 0x30, 0xd9, 0x15, 0x63, 0xd9, 0x87, 0xd0, 0x13, 0x08, 0x33, 0xc2, 0xc5, 0x8e, 0xe3,
+	0x4f, 0xd3, 0x83, 0x98, 0x9e, 0x02, 0x22, 0x84, 0x4a, 0xa5, 0x4d, 0x54, 0xd4, 0x96, 0xa8, 0xb4,
+	0x38, 0xa8, 0x6a, 0x70, 0x76, 0x4f, 0xcf, 0x93, 0xaa, 0x1a, 0x82, 0x1c, 0x36, 0x85, 0xf7, 0xdd,
+	0xd5, 0x1c, 0x36, 0xd5, 0xef, 0x24, 0x41, 0xd8, 0x05, 0xdd, 0x35, 0x90, 0xcd, 0x35, 0xc3, 0x33,
+	0x87, 0x88, 0x54, 0x88, 0x9c, 0x8b, 0x91, 0x0f, 0xce, 0x8c, 0xac, 0x6d, 0xf5, 0xac, 0x20, 0x46,
+	0x76, 0x3b, 0x49, 0xef, 0xaf, 0x12, 0x14, 0x78, 0x8f, 0xd5, 0x1d, 0x6f, 0xee, 0x28, 0xeb, 0x34,
+	0x3d, 0x99, 0xe7, 0xf7, 0x99, 0xcc, 0xbb, 0x5b, 0x26, 0xf3, 0xca, 0x74, 0x1b, 0x7f, 0x4f, 0xb5,
+	0xbc, 0x19, 0xfc, 0xe2, 0xaa, 0x06, 0x23, 0xb1, 0x13, 0x49, 0x72, 0x9a, 0x7e, 0x38, 0x48, 0xcd,
+	0x3f, 0x1c, 0xd4, 0x3b, 0x12, 0x0c, 0xf0, 0xdc, 0x32, 0xe2, 0x1d, 0xb5, 0xeb, 0x95, 0x99, 0x36,
+	0x77, 0x1f, 0x6b, 0x29, 0xb9, 0xd8, 0xba, 0x7a, 0x01, 0x4e, 0xa6, 0x6f, 0x93, 0xf6, 0xc1, 0x08,
+	0x7c, 0x1f, 0xb9, 0xac, 0xe1, 0x43, 0xb4, 0x31, 0x37, 0xfb, 0x57, 0x01, 0xf2, 0x2b, 0xd4, 0x92,
+	0xd7, 0x00, 0x52, 0x7f, 0xa7, 0xe9, 0x4c, 0x96, 0x86, 0x62, 0x51, 0x3b, 0xa0, 0x62, 0x72, 0x99,
+	0x35, 0x80, 0x14, 0xfb, 0x0f, 0x4a, 0xca, 0x7d, 0xec, 0xec, 0xc1, 0x2a, 0x1b, 0x06, 0x9a, 0x46,
+	0xd9, 0x37, 0x3a, 0x02, 0xa4, 0x55, 0x8b, 0x33, 0x07, 0x56, 0x4d, 0xac, 0x05, 0x30, 0x9c, 0x9d,
+	0xb6, 0xde, 0xec, 0x88, 0x92, 0xd1, 0x2e, 0x5e, 0x7c, 0x1e, 0xed, 0xc4, 0xec, 0x16, 0x8c, 0xb4,
+	0xbc, 0xeb, 0xd3, 0x1d, 0x91, 0xb2, 0xea, 0xc5, 0xb7, 0x9f, 0x4b, 0x3d, 0xed, 0x70, 0xf6, 0x7d,
+	0xea, 0xec, 0x70, 0x46, 0x7b, 0x1f, 0x87, 0xdb, 0xbc, 0x23, 0xf2, 0x97, 0x20, 0xef, 0xf1, 0x86,
+	0x74, 0x2e, 0x8e, 0xd6, 0x03, 0xc5, 0xcb, 0xcf, 0x79, 0x20, 0x1d, 0xf0, 0x96, 0xbe, 0xdc, 0x39,
+	0xe0, 0x59, 0xf5, 0x7d, 0x02, 0xde, 0xae, 0xc5, 0xca, 0x1f, 0xc3, 0xb1, 0xb0, 0xc7, 0xa8, 0x9d,
+	0x2b, 0x85, 0xeb, 0x14, 0xa7, 0xf6, 0xd7, 0x49, 0x80, 0x0d, 0xe8, 0x6b, 0x34, 0xb0, 0xd7, 0x3a,
+	0x07, 0x26, 0xd6, 0x2b, 0x96, 0x0f, 0xa6, 0x17, 0x1b, 0x29, 0x1e, 0xfb, 0x8a, 0xbf, 0xa7, 0xf3,
+	0xef, 0x3e, 0x78, 0x3a, 0x2e, 0x3d, 0x7c, 0x3a, 0x2e, 0xfd, 0xf1, 0x74, 0x5c, 0xfa, 0xf6, 0xd9,
+	0x78, 0xd7, 0xc3, 0x67, 0xe3, 0x5d, 0xbf, 0x3d, 0x1b, 0xef, 0xfa, 0x44, 0xcc, 0x17, 0x11, 0xe8,
+	0xd6, 0xf6, 0xe7, 0xe1, 0x80, 0x9b, 0x6a, 0x73, 0xf5, 0x1e, 0xf1, 0xa3, 0xeb, 0xc2, 0xbf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x40, 0xfb, 0x66, 0x88, 0x53, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreatePool creates a new `StableSwap` Pool.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// UpdatePool updates the params of a `StableSwap` Pool.
+	UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error)
+	// AddLiquidity allows a user to add liquidity to a `StableSwap` liquidity pool.
+	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
+	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
+	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	// TransferPosition allows a user to transfer bonded positions of a `StableSwap` liquidity pool to another address.
+	TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error)
+	// SetAutoCompound enables or disables the auto-compounding of the user rewards into bonded shares.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleUpdatePool schedules an update of the params of a `StableSwap` Pool, executed at a future time.
+	ScheduleUpdatePool(ctx context.Context, in *MsgScheduleUpdatePool, opts ...grpc.CallOption) (*MsgScheduleUpdatePoolResponse, error)
+	// CancelPoolUpdate cancels a pending scheduled update of a `StableSwap` Pool.
+	CancelPoolUpdate(ctx context.Context, in *MsgCancelPoolUpdate, opts ...grpc.CallOption) (*MsgCancelPoolUpdateResponse, error)
+	// RampA starts a bounded ramp of the amplification coefficient of a `StableSwap` Pool from its current value.
+	RampA(ctx context.Context, in *MsgRampA, opts ...grpc.CallOption) (*MsgRampAResponse, error)
+	// StopRampA stops the ongoing amplification coefficient ramp of a `StableSwap` Pool, freezing its current value.
+	StopRampA(ctx context.Context, in *MsgStopRampA, opts ...grpc.CallOption) (*MsgStopRampAResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/CreatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdatePool(ctx context.Context, in *MsgUpdatePool, opts ...grpc.CallOption) (*MsgUpdatePoolResponse, error) {
+	out := new(MsgUpdatePoolResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/UpdatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MintedShares != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MintedShares))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error) {
+	out := new(MsgAddLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/AddLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
+	out := new(MsgRemoveLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/RemoveLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferPosition(ctx context.Context, in *MsgTransferPosition, opts ...grpc.CallOption) (*MsgTransferPositionResponse, error) {
+	out := new(MsgTransferPositionResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/TransferPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ScheduleUpdatePool(ctx context.Context, in *MsgScheduleUpdatePool, opts ...grpc.CallOption) (*MsgScheduleUpdatePoolResponse, error) {
+	out := new(MsgScheduleUpdatePoolResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/ScheduleUpdatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelPoolUpdate(ctx context.Context, in *MsgCancelPoolUpdate, opts ...grpc.CallOption) (*MsgCancelPoolUpdateResponse, error) {
+	out := new(MsgCancelPoolUpdateResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/CancelPoolUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UnbondingShares.Size()
-		i -= size
-		if _, err := m.UnbondingShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) RampA(ctx context.Context, in *MsgRampA, opts ...grpc.CallOption) (*MsgRampAResponse, error) {
+	out := new(MsgRampAResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/RampA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) StopRampA(ctx context.Context, in *MsgStopRampA, opts ...grpc.CallOption) (*MsgStopRampAResponse, error) {
+	out := new(MsgStopRampAResponse)
+	err := c.cc.Invoke(ctx, "/noble.swap.stableswap.v1.Msg/StopRampA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreatePool creates a new `StableSwap` Pool.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// UpdatePool updates the params of a `StableSwap` Pool.
+	UpdatePool(context.Context, *MsgUpdatePool) (*MsgUpdatePoolResponse, error)
+	// AddLiquidity allows a user to add liquidity to a `StableSwap` liquidity pool.
+	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
+	// RemoveLiquidity allows a user to remove liquidity from a `StableSwap` liquidity pool.
+	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	// TransferPosition allows a user to transfer bonded positions of a `StableSwap` liquidity pool to another address.
+	TransferPosition(context.Context, *MsgTransferPosition) (*MsgTransferPositionResponse, error)
+	// SetAutoCompound enables or disables the auto-compounding of the user rewards into bonded shares.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleUpdatePool schedules an update of the params of a `StableSwap` Pool, executed at a future time.
+	ScheduleUpdatePool(context.Context, *MsgScheduleUpdatePool) (*MsgScheduleUpdatePoolResponse, error)
+	// CancelPoolUpdate cancels a pending scheduled update of a `StableSwap` Pool.
+	CancelPoolUpdate(context.Context, *MsgCancelPoolUpdate) (*MsgCancelPoolUpdateResponse, error)
+	// RampA starts a bounded ramp of the amplification coefficient of a `StableSwap` Pool from its current value.
+	RampA(context.Context, *MsgRampA) (*MsgRampAResponse, error)
+	// StopRampA stops the ongoing amplification coefficient ramp of a `StableSwap` Pool, freezing its current value.
+	StopRampA(context.Context, *MsgStopRampA) (*MsgStopRampAResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePool(ctx context.Context, req *MsgUpdatePool) (*MsgUpdatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePool not implemented")
+}
+func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiquidity) (*MsgAddLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) TransferPosition(ctx context.Context, req *MsgTransferPosition) (*MsgTransferPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPosition not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleUpdatePool(ctx context.Context, req *MsgScheduleUpdatePool) (*MsgScheduleUpdatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleUpdatePool not implemented")
+}
+func (*UnimplementedMsgServer) CancelPoolUpdate(ctx context.Context, req *MsgCancelPoolUpdate) (*MsgCancelPoolUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPoolUpdate not implemented")
+}
+func (*UnimplementedMsgServer) RampA(ctx context.Context, req *MsgRampA) (*MsgRampAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RampA not implemented")
+}
+func (*UnimplementedMsgServer) StopRampA(ctx context.Context, req *MsgStopRampA) (*MsgStopRampAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRampA not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProtocolFeePercentage != 0 {
-		n += 1 + sovTx(uint64(m.ProtocolFeePercentage))
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
 	}
-	if m.RewardsFee != 0 {
-		n += 1 + sovTx(uint64(m.RewardsFee))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/CreatePool",
 	}
-	if m.InitialA != 0 {
-		n += 1 + sovTx(uint64(m.InitialA))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
 	}
-	if m.FutureA != 0 {
-		n += 1 + sovTx(uint64(m.FutureA))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FutureATime != 0 {
-		n += 1 + sovTx(uint64(m.FutureATime))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePool(ctx, in)
 	}
-	if len(m.RateMultipliers) > 0 {
-		for _, e := range m.RateMultipliers {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/UpdatePool",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePool(ctx, req.(*MsgUpdatePool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/AddLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLiquidity(ctx, req.(*MsgAddLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquidity(ctx, in)
 	}
-	if m.ProtocolFeePercentage != 0 {
-		n += 1 + sovTx(uint64(m.ProtocolFeePercentage))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/RemoveLiquidity",
 	}
-	if m.RewardsFee != 0 {
-		n += 1 + sovTx(uint64(m.RewardsFee))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
 	}
-	if m.InitialA != 0 {
-		n += 1 + sovTx(uint64(m.InitialA))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPosition)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.FutureA != 0 {
-		n += 1 + sovTx(uint64(m.FutureA))
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPosition(ctx, in)
 	}
-	if m.FutureATime != 0 {
-		n += 1 + sovTx(uint64(m.FutureATime))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/TransferPosition",
 	}
-	if len(m.RateMultipliers) > 0 {
-		for _, e := range m.RateMultipliers {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPosition(ctx, req.(*MsgTransferPosition))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ScheduleUpdatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleUpdatePool)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleUpdatePool(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/ScheduleUpdatePool",
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleUpdatePool(ctx, req.(*MsgScheduleUpdatePool))
 	}
-	if m.SlippagePercentage != 0 {
-		n += 1 + sovTx(uint64(m.SlippagePercentage))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPoolUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPoolUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPoolUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/CancelPoolUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPoolUpdate(ctx, req.(*MsgCancelPoolUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RampA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRampA)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MintedShares != 0 {
-		n += 1 + sovTx(uint64(m.MintedShares))
+	if interceptor == nil {
+		return srv.(MsgServer).RampA(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/RampA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RampA(ctx, req.(*MsgRampA))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_StopRampA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStopRampA)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).StopRampA(ctx, in)
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.swap.stableswap.v1.Msg/StopRampA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StopRampA(ctx, req.(*MsgStopRampA))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.swap.stableswap.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "UpdatePool",
+			Handler:    _Msg_UpdatePool_Handler,
+		},
+		{
+			MethodName: "AddLiquidity",
+			Handler:    _Msg_AddLiquidity_Handler,
+		},
+		{
+			MethodName: "RemoveLiquidity",
+			Handler:    _Msg_RemoveLiquidity_Handler,
+		},
+		{
+			MethodName: "TransferPosition",
+			Handler:    _Msg_TransferPosition_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "ScheduleUpdatePool",
+			Handler:    _Msg_ScheduleUpdatePool_Handler,
+		},
+		{
+			MethodName: "CancelPoolUpdate",
+			Handler:    _Msg_CancelPoolUpdate_Handler,
+		},
+		{
+			MethodName: "RampA",
+			Handler:    _Msg_RampA_Handler,
+		},
+		{
+			MethodName: "StopRampA",
+			Handler:    _Msg_StopRampA_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/swap/stableswap/v1/tx.proto",
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DirectionalFee != nil {
+		{
+			size, err := m.DirectionalFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DynamicFee != nil {
+		{
+			size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for iNdEx := len(m.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RateMultipliers) > 0 {
+		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RewardsFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardsFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProtocolFeePercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProtocolFeePercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DirectionalFee != nil {
+		{
+			size, err := m.DirectionalFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DynamicFee != nil {
+		{
+			size, err := m.DynamicFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FeeSplits) > 0 {
+		for iNdEx := len(m.FeeSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for iNdEx := len(m.LockTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for iNdEx := len(m.ExitFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for iNdEx := len(m.UnbondingTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RateMultipliers) > 0 {
+		for iNdEx := len(m.RateMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FutureATime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InitialA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RewardsFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardsFee))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProtocolFeePercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProtocolFeePercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.SlippagePercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SlippagePercentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintedShares != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MintedShares))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Instant {
+		i--
+		if m.Instant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExitFee) > 0 {
+		for iNdEx := len(m.ExitFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExitFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.UnbondingShares.Size()
+		i -= size
+		if _, err := m.UnbondingShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TransferredShares.Size()
+		i -= size
+		if _, err := m.TransferredShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleUpdatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpdatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpdatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExecutionTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleUpdatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleUpdatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleUpdatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPoolUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPoolUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPoolUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPoolUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPoolUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRampA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRampA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRampA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FutureATime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureATime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FutureA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FutureA))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRampAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRampAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRampAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitialA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InitialA))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopRampA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopRampA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopRampA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStopRampAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStopRampAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStopRampAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentA != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CurrentA))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProtocolFeePercentage != 0 {
+		n += 1 + sovTx(uint64(m.ProtocolFeePercentage))
+	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovTx(uint64(m.RewardsFee))
+	}
+	if m.InitialA != 0 {
+		n += 1 + sovTx(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovTx(uint64(m.FutureA))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovTx(uint64(m.FutureATime))
+	}
+	if len(m.RateMultipliers) > 0 {
+		for _, e := range m.RateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for _, e := range m.ExitFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DynamicFee != nil {
+		l = m.DynamicFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DirectionalFee != nil {
+		l = m.DirectionalFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.ProtocolFeePercentage != 0 {
+		n += 1 + sovTx(uint64(m.ProtocolFeePercentage))
+	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovTx(uint64(m.RewardsFee))
+	}
+	if m.InitialA != 0 {
+		n += 1 + sovTx(uint64(m.InitialA))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovTx(uint64(m.FutureA))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovTx(uint64(m.FutureATime))
+	}
+	if len(m.RateMultipliers) > 0 {
+		for _, e := range m.RateMultipliers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnbondingTiers) > 0 {
+		for _, e := range m.UnbondingTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ExitFeeTiers) > 0 {
+		for _, e := range m.ExitFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.LockTiers) > 0 {
+		for _, e := range m.LockTiers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FeeSplits) > 0 {
+		for _, e := range m.FeeSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DynamicFee != nil {
+		l = m.DynamicFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DirectionalFee != nil {
+		l = m.DirectionalFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SlippagePercentage != 0 {
+		n += 1 + sovTx(uint64(m.SlippagePercentage))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintedShares != 0 {
+		n += 1 + sovTx(uint64(m.MintedShares))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Instant {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnbondingShares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ExitFee) > 0 {
+		for _, e := range m.ExitFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgTransferPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TransferredShares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleUpdatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionTime != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionTime))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleUpdatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPoolUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPoolUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRampA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.FutureA != 0 {
+		n += 1 + sovTx(uint64(m.FutureA))
+	}
+	if m.FutureATime != 0 {
+		n += 1 + sovTx(uint64(m.FutureATime))
+	}
+	return n
+}
+
+func (m *MsgRampAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitialA != 0 {
+		n += 1 + sovTx(uint64(m.InitialA))
+	}
+	return n
+}
+
+func (m *MsgStopRampA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgStopRampAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentA != 0 {
+		n += 1 + sovTx(uint64(m.CurrentA))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			m.ProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			m.InitialA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+			}
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
+			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFeeTiers = append(m.ExitFeeTiers, ExitFeeTier{})
+			if err := m.ExitFeeTiers[len(m.ExitFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, FeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFee == nil {
+				m.DynamicFee = &DynamicFee{}
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectionalFee == nil {
+				m.DirectionalFee = &DirectionalFee{}
+			}
+			if err := m.DirectionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+			}
+			m.ProtocolFeePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			m.InitialA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			m.FutureA = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureA |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+			}
+			m.FutureATime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureATime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
+			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTiers = append(m.UnbondingTiers, UnbondingTier{})
+			if err := m.UnbondingTiers[len(m.UnbondingTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFeeTiers = append(m.ExitFeeTiers, ExitFeeTier{})
+			if err := m.ExitFeeTiers[len(m.ExitFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTiers = append(m.LockTiers, LockTier{})
+			if err := m.LockTiers[len(m.LockTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSplits = append(m.FeeSplits, FeeSplit{})
+			if err := m.FeeSplits[len(m.FeeSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicFee == nil {
+				m.DynamicFee = &DynamicFee{}
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DirectionalFee == nil {
+				m.DirectionalFee = &DirectionalFee{}
+			}
+			if err := m.DirectionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippagePercentage", wireType)
+			}
+			m.SlippagePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlippagePercentage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedShares", wireType)
+			}
+			m.MintedShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintedShares |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Instant = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitFee = append(m.ExitFee, types.Coin{})
+			if err := m.ExitFee[len(m.ExitFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Percentage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgRemoveLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.UnbondingShares.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,15 +4571,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1237,11 +4607,63 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.TransferredShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1269,13 +4691,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.ProtocolFeePercentage = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1285,16 +4707,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.RewardsFee = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1304,35 +4726,117 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardsFee |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.InitialA = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialA |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FutureA = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleUpdatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleUpdatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1342,16 +4846,29 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureA |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
 			}
-			m.FutureATime = 0
+			m.ExecutionTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1361,14 +4878,14 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FutureATime |= int64(b&0x7F) << shift
+				m.ExecutionTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1395,8 +4912,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
-			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1421,7 +4937,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1444,12 +4960,31 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleUpdatePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1471,7 +5006,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPoolUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,10 +5029,10 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPoolUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1534,123 +5069,9 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeePercentage", wireType)
-			}
-			m.ProtocolFeePercentage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProtocolFeePercentage |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
-			}
-			m.RewardsFee = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RewardsFee |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
-			}
-			m.InitialA = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialA |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
-			}
-			m.FutureA = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FutureA |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
-			}
-			m.FutureATime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FutureATime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateMultipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1660,26 +5081,11 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RateMultipliers = append(m.RateMultipliers, types.Coin{})
-			if err := m.RateMultipliers[len(m.RateMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1701,7 +5107,7 @@ func (m *MsgUpdatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPoolUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1724,10 +5130,10 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPoolUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPoolUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1751,7 +5157,7 @@ func (m *MsgUpdatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgRampA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1774,10 +5180,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRampA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRampA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1832,10 +5238,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
 			}
-			var msglen int
+			m.FutureA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1845,31 +5251,16 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FutureA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlippagePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureATime", wireType)
 			}
-			m.SlippagePercentage = 0
+			m.FutureATime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1879,7 +5270,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlippagePercentage |= int64(b&0x7F) << shift
+				m.FutureATime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1905,7 +5296,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRampAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1928,17 +5319,17 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRampAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRampAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintedShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
 			}
-			m.MintedShares = 0
+			m.InitialA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1948,7 +5339,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MintedShares |= int64(b&0x7F) << shift
+				m.InitialA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1974,7 +5365,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgStopRampA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1997,10 +5388,10 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStopRampA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStopRampA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2054,40 +5445,6 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2109,7 +5466,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStopRampAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,17 +5489,17 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStopRampAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStopRampAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingShares", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentA", wireType)
 			}
-			var stringLen uint64
+			m.CurrentA = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2152,26 +5509,11 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentA |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UnbondingShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package stableswap
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultUnbondingTiers returns the unbonding schedule applied to the pools without custom tiers.
+func DefaultUnbondingTiers() []UnbondingTier {
+	return []UnbondingTier{
+		// Unbonding requests with negligible impact on the pool.
+		{Threshold: math.LegacyZeroDec(), Duration: 1 * time.Minute},
+		// Unbonding requests with small impact on the pool.
+		{Threshold: math.LegacyMustNewDecFromStr("0.1"), Duration: 30 * time.Minute},
+		// Unbonding requests with medium impact on the pool.
+		{Threshold: math.LegacyOneDec(), Duration: 12 * time.Hour},
+		// Unbonding requests with high impact on the pool.
+		{Threshold: math.LegacyNewDec(10), Duration: 24 * time.Hour},
+	}
+}
+
+// ValidateUnbondingTiers ensures that the tiers start from a zero threshold, and have strictly
+// increasing thresholds lower than 100% with non-negative durations. An empty list is valid.
+func ValidateUnbondingTiers(tiers []UnbondingTier) error {
+	for i, tier := range tiers {
+		var previous math.LegacyDec
+		if i > 0 {
+			previous = tiers[i-1].Threshold
+		}
+		if err := validateTierThreshold(i, tier.Threshold, previous); err != nil {
+			return err
+		}
+		if tier.Duration < 0 {
+			return fmt.Errorf("tier %d duration cannot be negative, got %s", i, tier.Duration)
+		}
+	}
+	return nil
+}
+
+// ValidateExitFeeTiers ensures that the tiers start from a zero threshold, and have strictly
+// increasing thresholds lower than 100% with fee percentages between 0 and 100. An empty list is valid.
+func ValidateExitFeeTiers(tiers []ExitFeeTier) error {
+	for i, tier := range tiers {
+		var previous math.LegacyDec
+		if i > 0 {
+			previous = tiers[i-1].Threshold
+		}
+		if err := validateTierThreshold(i, tier.Threshold, previous); err != nil {
+			return err
+		}
+		if tier.FeePercentage.IsNil() || tier.FeePercentage.IsNegative() || tier.FeePercentage.GTE(math.LegacyNewDec(100)) {
+			return fmt.Errorf("tier %d fee percentage must be >= 0 and < 100, got %s", i, tier.FeePercentage)
+		}
+	}
+	return nil
+}
+
+// validateTierThreshold ensures that the i-th tier threshold is valid, given the previous tier threshold.
+func validateTierThreshold(i int, threshold math.LegacyDec, previous math.LegacyDec) error {
+	if threshold.IsNil() {
+		return fmt.Errorf("tier %d threshold is missing", i)
+	}
+	if i == 0 && !threshold.IsZero() {
+		return fmt.Errorf("first tier threshold must be zero, got %s", threshold)
+	}
+	if i > 0 && threshold.LTE(previous) {
+		return fmt.Errorf("tier %d threshold must be greater than %s, got %s", i, previous, threshold)
+	}
+	if threshold.GTE(math.LegacyNewDec(100)) {
+		return fmt.Errorf("tier %d threshold must be lower than 100, got %s", i, threshold)
+	}
+	return nil
+}
@@ -89,6 +89,8 @@ type Swap struct {
 	Out types.Coin `protobuf:"bytes,3,opt,name=out,proto3" json:"out"`
 	// Any fees incurred during the swap.
 	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// Rewards fee rate applied to the swap, over the fee denominator.
+	RewardsFee int64 `protobuf:"varint,5,opt,name=rewards_fee,json=rewardsFee,proto3" json:"rewards_fee,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -152,36 +154,102 @@ func (m *Swap) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Swap) GetRewardsFee() int64 {
+	if m != nil {
+		return m.RewardsFee
+	}
+	return 0
+}
+
+type PoolProtocolFees struct {
+	// ID of the Pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Amount of protocol fees of the Pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *PoolProtocolFees) Reset()         { *m = PoolProtocolFees{} }
+func (m *PoolProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*PoolProtocolFees) ProtoMessage()    {}
+func (*PoolProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e685bfbd211195ec, []int{2}
+}
+func (m *PoolProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolProtocolFees.Merge(m, src)
+}
+func (m *PoolProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolProtocolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolProtocolFees proto.InternalMessageInfo
+
+func (m *PoolProtocolFees) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolProtocolFees) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Route)(nil), "noble.swap.v1.Route")
 	proto.RegisterType((*Swap)(nil), "noble.swap.v1.Swap")
+	proto.RegisterType((*PoolProtocolFees)(nil), "noble.swap.v1.PoolProtocolFees")
 }
 
 func init() { proto.RegisterFile("noble/swap/v1/swap.proto", fileDescriptor_e685bfbd211195ec) }
 
 var fileDescriptor_e685bfbd211195ec = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
-	0x14, 0x86, 0xe3, 0x36, 0x5f, 0xfb, 0x61, 0x60, 0x20, 0xaa, 0x44, 0xda, 0xc1, 0xad, 0x3a, 0x55,
-	0x95, 0xb0, 0x15, 0x18, 0xd9, 0x8a, 0x84, 0xc4, 0x1a, 0x98, 0x58, 0xaa, 0xfc, 0x98, 0x60, 0xd1,
-	0xf8, 0x44, 0xd8, 0x69, 0x29, 0x57, 0xc1, 0xcc, 0x15, 0x20, 0xa6, 0x5e, 0x46, 0xc7, 0x8e, 0x4c,
-	0x80, 0xda, 0xa1, 0xf7, 0xc0, 0x84, 0xe2, 0x64, 0x05, 0xb1, 0xe4, 0xfc, 0x3e, 0xf1, 0xab, 0xf7,
-	0x60, 0x57, 0x42, 0x38, 0xe1, 0x4c, 0xcd, 0x82, 0x8c, 0x4d, 0x3d, 0x13, 0x69, 0x76, 0x0f, 0x1a,
-	0x9c, 0x7d, 0x33, 0xa1, 0xa6, 0x33, 0xf5, 0x3a, 0x07, 0x41, 0x2a, 0x24, 0x30, 0xf3, 0x2d, 0x37,
-	0x3a, 0x24, 0x02, 0x95, 0x82, 0x62, 0x61, 0xa0, 0x38, 0x9b, 0x7a, 0x21, 0xd7, 0x81, 0xc7, 0x22,
-	0x10, 0xb2, 0x9a, 0xb7, 0x12, 0x48, 0xc0, 0xa4, 0xac, 0xc8, 0xca, 0x6e, 0xff, 0x14, 0xff, 0xf3,
-	0x21, 0xd7, 0xdc, 0x39, 0xc4, 0xcd, 0x0c, 0x60, 0x32, 0x16, 0xb1, 0x8b, 0x7a, 0x68, 0x60, 0xfb,
-	0x8d, 0xa2, 0xbc, 0x88, 0x9d, 0x36, 0xfe, 0x1f, 0x73, 0x09, 0xe9, 0x58, 0x83, 0x5b, 0xeb, 0xa1,
-	0xc1, 0x8e, 0xdf, 0x34, 0xf5, 0x15, 0xf4, 0xbf, 0x10, 0xb6, 0x2f, 0x67, 0x41, 0xf6, 0x33, 0xcc,
-	0x70, 0x4d, 0x48, 0x83, 0xed, 0x1e, 0xb7, 0x69, 0xa9, 0x90, 0x16, 0x0a, 0x69, 0xa5, 0x90, 0x9e,
-	0x81, 0x90, 0x23, 0x7b, 0xf9, 0xde, 0xb5, 0xfc, 0x9a, 0x90, 0x8e, 0x87, 0xeb, 0x90, 0x6b, 0xb7,
-	0xfe, 0x37, 0xa2, 0xd8, 0x75, 0x72, 0x6c, 0xdf, 0x70, 0xae, 0x5c, 0xbb, 0x57, 0xff, 0x9d, 0x39,
-	0x2f, 0x98, 0xd7, 0x8f, 0xee, 0x20, 0x11, 0xfa, 0x36, 0x0f, 0x69, 0x04, 0x29, 0xab, 0x4c, 0x2b,
-	0xc3, 0x91, 0x8a, 0xef, 0x98, 0x9e, 0x67, 0x5c, 0x19, 0x40, 0x3d, 0x6f, 0x17, 0xc3, 0xbd, 0x09,
-	0x4f, 0x82, 0x68, 0x3e, 0x2e, 0x9c, 0x54, 0x2f, 0xdb, 0xc5, 0x10, 0xf9, 0xe6, 0xb9, 0x11, 0x5d,
-	0xae, 0x09, 0x5a, 0xad, 0x09, 0xfa, 0x5c, 0x13, 0xf4, 0xb4, 0x21, 0xd6, 0x6a, 0x43, 0xac, 0xb7,
-	0x0d, 0xb1, 0xae, 0x5b, 0xe6, 0x4a, 0xe5, 0xc1, 0x1e, 0xe6, 0x8f, 0xe5, 0x1f, 0xc3, 0x86, 0x31,
-	0xfc, 0xe4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x0a, 0xfa, 0x3f, 0xe4, 0x01, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xe3, 0x24, 0xd7, 0x03, 0x1f, 0x48, 0x10, 0x9d, 0x44, 0xee, 0x86, 0x34, 0xea, 0x14,
+	0x9d, 0x84, 0xad, 0xc0, 0xc8, 0x76, 0x48, 0x95, 0xd8, 0x4e, 0x81, 0x89, 0x25, 0x72, 0x12, 0x37,
+	0x44, 0x24, 0x7e, 0x51, 0xed, 0xb4, 0x84, 0xbf, 0x82, 0x19, 0x76, 0x84, 0x98, 0xfa, 0x67, 0x74,
+	0xec, 0xc8, 0x04, 0xa8, 0x1d, 0xfa, 0x6f, 0x20, 0x3b, 0x59, 0x8b, 0x98, 0x58, 0xf2, 0x7e, 0x7e,
+	0xf2, 0x9e, 0xbf, 0x7a, 0xd8, 0x17, 0x90, 0xd5, 0x9c, 0xca, 0x35, 0x6b, 0xe9, 0x2a, 0x36, 0x96,
+	0xb4, 0x4b, 0x50, 0xe0, 0x3d, 0x34, 0x15, 0x62, 0x32, 0xab, 0xf8, 0xfa, 0x31, 0x6b, 0x2a, 0x01,
+	0xd4, 0x7c, 0x87, 0x8e, 0xeb, 0x20, 0x07, 0xd9, 0x80, 0xa4, 0x19, 0x93, 0x9c, 0xae, 0xe2, 0x8c,
+	0x2b, 0x16, 0xd3, 0x1c, 0x2a, 0x31, 0xd6, 0x2f, 0x4b, 0x28, 0xc1, 0xb8, 0x54, 0x7b, 0x43, 0x76,
+	0xf6, 0x02, 0x9f, 0x25, 0xd0, 0x29, 0xee, 0x3d, 0xc1, 0xe7, 0x2d, 0x40, 0x9d, 0x56, 0x85, 0x8f,
+	0x42, 0x14, 0xb9, 0xc9, 0x44, 0x87, 0xaf, 0x0a, 0xef, 0x0a, 0xdf, 0x2b, 0xb8, 0x80, 0x26, 0x55,
+	0xe0, 0xdb, 0x21, 0x8a, 0xee, 0x27, 0xe7, 0x26, 0x7e, 0x03, 0xb3, 0x2f, 0x36, 0x76, 0x5f, 0xaf,
+	0x59, 0x7b, 0x1a, 0xa6, 0xd8, 0xae, 0x84, 0xc1, 0x2e, 0x9e, 0x5d, 0x91, 0x61, 0x43, 0xa2, 0x37,
+	0x24, 0xe3, 0x86, 0xe4, 0x25, 0x54, 0xe2, 0xd6, 0xdd, 0xfe, 0x9c, 0x5a, 0x89, 0x5d, 0x09, 0x2f,
+	0xc6, 0x0e, 0x74, 0xca, 0x77, 0xfe, 0x8d, 0xd0, 0xbd, 0x5e, 0x87, 0xdd, 0x05, 0xe7, 0xd2, 0x77,
+	0x43, 0xe7, 0xef, 0xcc, 0x5c, 0x33, 0xdf, 0x7f, 0x4d, 0xa3, 0xb2, 0x52, 0xef, 0xba, 0x8c, 0xe4,
+	0xd0, 0xd0, 0x51, 0xb4, 0xc1, 0x3c, 0x95, 0xc5, 0x7b, 0xaa, 0xfa, 0x96, 0x4b, 0x03, 0xc8, 0xcf,
+	0xc7, 0xcd, 0xcd, 0x83, 0x9a, 0x97, 0x2c, 0xef, 0x53, 0xad, 0xa4, 0xfc, 0x76, 0xdc, 0xdc, 0xa0,
+	0xc4, 0x8c, 0xf3, 0xa6, 0xf8, 0x62, 0xc9, 0xd7, 0x6c, 0x59, 0xc8, 0x74, 0xc1, 0xb9, 0x7f, 0x16,
+	0xa2, 0xc8, 0x49, 0xf0, 0x98, 0x9a, 0x73, 0x3e, 0xfb, 0x8a, 0xf0, 0xa3, 0x3b, 0x80, 0xfa, 0x4e,
+	0x0b, 0x9d, 0x43, 0x3d, 0xd7, 0xd4, 0x49, 0xa5, 0x7a, 0x3c, 0x61, 0x0d, 0x74, 0x42, 0xf9, 0xf6,
+	0xff, 0x7a, 0xc7, 0x38, 0xf0, 0x96, 0x6c, 0xf7, 0x01, 0xda, 0xed, 0x03, 0xf4, 0x7b, 0x1f, 0xa0,
+	0x4f, 0x87, 0xc0, 0xda, 0x1d, 0x02, 0xeb, 0xc7, 0x21, 0xb0, 0xde, 0x5e, 0x9a, 0x7b, 0x1b, 0x4e,
+	0xef, 0x43, 0xff, 0x71, 0xf8, 0x67, 0x36, 0x31, 0xa7, 0xf3, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x59, 0x09, 0xfc, 0xba, 0xae, 0x02, 0x00, 0x00,
 }
 
 func (m *Route) Marshal() (dAtA []byte, err error) {
@@ -239,6 +307,11 @@ func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardsFee != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.RewardsFee))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Fees) > 0 {
 		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +354,48 @@ func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSwap(v)
 	base := offset
@@ -327,6 +442,27 @@ func (m *Swap) Size() (n int) {
 			n += 1 + l + sovSwap(uint64(l))
 		}
 	}
+	if m.RewardsFee != 0 {
+		n += 1 + sovSwap(uint64(m.RewardsFee))
+	}
+	return n
+}
+
+func (m *PoolProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovSwap(uint64(m.PoolId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -585,6 +721,128 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsFee", wireType)
+			}
+			m.RewardsFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardsFee |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolProtocolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolProtocolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSwap(dAtA[iNdEx:])
@@ -32,6 +32,8 @@ type SwapResult struct {
 	Dy          math.LegacyDec
 	ProtocolFee math.LegacyDec
 	RewardsFee  math.LegacyDec
+	// RewardsFeeRate is the rewards fee applied to the swap, over the fee denominator.
+	RewardsFeeRate int64
 }
 
 // AddLiquidityCommitment commits to adding liquidity (via a bonded position) to a stableswap pool.
@@ -42,6 +44,14 @@ type AddLiquidityCommitment struct {
 // RemoveLiquidityCommitment commits to removing liquidity (via an unbonding position) from a stableswap pool.
 type RemoveLiquidityCommitment struct {
 	UnbondingPosition stableswap.UnbondingPosition
+	ExitFee           sdk.Coins
+}
+
+// PositionSettlement details the liquidity returned to a provider of a closed pool.
+type PositionSettlement struct {
+	Address string
+	Shares  math.LegacyDec
+	Amount  sdk.Coins
 }
 
 // ReceiverMulti specifies a recipient and multiple coin amounts for distribution.
@@ -51,10 +61,25 @@ type ReceiverMulti struct {
 	PoolId  uint64
 }
 
+// ReceiverKind specifies how the coins of a Receiver are delivered.
+type ReceiverKind int
+
+const (
+	// ReceiverSend transfers the coins to the receiver address.
+	ReceiverSend ReceiverKind = iota
+	// ReceiverBurn burns the coins through the module account.
+	ReceiverBurn
+	// ReceiverCommunityPool funds the community pool with the coins.
+	ReceiverCommunityPool
+)
+
 // Receiver specifies a recipient and a single coin amount.
 type Receiver struct {
 	Amount  sdk.Coin
 	Address sdk.AccAddress
+	// Name of the fee split the receiver belongs to, empty for the pool accounts.
+	Name string
+	Kind ReceiverKind
 }
 
 // SwapCommitment details a swap, including input/output coins and fee distributions.
@@ -62,6 +87,8 @@ type SwapCommitment struct {
 	In   sdk.Coin
 	Out  sdk.Coin
 	Fees []Receiver
+	// RewardsFee is the rewards fee rate applied to the swap, over the fee denominator.
+	RewardsFee int64
 }
 
 // PlanSwapRoute defines one step of a multi-hop swap, referencing a pool and its swap details.
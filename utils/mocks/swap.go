@@ -56,6 +56,10 @@ func SwapKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 }
 
 func SwapKeeperWithKeepers(t testing.TB, account AccountKeeper, bank BankKeeper) (*keeper.Keeper, sdk.Context) {
+	return SwapKeeperWithFeeConversion(t, account, bank, nil)
+}
+
+func SwapKeeperWithFeeConversion(t testing.TB, account AccountKeeper, bank BankKeeper, feeConversion *modulev1.FeeConversion) (*keeper.Keeper, sdk.Context) {
 	key := storetypes.NewKVStoreKey(types.ModuleName)
 	tkey := storetypes.NewTransientStoreKey("transient_authority")
 	wrapper := testutil.DefaultContextWithDB(t, key, tkey)
@@ -73,9 +77,12 @@ func SwapKeeperWithKeepers(t testing.TB, account AccountKeeper, bank BankKeeper)
 		"uusdn",
 		1e6,
 		0.5e4,
+		100,
 		&modulev1.StableSwap{
-			UnbondingBlockDelta: 10,
+			UnbondingBlockDelta:   10,
+			CompoundingBlockDelta: 20,
 		},
+		feeConversion,
 		address.NewBech32Codec("noble"),
 		account,
 		bank,